@@ -43,6 +43,14 @@ func TestPriorityDecorator(t *testing.T) {
 			initPrio: 50,
 			expected: ante.LivenessTxPriority,
 		},
+		{
+			name: "Batched finality signatures tx",
+			msgs: []sdk.Msg{
+				&ftypes.MsgAddFinalitySigs{},
+			},
+			initPrio: 50,
+			expected: ante.LivenessTxPriority,
+		},
 		{
 			name: "Mixed messages (regular and liveness-related), is not liveness-tx",
 			msgs: []sdk.Msg{
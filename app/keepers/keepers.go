@@ -572,9 +572,15 @@ func (ak *AppKeepers) InitKeepers(
 	)
 
 	// set up finality keeper
+	// the finality keeper queries the committed multistore for finality proofs
+	storeQuerier, ok := bApp.CommitMultiStore().(storetypes.Queryable)
+	if !ok {
+		panic("the commit multistore does not implement storetypes.Queryable")
+	}
 	ak.FinalityKeeper = finalitykeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(keys[finalitytypes.StoreKey]),
+		storeQuerier,
 		ak.BTCStakingKeeper,
 		ak.IncentiveKeeper,
 		ak.CheckpointingKeeper,
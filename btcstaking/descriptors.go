@@ -0,0 +1,638 @@
+package btcstaking
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+)
+
+// Output descriptors of Babylon outputs follow BIP-386 i.e they have the form
+// tr(<unspendable internal key>,<tree>) where every leaf of the tree is
+// expressed in miniscript. Babylon scripts map to miniscript as follows:
+//   - timelock path: and_v(v:pk(Staker_PK),older(Staking_Time_Blocks))
+//   - unbonding path: and_v(v:pk(Staker_PK),multi_a(M,Covenant_PK1,...,Covenant_PKN))
+//   - slashing path: and_v(v:pk(Staker_PK),and_v(v:multi_a(1,FP_PK1,...,FP_PKN),multi_a(M,Covenant_PK1,...,Covenant_PKN)))
+// where single key multisigs are expressed as pk() in the same way as
+// buildMultiSigScript builds single key sig scripts.
+
+const (
+	descriptorInputCharset    = "0123456789()[],'/*abcdefgh@:$%{}IJKLMNOPQRSTUVWXYZ&+-.;<=>?!^_|~ijklmnopqrstuvwxyzABCDEFGH`#\"\\ "
+	descriptorChecksumCharset = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	descriptorChecksumLen     = 8
+)
+
+var descriptorChecksumGenerator = [5]uint64{0xf5dee51989, 0xa9fdca3312, 0x1bab10e32d, 0x3706b1677a, 0x644d626ffd}
+
+func descriptorPolymod(symbols []uint64) uint64 {
+	chk := uint64(1)
+	for _, value := range symbols {
+		top := chk >> 35
+		chk = (chk&0x7ffffffff)<<5 ^ value
+		for i := 0; i < 5; i++ {
+			if (top>>i)&1 == 1 {
+				chk ^= descriptorChecksumGenerator[i]
+			}
+		}
+	}
+	return chk
+}
+
+// DescriptorChecksum returns the BIP-380 checksum of the given descriptor
+// without the checksum part
+func DescriptorChecksum(desc string) (string, error) {
+	var (
+		symbols []uint64
+		groups  []uint64
+	)
+
+	for _, c := range desc {
+		v := strings.IndexRune(descriptorInputCharset, c)
+		if v < 0 {
+			return "", fmt.Errorf("invalid character %q in descriptor", c)
+		}
+
+		symbols = append(symbols, uint64(v&31))
+		groups = append(groups, uint64(v>>5))
+		if len(groups) == 3 {
+			symbols = append(symbols, groups[0]*9+groups[1]*3+groups[2])
+			groups = groups[:0]
+		}
+	}
+
+	switch len(groups) {
+	case 1:
+		symbols = append(symbols, groups[0])
+	case 2:
+		symbols = append(symbols, groups[0]*3+groups[1])
+	}
+
+	symbols = append(symbols, make([]uint64, descriptorChecksumLen)...)
+	checksum := descriptorPolymod(symbols) ^ 1
+
+	result := make([]byte, descriptorChecksumLen)
+	for i := 0; i < descriptorChecksumLen; i++ {
+		result[i] = descriptorChecksumCharset[(checksum>>(5*(7-i)))&31]
+	}
+
+	return string(result), nil
+}
+
+func addDescriptorChecksum(desc string) (string, error) {
+	checksum, err := DescriptorChecksum(desc)
+	if err != nil {
+		return "", err
+	}
+
+	return desc + "#" + checksum, nil
+}
+
+// msNode is a node of the miniscript subset used by Babylon scripts
+type msNode struct {
+	// fragment is one of pk, multi_a, older, and_v
+	fragment string
+	// verify is set when the fragment is wrapped with v:
+	verify    bool
+	xOnlyKeys [][]byte
+	// number is the threshold of multi_a or the lock time of older
+	number int64
+	args   []*msNode
+}
+
+// isVerifyType returns whether the node leaves nothing on the stack
+func (n *msNode) isVerifyType() bool {
+	if n.fragment == "and_v" {
+		return n.args[1].isVerifyType()
+	}
+	return n.verify
+}
+
+// splitTopLevel splits the string on commas which are not nested in any
+// parentheses or braces
+func splitTopLevel(s string) []string {
+	var (
+		parts []string
+		depth int
+		start int
+	)
+
+	for i, c := range s {
+		switch c {
+		case '(', '{':
+			depth++
+		case ')', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+
+	return append(parts, s[start:])
+}
+
+func parseXOnlyKeyHex(keyHex string) ([]byte, error) {
+	key, err := hex.DecodeString(keyHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid key %s: %w", keyHex, err)
+	}
+
+	if _, err := schnorr.ParsePubKey(key); err != nil {
+		return nil, fmt.Errorf("invalid x-only key %s: %w", keyHex, err)
+	}
+
+	return key, nil
+}
+
+// parseMiniscript parses the subset of miniscript used by Babylon scripts
+func parseMiniscript(ms string) (*msNode, error) {
+	node := &msNode{}
+
+	open := strings.IndexByte(ms, '(')
+	if open < 0 || !strings.HasSuffix(ms, ")") {
+		return nil, fmt.Errorf("invalid miniscript fragment: %s", ms)
+	}
+
+	name := ms[:open]
+	if wrappers, fragment, found := strings.Cut(name, ":"); found {
+		if wrappers != "v" {
+			return nil, fmt.Errorf("unsupported miniscript wrappers: %s", wrappers)
+		}
+		node.verify = true
+		name = fragment
+	}
+	node.fragment = name
+
+	args := splitTopLevel(ms[open+1 : len(ms)-1])
+
+	switch name {
+	case "pk":
+		if len(args) != 1 {
+			return nil, fmt.Errorf("pk expects exactly one key")
+		}
+		key, err := parseXOnlyKeyHex(args[0])
+		if err != nil {
+			return nil, err
+		}
+		node.xOnlyKeys = [][]byte{key}
+	case "multi_a":
+		if len(args) < 2 {
+			return nil, fmt.Errorf("multi_a expects threshold and at least one key")
+		}
+		threshold, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid multi_a threshold: %w", err)
+		}
+		if threshold < 1 || threshold > int64(len(args)-1) {
+			return nil, fmt.Errorf("invalid multi_a threshold %d for %d keys", threshold, len(args)-1)
+		}
+		node.number = threshold
+		for _, arg := range args[1:] {
+			key, err := parseXOnlyKeyHex(arg)
+			if err != nil {
+				return nil, err
+			}
+			node.xOnlyKeys = append(node.xOnlyKeys, key)
+		}
+	case "older":
+		if node.verify {
+			return nil, fmt.Errorf("v:older is not supported")
+		}
+		if len(args) != 1 {
+			return nil, fmt.Errorf("older expects exactly one argument")
+		}
+		lockTime, err := strconv.ParseInt(args[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid older argument: %w", err)
+		}
+		if lockTime < 1 || lockTime >= 1<<31 {
+			return nil, fmt.Errorf("invalid older argument: %d", lockTime)
+		}
+		node.number = lockTime
+	case "and_v":
+		if node.verify {
+			return nil, fmt.Errorf("v:and_v is not supported")
+		}
+		if len(args) != 2 {
+			return nil, fmt.Errorf("and_v expects exactly two arguments")
+		}
+		for _, arg := range args {
+			child, err := parseMiniscript(arg)
+			if err != nil {
+				return nil, err
+			}
+			node.args = append(node.args, child)
+		}
+		if !node.args[0].isVerifyType() {
+			return nil, fmt.Errorf("first argument of and_v must be of verify type")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported miniscript fragment: %s", name)
+	}
+
+	return node, nil
+}
+
+func (n *msNode) compile(builder *txscript.ScriptBuilder) {
+	switch n.fragment {
+	case "pk":
+		builder.AddData(n.xOnlyKeys[0])
+		if n.verify {
+			builder.AddOp(txscript.OP_CHECKSIGVERIFY)
+		} else {
+			builder.AddOp(txscript.OP_CHECKSIG)
+		}
+	case "multi_a":
+		for i, key := range n.xOnlyKeys {
+			builder.AddData(key)
+			if i == 0 {
+				builder.AddOp(txscript.OP_CHECKSIG)
+			} else {
+				builder.AddOp(txscript.OP_CHECKSIGADD)
+			}
+		}
+		builder.AddInt64(n.number)
+		if n.verify {
+			builder.AddOp(txscript.OP_NUMEQUALVERIFY)
+		} else {
+			builder.AddOp(txscript.OP_NUMEQUAL)
+		}
+	case "older":
+		builder.AddInt64(n.number)
+		builder.AddOp(txscript.OP_CHECKSEQUENCEVERIFY)
+	case "and_v":
+		n.args[0].compile(builder)
+		n.args[1].compile(builder)
+	}
+}
+
+// miniscriptToScript compiles the subset of miniscript used by Babylon scripts
+// to tapscript
+func miniscriptToScript(ms string) ([]byte, error) {
+	node, err := parseMiniscript(ms)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := txscript.NewScriptBuilder()
+	node.compile(builder)
+	return builder.Script()
+}
+
+// scriptToMiniscript returns miniscript of the given Babylon script
+func scriptToMiniscript(script []byte) (string, error) {
+	var ms string
+
+	if stakerKey, lockTime, err := parseTimeLockScript(script); err == nil {
+		ms = fmt.Sprintf(
+			"and_v(v:pk(%s),older(%d))",
+			hex.EncodeToString(schnorr.SerializePubKey(stakerKey)),
+			lockTime,
+		)
+	} else {
+		groups, err := scriptSigGroups(script)
+		if err != nil {
+			return "", err
+		}
+
+		if len(groups) == 0 {
+			return "", fmt.Errorf("script does not contain any keys")
+		}
+
+		fragments := make([]string, len(groups))
+		for i, g := range groups {
+			keys := make([]string, len(g.xOnlyKeys))
+			for j, k := range g.xOnlyKeys {
+				keys[j] = hex.EncodeToString(k)
+			}
+
+			if len(keys) == 1 {
+				fragments[i] = fmt.Sprintf("pk(%s)", keys[0])
+			} else {
+				fragments[i] = fmt.Sprintf("multi_a(%d,%s)", g.threshold, strings.Join(keys, ","))
+			}
+
+			if g.isVerify {
+				fragments[i] = "v:" + fragments[i]
+			}
+		}
+
+		ms = fragments[len(fragments)-1]
+		for i := len(fragments) - 2; i >= 0; i-- {
+			ms = fmt.Sprintf("and_v(%s,%s)", fragments[i], ms)
+		}
+	}
+
+	// ensure that miniscript describes exactly the given script
+	compiled, err := miniscriptToScript(ms)
+	if err != nil {
+		return "", err
+	}
+
+	if !bytes.Equal(compiled, script) {
+		return "", fmt.Errorf("script cannot be expressed in miniscript")
+	}
+
+	return ms, nil
+}
+
+// Miniscript returns the miniscript of the revealed script
+func (si *SpendInfo) Miniscript() (string, error) {
+	return scriptToMiniscript(si.GetPkScriptPath())
+}
+
+// tapTreeDescriptor returns the BIP-386 tree expression of the given taproot tree
+func tapTreeDescriptor(node txscript.TapNode) (string, error) {
+	if leaf, ok := node.(txscript.TapLeaf); ok {
+		return scriptToMiniscript(leaf.Script)
+	}
+
+	if node.Left() == nil || node.Right() == nil {
+		return "", fmt.Errorf("invalid taproot script tree")
+	}
+
+	left, err := tapTreeDescriptor(node.Left())
+	if err != nil {
+		return "", err
+	}
+
+	right, err := tapTreeDescriptor(node.Right())
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("{%s,%s}", left, right), nil
+}
+
+func (t *taprootScriptHolder) descriptor() (string, error) {
+	tree, err := tapTreeDescriptor(t.scriptTree.RootNode)
+	if err != nil {
+		return "", err
+	}
+
+	return addDescriptorChecksum(fmt.Sprintf(
+		"tr(%s,%s)",
+		hex.EncodeToString(schnorr.SerializePubKey(t.internalPubKey)),
+		tree,
+	))
+}
+
+// Descriptor returns the BIP-386 output descriptor of the staking output
+// together with its checksum
+func (i *StakingInfo) Descriptor() (string, error) {
+	return i.scriptHolder.descriptor()
+}
+
+// Descriptor returns the BIP-386 output descriptor of the unbonding output
+// together with its checksum
+func (i *UnbondingInfo) Descriptor() (string, error) {
+	return i.scriptHolder.descriptor()
+}
+
+// parseTapTreeDescriptor parses the BIP-386 tree expression and returns the
+// tree together with the scripts of its leaves in depth-first order
+func parseTapTreeDescriptor(tree string) (txscript.TapNode, [][]byte, error) {
+	if strings.HasPrefix(tree, "{") {
+		if !strings.HasSuffix(tree, "}") {
+			return nil, nil, fmt.Errorf("invalid tree expression: %s", tree)
+		}
+
+		children := splitTopLevel(tree[1 : len(tree)-1])
+		if len(children) != 2 {
+			return nil, nil, fmt.Errorf("tree branch must have exactly two children")
+		}
+
+		left, leftScripts, err := parseTapTreeDescriptor(children[0])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		right, rightScripts, err := parseTapTreeDescriptor(children[1])
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return txscript.NewTapBranch(left, right), append(leftScripts, rightScripts...), nil
+	}
+
+	script, err := miniscriptToScript(tree)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return txscript.NewBaseTapLeaf(script), [][]byte{script}, nil
+}
+
+// parseBabylonDescriptor parses the BIP-386 descriptor of one of Babylon outputs
+// and returns the parsed leaf scripts together with the pk script of the
+// output described by the descriptor. Checksum is verified if present.
+func parseBabylonDescriptor(descriptor string) ([]*ScriptPathSpend, []byte, error) {
+	desc, checksum, hasChecksum := strings.Cut(descriptor, "#")
+	if hasChecksum {
+		expectedChecksum, err := DescriptorChecksum(desc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if checksum != expectedChecksum {
+			return nil, nil, fmt.Errorf("invalid descriptor checksum: %s, expected: %s", checksum, expectedChecksum)
+		}
+	}
+
+	if !strings.HasPrefix(desc, "tr(") || !strings.HasSuffix(desc, ")") {
+		return nil, nil, fmt.Errorf("descriptor must be a tr() descriptor")
+	}
+
+	args := splitTopLevel(desc[len("tr(") : len(desc)-1])
+	if len(args) != 2 {
+		return nil, nil, fmt.Errorf("descriptor must have an internal key and a script tree")
+	}
+
+	internalKey, err := hex.DecodeString(args[0])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid internal key: %w", err)
+	}
+
+	if !bytes.Equal(internalKey, schnorr.SerializePubKey(&unspendableKeyPathKey)) {
+		return nil, nil, fmt.Errorf("internal key is not Babylon unspendable key")
+	}
+
+	root, scripts, err := parseTapTreeDescriptor(args[1])
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaves := make([]*ScriptPathSpend, len(scripts))
+	for i, script := range scripts {
+		leaves[i], err = parseBabylonScript(script)
+		if err != nil {
+			return nil, nil, fmt.Errorf("leaf %d is not a Babylon script: %w", i, err)
+		}
+	}
+
+	rootHash := root.TapHash()
+	outputKey := txscript.ComputeTaprootOutputKey(&unspendableKeyPathKey, rootHash[:])
+	pkScript, err := txscript.PayToTaprootScript(outputKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return leaves, pkScript, nil
+}
+
+// findLeaf returns the only leaf with the given spend path
+func findLeaf(leaves []*ScriptPathSpend, path SpendPathType) (*ScriptPathSpend, error) {
+	var found *ScriptPathSpend
+	for _, leaf := range leaves {
+		if leaf.Path != path {
+			continue
+		}
+		if found != nil {
+			return nil, fmt.Errorf("descriptor contains more than one %s script", path)
+		}
+		found = leaf
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("descriptor does not contain %s script", path)
+	}
+
+	return found, nil
+}
+
+// commitsToAggregatedCovenant returns whether the unbonding leaf commits to the
+// MuSig2 aggregated key of the covenant committee of the slashing leaf rather
+// than to the covenant multisig. The covenant keys revealed in the slashing
+// leaf are x-only, so they are aggregated as BIP-340 keys, in the same way as
+// the covenant keys of Babylon params.
+func commitsToAggregatedCovenant(unbondingLeaf, slashingLeaf *ScriptPathSpend) bool {
+	if len(unbondingLeaf.CovenantKeys) != 1 || unbondingLeaf.CovenantQuorum != 1 {
+		return false
+	}
+
+	aggKey, err := AggregateCovenantKeys(slashingLeaf.CovenantKeys)
+	if err != nil {
+		return false
+	}
+
+	// leaf scripts commit to x-only keys
+	return bytes.Equal(schnorr.SerializePubKey(aggKey), schnorr.SerializePubKey(unbondingLeaf.CovenantKeys[0]))
+}
+
+// ParseStakingDescriptor parses the BIP-386 descriptor of the staking output
+// and returns the staking info committing to the same scripts. As descriptors
+// do not commit to amounts, the staking amount must be provided. Staking
+// outputs whose unbonding path commits to the MuSig2 aggregated covenant key
+// are recognized as well. Descriptors of outputs which are not Babylon
+// staking outputs are rejected.
+func ParseStakingDescriptor(
+	descriptor string,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*StakingInfo, error) {
+	leaves, pkScript, err := parseBabylonDescriptor(descriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leaves) != 3 {
+		return nil, fmt.Errorf("staking output descriptor must have 3 leaves, got %d", len(leaves))
+	}
+
+	timeLockLeaf, err := findLeaf(leaves, SpendPathTypeTimeLock)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingLeaf, err := findLeaf(leaves, SpendPathTypeUnbonding)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingLeaf, err := findLeaf(leaves, SpendPathTypeSlashing)
+	if err != nil {
+		return nil, err
+	}
+
+	buildStakingInfo := BuildStakingInfo
+	if commitsToAggregatedCovenant(unbondingLeaf, slashingLeaf) {
+		buildStakingInfo = BuildStakingInfoWithAggregatedCovenant
+	}
+
+	info, err := buildStakingInfo(
+		slashingLeaf.StakerKey,
+		slashingLeaf.FinalityProviderKeys,
+		slashingLeaf.CovenantKeys,
+		slashingLeaf.CovenantQuorum,
+		timeLockLeaf.LockTime,
+		stakingAmount,
+		net,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the output committing to the parsed scripts must be the same as the
+	// output described by descriptor, which ensures all leaves are consistent
+	if !bytes.Equal(info.GetPkScript(), pkScript) {
+		return nil, fmt.Errorf("descriptor does not describe Babylon staking output")
+	}
+
+	return info, nil
+}
+
+// ParseUnbondingDescriptor parses the BIP-386 descriptor of the unbonding output
+// and returns the unbonding info committing to the same scripts. As descriptors
+// do not commit to amounts, the unbonding amount must be provided. Descriptors
+// of outputs which are not Babylon unbonding outputs are rejected.
+func ParseUnbondingDescriptor(
+	descriptor string,
+	unbondingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*UnbondingInfo, error) {
+	leaves, pkScript, err := parseBabylonDescriptor(descriptor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(leaves) != 2 {
+		return nil, fmt.Errorf("unbonding output descriptor must have 2 leaves, got %d", len(leaves))
+	}
+
+	timeLockLeaf, err := findLeaf(leaves, SpendPathTypeTimeLock)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingLeaf, err := findLeaf(leaves, SpendPathTypeSlashing)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := BuildUnbondingInfo(
+		slashingLeaf.StakerKey,
+		slashingLeaf.FinalityProviderKeys,
+		slashingLeaf.CovenantKeys,
+		slashingLeaf.CovenantQuorum,
+		timeLockLeaf.LockTime,
+		unbondingAmount,
+		net,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(info.UnbondingOutput.PkScript, pkScript) {
+		return nil, fmt.Errorf("descriptor does not describe Babylon unbonding output")
+	}
+
+	return info, nil
+}
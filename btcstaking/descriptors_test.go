@@ -0,0 +1,254 @@
+package btcstaking_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+)
+
+func TestDescriptorChecksum(t *testing.T) {
+	// test vectors from BIP-380
+	checksum, err := btcstaking.DescriptorChecksum("raw(deadbeef)")
+	require.NoError(t, err)
+	require.Equal(t, "89f8spxm", checksum)
+
+	checksum, err = btcstaking.DescriptorChecksum("addr(mkmZxiEcEd8ZqjQWVZuC6so5dFMKEFpN2j)")
+	require.NoError(t, err)
+	require.Equal(t, "02wpgw69", checksum)
+
+	_, err = btcstaking.DescriptorChecksum("raw(deadbeef)\n")
+	require.Error(t, err)
+}
+
+func FuzzStakingDescriptorRoundTrip(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numFps := uint32(r.Intn(3) + 1)
+		numCovenants := uint32(r.Intn(9) + 1)
+		quorum := uint32(r.Intn(int(numCovenants)) + 1)
+		stakingTime := uint16(r.Intn(60000) + 1)
+		scenario := GenerateTestScenario(r, t, numFps, numCovenants, quorum, btcutil.Amount(2*10e8), stakingTime)
+
+		stakingInfo, err := btcstaking.BuildStakingInfo(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			scenario.CovenantPublicKeys(),
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+
+		descriptor, err := stakingInfo.Descriptor()
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(descriptor, "tr(50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0,{{"))
+
+		parsed, err := btcstaking.ParseStakingDescriptor(descriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, stakingInfo.StakingOutput, parsed.StakingOutput)
+
+		// descriptor without checksum is accepted as well
+		withoutChecksum, _, _ := strings.Cut(descriptor, "#")
+		parsed, err = btcstaking.ParseStakingDescriptor(withoutChecksum, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, stakingInfo.StakingOutput, parsed.StakingOutput)
+
+		// spend info of parsed staking info is the same
+		expectedSi, err := stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		parsedSi, err := parsed.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		require.Equal(t, expectedSi.GetPkScriptPath(), parsedSi.GetPkScriptPath())
+
+		// unbonding output descriptor
+		unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			scenario.CovenantPublicKeys(),
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+
+		unbondingDescriptor, err := unbondingInfo.Descriptor()
+		require.NoError(t, err)
+
+		parsedUnbonding, err := btcstaking.ParseUnbondingDescriptor(unbondingDescriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, unbondingInfo.UnbondingOutput, parsedUnbonding.UnbondingOutput)
+
+		// staking and unbonding descriptors are not interchangeable
+		_, err = btcstaking.ParseStakingDescriptor(unbondingDescriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.Error(t, err)
+		_, err = btcstaking.ParseUnbondingDescriptor(descriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.Error(t, err)
+
+		// corrupted checksum is rejected
+		corrupted := withoutChecksum + "#" + strings.Repeat("q", 8)
+		_, err = btcstaking.ParseStakingDescriptor(corrupted, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.Error(t, err)
+	})
+}
+
+func FuzzAggregatedCovenantStakingDescriptorRoundTrip(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numFps := uint32(r.Intn(3) + 1)
+		numCovenants := uint32(r.Intn(9) + 1)
+		quorum := uint32(r.Intn(int(numCovenants)) + 1)
+		stakingTime := uint16(r.Intn(60000) + 1)
+		scenario := GenerateTestScenario(r, t, numFps, numCovenants, quorum, btcutil.Amount(2*10e8), stakingTime)
+		// covenant keys of Babylon params are BIP-340 keys
+		covenantKeys := toXOnlyKeys(t, scenario.CovenantPublicKeys())
+
+		stakingInfo, err := btcstaking.BuildStakingInfoWithAggregatedCovenant(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			covenantKeys,
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+
+		descriptor, err := stakingInfo.Descriptor()
+		require.NoError(t, err)
+
+		parsed, err := btcstaking.ParseStakingDescriptor(descriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.NoError(t, err)
+		require.Equal(t, stakingInfo.StakingOutput, parsed.StakingOutput)
+
+		// unbonding path of parsed staking info commits to the aggregated key
+		expectedSi, err := stakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+		parsedSi, err := parsed.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+		require.Equal(t, expectedSi.GetPkScriptPath(), parsedSi.GetPkScriptPath())
+
+		reencoded, err := parsed.Descriptor()
+		require.NoError(t, err)
+		require.Equal(t, descriptor, reencoded)
+
+		// aggregated key of other covenant committee is rejected
+		otherCovenantKeys := append(covenantKeys[1:], toXOnlyKeys(t, []*btcec.PublicKey{scenario.StakerKey.PubKey()})...)
+		otherInfo, err := btcstaking.BuildStakingInfoWithAggregatedCovenant(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			otherCovenantKeys,
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		if err != nil {
+			// staker key cannot be a covenant key
+			return
+		}
+		otherDescriptor, err := otherInfo.Descriptor()
+		require.NoError(t, err)
+		_, err = btcstaking.ParseStakingDescriptor(otherDescriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+		require.Error(t, err)
+	})
+}
+
+// toXOnlyKeys returns the given keys with even y coordinate, as BIP-340 keys
+func toXOnlyKeys(t *testing.T, keys []*btcec.PublicKey) []*btcec.PublicKey {
+	xOnlyKeys := make([]*btcec.PublicKey, len(keys))
+	for i, key := range keys {
+		xOnlyKey, err := schnorr.ParsePubKey(schnorr.SerializePubKey(key))
+		require.NoError(t, err)
+		xOnlyKeys[i] = xOnlyKey
+	}
+	return xOnlyKeys
+}
+
+func TestSpendInfoMiniscript(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	scenario := GenerateTestScenario(r, t, 1, 3, 2, btcutil.Amount(2*10e8), 1008)
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	stakerKey := hex.EncodeToString(schnorr.SerializePubKey(scenario.StakerKey.PubKey()))
+	fpKey := hex.EncodeToString(schnorr.SerializePubKey(scenario.FinalityProviderKeys[0].PubKey()))
+	sortedCovenantKeys := btcstaking.SortKeys(scenario.CovenantPublicKeys())
+	covenantKeys := make([]string, len(sortedCovenantKeys))
+	for i, k := range sortedCovenantKeys {
+		covenantKeys[i] = hex.EncodeToString(schnorr.SerializePubKey(k))
+	}
+	covenantMultisig := fmt.Sprintf("multi_a(2,%s)", strings.Join(covenantKeys, ","))
+
+	si, err := stakingInfo.TimeLockPathSpendInfo()
+	require.NoError(t, err)
+	ms, err := si.Miniscript()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("and_v(v:pk(%s),older(1008))", stakerKey), ms)
+
+	si, err = stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+	ms, err = si.Miniscript()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("and_v(v:pk(%s),%s)", stakerKey, covenantMultisig), ms)
+
+	si, err = stakingInfo.SlashingPathSpendInfo()
+	require.NoError(t, err)
+	ms, err = si.Miniscript()
+	require.NoError(t, err)
+	require.Equal(t, fmt.Sprintf("and_v(v:pk(%s),and_v(v:pk(%s),%s))", stakerKey, fpKey, covenantMultisig), ms)
+}
+
+func TestParseInvalidStakingDescriptor(t *testing.T) {
+	r := rand.New(rand.NewSource(11))
+	scenario := GenerateTestScenario(r, t, 2, 3, 2, btcutil.Amount(2*10e8), 1008)
+	stakerKey := hex.EncodeToString(schnorr.SerializePubKey(scenario.StakerKey.PubKey()))
+	timeLockLeaf := fmt.Sprintf("and_v(v:pk(%s),older(1008))", stakerKey)
+
+	testCases := []struct {
+		name       string
+		descriptor string
+	}{
+		{"not a tr descriptor", fmt.Sprintf("wsh(%s)", timeLockLeaf)},
+		{"internal key is not unspendable", fmt.Sprintf("tr(%s,%s)", stakerKey, timeLockLeaf)},
+		{"key path only", "tr(50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0)"},
+		{"too few leaves", fmt.Sprintf("tr(50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0,%s)", timeLockLeaf)},
+		{"unsupported fragment", "tr(50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0,{{after(10),after(11)},after(12)})"},
+		{"and_v with non verify first argument", fmt.Sprintf(
+			"tr(50929b74c1a04954b78b4b6035e97a5e078a5a0f28ec96d547bfee9ace803ac0,{{and_v(pk(%s),older(10)),%s},%s})",
+			stakerKey, timeLockLeaf, timeLockLeaf,
+		)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := btcstaking.ParseStakingDescriptor(tc.descriptor, scenario.StakingAmount, &chaincfg.MainNetParams)
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package btcstaking
+
+import (
+	"fmt"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TxSizeEstimate contains the size of a transaction spending a Babylon output
+// through one of its script paths
+type TxSizeEstimate struct {
+	// WitnessSize is the serialized size of the spending input witness in bytes
+	WitnessSize int64
+	// Weight is the weight of the whole transaction in weight units
+	Weight int64
+	// VSize is the virtual size of the whole transaction in vbytes
+	VSize int64
+}
+
+// Fee returns the fee required by the transaction at the given fee rate
+// expressed in satoshis per virtual byte
+func (e *TxSizeEstimate) Fee(satPerVByte btcutil.Amount) btcutil.Amount {
+	return btcutil.Amount(e.VSize) * satPerVByte
+}
+
+// placeholderWitness builds a witness of the same size as the witness spending
+// through the script path of the given spend info. Every signature check group
+// of the script receives exactly threshold signatures, the remaining keys
+// receive empty elements, as it happens in CreateUnbondingPathWitness and
+// CreateSlashingPathWitness.
+func placeholderWitness(si *SpendInfo) (wire.TxWitness, error) {
+	groups, err := scriptSigGroups(si.GetPkScriptPath())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, fmt.Errorf("revealed script does not contain any keys")
+	}
+
+	placeholderSig := make([]byte, schnorr.SignatureSize)
+
+	var sigs [][]byte
+	for _, g := range groups {
+		for i := range g.xOnlyKeys {
+			if i < g.threshold {
+				sigs = append(sigs, placeholderSig)
+			} else {
+				sigs = append(sigs, []byte{})
+			}
+		}
+	}
+
+	return CreateWitness(si, sigs)
+}
+
+// WitnessSize returns the serialized size in bytes of the witness spending
+// through the script path of the given spend info
+func (si *SpendInfo) WitnessSize() (int64, error) {
+	witness, err := placeholderWitness(si)
+	if err != nil {
+		return 0, err
+	}
+
+	return int64(witness.SerializeSize()), nil
+}
+
+// EstimateScriptPathSpendTxSize returns the exact size of a transaction with
+// a single input spending through the script path of the given spend info
+// and the given outputs
+func EstimateScriptPathSpendTxSize(
+	si *SpendInfo,
+	outputs []*wire.TxOut,
+) (*TxSizeEstimate, error) {
+	if si == nil {
+		return nil, fmt.Errorf("spend info must not be nil")
+	}
+
+	if len(outputs) == 0 {
+		return nil, fmt.Errorf("spending tx must have at least one output")
+	}
+
+	witness, err := placeholderWitness(si)
+	if err != nil {
+		return nil, err
+	}
+
+	tx := wire.NewMsgTx(wire.TxVersion)
+	tx.AddTxIn(wire.NewTxIn(&wire.OutPoint{}, nil, witness))
+	for _, out := range outputs {
+		if out == nil {
+			return nil, fmt.Errorf("output must not be nil")
+		}
+		tx.AddTxOut(out)
+	}
+
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+
+	return &TxSizeEstimate{
+		WitnessSize: int64(witness.SerializeSize()),
+		Weight:      weight,
+		VSize:       (weight + blockchain.WitnessScaleFactor - 1) / blockchain.WitnessScaleFactor,
+	}, nil
+}
+
+// StakingSpendTxSizes contains the sizes of the transactions spending
+// the staking output through each of its script paths
+type StakingSpendTxSizes struct {
+	// TimeLockPath is the size of the withdrawal tx with one taproot output
+	TimeLockPath *TxSizeEstimate
+	// UnbondingPath is the size of the unbonding tx
+	UnbondingPath *TxSizeEstimate
+	// SlashingPath is the size of the slashing tx
+	SlashingPath *TxSizeEstimate
+}
+
+// UnbondingSpendTxSizes contains the sizes of the transactions spending
+// the unbonding output through each of its script paths
+type UnbondingSpendTxSizes struct {
+	// TimeLockPath is the size of the withdrawal tx with one taproot output
+	TimeLockPath *TxSizeEstimate
+	// SlashingPath is the size of the slashing tx
+	SlashingPath *TxSizeEstimate
+}
+
+// slashingTxOutputs returns outputs of the same size as the outputs of the
+// slashing tx i.e the slashing output and the taproot change output
+func slashingTxOutputs(slashingPkScript []byte, taprootPkScript []byte) []*wire.TxOut {
+	return []*wire.TxOut{
+		wire.NewTxOut(0, slashingPkScript),
+		wire.NewTxOut(0, taprootPkScript),
+	}
+}
+
+// EstimateSpendTxSizes returns the sizes of transactions spending the staking
+// output through each of its script paths. The covenant quorum and the number of
+// finality providers are the ones committed to in the staking scripts.
+// `slashingPkScript` is the pk script of the slashing output, as in Babylon params.
+// Withdrawal, unbonding and slashing change outputs are assumed to be taproot outputs.
+func (i *StakingInfo) EstimateSpendTxSizes(slashingPkScript []byte) (*StakingSpendTxSizes, error) {
+	if len(slashingPkScript) == 0 {
+		return nil, fmt.Errorf("slashing pk script must not be empty")
+	}
+
+	// every babylon output is a taproot output, so the staking output pk script
+	// has the same size as all taproot outputs
+	taprootPkScript := i.GetPkScript()
+
+	timeLockSpendInfo, err := i.TimeLockPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingSpendInfo, err := i.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	slashingSpendInfo, err := i.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	timeLockSize, err := EstimateScriptPathSpendTxSize(
+		timeLockSpendInfo, []*wire.TxOut{wire.NewTxOut(0, taprootPkScript)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	unbondingSize, err := EstimateScriptPathSpendTxSize(
+		unbondingSpendInfo, []*wire.TxOut{wire.NewTxOut(0, taprootPkScript)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingSize, err := EstimateScriptPathSpendTxSize(
+		slashingSpendInfo, slashingTxOutputs(slashingPkScript, taprootPkScript),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StakingSpendTxSizes{
+		TimeLockPath:  timeLockSize,
+		UnbondingPath: unbondingSize,
+		SlashingPath:  slashingSize,
+	}, nil
+}
+
+// EstimateSpendTxSizes returns the sizes of transactions spending the unbonding
+// output through each of its script paths. The covenant quorum and the number of
+// finality providers are the ones committed to in the unbonding scripts.
+// `slashingPkScript` is the pk script of the slashing output, as in Babylon params.
+// Withdrawal and slashing change outputs are assumed to be taproot outputs.
+func (i *UnbondingInfo) EstimateSpendTxSizes(slashingPkScript []byte) (*UnbondingSpendTxSizes, error) {
+	if len(slashingPkScript) == 0 {
+		return nil, fmt.Errorf("slashing pk script must not be empty")
+	}
+
+	taprootPkScript := i.UnbondingOutput.PkScript
+
+	timeLockSpendInfo, err := i.TimeLockPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	slashingSpendInfo, err := i.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	timeLockSize, err := EstimateScriptPathSpendTxSize(
+		timeLockSpendInfo, []*wire.TxOut{wire.NewTxOut(0, taprootPkScript)},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	slashingSize, err := EstimateScriptPathSpendTxSize(
+		slashingSpendInfo, slashingTxOutputs(slashingPkScript, taprootPkScript),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &UnbondingSpendTxSizes{
+		TimeLockPath: timeLockSize,
+		SlashingPath: slashingSize,
+	}, nil
+}
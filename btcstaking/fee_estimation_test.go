@@ -0,0 +1,170 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// requireEstimateMatchesTx checks that the estimate matches the size of the given signed tx
+func requireEstimateMatchesTx(t *testing.T, estimate *btcstaking.TxSizeEstimate, tx *wire.MsgTx) {
+	weight := blockchain.GetTransactionWeight(btcutil.NewTx(tx))
+	require.Equal(t, int64(tx.TxIn[0].Witness.SerializeSize()), estimate.WitnessSize)
+	require.Equal(t, weight, estimate.Weight)
+	require.Equal(t, mempoolVSize(tx), estimate.VSize)
+}
+
+func mempoolVSize(tx *wire.MsgTx) int64 {
+	return (blockchain.GetTransactionWeight(btcutil.NewTx(tx)) + 3) / 4
+}
+
+func FuzzEstimateSpendTxSizes(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numFps := uint32(r.Intn(3) + 1)
+		numCovenants := uint32(r.Intn(9) + 1)
+		quorum := uint32(r.Intn(int(numCovenants)) + 1)
+		stakingTime := uint16(r.Intn(60000) + 1)
+
+		scenario := GenerateTestScenario(r, t, numFps, numCovenants, quorum, btcutil.Amount(2*10e8), stakingTime)
+
+		stakingInfo, err := btcstaking.BuildStakingInfo(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			scenario.CovenantPublicKeys(),
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+
+		slashingAddress, err := genRandomBTCAddress(r)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+
+		sizes, err := stakingInfo.EstimateSpendTxSizes(slashingPkScript)
+		require.NoError(t, err)
+
+		// timelock path
+		withdrawalTx := createSpendStakeTx(scenario.StakingAmount / 2)
+		withdrawalTx.TxOut[0].PkScript = stakingInfo.GetPkScript()
+		si, err := stakingInfo.TimeLockPathSpendInfo()
+		require.NoError(t, err)
+		sig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+			withdrawalTx, stakingInfo.StakingOutput, scenario.StakerKey, si.RevealedLeaf,
+		)
+		require.NoError(t, err)
+		withdrawalTx.TxIn[0].Witness, err = si.CreateTimeLockPathWitness(sig)
+		require.NoError(t, err)
+		requireEstimateMatchesTx(t, sizes.TimeLockPath, withdrawalTx)
+
+		// unbonding path
+		unbondingTx := createSpendStakeTx(scenario.StakingAmount / 2)
+		unbondingTx.TxOut[0].PkScript = stakingInfo.GetPkScript()
+		si, err = stakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+		stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+			unbondingTx, stakingInfo.StakingOutput, scenario.StakerKey, si.RevealedLeaf,
+		)
+		require.NoError(t, err)
+		covSigs := datagen.GenerateSignatures(t, scenario.CovenantKeys, unbondingTx, stakingInfo.StakingOutput, si.RevealedLeaf)
+		for i := 0; i < int(numCovenants-quorum); i++ {
+			covSigs[i] = nil
+		}
+		unbondingTx.TxIn[0].Witness, err = si.CreateUnbondingPathWitness(covSigs, stakerSig)
+		require.NoError(t, err)
+		requireEstimateMatchesTx(t, sizes.UnbondingPath, unbondingTx)
+
+		// slashing path
+		slashingTx := createSpendStakeTx(scenario.StakingAmount / 2)
+		slashingTx.TxOut[0].PkScript = slashingPkScript
+		slashingTx.AddTxOut(wire.NewTxOut(int64(scenario.StakingAmount/4), stakingInfo.GetPkScript()))
+		si, err = stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		stakerSig, err = btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+			slashingTx, stakingInfo.StakingOutput, scenario.StakerKey, si.RevealedLeaf,
+		)
+		require.NoError(t, err)
+		covSigs = datagen.GenerateSignatures(t, scenario.CovenantKeys, slashingTx, stakingInfo.StakingOutput, si.RevealedLeaf)
+		for i := 0; i < int(numCovenants-quorum); i++ {
+			covSigs[i] = nil
+		}
+		fpSigs := datagen.GenerateSignatures(t, scenario.FinalityProviderKeys, slashingTx, stakingInfo.StakingOutput, si.RevealedLeaf)
+		for i := 1; i < len(fpSigs); i++ {
+			fpSigs[i] = nil
+		}
+		slashingTx.TxIn[0].Witness, err = si.CreateSlashingPathWitness(covSigs, fpSigs, stakerSig)
+		require.NoError(t, err)
+		requireEstimateMatchesTx(t, sizes.SlashingPath, slashingTx)
+	})
+}
+
+func TestEstimateUnbondingSpendTxSizes(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 9, 6, btcutil.Amount(2*10e8), 1008)
+
+	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	slashingAddress, err := genRandomBTCAddress(r)
+	require.NoError(t, err)
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	require.NoError(t, err)
+
+	sizes, err := unbondingInfo.EstimateSpendTxSizes(slashingPkScript)
+	require.NoError(t, err)
+
+	// witness: 6 signatures, 3 empty covenant elements, 1 empty finality provider
+	// element is not present as there is only one finality provider, staker signature,
+	// script and control block
+	si, err := unbondingInfo.SlashingPathSpendInfo()
+	require.NoError(t, err)
+	controlBlock, err := si.ControlBlock.ToBytes()
+	require.NoError(t, err)
+	script := si.GetPkScriptPath()
+	expectedWitnessSize := 1 + // number of elements
+		8*(1+schnorr.SignatureSize) + // 6 covenant, 1 fp and 1 staker signatures
+		3 + // empty covenant signatures
+		wire.VarIntSerializeSize(uint64(len(script))) + len(script) +
+		1 + len(controlBlock)
+	require.Equal(t, int64(expectedWitnessSize), sizes.SlashingPath.WitnessSize)
+	require.Equal(t, btcutil.Amount(sizes.SlashingPath.VSize*10), sizes.SlashingPath.Fee(10))
+
+	witnessSize, err := si.WitnessSize()
+	require.NoError(t, err)
+	require.Equal(t, sizes.SlashingPath.WitnessSize, witnessSize)
+
+	// timelock path witness has only the staker signature
+	si, err = unbondingInfo.TimeLockPathSpendInfo()
+	require.NoError(t, err)
+	controlBlock, err = si.ControlBlock.ToBytes()
+	require.NoError(t, err)
+	script = si.GetPkScriptPath()
+	expectedWitnessSize = 1 + 1 + schnorr.SignatureSize + 1 + len(script) + 1 + len(controlBlock)
+	require.Equal(t, int64(expectedWitnessSize), sizes.TimeLockPath.WitnessSize)
+
+	_, err = unbondingInfo.EstimateSpendTxSizes(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,573 @@
+package btcstaking
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+const (
+	// V1OpReturnVersion is the version byte of V1 op_return data
+	V1OpReturnVersion byte = 1
+	// v1OpReturnFixedSize 4 bytes tag + 1 byte version + 32 bytes staker public key +
+	// 2 bytes staking time + 1 byte number of finality provider keys
+	v1OpReturnFixedSize = TagLen + 1 + schnorr.PubKeyBytesLen + 2 + 1
+	// MaxV1OpReturnDataSize is the maximum size of V1 op_return data i.e the maximum
+	// size of a single data push. Note that op_return data larger than 80 bytes,
+	// which is the case for more than one finality provider key, is only relayed by
+	// nodes with data carrier size policy allowing it.
+	MaxV1OpReturnDataSize = txscript.MaxScriptElementSize
+	// maxTLVValueLen is the maximum length of a single TLV field value
+	maxTLVValueLen = 255
+
+	// V1TLVTypeBabylonAddress is the type of the TLV field carrying the address
+	// of the staker on Babylon chain
+	V1TLVTypeBabylonAddress byte = 0x01
+
+	v1OpReturnCreationErrMsg = "cannot create V1 op_return data"
+)
+
+// V1OpReturnTLV is an optional typed field of V1 op_return data. Fields of
+// unknown types are preserved by the parser, so new field types can be added
+// without breaking existing parsers.
+type V1OpReturnTLV struct {
+	Type  byte
+	Value []byte
+}
+
+// V1OpReturnData represents the data that is embedded in the OP_RETURN output
+// of V1 identifiable staking transactions. It marshalls to:
+// 4 bytes tag | 1 byte version | 32 bytes staker public key | 2 bytes staking time |
+// 1 byte number of finality provider keys | 32 bytes per finality provider key |
+// TLV fields each as 1 byte type | 1 byte length | value, ordered by strictly increasing type
+type V1OpReturnData struct {
+	Tag                        []byte
+	Version                    byte
+	StakerPublicKey            *XonlyPubKey
+	StakingTime                uint16
+	FinalityProviderPublicKeys []*XonlyPubKey
+	TLVs                       []V1OpReturnTLV
+}
+
+func validateV1TLVs(tlvs []V1OpReturnTLV) error {
+	for i, tlv := range tlvs {
+		if len(tlv.Value) > maxTLVValueLen {
+			return fmt.Errorf("tlv field of type %d is too long: %d, max: %d", tlv.Type, len(tlv.Value), maxTLVValueLen)
+		}
+
+		if i > 0 && tlv.Type <= tlvs[i-1].Type {
+			return fmt.Errorf("tlv fields must be ordered by strictly increasing type")
+		}
+	}
+
+	return nil
+}
+
+func NewV1OpReturnDataFromParsed(
+	tag []byte,
+	stakerPublicKey *btcec.PublicKey,
+	finalityProviderPublicKeys []*btcec.PublicKey,
+	stakingTime uint16,
+	tlvs []V1OpReturnTLV,
+) (*V1OpReturnData, error) {
+	if len(tag) != TagLen {
+		return nil, fmt.Errorf("%s: invalid tag length: %d, expected: %d", v1OpReturnCreationErrMsg, len(tag), TagLen)
+	}
+
+	if stakerPublicKey == nil {
+		return nil, fmt.Errorf("%s: nil staker public key", v1OpReturnCreationErrMsg)
+	}
+
+	if len(finalityProviderPublicKeys) == 0 {
+		return nil, fmt.Errorf("%s: no finality provider public keys", v1OpReturnCreationErrMsg)
+	}
+
+	fpKeys := make([]*XonlyPubKey, len(finalityProviderPublicKeys))
+	fpKeysSet := make(map[string]struct{}, len(finalityProviderPublicKeys))
+	for i, fpKey := range finalityProviderPublicKeys {
+		if fpKey == nil {
+			return nil, fmt.Errorf("%s: nil finality provider public key", v1OpReturnCreationErrMsg)
+		}
+
+		keyStr := keyToString(fpKey)
+		if _, ok := fpKeysSet[keyStr]; ok {
+			return nil, fmt.Errorf("%s: duplicated finality provider public key: %s", v1OpReturnCreationErrMsg, keyStr)
+		}
+		fpKeysSet[keyStr] = struct{}{}
+		fpKeys[i] = &XonlyPubKey{fpKey}
+	}
+
+	if err := validateV1TLVs(tlvs); err != nil {
+		return nil, fmt.Errorf("%s: %w", v1OpReturnCreationErrMsg, err)
+	}
+
+	data := &V1OpReturnData{
+		Tag:                        tag,
+		Version:                    V1OpReturnVersion,
+		StakerPublicKey:            &XonlyPubKey{stakerPublicKey},
+		StakingTime:                stakingTime,
+		FinalityProviderPublicKeys: fpKeys,
+		TLVs:                       tlvs,
+	}
+
+	if size := len(data.Marshall()); size > MaxV1OpReturnDataSize {
+		return nil, fmt.Errorf("%s: op return data too large: %d, max: %d", v1OpReturnCreationErrMsg, size, MaxV1OpReturnDataSize)
+	}
+
+	return data, nil
+}
+
+func NewV1OpReturnDataFromBytes(b []byte) (*V1OpReturnData, error) {
+	if len(b) < v1OpReturnFixedSize+schnorr.PubKeyBytesLen {
+		return nil, fmt.Errorf("invalid op return data length: %d, expected at least: %d", len(b), v1OpReturnFixedSize+schnorr.PubKeyBytesLen)
+	}
+
+	if len(b) > MaxV1OpReturnDataSize {
+		return nil, fmt.Errorf("invalid op return data length: %d, max: %d", len(b), MaxV1OpReturnDataSize)
+	}
+
+	tag := b[:TagLen]
+	version := b[TagLen]
+
+	if version != V1OpReturnVersion {
+		return nil, fmt.Errorf("invalid op return version: %d, expected: %d", version, V1OpReturnVersion)
+	}
+
+	offset := TagLen + 1
+
+	stakerKey, err := XOnlyPublicKeyFromBytes(b[offset : offset+schnorr.PubKeyBytesLen])
+	if err != nil {
+		return nil, fmt.Errorf("invalid staker public key: %w", err)
+	}
+	offset += schnorr.PubKeyBytesLen
+
+	stakingTime, err := uint16FromBytes(b[offset : offset+2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid staking time: %w", err)
+	}
+	offset += 2
+
+	numFpKeys := int(b[offset])
+	offset++
+
+	if len(b) < offset+numFpKeys*schnorr.PubKeyBytesLen {
+		return nil, fmt.Errorf("op return data too short for %d finality provider keys", numFpKeys)
+	}
+
+	fpKeys := make([]*btcec.PublicKey, numFpKeys)
+	for i := 0; i < numFpKeys; i++ {
+		fpKey, err := XOnlyPublicKeyFromBytes(b[offset : offset+schnorr.PubKeyBytesLen])
+		if err != nil {
+			return nil, fmt.Errorf("invalid finality provider public key: %w", err)
+		}
+		fpKeys[i] = fpKey.PubKey
+		offset += schnorr.PubKeyBytesLen
+	}
+
+	var tlvs []V1OpReturnTLV
+	for offset < len(b) {
+		if len(b) < offset+2 {
+			return nil, fmt.Errorf("truncated tlv field header")
+		}
+
+		tlvType := b[offset]
+		tlvLen := int(b[offset+1])
+		offset += 2
+
+		if len(b) < offset+tlvLen {
+			return nil, fmt.Errorf("truncated value of tlv field of type %d", tlvType)
+		}
+
+		tlvs = append(tlvs, V1OpReturnTLV{
+			Type:  tlvType,
+			Value: b[offset : offset+tlvLen],
+		})
+		offset += tlvLen
+	}
+
+	return NewV1OpReturnDataFromParsed(tag, stakerKey.PubKey, fpKeys, stakingTime, tlvs)
+}
+
+// getOpReturnPushedData returns data pushed by an op_return script with
+// single data push of any size
+func getOpReturnPushedData(out *wire.TxOut) ([]byte, error) {
+	if out == nil {
+		return nil, fmt.Errorf("nil tx output")
+	}
+
+	if len(out.PkScript) < 2 || out.PkScript[0] != txscript.OP_RETURN {
+		return nil, fmt.Errorf("invalid op return script")
+	}
+
+	tokenizer := txscript.MakeScriptTokenizer(0, out.PkScript[1:])
+	if !tokenizer.Next() || !tokenizer.Done() || tokenizer.Opcode() > txscript.OP_PUSHDATA4 {
+		return nil, fmt.Errorf("invalid op return script")
+	}
+
+	return tokenizer.Data(), nil
+}
+
+func NewV1OpReturnDataFromTxOutput(out *wire.TxOut) (*V1OpReturnData, error) {
+	data, err := getOpReturnPushedData(out)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse op return data: %w", err)
+	}
+
+	return NewV1OpReturnDataFromBytes(data)
+}
+
+func (d *V1OpReturnData) Marshall() []byte {
+	var data []byte
+	data = append(data, d.Tag...)
+	data = append(data, d.Version)
+	data = append(data, d.StakerPublicKey.Marshall()...)
+	data = append(data, uint16ToBytes(d.StakingTime)...)
+	data = append(data, byte(len(d.FinalityProviderPublicKeys)))
+	for _, fpKey := range d.FinalityProviderPublicKeys {
+		data = append(data, fpKey.Marshall()...)
+	}
+	for _, tlv := range d.TLVs {
+		data = append(data, tlv.Type, byte(len(tlv.Value)))
+		data = append(data, tlv.Value...)
+	}
+	return data
+}
+
+func (d *V1OpReturnData) ToTxOutput() (*wire.TxOut, error) {
+	// txscript.NullDataScript is not used as it limits data to 80 bytes
+	dataScript, err := txscript.NewScriptBuilder().
+		AddOp(txscript.OP_RETURN).
+		AddData(d.Marshall()).
+		Script()
+	if err != nil {
+		return nil, err
+	}
+	return wire.NewTxOut(0, dataScript), nil
+}
+
+// FinalityProviderKeys returns the finality provider keys as btcec public keys
+func (d *V1OpReturnData) FinalityProviderKeys() []*btcec.PublicKey {
+	keys := make([]*btcec.PublicKey, len(d.FinalityProviderPublicKeys))
+	for i, k := range d.FinalityProviderPublicKeys {
+		keys[i] = k.PubKey
+	}
+	return keys
+}
+
+// GetTLV returns the value of the TLV field of the given type if present
+func (d *V1OpReturnData) GetTLV(tlvType byte) ([]byte, bool) {
+	for _, tlv := range d.TLVs {
+		if tlv.Type == tlvType {
+			return tlv.Value, true
+		}
+	}
+	return nil, false
+}
+
+// BuildV1IdentifiableStakingOutputs creates outputs which every V1 staking transaction must have
+func BuildV1IdentifiableStakingOutputs(
+	tag []byte,
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+	tlvs []V1OpReturnTLV,
+) (*IdentifiableStakingInfo, error) {
+	info, err := BuildStakingInfo(
+		stakerKey,
+		fpKeys,
+		covenantKeys,
+		covenantQuorum,
+		stakingTime,
+		stakingAmount,
+		net,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	opReturnData, err := NewV1OpReturnDataFromParsed(tag, stakerKey, fpKeys, stakingTime, tlvs)
+
+	if err != nil {
+		return nil, err
+	}
+
+	dataOutput, err := opReturnData.ToTxOutput()
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &IdentifiableStakingInfo{
+		StakingOutput:         info.StakingOutput,
+		scriptHolder:          info.scriptHolder,
+		timeLockPathLeafHash:  info.timeLockPathLeafHash,
+		unbondingPathLeafHash: info.unbondingPathLeafHash,
+		slashingPathLeafHash:  info.slashingPathLeafHash,
+		OpReturnOutput:        dataOutput,
+	}, nil
+}
+
+// BuildV1IdentifiableStakingOutputsAndTx creates outputs which every V1 staking transaction must have and
+// returns the not-funded transaction with these outputs
+func BuildV1IdentifiableStakingOutputsAndTx(
+	tag []byte,
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+	tlvs []V1OpReturnTLV,
+) (*IdentifiableStakingInfo, *wire.MsgTx, error) {
+	info, err := BuildV1IdentifiableStakingOutputs(
+		tag,
+		stakerKey,
+		fpKeys,
+		covenantKeys,
+		covenantQuorum,
+		stakingTime,
+		stakingAmount,
+		net,
+		tlvs,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx := wire.NewMsgTx(2)
+	tx.AddTxOut(info.StakingOutput)
+	tx.AddTxOut(info.OpReturnOutput)
+	return info, tx, nil
+}
+
+type ParsedV1StakingTx struct {
+	StakingOutput     *wire.TxOut
+	StakingOutputIdx  int
+	OpReturnOutput    *wire.TxOut
+	OpReturnOutputIdx int
+	OpReturnData      *V1OpReturnData
+}
+
+// tryToGetTaggedOpReturnOutput returns the op_return output with data starting
+// with the expected tag
+func tryToGetTaggedOpReturnOutput(outputs []*wire.TxOut, expectedTag []byte) ([]byte, int, error) {
+	var (
+		opReturnData      []byte
+		opReturnOutputIdx = -1
+	)
+
+	for i, output := range outputs {
+		data, err := getOpReturnPushedData(output)
+		if err != nil || len(data) <= TagLen || !bytes.Equal(data[:TagLen], expectedTag) {
+			// this is not an op return output recognized by Babylon, move forward
+			continue
+		}
+
+		// as in tryToGetOpReturnDataFromOutputs, multiple Babylon op return
+		// outputs in a single transaction are not allowed
+		if opReturnData != nil {
+			return nil, -1, fmt.Errorf("multiple op return outputs found")
+		}
+
+		opReturnData = data
+		opReturnOutputIdx = i
+	}
+
+	return opReturnData, opReturnOutputIdx, nil
+}
+
+// ParseV1StakingTx takes a btc transaction and checks whether it is a V1 staking transaction and if so parses it
+// for easy data retrieval.
+// It does all necessary checks to ensure that the transaction is valid staking transaction.
+func ParseV1StakingTx(
+	tx *wire.MsgTx,
+	expectedTag []byte,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	net *chaincfg.Params,
+) (*ParsedV1StakingTx, error) {
+	// 1. Basic arguments checks
+	if tx == nil {
+		return nil, fmt.Errorf("nil tx")
+	}
+
+	if len(expectedTag) != TagLen {
+		return nil, fmt.Errorf("invalid tag length: %d, expected: %d", len(expectedTag), TagLen)
+	}
+
+	if len(covenantKeys) == 0 {
+		return nil, fmt.Errorf("no covenant keys specified")
+	}
+
+	if int(covenantQuorum) > len(covenantKeys) {
+		return nil, fmt.Errorf("covenant quorum is greater than the number of covenant keys")
+	}
+
+	// 2. Identify whether the transaction has expected shape
+	if len(tx.TxOut) < 2 {
+		return nil, fmt.Errorf("staking tx must have at least 2 outputs")
+	}
+
+	data, opReturnOutputIdx, err := tryToGetTaggedOpReturnOutput(tx.TxOut, expectedTag)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse staking transaction: %w", err)
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("transaction does not have expected op return output")
+	}
+
+	opReturnData, err := NewV1OpReturnDataFromBytes(data)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse staking transaction: %w", err)
+	}
+
+	// 3. Op return is valid V1 op return output. Now, we need to check whether
+	// the staking output exists and is valid.
+	stakingInfo, err := BuildStakingInfo(
+		opReturnData.StakerPublicKey.PubKey,
+		opReturnData.FinalityProviderKeys(),
+		covenantKeys,
+		covenantQuorum,
+		opReturnData.StakingTime,
+		// we can pass 0 here, as staking amount is not used when creating taproot address
+		0,
+		net,
+	)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot build staking info: %w", err)
+	}
+
+	stakingOutput, stakingOutputIdx, err := tryToGetStakingOutput(tx.TxOut, stakingInfo.StakingOutput.PkScript)
+
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse staking transaction: %w", err)
+	}
+
+	if stakingOutput == nil {
+		return nil, fmt.Errorf("staking output not found in potential staking transaction")
+	}
+
+	return &ParsedV1StakingTx{
+		StakingOutput:     stakingOutput,
+		StakingOutputIdx:  stakingOutputIdx,
+		OpReturnOutput:    tx.TxOut[opReturnOutputIdx],
+		OpReturnOutputIdx: opReturnOutputIdx,
+		OpReturnData:      opReturnData,
+	}, nil
+}
+
+// ParsedStakingTx is the version independent view of the parsed identifiable
+// staking transaction
+type ParsedStakingTx struct {
+	Version                    byte
+	StakingOutput              *wire.TxOut
+	StakingOutputIdx           int
+	OpReturnOutput             *wire.TxOut
+	OpReturnOutputIdx          int
+	StakerPublicKey            *XonlyPubKey
+	FinalityProviderPublicKeys []*XonlyPubKey
+	StakingTime                uint16
+	// TLVs are only present in V1 staking transactions
+	TLVs []V1OpReturnTLV
+}
+
+// ParseIdentifiableStakingTx takes a btc transaction and checks whether it is
+// an identifiable staking transaction of any supported version. The version is
+// taken from the op_return output with the expected tag and the transaction is
+// parsed with the parser of this version.
+func ParseIdentifiableStakingTx(
+	tx *wire.MsgTx,
+	expectedTag []byte,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	net *chaincfg.Params,
+) (*ParsedStakingTx, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("nil tx")
+	}
+
+	if len(expectedTag) != TagLen {
+		return nil, fmt.Errorf("invalid tag length: %d, expected: %d", len(expectedTag), TagLen)
+	}
+
+	data, _, err := tryToGetTaggedOpReturnOutput(tx.TxOut, expectedTag)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse staking transaction: %w", err)
+	}
+
+	if data == nil {
+		return nil, fmt.Errorf("transaction does not have op return output with tag %s", hex.EncodeToString(expectedTag))
+	}
+
+	switch version := data[TagLen]; version {
+	case 0:
+		parsed, err := ParseV0StakingTx(tx, expectedTag, covenantKeys, covenantQuorum, net)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ParsedStakingTx{
+			Version:                    parsed.OpReturnData.Version,
+			StakingOutput:              parsed.StakingOutput,
+			StakingOutputIdx:           parsed.StakingOutputIdx,
+			OpReturnOutput:             parsed.OpReturnOutput,
+			OpReturnOutputIdx:          parsed.OpReturnOutputIdx,
+			StakerPublicKey:            parsed.OpReturnData.StakerPublicKey,
+			FinalityProviderPublicKeys: []*XonlyPubKey{parsed.OpReturnData.FinalityProviderPublicKey},
+			StakingTime:                parsed.OpReturnData.StakingTime,
+		}, nil
+	case V1OpReturnVersion:
+		parsed, err := ParseV1StakingTx(tx, expectedTag, covenantKeys, covenantQuorum, net)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ParsedStakingTx{
+			Version:                    parsed.OpReturnData.Version,
+			StakingOutput:              parsed.StakingOutput,
+			StakingOutputIdx:           parsed.StakingOutputIdx,
+			OpReturnOutput:             parsed.OpReturnOutput,
+			OpReturnOutputIdx:          parsed.OpReturnOutputIdx,
+			StakerPublicKey:            parsed.OpReturnData.StakerPublicKey,
+			FinalityProviderPublicKeys: parsed.OpReturnData.FinalityProviderPublicKeys,
+			StakingTime:                parsed.OpReturnData.StakingTime,
+			TLVs:                       parsed.OpReturnData.TLVs,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported op return version: %d", version)
+	}
+}
+
+// IsPossibleIdentifiableStakingTx checks whether transaction may be a valid
+// staking transaction of any supported version i.e whether it has at least 2
+// outputs and an op return output with expected tag and supported version.
+// This function is much faster than ParseIdentifiableStakingTx, as it does not
+// perform all necessary checks.
+func IsPossibleIdentifiableStakingTx(tx *wire.MsgTx, expectedTag []byte) bool {
+	if len(expectedTag) != TagLen || len(tx.TxOut) < 2 {
+		return false
+	}
+
+	data, _, err := tryToGetTaggedOpReturnOutput(tx.TxOut, expectedTag)
+	if err != nil || data == nil {
+		return false
+	}
+
+	version := data[TagLen]
+	return version == 0 || version == V1OpReturnVersion
+}
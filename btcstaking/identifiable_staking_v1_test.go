@@ -0,0 +1,328 @@
+package btcstaking_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+)
+
+type V1TLV struct {
+	Type  int    `json:"type"`
+	Value string `json:"value"`
+}
+
+type V1Parameters struct {
+	CovenantPublicKeys         []string `json:"covenant_public_keys"`
+	CovenantQuorum             int      `json:"covenant_quorum"`
+	FinalityProviderPublicKeys []string `json:"finality_provider_public_keys"`
+	StakerPublicKey            string   `json:"staker_public_key"`
+	StakingTime                int      `json:"staking_time"`
+	StakingValue               int      `json:"staking_value"`
+	Tag                        string   `json:"tag"`
+	Network                    string   `json:"network"`
+	TLVs                       []V1TLV  `json:"tlvs"`
+}
+
+type V1Expected struct {
+	StakingOutputPkScript string `json:"staking_output_pkscript_hex"`
+	OpReturnScript        string `json:"op_return_script_hex"`
+}
+
+type V1TestCase struct {
+	Description string        `json:"name"`
+	Parameters  *V1Parameters `json:"parameters"`
+	Expected    *V1Expected   `json:"expected"`
+}
+
+type V1InvalidTestCase struct {
+	Description string `json:"name"`
+	OpReturnHex string `json:"op_return_data_hex"`
+}
+
+type V1TestCases struct {
+	Test    []V1TestCase        `json:"vectors"`
+	Invalid []V1InvalidTestCase `json:"invalid_vectors"`
+}
+
+func readV1TestCases(t *testing.T) *V1TestCases {
+	bz, err := os.ReadFile("./testvectors/v1_vectors.json")
+	require.NoError(t, err)
+
+	var cases V1TestCases
+	require.NoError(t, json.Unmarshal(bz, &cases))
+	return &cases
+}
+
+func TestV1VectorsCompatibility(t *testing.T) {
+	cases := readV1TestCases(t)
+
+	for _, tc := range cases.Test {
+		t.Run(tc.Description, func(t *testing.T) {
+			p := tc.Parameters
+			tag, err := hex.DecodeString(p.Tag)
+			require.NoError(t, err)
+			net, err := getBtcNetworkParams(p.Network)
+			require.NoError(t, err)
+
+			var tlvs []btcstaking.V1OpReturnTLV
+			for _, tlv := range p.TLVs {
+				value, err := hex.DecodeString(tlv.Value)
+				require.NoError(t, err)
+				tlvs = append(tlvs, btcstaking.V1OpReturnTLV{Type: byte(tlv.Type), Value: value})
+			}
+
+			covenantKeys := keysToPubKeys(t, p.CovenantPublicKeys)
+
+			info, tx, err := btcstaking.BuildV1IdentifiableStakingOutputsAndTx(
+				tag,
+				keysToPubKeys(t, []string{p.StakerPublicKey})[0],
+				keysToPubKeys(t, p.FinalityProviderPublicKeys),
+				covenantKeys,
+				uint32(p.CovenantQuorum),
+				uint16(p.StakingTime),
+				btcutil.Amount(p.StakingValue),
+				net,
+				tlvs,
+			)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.Expected.StakingOutputPkScript, hex.EncodeToString(info.StakingOutput.PkScript))
+			require.Equal(t, tc.Expected.OpReturnScript, hex.EncodeToString(info.OpReturnOutput.PkScript))
+
+			parsed, err := btcstaking.ParseV1StakingTx(tx, tag, covenantKeys, uint32(p.CovenantQuorum), net)
+			require.NoError(t, err)
+			require.Equal(t, uint16(p.StakingTime), parsed.OpReturnData.StakingTime)
+			require.Len(t, parsed.OpReturnData.FinalityProviderPublicKeys, len(p.FinalityProviderPublicKeys))
+			require.Len(t, parsed.OpReturnData.TLVs, len(p.TLVs))
+		})
+	}
+
+	for _, tc := range cases.Invalid {
+		t.Run(tc.Description, func(t *testing.T) {
+			data, err := hex.DecodeString(tc.OpReturnHex)
+			require.NoError(t, err)
+			_, err = btcstaking.NewV1OpReturnDataFromBytes(data)
+			require.Error(t, err)
+		})
+	}
+}
+
+func genRandomTLVs(r *rand.Rand) []btcstaking.V1OpReturnTLV {
+	var tlvs []btcstaking.V1OpReturnTLV
+	if r.Intn(2) == 0 {
+		tlvs = append(tlvs, btcstaking.V1OpReturnTLV{
+			Type:  btcstaking.V1TLVTypeBabylonAddress,
+			Value: datagen.GenRandomByteArray(r, 20),
+		})
+	}
+	if r.Intn(2) == 0 {
+		// unknown field types must be preserved
+		tlvs = append(tlvs, btcstaking.V1OpReturnTLV{
+			Type:  byte(r.Intn(100) + 100),
+			Value: datagen.GenRandomByteArray(r, uint64(r.Intn(50))),
+		})
+	}
+	return tlvs
+}
+
+// Property: Every V1 staking tx generated by our generator should be properly parsed by
+// V1 parser and by the version dispatching parser
+func FuzzGenerateAndParseValidV1StakingTransaction(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 100)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		numCovenantKeys := uint32(r.Int31n(7) + 3)
+		quorum := uint32(r.Intn(int(numCovenantKeys)) + 1)
+		numFpKeys := uint32(r.Intn(10) + 1)
+		stakingAmount := btcutil.Amount(r.Int63n(1000000000) + 10000)
+		stakingTime := uint16(r.Int31n(math.MaxUint16-1) + 1)
+		tag := datagen.GenRandomByteArray(r, btcstaking.TagLen)
+		tlvs := genRandomTLVs(r)
+		net := &chaincfg.MainNetParams
+
+		sc := GenerateTestScenario(r, t, numFpKeys, numCovenantKeys, quorum, stakingAmount, stakingTime)
+
+		outputs, err := btcstaking.BuildV1IdentifiableStakingOutputs(
+			tag,
+			sc.StakerKey.PubKey(),
+			sc.FinalityProviderPublicKeys(),
+			sc.CovenantPublicKeys(),
+			quorum,
+			stakingTime,
+			stakingAmount,
+			net,
+			tlvs,
+		)
+		require.NoError(t, err)
+
+		tx, stakingOutputIdx, opReturnOutputIdx := generateTxFromOutputs(r, outputs)
+
+		require.True(t, btcstaking.IsPossibleIdentifiableStakingTx(tx, tag))
+		require.False(t, btcstaking.IsPossibleV0StakingTx(tx, tag))
+
+		parsedTx, err := btcstaking.ParseV1StakingTx(tx, tag, sc.CovenantPublicKeys(), quorum, net)
+		require.NoError(t, err)
+
+		require.Equal(t, outputs.StakingOutput.PkScript, parsedTx.StakingOutput.PkScript)
+		require.Equal(t, stakingOutputIdx, parsedTx.StakingOutputIdx)
+		require.Equal(t, outputs.OpReturnOutput.PkScript, parsedTx.OpReturnOutput.PkScript)
+		require.Equal(t, opReturnOutputIdx, parsedTx.OpReturnOutputIdx)
+
+		require.Equal(t, tag, parsedTx.OpReturnData.Tag)
+		require.Equal(t, btcstaking.V1OpReturnVersion, parsedTx.OpReturnData.Version)
+		require.Equal(t, stakingTime, parsedTx.OpReturnData.StakingTime)
+		require.Equal(t, schnorr.SerializePubKey(sc.StakerKey.PubKey()), parsedTx.OpReturnData.StakerPublicKey.Marshall())
+		require.Len(t, parsedTx.OpReturnData.FinalityProviderPublicKeys, int(numFpKeys))
+		for i, fpKey := range sc.FinalityProviderPublicKeys() {
+			require.Equal(t, schnorr.SerializePubKey(fpKey), parsedTx.OpReturnData.FinalityProviderPublicKeys[i].Marshall())
+		}
+		require.Equal(t, len(tlvs), len(parsedTx.OpReturnData.TLVs))
+		for i, tlv := range tlvs {
+			require.Equal(t, tlv.Type, parsedTx.OpReturnData.TLVs[i].Type)
+			require.Equal(t, tlv.Value, parsedTx.OpReturnData.TLVs[i].Value)
+		}
+
+		// dispatching parser returns the same data
+		parsed, err := btcstaking.ParseIdentifiableStakingTx(tx, tag, sc.CovenantPublicKeys(), quorum, net)
+		require.NoError(t, err)
+		require.Equal(t, btcstaking.V1OpReturnVersion, parsed.Version)
+		require.Equal(t, stakingOutputIdx, parsed.StakingOutputIdx)
+		require.Equal(t, parsedTx.OpReturnData.FinalityProviderPublicKeys, parsed.FinalityProviderPublicKeys)
+
+		// staking tx with different staking time in op return is rejected
+		otherOutputs, err := btcstaking.BuildV1IdentifiableStakingOutputs(
+			tag,
+			sc.StakerKey.PubKey(),
+			sc.FinalityProviderPublicKeys(),
+			sc.CovenantPublicKeys(),
+			quorum,
+			stakingTime+1,
+			stakingAmount,
+			net,
+			tlvs,
+		)
+		require.NoError(t, err)
+		tx.TxOut[opReturnOutputIdx] = otherOutputs.OpReturnOutput
+		_, err = btcstaking.ParseIdentifiableStakingTx(tx, tag, sc.CovenantPublicKeys(), quorum, net)
+		require.Error(t, err)
+	})
+}
+
+func TestParseIdentifiableStakingTxV0(t *testing.T) {
+	r := rand.New(rand.NewSource(12))
+	tag := datagen.GenRandomByteArray(r, btcstaking.TagLen)
+	sc := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(100000), 1000)
+
+	outputs, err := btcstaking.BuildV0IdentifiableStakingOutputs(
+		tag,
+		sc.StakerKey.PubKey(),
+		sc.FinalityProviderKeys[0].PubKey(),
+		sc.CovenantPublicKeys(),
+		sc.RequiredCovenantSigs,
+		sc.StakingTime,
+		sc.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	tx, stakingOutputIdx, opReturnOutputIdx := generateTxFromOutputs(r, outputs)
+	require.True(t, btcstaking.IsPossibleIdentifiableStakingTx(tx, tag))
+
+	parsed, err := btcstaking.ParseIdentifiableStakingTx(tx, tag, sc.CovenantPublicKeys(), sc.RequiredCovenantSigs, &chaincfg.MainNetParams)
+	require.NoError(t, err)
+	require.Equal(t, uint8(0), parsed.Version)
+	require.Equal(t, stakingOutputIdx, parsed.StakingOutputIdx)
+	require.Equal(t, opReturnOutputIdx, parsed.OpReturnOutputIdx)
+	require.Len(t, parsed.FinalityProviderPublicKeys, 1)
+	require.Equal(t, schnorr.SerializePubKey(sc.FinalityProviderKeys[0].PubKey()), parsed.FinalityProviderPublicKeys[0].Marshall())
+	require.Empty(t, parsed.TLVs)
+
+	// unsupported version is rejected
+	data := append([]byte{}, outputs.OpReturnOutput.PkScript[2:]...)
+	data[btcstaking.TagLen] = 2
+	dataScript, err := txscript.NullDataScript(data)
+	require.NoError(t, err)
+	tx.TxOut[opReturnOutputIdx] = wire.NewTxOut(0, dataScript)
+	require.False(t, btcstaking.IsPossibleIdentifiableStakingTx(tx, tag))
+	_, err = btcstaking.ParseIdentifiableStakingTx(tx, tag, sc.CovenantPublicKeys(), sc.RequiredCovenantSigs, &chaincfg.MainNetParams)
+	require.Error(t, err)
+
+	// multiple op return outputs with the same tag are rejected
+	tx.TxOut[opReturnOutputIdx] = outputs.OpReturnOutput
+	tx.AddTxOut(wire.NewTxOut(0, outputs.OpReturnOutput.PkScript))
+	_, err = btcstaking.ParseIdentifiableStakingTx(tx, tag, sc.CovenantPublicKeys(), sc.RequiredCovenantSigs, &chaincfg.MainNetParams)
+	require.Error(t, err)
+}
+
+func TestNewV1OpReturnDataValidation(t *testing.T) {
+	r := rand.New(rand.NewSource(13))
+	sc := GenerateTestScenario(r, t, 16, 3, 2, btcutil.Amount(100000), 1000)
+	tag := datagen.GenRandomByteArray(r, btcstaking.TagLen)
+	fpKeys := sc.FinalityProviderPublicKeys()
+
+	testCases := []struct {
+		name   string
+		modify func() error
+	}{
+		{"invalid tag", func() error {
+			_, err := btcstaking.NewV1OpReturnDataFromParsed(tag[:3], sc.StakerKey.PubKey(), fpKeys[:1], 10, nil)
+			return err
+		}},
+		{"no finality provider keys", func() error {
+			_, err := btcstaking.NewV1OpReturnDataFromParsed(tag, sc.StakerKey.PubKey(), nil, 10, nil)
+			return err
+		}},
+		{"duplicated finality provider keys", func() error {
+			_, err := btcstaking.NewV1OpReturnDataFromParsed(tag, sc.StakerKey.PubKey(), []*btcec.PublicKey{fpKeys[0], fpKeys[0]}, 10, nil)
+			return err
+		}},
+		{"too large data", func() error {
+			_, err := btcstaking.NewV1OpReturnDataFromParsed(tag, sc.StakerKey.PubKey(), fpKeys, 10, nil)
+			return err
+		}},
+		{"unordered tlv fields", func() error {
+			_, err := btcstaking.NewV1OpReturnDataFromParsed(tag, sc.StakerKey.PubKey(), fpKeys[:1], 10, []btcstaking.V1OpReturnTLV{
+				{Type: 2, Value: []byte{1}},
+				{Type: 1, Value: []byte{1}},
+			})
+			return err
+		}},
+		{"too long tlv field", func() error {
+			_, err := btcstaking.NewV1OpReturnDataFromParsed(tag, sc.StakerKey.PubKey(), fpKeys[:1], 10, []btcstaking.V1OpReturnTLV{
+				{Type: 1, Value: make([]byte, 256)},
+			})
+			return err
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, tc.modify(), fmt.Sprintf("case %s must fail", tc.name))
+		})
+	}
+
+	data, err := btcstaking.NewV1OpReturnDataFromParsed(tag, sc.StakerKey.PubKey(), fpKeys[:2], 10, []btcstaking.V1OpReturnTLV{
+		{Type: btcstaking.V1TLVTypeBabylonAddress, Value: []byte{1, 2, 3}},
+	})
+	require.NoError(t, err)
+	addr, found := data.GetTLV(btcstaking.V1TLVTypeBabylonAddress)
+	require.True(t, found)
+	require.Equal(t, []byte{1, 2, 3}, addr)
+	_, found = data.GetTLV(2)
+	require.False(t, found)
+}
@@ -0,0 +1,377 @@
+package btcstaking
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	// MuSig2PubNonceSize is the size of the public nonce a covenant member
+	// commits to in the first round of MuSig2 signing
+	MuSig2PubNonceSize = musig2.PubNonceSize
+	// MuSig2PartialSigSize is the size of the serialized partial signature
+	MuSig2PartialSigSize = 32
+)
+
+// AggregateCovenantKeys returns MuSig2 aggregated key of the covenant committee.
+// Keys are sorted before aggregation so the result does not depend on the order
+// of the provided keys. The aggregated key is not tweaked, as it is only used
+// inside tapscript leaves.
+func AggregateCovenantKeys(covenantKeys []*btcec.PublicKey) (*btcec.PublicKey, error) {
+	if len(covenantKeys) == 0 {
+		return nil, fmt.Errorf("cannot aggregate empty set of covenant keys")
+	}
+
+	keyMap := make(map[string]struct{})
+	for _, key := range covenantKeys {
+		keyStr := keyToString(key)
+		if _, ok := keyMap[keyStr]; ok {
+			return nil, fmt.Errorf("key: %s: %w", keyStr, ErrDuplicatedKeyInScript)
+		}
+		keyMap[keyStr] = struct{}{}
+	}
+
+	aggKey, _, _, err := musig2.AggregateKeys(SortKeys(covenantKeys), true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate covenant keys: %w", err)
+	}
+
+	return aggKey.FinalKey, nil
+}
+
+// buildAggregatedCovenantUnbondingScript builds unbonding path script in which
+// the covenant committee is represented by its MuSig2 aggregated key
+// SCRIPT: <Staker_PK> OP_CHECKSIGVERIFY <Covenant_Agg_PK> OP_CHECKSIG
+func buildAggregatedCovenantUnbondingScript(
+	stakerKey *btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+) ([]byte, error) {
+	aggKey, err := AggregateCovenantKeys(covenantKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	stakerSigScript, err := buildSingleKeySigScript(stakerKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	covenantSigScript, err := buildSingleKeySigScript(aggKey, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateScripts(stakerSigScript, covenantSigScript), nil
+}
+
+// BuildStakingInfoWithAggregatedCovenant builds the staking output in which the
+// unbonding path commits to the MuSig2 aggregated key of the covenant committee
+// instead of the covenant multisig. This makes unbonding witness constant size
+// regardless of the committee size.
+// As MuSig2 is n-of-n scheme, unbonding through this path requires all covenant
+// members to sign. Slashing path still uses covenant multisig with the quorum,
+// as covenant slashing signatures are adaptor signatures.
+func BuildStakingInfoWithAggregatedCovenant(
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	covenantQuorum uint32,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*StakingInfo, error) {
+	babylonScripts, err := newBabylonScriptPaths(
+		stakerKey,
+		fpKeys,
+		covenantKeys,
+		covenantQuorum,
+		stakingTime,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errBuildingStakingInfo, err)
+	}
+
+	unbondingPathScript, err := buildAggregatedCovenantUnbondingScript(stakerKey, covenantKeys)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", errBuildingStakingInfo, err)
+	}
+	babylonScripts.unbondingPathScript = unbondingPathScript
+
+	return buildStakingInfoFromScripts(babylonScripts, stakingAmount, net)
+}
+
+// CreateAggregatedUnbondingPathWitness helper function to create a witness to
+// spend the staking output through the unbonding path committing to the MuSig2
+// aggregated covenant key.
+func (si *SpendInfo) CreateAggregatedUnbondingPathWitness(
+	covenantAggSig *schnorr.Signature,
+	delegatorSig *schnorr.Signature,
+) (wire.TxWitness, error) {
+	if si == nil {
+		panic("cannot build witness without spend info")
+	}
+	if covenantAggSig == nil {
+		return nil, fmt.Errorf("aggregated covenant signature should not be nil")
+	}
+	if delegatorSig == nil {
+		return nil, fmt.Errorf("delegator signature should not be nil")
+	}
+
+	return CreateWitness(si, [][]byte{covenantAggSig.Serialize(), delegatorSig.Serialize()})
+}
+
+// UnbondingSigHash returns the message signed by the staker and the covenant
+// committee when spending the funding output through the given script path.
+// Unbonding tx must have exactly one input.
+func UnbondingSigHash(
+	unbondingTx *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	scriptPath []byte,
+) ([32]byte, error) {
+	var msg [32]byte
+
+	if unbondingTx == nil || fundingOutput == nil {
+		return msg, fmt.Errorf("unbonding tx and funding output must not be nil")
+	}
+	if len(unbondingTx.TxIn) != 1 {
+		return msg, fmt.Errorf("unbonding tx must have exactly one input")
+	}
+
+	inputFetcher := txscript.NewCannedPrevOutputFetcher(
+		fundingOutput.PkScript,
+		fundingOutput.Value,
+	)
+	sigHashes := txscript.NewTxSigHashes(unbondingTx, inputFetcher)
+
+	sigHash, err := txscript.CalcTapscriptSignaturehash(
+		sigHashes,
+		txscript.SigHashDefault,
+		unbondingTx,
+		0,
+		inputFetcher,
+		txscript.NewBaseTapLeaf(scriptPath),
+	)
+	if err != nil {
+		return msg, err
+	}
+
+	copy(msg[:], sigHash)
+	return msg, nil
+}
+
+// ParseMuSig2PubNonce parses public nonce submitted by a covenant member
+func ParseMuSig2PubNonce(nonce []byte) ([MuSig2PubNonceSize]byte, error) {
+	var pubNonce [MuSig2PubNonceSize]byte
+	if len(nonce) != MuSig2PubNonceSize {
+		return pubNonce, fmt.Errorf("public nonce must be %d bytes, got %d", MuSig2PubNonceSize, len(nonce))
+	}
+	// both nonce points must be valid compressed points
+	if _, err := btcec.ParsePubKey(nonce[:btcec.PubKeyBytesLenCompressed]); err != nil {
+		return pubNonce, fmt.Errorf("invalid first nonce point: %w", err)
+	}
+	if _, err := btcec.ParsePubKey(nonce[btcec.PubKeyBytesLenCompressed:]); err != nil {
+		return pubNonce, fmt.Errorf("invalid second nonce point: %w", err)
+	}
+	copy(pubNonce[:], nonce)
+	return pubNonce, nil
+}
+
+// ParseMuSig2PartialSig parses partial signature submitted by a covenant member
+func ParseMuSig2PartialSig(sig []byte) (*musig2.PartialSignature, error) {
+	if len(sig) != MuSig2PartialSigSize {
+		return nil, fmt.Errorf("partial signature must be %d bytes, got %d", MuSig2PartialSigSize, len(sig))
+	}
+
+	var partialSig musig2.PartialSignature
+	if err := partialSig.Decode(bytes.NewReader(sig)); err != nil {
+		return nil, fmt.Errorf("invalid partial signature: %w", err)
+	}
+
+	return &partialSig, nil
+}
+
+// SerializeMuSig2PartialSig serializes partial signature to the format accepted
+// by ParseMuSig2PartialSig
+func SerializeMuSig2PartialSig(sig *musig2.PartialSignature) []byte {
+	var buf bytes.Buffer
+	// writing to bytes.Buffer never fails
+	_ = sig.Encode(&buf)
+	return buf.Bytes()
+}
+
+// CovenantMuSig2Session contains public data of a MuSig2 signing session of the
+// covenant committee over a single message. The order of nonces and partial
+// signatures follows the order of covenant keys.
+type CovenantMuSig2Session struct {
+	covenantKeys  []*btcec.PublicKey
+	aggKey        *btcec.PublicKey
+	msg           [32]byte
+	pubNonces     [][MuSig2PubNonceSize]byte
+	combinedNonce [MuSig2PubNonceSize]byte
+}
+
+// NewCovenantMuSig2Session creates signing session after all covenant members
+// committed to their public nonces
+func NewCovenantMuSig2Session(
+	covenantKeys []*btcec.PublicKey,
+	pubNonces [][MuSig2PubNonceSize]byte,
+	msg [32]byte,
+) (*CovenantMuSig2Session, error) {
+	if len(pubNonces) != len(covenantKeys) {
+		return nil, fmt.Errorf("number of nonces %d does not match number of covenant keys %d",
+			len(pubNonces), len(covenantKeys))
+	}
+
+	aggKey, err := AggregateCovenantKeys(covenantKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	combinedNonce, err := musig2.AggregateNonces(pubNonces)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate nonces: %w", err)
+	}
+
+	return &CovenantMuSig2Session{
+		covenantKeys:  covenantKeys,
+		aggKey:        aggKey,
+		msg:           msg,
+		pubNonces:     pubNonces,
+		combinedNonce: combinedNonce,
+	}, nil
+}
+
+// keySet returns a copy of the covenant keys, as musig2 sorts the provided
+// key set in place
+func (s *CovenantMuSig2Session) keySet() []*btcec.PublicKey {
+	return SortKeys(s.covenantKeys)
+}
+
+// CombinedNonce returns aggregated nonce of the session
+func (s *CovenantMuSig2Session) CombinedNonce() [MuSig2PubNonceSize]byte {
+	return s.combinedNonce
+}
+
+// AggregatedKey returns MuSig2 aggregated covenant key of the session
+func (s *CovenantMuSig2Session) AggregatedKey() *btcec.PublicKey {
+	return s.aggKey
+}
+
+// normalizeCovenantSk negates the secret key if its public key has odd y
+// coordinate. Covenant keys are BIP-340 keys, so the committee key set always
+// contains the even y variant of the public key.
+func normalizeCovenantSk(covenantSk *btcec.PrivateKey) *btcec.PrivateKey {
+	if covenantSk.PubKey().SerializeCompressed()[0] != secp256k1.PubKeyFormatCompressedOdd {
+		return covenantSk
+	}
+
+	var negated btcec.ModNScalar
+	negated.Set(&covenantSk.Key).Negate()
+	return btcec.PrivKeyFromScalar(&negated)
+}
+
+// NewCovenantMuSig2Nonces generates fresh nonces of the covenant member for
+// signing the given message. Secret nonce must never be reused.
+func NewCovenantMuSig2Nonces(covenantSk *btcec.PrivateKey, msg [32]byte) (*musig2.Nonces, error) {
+	sk := normalizeCovenantSk(covenantSk)
+
+	return musig2.GenNonces(
+		musig2.WithPublicKey(sk.PubKey()),
+		musig2.WithNonceSecretKeyAux(sk),
+		musig2.WithNonceMessageAux(msg),
+	)
+}
+
+// Sign creates partial signature of the covenant member using secret nonce
+// matching the public nonce committed to in the session
+func (s *CovenantMuSig2Session) Sign(
+	secNonce [musig2.SecNonceSize]byte,
+	covenantSk *btcec.PrivateKey,
+) (*musig2.PartialSignature, error) {
+	return musig2.Sign(
+		secNonce, normalizeCovenantSk(covenantSk), s.combinedNonce, s.keySet(), s.msg, musig2.WithSortedKeys(),
+	)
+}
+
+// VerifyPartialSig verifies partial signature of the covenant member at the
+// given index
+func (s *CovenantMuSig2Session) VerifyPartialSig(idx int, partialSig *musig2.PartialSignature) error {
+	if idx < 0 || idx >= len(s.covenantKeys) {
+		return fmt.Errorf("covenant index %d out of range", idx)
+	}
+
+	if !partialSig.Verify(
+		s.pubNonces[idx], s.combinedNonce, s.keySet(), s.covenantKeys[idx], s.msg, musig2.WithSortedKeys(),
+	) {
+		return fmt.Errorf("invalid partial signature of covenant member %d", idx)
+	}
+
+	return nil
+}
+
+// finalNonce computes the nonce R of the final signature
+// R = R_1 + b*R_2, b = H(tag=MuSig/noncecoef, aggNonce || Q || m)
+func (s *CovenantMuSig2Session) finalNonce() (*btcec.PublicKey, error) {
+	var msgBuf bytes.Buffer
+	msgBuf.Write(s.combinedNonce[:])
+	msgBuf.Write(schnorr.SerializePubKey(s.aggKey))
+	msgBuf.Write(s.msg[:])
+	blindHash := chainhash.TaggedHash(musig2.NonceBlindTag, msgBuf.Bytes())
+
+	var blinder btcec.ModNScalar
+	blinder.SetByteSlice(blindHash[:])
+
+	r1, err := btcec.ParseJacobian(s.combinedNonce[:btcec.PubKeyBytesLenCompressed])
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregated nonce: %w", err)
+	}
+	r2, err := btcec.ParseJacobian(s.combinedNonce[btcec.PubKeyBytesLenCompressed:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid aggregated nonce: %w", err)
+	}
+
+	var r btcec.JacobianPoint
+	btcec.ScalarMultNonConst(&blinder, &r2, &r2)
+	btcec.AddNonConst(&r1, &r2, &r)
+
+	if (r.X.IsZero() && r.Y.IsZero()) || r.Z.IsZero() {
+		btcec.Generator().AsJacobian(&r)
+	}
+	r.ToAffine()
+
+	return btcec.NewPublicKey(&r.X, &r.Y), nil
+}
+
+// AggregatePartialSigs combines partial signatures of all covenant members into
+// a single BIP-340 signature and verifies it against the aggregated covenant key
+func (s *CovenantMuSig2Session) AggregatePartialSigs(
+	partialSigs []*musig2.PartialSignature,
+) (*schnorr.Signature, error) {
+	if len(partialSigs) != len(s.covenantKeys) {
+		return nil, fmt.Errorf("number of partial signatures %d does not match number of covenant keys %d",
+			len(partialSigs), len(s.covenantKeys))
+	}
+
+	r, err := s.finalNonce()
+	if err != nil {
+		return nil, err
+	}
+
+	sig := musig2.CombineSigs(r, partialSigs)
+
+	if !sig.Verify(s.msg[:], s.aggKey) {
+		return nil, fmt.Errorf("aggregated covenant signature is not valid")
+	}
+
+	return sig, nil
+}
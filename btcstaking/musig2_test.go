@@ -0,0 +1,156 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	btctest "github.com/babylonlabs-io/babylon/v4/testutil/bitcoin"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+)
+
+// xOnlyKeys mimics covenant keys stored on Babylon as BIP-340 public keys
+func xOnlyKeys(t *testing.T, keys []*btcec.PublicKey) []*btcec.PublicKey {
+	xOnly := make([]*btcec.PublicKey, len(keys))
+	for i, k := range keys {
+		parsed, err := schnorr.ParsePubKey(schnorr.SerializePubKey(k))
+		require.NoError(t, err)
+		xOnly[i] = parsed
+	}
+	return xOnly
+}
+
+func TestAggregateCovenantKeysOrderIndependent(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 5)
+	covenantKeys := scenario.CovenantPublicKeys()
+
+	aggKey, err := btcstaking.AggregateCovenantKeys(covenantKeys)
+	require.NoError(t, err)
+
+	reversed := make([]*btcec.PublicKey, len(covenantKeys))
+	for i, k := range covenantKeys {
+		reversed[len(covenantKeys)-1-i] = k
+	}
+	aggKeyReversed, err := btcstaking.AggregateCovenantKeys(reversed)
+	require.NoError(t, err)
+	require.True(t, aggKey.IsEqual(aggKeyReversed))
+
+	_, err = btcstaking.AggregateCovenantKeys(append(covenantKeys, covenantKeys[0]))
+	require.Error(t, err)
+}
+
+func FuzzSpendingUnbondingPathAggregatedCovenant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numCovenants := uint32(r.Intn(9) + 1)
+		quorum := uint32(r.Intn(int(numCovenants)) + 1)
+		scenario := GenerateTestScenario(r, t, 1, numCovenants, quorum, btcutil.Amount(2*10e8), 5)
+		covenantKeys := xOnlyKeys(t, scenario.CovenantPublicKeys())
+
+		stakingInfo, err := btcstaking.BuildStakingInfoWithAggregatedCovenant(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			covenantKeys,
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+
+		// slashing path is the same as in the regular staking output
+		regularStakingInfo, err := btcstaking.BuildStakingInfo(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			covenantKeys,
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			&chaincfg.MainNetParams,
+		)
+		require.NoError(t, err)
+		slashingSi, err := stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		regularSlashingSi, err := regularStakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		require.Equal(t, regularSlashingSi.GetPkScriptPath(), slashingSi.GetPkScriptPath())
+
+		spendStakeTx := createSpendStakeTx(scenario.StakingAmount.MulF64(0.5))
+		si, err := stakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+
+		msg, err := btcstaking.UnbondingSigHash(spendStakeTx, stakingInfo.StakingOutput, si.GetPkScriptPath())
+		require.NoError(t, err)
+
+		// first round: each covenant member commits to its nonce
+		nonces := make([]*musig2.Nonces, numCovenants)
+		pubNonces := make([][btcstaking.MuSig2PubNonceSize]byte, numCovenants)
+		for i, sk := range scenario.CovenantKeys {
+			nonces[i], err = btcstaking.NewCovenantMuSig2Nonces(sk, msg)
+			require.NoError(t, err)
+			pubNonces[i], err = btcstaking.ParseMuSig2PubNonce(nonces[i].PubNonce[:])
+			require.NoError(t, err)
+		}
+
+		session, err := btcstaking.NewCovenantMuSig2Session(covenantKeys, pubNonces, msg)
+		require.NoError(t, err)
+
+		// second round: each covenant member creates partial signature which
+		// is verified by the aggregator
+		partialSigs := make([]*musig2.PartialSignature, numCovenants)
+		for i, sk := range scenario.CovenantKeys {
+			partialSig, err := session.Sign(nonces[i].SecNonce, sk)
+			require.NoError(t, err)
+
+			partialSigs[i], err = btcstaking.ParseMuSig2PartialSig(btcstaking.SerializeMuSig2PartialSig(partialSig))
+			require.NoError(t, err)
+			require.NoError(t, session.VerifyPartialSig(i, partialSigs[i]))
+
+			if numCovenants > 1 {
+				// partial signature is bound to the signer
+				require.Error(t, session.VerifyPartialSig((i+1)%int(numCovenants), partialSigs[i]))
+			}
+		}
+
+		aggSig, err := session.AggregatePartialSigs(partialSigs)
+		require.NoError(t, err)
+
+		stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+			spendStakeTx,
+			stakingInfo.StakingOutput,
+			scenario.StakerKey,
+			si.RevealedLeaf,
+		)
+		require.NoError(t, err)
+
+		witness, err := si.CreateAggregatedUnbondingPathWitness(aggSig, stakerSig)
+		require.NoError(t, err)
+		spendStakeTx.TxIn[0].Witness = witness
+
+		prevOutputFetcher := stakingInfo.GetOutputFetcher()
+		newEngine := func() (*txscript.Engine, error) {
+			return txscript.NewEngine(
+				stakingInfo.GetPkScript(),
+				spendStakeTx, 0, txscript.StandardVerifyFlags, nil,
+				txscript.NewTxSigHashes(spendStakeTx, prevOutputFetcher), stakingInfo.StakingOutput.Value,
+				prevOutputFetcher,
+			)
+		}
+		btctest.AssertEngineExecution(t, 0, true, newEngine)
+
+		// aggregation fails without partial signature of every covenant member
+		_, err = session.AggregatePartialSigs(partialSigs[1:])
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,787 @@
+package btcstaking
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// PsbtKeyDerivation describes the BIP-32 origin of a key committed to in one of
+// the Babylon scripts. It is used to populate BIP-371 taproot derivation fields
+// so that signing devices can recognise the keys they control.
+type PsbtKeyDerivation struct {
+	PubKey               *btcec.PublicKey
+	MasterKeyFingerprint uint32
+	Bip32Path            []uint32
+}
+
+// scriptSigGroup is a group of public keys checked by a single signature
+// check of a script i.e. either a single key check or a multisig
+// <Pk1> OP_CHECKSIG <Pk2> OP_CHECKSIGADD ... <threshold> OP_NUMEQUAL(VERIFY)
+type scriptSigGroup struct {
+	xOnlyKeys [][]byte
+	threshold int
+	// isVerify is true if the group signature check must succeed for the
+	// script to continue execution
+	isVerify bool
+}
+
+// scriptSigGroups returns all signature check groups of the given script
+// in the order in which they are executed
+func scriptSigGroups(script []byte) ([]*scriptSigGroup, error) {
+	var (
+		groups     []*scriptSigGroup
+		pendingKey []byte
+		// pendingThreshold holds number pushed after multisig keys
+		pendingThreshold = -1
+	)
+
+	currentGroup := func() *scriptSigGroup {
+		if len(groups) == 0 {
+			return nil
+		}
+		return groups[len(groups)-1]
+	}
+
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+	for tokenizer.Next() {
+		op := tokenizer.Opcode()
+		data := tokenizer.Data()
+
+		switch {
+		case pendingKey != nil && op == txscript.OP_CHECKSIG:
+			groups = append(groups, &scriptSigGroup{xOnlyKeys: [][]byte{pendingKey}, threshold: 1})
+		case pendingKey != nil && op == txscript.OP_CHECKSIGVERIFY:
+			groups = append(groups, &scriptSigGroup{xOnlyKeys: [][]byte{pendingKey}, threshold: 1, isVerify: true})
+		case pendingKey != nil && op == txscript.OP_CHECKSIGADD:
+			group := currentGroup()
+			if group == nil || group.isVerify {
+				return nil, fmt.Errorf("unexpected OP_CHECKSIGADD in script")
+			}
+			group.xOnlyKeys = append(group.xOnlyKeys, pendingKey)
+		case pendingThreshold >= 0 && (op == txscript.OP_NUMEQUAL || op == txscript.OP_NUMEQUALVERIFY):
+			group := currentGroup()
+			if group == nil || group.isVerify {
+				return nil, fmt.Errorf("unexpected multisig threshold in script")
+			}
+			group.threshold = pendingThreshold
+			group.isVerify = op == txscript.OP_NUMEQUALVERIFY
+		}
+
+		pendingKey = nil
+		pendingThreshold = -1
+
+		switch {
+		case len(data) == schnorr.PubKeyBytesLen:
+			pendingKey = data
+		case op >= txscript.OP_1 && op <= txscript.OP_16:
+			pendingThreshold = int(op-txscript.OP_1) + 1
+		case len(data) > 0 && len(data) <= 4 && data[len(data)-1]&0x80 == 0:
+			// minimally encoded positive script number
+			pendingThreshold = 0
+			for i := len(data) - 1; i >= 0; i-- {
+				pendingThreshold = pendingThreshold<<8 | int(data[i])
+			}
+		}
+	}
+
+	if err := tokenizer.Err(); err != nil {
+		return nil, fmt.Errorf("failed to parse script: %w", err)
+	}
+
+	for _, g := range groups {
+		if g.threshold < 1 || g.threshold > len(g.xOnlyKeys) {
+			return nil, fmt.Errorf("invalid signature threshold %d for %d keys", g.threshold, len(g.xOnlyKeys))
+		}
+	}
+
+	return groups, nil
+}
+
+func scriptContainsKey(script []byte, key *btcec.PublicKey) (bool, error) {
+	groups, err := scriptSigGroups(script)
+	if err != nil {
+		return false, err
+	}
+
+	keyBytes := schnorr.SerializePubKey(key)
+	for _, g := range groups {
+		for _, k := range g.xOnlyKeys {
+			if bytes.Equal(k, keyBytes) {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
+}
+
+func validateDerivations(derivations []*PsbtKeyDerivation) error {
+	for i, d := range derivations {
+		if d == nil || d.PubKey == nil {
+			return fmt.Errorf("derivation %d must contain public key", i)
+		}
+	}
+	return nil
+}
+
+// serializeTapTree serializes the script tree in the format defined by BIP-371
+// for the PSBT_OUT_TAP_TREE field i.e. a depth-first list of
+// <depth> <leaf version> <script length> <script> tuples
+func serializeTapTree(tree *txscript.IndexedTapScriptTree) ([]byte, error) {
+	var buf bytes.Buffer
+
+	var walk func(node txscript.TapNode, depth uint8) error
+	walk = func(node txscript.TapNode, depth uint8) error {
+		if leaf, ok := node.(txscript.TapLeaf); ok {
+			if err := buf.WriteByte(depth); err != nil {
+				return err
+			}
+			if err := buf.WriteByte(byte(leaf.LeafVersion)); err != nil {
+				return err
+			}
+			return wire.WriteVarBytes(&buf, 0, leaf.Script)
+		}
+
+		if node.Left() == nil || node.Right() == nil {
+			return fmt.Errorf("invalid taproot script tree")
+		}
+
+		if err := walk(node.Left(), depth+1); err != nil {
+			return err
+		}
+		return walk(node.Right(), depth+1)
+	}
+
+	if tree == nil || tree.RootNode == nil {
+		return nil, fmt.Errorf("taproot script tree is empty")
+	}
+
+	if err := walk(tree.RootNode, 0); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// newTaprootPsbtOutput returns psbt output committing to the taproot script tree
+// held by the provided script holder
+func newTaprootPsbtOutput(
+	sh *taprootScriptHolder,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.POutput, error) {
+	tapTree, err := serializeTapTree(sh.scriptTree)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &psbt.POutput{
+		TaprootInternalKey: schnorr.SerializePubKey(sh.internalPubKey),
+		TaprootTapTree:     tapTree,
+	}
+
+	for _, d := range derivations {
+		var leafHashes [][]byte
+		for _, proof := range sh.scriptTree.LeafMerkleProofs {
+			containsKey, err := scriptContainsKey(proof.TapLeaf.Script, d.PubKey)
+			if err != nil {
+				return nil, err
+			}
+
+			if containsKey {
+				leafHash := proof.TapLeaf.TapHash()
+				leafHashes = append(leafHashes, leafHash[:])
+			}
+		}
+
+		// key is not part of any output script, nothing to derive
+		if len(leafHashes) == 0 {
+			continue
+		}
+
+		out.TaprootBip32Derivation = append(out.TaprootBip32Derivation, &psbt.TaprootBip32Derivation{
+			XOnlyPubKey:          schnorr.SerializePubKey(d.PubKey),
+			LeafHashes:           leafHashes,
+			MasterKeyFingerprint: d.MasterKeyFingerprint,
+			Bip32Path:            d.Bip32Path,
+		})
+	}
+
+	return out, nil
+}
+
+func setTaprootPsbtOutput(
+	p *psbt.Packet,
+	outputIdx uint32,
+	expectedOutput *wire.TxOut,
+	sh *taprootScriptHolder,
+	derivations []*PsbtKeyDerivation,
+) error {
+	if int(outputIdx) >= len(p.UnsignedTx.TxOut) {
+		return fmt.Errorf("invalid output index %d, tx has %d outputs", outputIdx, len(p.UnsignedTx.TxOut))
+	}
+
+	txOut := p.UnsignedTx.TxOut[outputIdx]
+	if !bytes.Equal(txOut.PkScript, expectedOutput.PkScript) {
+		return fmt.Errorf("output %d does not commit to the expected scripts", outputIdx)
+	}
+
+	if txOut.Value != expectedOutput.Value {
+		return fmt.Errorf("output %d value %d does not match expected value %d", outputIdx, txOut.Value, expectedOutput.Value)
+	}
+
+	out, err := newTaprootPsbtOutput(sh, derivations)
+	if err != nil {
+		return err
+	}
+
+	p.Outputs[outputIdx] = *out
+
+	return nil
+}
+
+// BuildScriptPathSpendPsbt creates a PSBT for the given unsigned transaction
+// in which the input at `inputIdx` spends a Babylon taproot output through the
+// script path described by `spendInfo`.
+// `prevOutputs` must contain the outputs spent by every input of the transaction
+// in the order of inputs, as all of them are committed to in taproot signatures.
+// The spending input is populated with the BIP-371 tap leaf script, control block,
+// internal key, merkle root and derivations of the provided keys which are part
+// of the revealed script. Derivations of other keys are ignored, so the same list
+// of derivations can be used for every Babylon transaction.
+func BuildScriptPathSpendPsbt(
+	tx *wire.MsgTx,
+	prevOutputs []*wire.TxOut,
+	inputIdx int,
+	spendInfo *SpendInfo,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("tx must not be nil")
+	}
+
+	if spendInfo == nil {
+		return nil, fmt.Errorf("spend info must not be nil")
+	}
+
+	if len(prevOutputs) != len(tx.TxIn) {
+		return nil, fmt.Errorf("expected %d previous outputs, got %d", len(tx.TxIn), len(prevOutputs))
+	}
+
+	if inputIdx < 0 || inputIdx >= len(tx.TxIn) {
+		return nil, fmt.Errorf("input index %d out of range", inputIdx)
+	}
+
+	if err := validateDerivations(derivations); err != nil {
+		return nil, err
+	}
+
+	p, err := psbt.NewFromUnsignedTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create psbt: %w", err)
+	}
+
+	for i, prevOut := range prevOutputs {
+		if prevOut == nil {
+			return nil, fmt.Errorf("previous output for input %d must not be nil", i)
+		}
+		p.Inputs[i].WitnessUtxo = prevOut
+	}
+
+	script := spendInfo.GetPkScriptPath()
+	rootHash := spendInfo.ControlBlock.RootHash(script)
+	outputKey := txscript.ComputeTaprootOutputKey(spendInfo.ControlBlock.InternalKey, rootHash)
+
+	expectedPkScript, err := txscript.PayToTaprootScript(outputKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(expectedPkScript, prevOutputs[inputIdx].PkScript) {
+		return nil, fmt.Errorf("previous output of input %d does not commit to the revealed script", inputIdx)
+	}
+
+	controlBlockBytes, err := spendInfo.ControlBlock.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	leafHash := spendInfo.RevealedLeaf.TapHash()
+
+	in := &p.Inputs[inputIdx]
+	in.TaprootLeafScript = []*psbt.TaprootTapLeafScript{
+		{
+			ControlBlock: controlBlockBytes,
+			Script:       script,
+			LeafVersion:  spendInfo.RevealedLeaf.LeafVersion,
+		},
+	}
+	in.TaprootInternalKey = schnorr.SerializePubKey(spendInfo.ControlBlock.InternalKey)
+	in.TaprootMerkleRoot = rootHash
+
+	for _, d := range derivations {
+		containsKey, err := scriptContainsKey(script, d.PubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if !containsKey {
+			continue
+		}
+
+		in.TaprootBip32Derivation = append(in.TaprootBip32Derivation, &psbt.TaprootBip32Derivation{
+			XOnlyPubKey:          schnorr.SerializePubKey(d.PubKey),
+			LeafHashes:           [][]byte{leafHash[:]},
+			MasterKeyFingerprint: d.MasterKeyFingerprint,
+			Bip32Path:            d.Bip32Path,
+		})
+	}
+
+	return p, nil
+}
+
+// BuildStakingPsbt creates a PSBT for the unsigned staking transaction.
+// `prevOutputs` are the outputs spent by the staking transaction inputs, in the
+// order of inputs. The staking output at `stakingOutputIdx` is populated with
+// the BIP-371 internal key, tap tree and derivations of the provided keys.
+func BuildStakingPsbt(
+	stakingTx *wire.MsgTx,
+	prevOutputs []*wire.TxOut,
+	stakingInfo *StakingInfo,
+	stakingOutputIdx uint32,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if stakingTx == nil {
+		return nil, fmt.Errorf("staking tx must not be nil")
+	}
+
+	if stakingInfo == nil {
+		return nil, fmt.Errorf("staking info must not be nil")
+	}
+
+	if len(prevOutputs) != len(stakingTx.TxIn) {
+		return nil, fmt.Errorf("expected %d previous outputs, got %d", len(stakingTx.TxIn), len(prevOutputs))
+	}
+
+	if err := validateDerivations(derivations); err != nil {
+		return nil, err
+	}
+
+	p, err := psbt.NewFromUnsignedTx(stakingTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create psbt: %w", err)
+	}
+
+	for i, prevOut := range prevOutputs {
+		if prevOut == nil {
+			return nil, fmt.Errorf("previous output for input %d must not be nil", i)
+		}
+		p.Inputs[i].WitnessUtxo = prevOut
+	}
+
+	if err := setTaprootPsbtOutput(
+		p,
+		stakingOutputIdx,
+		stakingInfo.StakingOutput,
+		stakingInfo.scriptHolder,
+		derivations,
+	); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// BuildUnbondingPsbt creates a PSBT for the unsigned unbonding transaction which
+// spends the staking output through the unbonding path. The unbonding output
+// (always at index 0) is populated with the tap tree of the provided unbonding info.
+func BuildUnbondingPsbt(
+	unbondingTx *wire.MsgTx,
+	stakingInfo *StakingInfo,
+	unbondingInfo *UnbondingInfo,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if stakingInfo == nil {
+		return nil, fmt.Errorf("staking info must not be nil")
+	}
+
+	if unbondingInfo == nil {
+		return nil, fmt.Errorf("unbonding info must not be nil")
+	}
+
+	if err := CheckPreSignedUnbondingTxSanity(unbondingTx); err != nil {
+		return nil, err
+	}
+
+	spendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := BuildScriptPathSpendPsbt(
+		unbondingTx,
+		[]*wire.TxOut{stakingInfo.StakingOutput},
+		0,
+		spendInfo,
+		derivations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setTaprootPsbtOutput(
+		p,
+		0,
+		unbondingInfo.UnbondingOutput,
+		unbondingInfo.scriptHolder,
+		derivations,
+	); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// BuildStakingSlashingPsbt creates a PSBT for the unsigned slashing transaction
+// spending the staking output through the slashing path.
+func BuildStakingSlashingPsbt(
+	slashingTx *wire.MsgTx,
+	stakingInfo *StakingInfo,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if stakingInfo == nil {
+		return nil, fmt.Errorf("staking info must not be nil")
+	}
+
+	if err := CheckPreSignedSlashingTxSanity(slashingTx); err != nil {
+		return nil, err
+	}
+
+	spendInfo, err := stakingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildScriptPathSpendPsbt(
+		slashingTx,
+		[]*wire.TxOut{stakingInfo.StakingOutput},
+		0,
+		spendInfo,
+		derivations,
+	)
+}
+
+// BuildUnbondingSlashingPsbt creates a PSBT for the unsigned slashing transaction
+// spending the unbonding output through the slashing path.
+func BuildUnbondingSlashingPsbt(
+	slashingTx *wire.MsgTx,
+	unbondingInfo *UnbondingInfo,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if unbondingInfo == nil {
+		return nil, fmt.Errorf("unbonding info must not be nil")
+	}
+
+	if err := CheckPreSignedSlashingTxSanity(slashingTx); err != nil {
+		return nil, err
+	}
+
+	spendInfo, err := unbondingInfo.SlashingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildScriptPathSpendPsbt(
+		slashingTx,
+		[]*wire.TxOut{unbondingInfo.UnbondingOutput},
+		0,
+		spendInfo,
+		derivations,
+	)
+}
+
+// BuildStakingTimeLockPsbt creates a PSBT for the unsigned withdrawal transaction
+// spending the staking output through the timelock path.
+// It is up to the caller to set the input sequence to at least the staking time.
+func BuildStakingTimeLockPsbt(
+	withdrawalTx *wire.MsgTx,
+	stakingInfo *StakingInfo,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if stakingInfo == nil {
+		return nil, fmt.Errorf("staking info must not be nil")
+	}
+
+	if withdrawalTx == nil || len(withdrawalTx.TxIn) != 1 {
+		return nil, fmt.Errorf("withdrawal tx must have exactly one input")
+	}
+
+	spendInfo, err := stakingInfo.TimeLockPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildScriptPathSpendPsbt(
+		withdrawalTx,
+		[]*wire.TxOut{stakingInfo.StakingOutput},
+		0,
+		spendInfo,
+		derivations,
+	)
+}
+
+// BuildUnbondingTimeLockPsbt creates a PSBT for the unsigned withdrawal transaction
+// spending the unbonding output through the timelock path.
+// It is up to the caller to set the input sequence to at least the unbonding time.
+func BuildUnbondingTimeLockPsbt(
+	withdrawalTx *wire.MsgTx,
+	unbondingInfo *UnbondingInfo,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if unbondingInfo == nil {
+		return nil, fmt.Errorf("unbonding info must not be nil")
+	}
+
+	if withdrawalTx == nil || len(withdrawalTx.TxIn) != 1 {
+		return nil, fmt.Errorf("withdrawal tx must have exactly one input")
+	}
+
+	spendInfo, err := unbondingInfo.TimeLockPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	return BuildScriptPathSpendPsbt(
+		withdrawalTx,
+		[]*wire.TxOut{unbondingInfo.UnbondingOutput},
+		0,
+		spendInfo,
+		derivations,
+	)
+}
+
+// BuildStakeExpansionPsbt creates a PSBT for the unsigned stake expansion
+// transaction. The stake expansion transaction has exactly two inputs:
+// - input 0 spends the previous staking output through the unbonding path
+// - input 1 spends `fundingOutput` which pays for fees and optionally increases
+// the staked amount
+// The new staking output at `stakingOutputIdx` is populated with the tap tree
+// of `newStakingInfo`.
+func BuildStakeExpansionPsbt(
+	stakeExpansionTx *wire.MsgTx,
+	prevStakingInfo *StakingInfo,
+	fundingOutput *wire.TxOut,
+	newStakingInfo *StakingInfo,
+	stakingOutputIdx uint32,
+	derivations []*PsbtKeyDerivation,
+) (*psbt.Packet, error) {
+	if prevStakingInfo == nil || newStakingInfo == nil {
+		return nil, fmt.Errorf("staking info must not be nil")
+	}
+
+	if fundingOutput == nil {
+		return nil, fmt.Errorf("funding output must not be nil")
+	}
+
+	if stakeExpansionTx == nil || len(stakeExpansionTx.TxIn) != 2 {
+		return nil, fmt.Errorf("stake expansion tx must have exactly two inputs")
+	}
+
+	spendInfo, err := prevStakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := BuildScriptPathSpendPsbt(
+		stakeExpansionTx,
+		[]*wire.TxOut{prevStakingInfo.StakingOutput, fundingOutput},
+		0,
+		spendInfo,
+		derivations,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := setTaprootPsbtOutput(
+		p,
+		stakingOutputIdx,
+		newStakingInfo.StakingOutput,
+		newStakingInfo.scriptHolder,
+		derivations,
+	); err != nil {
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// psbtInputLeafScript returns the only tap leaf script of the input at `inputIdx`
+func psbtInputLeafScript(p *psbt.Packet, inputIdx int) (*psbt.TaprootTapLeafScript, error) {
+	if p == nil {
+		return nil, fmt.Errorf("psbt must not be nil")
+	}
+
+	if inputIdx < 0 || inputIdx >= len(p.Inputs) {
+		return nil, fmt.Errorf("input index %d out of range", inputIdx)
+	}
+
+	in := &p.Inputs[inputIdx]
+	if len(in.TaprootLeafScript) != 1 {
+		return nil, fmt.Errorf("input %d must have exactly one tap leaf script, got %d", inputIdx, len(in.TaprootLeafScript))
+	}
+
+	return in.TaprootLeafScript[0], nil
+}
+
+// AddScriptPathSigToPsbt adds a BIP-340 signature over the script path spent by
+// the input at `inputIdx`. It is intended to merge signatures produced outside
+// of a PSBT flow (e.g. covenant signatures retrieved from Babylon) into the PSBT.
+func AddScriptPathSigToPsbt(
+	p *psbt.Packet,
+	inputIdx int,
+	pubKey *btcec.PublicKey,
+	sig *schnorr.Signature,
+) error {
+	if pubKey == nil {
+		return fmt.Errorf("public key must not be nil")
+	}
+
+	if sig == nil {
+		return fmt.Errorf("signature must not be nil")
+	}
+
+	leafScript, err := psbtInputLeafScript(p, inputIdx)
+	if err != nil {
+		return err
+	}
+
+	containsKey, err := scriptContainsKey(leafScript.Script, pubKey)
+	if err != nil {
+		return err
+	}
+
+	if !containsKey {
+		return fmt.Errorf("key %s is not part of the revealed script", keyToString(pubKey))
+	}
+
+	leafHash := txscript.NewTapLeaf(leafScript.LeafVersion, leafScript.Script).TapHash()
+	xOnlyKey := schnorr.SerializePubKey(pubKey)
+
+	in := &p.Inputs[inputIdx]
+	for _, s := range in.TaprootScriptSpendSig {
+		if bytes.Equal(s.XOnlyPubKey, xOnlyKey) && bytes.Equal(s.LeafHash, leafHash[:]) {
+			return fmt.Errorf("signature of key %s already present", keyToString(pubKey))
+		}
+	}
+
+	in.TaprootScriptSpendSig = append(in.TaprootScriptSpendSig, &psbt.TaprootScriptSpendSig{
+		XOnlyPubKey: xOnlyKey,
+		LeafHash:    leafHash[:],
+		Signature:   sig.Serialize(),
+		SigHash:     txscript.SigHashDefault,
+	})
+
+	return nil
+}
+
+// FinalizeScriptPathPsbtInput builds the final witness of the input at `inputIdx`
+// from the script spend signatures in the PSBT. The produced witness has the same
+// layout as the one built by CreateTimeLockPathWitness, CreateUnbondingPathWitness
+// or CreateSlashingPathWitness for the revealed script i.e signatures are ordered
+// in reverse order of the keys in the script and missing multisig signatures
+// are replaced by empty elements.
+// As multisig scripts require exactly the threshold number of valid signatures,
+// if more signatures than the threshold are present only the ones of keys appearing
+// first in the script are used.
+func FinalizeScriptPathPsbtInput(p *psbt.Packet, inputIdx int) error {
+	leafScript, err := psbtInputLeafScript(p, inputIdx)
+	if err != nil {
+		return err
+	}
+
+	in := &p.Inputs[inputIdx]
+	if len(in.FinalScriptWitness) > 0 {
+		return psbt.ErrInputAlreadyFinalized
+	}
+
+	controlBlock, err := txscript.ParseControlBlock(leafScript.ControlBlock)
+	if err != nil {
+		return fmt.Errorf("invalid control block: %w", err)
+	}
+
+	revealedLeaf := txscript.NewTapLeaf(leafScript.LeafVersion, leafScript.Script)
+	leafHash := revealedLeaf.TapHash()
+
+	groups, err := scriptSigGroups(leafScript.Script)
+	if err != nil {
+		return err
+	}
+
+	if len(groups) == 0 {
+		return fmt.Errorf("revealed script does not contain any keys")
+	}
+
+	sigs := make(map[string][]byte)
+	for _, s := range in.TaprootScriptSpendSig {
+		if !bytes.Equal(s.LeafHash, leafHash[:]) {
+			return fmt.Errorf("signature of key %x does not commit to the revealed script", s.XOnlyPubKey)
+		}
+
+		if s.SigHash != txscript.SigHashDefault {
+			return fmt.Errorf("signature of key %x has unexpected sighash type %v", s.XOnlyPubKey, s.SigHash)
+		}
+
+		sigs[string(s.XOnlyPubKey)] = s.Signature
+	}
+
+	// signatures in the order of keys in the script
+	var orderedSigs [][]byte
+	for _, g := range groups {
+		numSigs := 0
+		for _, key := range g.xOnlyKeys {
+			sig, ok := sigs[string(key)]
+			if !ok || numSigs == g.threshold {
+				orderedSigs = append(orderedSigs, []byte{})
+				continue
+			}
+			orderedSigs = append(orderedSigs, sig)
+			numSigs++
+		}
+
+		if numSigs < g.threshold {
+			return fmt.Errorf("not enough signatures for keys %x: got %d, required %d", g.xOnlyKeys, numSigs, g.threshold)
+		}
+	}
+
+	// the first key in the script consumes the top of the stack, so the
+	// signatures must be provided in reverse order of the keys
+	witnessSigs := make([][]byte, len(orderedSigs))
+	for i, sig := range orderedSigs {
+		witnessSigs[len(orderedSigs)-1-i] = sig
+	}
+
+	witness, err := CreateWitness(&SpendInfo{
+		ControlBlock: *controlBlock,
+		RevealedLeaf: revealedLeaf,
+	}, witnessSigs)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := psbt.WriteTxWitness(&buf, witness); err != nil {
+		return err
+	}
+
+	finalInput := psbt.NewPsbtInput(nil, in.WitnessUtxo)
+	finalInput.FinalScriptWitness = buf.Bytes()
+	p.Inputs[inputIdx] = *finalInput
+
+	return nil
+}
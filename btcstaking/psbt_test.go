@@ -0,0 +1,494 @@
+package btcstaking_test
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	btctest "github.com/babylonlabs-io/babylon/v4/testutil/bitcoin"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/btcutil/psbt"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+)
+
+// tapTreeRoot rebuilds the merkle root of the tree serialized in PSBT_OUT_TAP_TREE format
+func tapTreeRoot(t *testing.T, tapTree []byte) chainhash.Hash {
+	type node struct {
+		depth uint8
+		hash  chainhash.Hash
+	}
+
+	var stack []node
+	r := bytes.NewReader(tapTree)
+	for r.Len() > 0 {
+		depth, err := r.ReadByte()
+		require.NoError(t, err)
+		version, err := r.ReadByte()
+		require.NoError(t, err)
+		script, err := wire.ReadVarBytes(r, 0, txscript.MaxScriptSize, "script")
+		require.NoError(t, err)
+
+		stack = append(stack, node{
+			depth: depth,
+			hash:  txscript.NewTapLeaf(txscript.TapscriptLeafVersion(version), script).TapHash(),
+		})
+
+		// merge siblings as long as two last nodes are at the same depth
+		for len(stack) >= 2 && stack[len(stack)-1].depth == stack[len(stack)-2].depth {
+			left, right := stack[len(stack)-2], stack[len(stack)-1]
+			branch := txscript.NewTapBranch(
+				hashNode(left.hash), hashNode(right.hash),
+			)
+			stack = append(stack[:len(stack)-2], node{depth: left.depth - 1, hash: branch.TapHash()})
+		}
+	}
+
+	require.Len(t, stack, 1)
+	require.Equal(t, uint8(0), stack[0].depth)
+
+	return stack[0].hash
+}
+
+// hashNode is a tap node with only a known hash
+type hashNode chainhash.Hash
+
+func (h hashNode) TapHash() chainhash.Hash { return chainhash.Hash(h) }
+func (h hashNode) Left() txscript.TapNode  { return nil }
+func (h hashNode) Right() txscript.TapNode { return nil }
+
+func requirePsbtOutputCommitsTo(t *testing.T, out *psbt.POutput, txOut *wire.TxOut) {
+	internalKey, err := schnorr.ParsePubKey(out.TaprootInternalKey)
+	require.NoError(t, err)
+
+	root := tapTreeRoot(t, out.TaprootTapTree)
+	outputKey := txscript.ComputeTaprootOutputKey(internalKey, root[:])
+	pkScript, err := txscript.PayToTaprootScript(outputKey)
+	require.NoError(t, err)
+	require.Equal(t, txOut.PkScript, pkScript)
+}
+
+// roundTripPsbt serializes and deserializes psbt to ensure all populated fields are valid
+func roundTripPsbt(t *testing.T, p *psbt.Packet) *psbt.Packet {
+	encoded, err := p.B64Encode()
+	require.NoError(t, err)
+
+	decoded, err := psbt.NewFromRawBytes(bytes.NewReader([]byte(encoded)), true)
+	require.NoError(t, err)
+
+	return decoded
+}
+
+func finalizeAndExecute(
+	t *testing.T,
+	p *psbt.Packet,
+	prevOutputs []*wire.TxOut,
+	expectedWitness wire.TxWitness,
+) {
+	p = roundTripPsbt(t, p)
+	require.NoError(t, btcstaking.FinalizeScriptPathPsbtInput(p, 0))
+
+	// other inputs are regular wallet inputs, which are not under test
+	for i := 1; i < len(p.Inputs); i++ {
+		p.Inputs[i].FinalScriptWitness = []byte{0}
+	}
+
+	tx, err := psbt.Extract(p)
+	require.NoError(t, err)
+	require.Equal(t, expectedWitness, tx.TxIn[0].Witness)
+
+	prevOutputFetcher := txscript.NewMultiPrevOutFetcher(nil)
+	for i, out := range prevOutputs {
+		prevOutputFetcher.AddPrevOut(tx.TxIn[i].PreviousOutPoint, out)
+	}
+
+	newEngine := func() (*txscript.Engine, error) {
+		return txscript.NewEngine(
+			prevOutputs[0].PkScript,
+			tx, 0, txscript.StandardVerifyFlags, nil,
+			txscript.NewTxSigHashes(tx, prevOutputFetcher), prevOutputs[0].Value,
+			prevOutputFetcher,
+		)
+	}
+	btctest.AssertEngineExecution(t, 0, true, newEngine)
+}
+
+func addSigs(
+	t *testing.T,
+	p *psbt.Packet,
+	keys []*btcec.PrivateKey,
+	sigs []*schnorr.Signature,
+) {
+	for i, sig := range sigs {
+		if sig == nil {
+			continue
+		}
+		require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, keys[i].PubKey(), sig))
+	}
+}
+
+func signAll(
+	t *testing.T,
+	keys []*btcec.PrivateKey,
+	tx *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	leaf txscript.TapLeaf,
+) []*schnorr.Signature {
+	sigs := make([]*schnorr.Signature, len(keys))
+	for i, key := range keys {
+		sig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(tx, fundingOutput, key, leaf)
+		require.NoError(t, err)
+		sigs[i] = sig
+	}
+	return sigs
+}
+
+func stakerDerivation(scenario *TestScenario) []*btcstaking.PsbtKeyDerivation {
+	return []*btcstaking.PsbtKeyDerivation{
+		{
+			PubKey:               scenario.StakerKey.PubKey(),
+			MasterKeyFingerprint: 0xdeadbeef,
+			Bip32Path:            []uint32{86 + 0x80000000, 0x80000000, 0x80000000, 0, 1},
+		},
+	}
+}
+
+func TestStakingPsbt(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 5)
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	fundingOutput := taprootOutputWithValue(t, r, scenario.StakingAmount*2)
+	stakingTx := wire.NewMsgTx(2)
+	stakingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	stakingTx.AddTxOut(taprootOutputWithValue(t, r, scenario.StakingAmount/2))
+	stakingTx.AddTxOut(stakingInfo.StakingOutput)
+
+	derivations := stakerDerivation(scenario)
+
+	p, err := btcstaking.BuildStakingPsbt(
+		stakingTx, []*wire.TxOut{fundingOutput}, stakingInfo, 1, derivations,
+	)
+	require.NoError(t, err)
+	p = roundTripPsbt(t, p)
+
+	require.Equal(t, fundingOutput, p.Inputs[0].WitnessUtxo)
+	require.Empty(t, p.Outputs[0].TaprootTapTree)
+	requirePsbtOutputCommitsTo(t, &p.Outputs[1], stakingInfo.StakingOutput)
+
+	// staker key is part of all three staking paths
+	require.Len(t, p.Outputs[1].TaprootBip32Derivation, 1)
+	derivation := p.Outputs[1].TaprootBip32Derivation[0]
+	require.Equal(t, schnorr.SerializePubKey(scenario.StakerKey.PubKey()), derivation.XOnlyPubKey)
+	require.Len(t, derivation.LeafHashes, 3)
+	require.Equal(t, derivations[0].MasterKeyFingerprint, derivation.MasterKeyFingerprint)
+	require.Equal(t, derivations[0].Bip32Path, derivation.Bip32Path)
+
+	// wrong output index must be rejected
+	_, err = btcstaking.BuildStakingPsbt(
+		stakingTx, []*wire.TxOut{fundingOutput}, stakingInfo, 0, derivations,
+	)
+	require.Error(t, err)
+}
+
+func TestUnbondingPathPsbt(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 5)
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount-1000,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	unbondingTx := wire.NewMsgTx(2)
+	unbondingTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	unbondingTx.AddTxOut(unbondingInfo.UnbondingOutput)
+
+	p, err := btcstaking.BuildUnbondingPsbt(unbondingTx, stakingInfo, unbondingInfo, stakerDerivation(scenario))
+	require.NoError(t, err)
+	p = roundTripPsbt(t, p)
+
+	require.Len(t, p.Inputs[0].TaprootLeafScript, 1)
+	require.Len(t, p.Inputs[0].TaprootBip32Derivation, 1)
+	require.NotEmpty(t, p.Inputs[0].TaprootMerkleRoot)
+	requirePsbtOutputCommitsTo(t, &p.Outputs[0], unbondingInfo.UnbondingOutput)
+
+	si, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+		unbondingTx, stakingInfo.StakingOutput, scenario.StakerKey, si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+
+	covenantSigs := signAll(t, scenario.CovenantKeys, unbondingTx, stakingInfo.StakingOutput, si.RevealedLeaf)
+	covenantSigs[1] = nil
+	covenantSigs[3] = nil
+
+	addSigs(t, p, scenario.CovenantKeys, covenantSigs)
+	require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.StakerKey.PubKey(), stakerSig))
+
+	// adding the same signature twice or signature of unrelated key must fail
+	require.Error(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.StakerKey.PubKey(), stakerSig))
+	require.Error(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.FinalityProviderKeys[0].PubKey(), stakerSig))
+
+	// covenant signatures in witness follow reverse lexicographical order of keys
+	sortedCovSigs := make([]*schnorr.Signature, 0, len(covenantSigs))
+	for _, pk := range btcstaking.SortKeys(scenario.CovenantPublicKeys()) {
+		for i, key := range scenario.CovenantKeys {
+			if key.PubKey().IsEqual(pk) {
+				sortedCovSigs = append([]*schnorr.Signature{covenantSigs[i]}, sortedCovSigs...)
+			}
+		}
+	}
+	expectedWitness, err := si.CreateUnbondingPathWitness(sortedCovSigs, stakerSig)
+	require.NoError(t, err)
+
+	finalizeAndExecute(t, p, []*wire.TxOut{stakingInfo.StakingOutput}, expectedWitness)
+}
+
+func TestSlashingPathPsbt(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 2, 5, 3, btcutil.Amount(2*10e8), 5)
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount-1000,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	// slashing tx has slashing output and change output
+	slashingTx := createSpendStakeTx(scenario.StakingAmount.MulF64(0.1))
+	slashingTx.AddTxOut(taprootOutputWithValue(t, r, scenario.StakingAmount.MulF64(0.8)))
+
+	testCases := []struct {
+		name          string
+		fundingOutput *wire.TxOut
+		spendInfo     func() (*btcstaking.SpendInfo, error)
+		build         func() (*psbt.Packet, error)
+	}{
+		{
+			name:          "slashing staking output",
+			fundingOutput: stakingInfo.StakingOutput,
+			spendInfo:     stakingInfo.SlashingPathSpendInfo,
+			build: func() (*psbt.Packet, error) {
+				return btcstaking.BuildStakingSlashingPsbt(slashingTx, stakingInfo, stakerDerivation(scenario))
+			},
+		},
+		{
+			name:          "slashing unbonding output",
+			fundingOutput: unbondingInfo.UnbondingOutput,
+			spendInfo:     unbondingInfo.SlashingPathSpendInfo,
+			build: func() (*psbt.Packet, error) {
+				return btcstaking.BuildUnbondingSlashingPsbt(slashingTx, unbondingInfo, stakerDerivation(scenario))
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := tc.build()
+			require.NoError(t, err)
+
+			si, err := tc.spendInfo()
+			require.NoError(t, err)
+
+			stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+				slashingTx, tc.fundingOutput, scenario.StakerKey, si.RevealedLeaf,
+			)
+			require.NoError(t, err)
+
+			covenantSigs := datagen.GenerateSignatures(t, scenario.CovenantKeys, slashingTx, tc.fundingOutput, si.RevealedLeaf)
+			fpSigs := datagen.GenerateSignatures(t, scenario.FinalityProviderKeys, slashingTx, tc.fundingOutput, si.RevealedLeaf)
+
+			require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.StakerKey.PubKey(), stakerSig))
+			addSigs(t, p, scenario.CovenantKeys, signAll(t, scenario.CovenantKeys, slashingTx, tc.fundingOutput, si.RevealedLeaf))
+
+			// only one of the finality providers signs
+			fpSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+				slashingTx, tc.fundingOutput, scenario.FinalityProviderKeys[0], si.RevealedLeaf,
+			)
+			require.NoError(t, err)
+			require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.FinalityProviderKeys[0].PubKey(), fpSig))
+			for i := range fpSigs {
+				if !bytes.Equal(fpSigs[i].Serialize(), fpSig.Serialize()) {
+					fpSigs[i] = nil
+				}
+			}
+
+			// all covenant members signed, finalizer uses only the quorum of signatures
+			// of keys first in the script
+			covenantSigs[0] = nil
+			covenantSigs[1] = nil
+
+			expectedWitness, err := si.CreateSlashingPathWitness(covenantSigs, fpSigs, stakerSig)
+			require.NoError(t, err)
+
+			finalizeAndExecute(t, p, []*wire.TxOut{tc.fundingOutput}, expectedWitness)
+		})
+	}
+}
+
+func TestTimeLockPathPsbt(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 5)
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	withdrawalTx := createSpendStakeTx(scenario.StakingAmount.MulF64(0.5))
+	withdrawalTx.TxIn[0].Sequence = uint32(scenario.StakingTime)
+
+	p, err := btcstaking.BuildStakingTimeLockPsbt(withdrawalTx, stakingInfo, stakerDerivation(scenario))
+	require.NoError(t, err)
+
+	si, err := stakingInfo.TimeLockPathSpendInfo()
+	require.NoError(t, err)
+
+	// finalizing without staker signature must fail
+	require.Error(t, btcstaking.FinalizeScriptPathPsbtInput(p, 0))
+
+	sig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(
+		withdrawalTx, stakingInfo.StakingOutput, scenario.StakerKey, si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+	require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.StakerKey.PubKey(), sig))
+
+	expectedWitness, err := si.CreateTimeLockPathWitness(sig)
+	require.NoError(t, err)
+
+	finalizeAndExecute(t, p, []*wire.TxOut{stakingInfo.StakingOutput}, expectedWitness)
+}
+
+func TestStakeExpansionPsbt(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 5)
+
+	prevStakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	newStakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount*2,
+		&chaincfg.MainNetParams,
+	)
+	require.NoError(t, err)
+
+	fundingOutput := taprootOutputWithValue(t, r, scenario.StakingAmount+10000)
+
+	stakeExpansionTx := wire.NewMsgTx(2)
+	stakeExpansionTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{1}, 0), nil, nil))
+	stakeExpansionTx.AddTxIn(wire.NewTxIn(wire.NewOutPoint(&chainhash.Hash{2}, 1), nil, nil))
+	stakeExpansionTx.AddTxOut(newStakingInfo.StakingOutput)
+
+	p, err := btcstaking.BuildStakeExpansionPsbt(
+		stakeExpansionTx,
+		prevStakingInfo,
+		fundingOutput,
+		newStakingInfo,
+		0,
+		stakerDerivation(scenario),
+	)
+	require.NoError(t, err)
+	require.Equal(t, fundingOutput, p.Inputs[1].WitnessUtxo)
+	requirePsbtOutputCommitsTo(t, &p.Outputs[0], newStakingInfo.StakingOutput)
+
+	si, err := prevStakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	stakerSig, err := btcstaking.SignTxForFirstScriptSpendWithTwoInputsFromTapLeaf(
+		stakeExpansionTx, prevStakingInfo.StakingOutput, fundingOutput, scenario.StakerKey, si.RevealedLeaf,
+	)
+	require.NoError(t, err)
+	require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, scenario.StakerKey.PubKey(), stakerSig))
+
+	covenantSigs := datagen.GenerateSignaturesForStakeExpansion(
+		t, scenario.CovenantKeys, stakeExpansionTx, prevStakingInfo.StakingOutput, fundingOutput, si.RevealedLeaf,
+	)
+	for _, key := range scenario.CovenantKeys {
+		sig, err := btcstaking.SignTxForFirstScriptSpendWithTwoInputsFromTapLeaf(
+			stakeExpansionTx, prevStakingInfo.StakingOutput, fundingOutput, key, si.RevealedLeaf,
+		)
+		require.NoError(t, err)
+		require.NoError(t, btcstaking.AddScriptPathSigToPsbt(p, 0, key.PubKey(), sig))
+	}
+
+	covenantSigs[0] = nil
+	covenantSigs[1] = nil
+
+	expectedWitness, err := si.CreateUnbondingPathWitness(covenantSigs, stakerSig)
+	require.NoError(t, err)
+
+	finalizeAndExecute(t, p, []*wire.TxOut{prevStakingInfo.StakingOutput, fundingOutput}, expectedWitness)
+}
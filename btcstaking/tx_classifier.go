@@ -0,0 +1,684 @@
+package btcstaking
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+
+	"github.com/babylonlabs-io/babylon/v4/btctxformatter"
+)
+
+// BabylonTxType is the type of Babylon related Bitcoin transaction
+type BabylonTxType int
+
+const (
+	// BabylonTxTypeUnknown is a transaction not recognized as Babylon related
+	BabylonTxTypeUnknown BabylonTxType = iota
+	// BabylonTxTypeV0Staking is a V0 identifiable staking transaction
+	BabylonTxTypeV0Staking
+	// BabylonTxTypeUnbonding is an unbonding transaction spending the staking
+	// output through the unbonding path
+	BabylonTxTypeUnbonding
+	// BabylonTxTypeSlashing is a slashing transaction spending the staking or
+	// unbonding output through the slashing path
+	BabylonTxTypeSlashing
+	// BabylonTxTypeStakeExpansion is a stake expansion transaction spending the
+	// previous staking output through the unbonding path together with a funding output
+	BabylonTxTypeStakeExpansion
+	// BabylonTxTypeTimeLockWithdrawal is a transaction spending the staking, unbonding
+	// or slashing change output through the timelock path
+	BabylonTxTypeTimeLockWithdrawal
+	// BabylonTxTypeCheckpoint is a transaction carrying one of the parts of
+	// Babylon checkpoint in its OP_RETURN output
+	BabylonTxTypeCheckpoint
+	// BabylonTxTypeV1Staking is a V1 identifiable staking transaction
+	BabylonTxTypeV1Staking
+)
+
+func (t BabylonTxType) String() string {
+	switch t {
+	case BabylonTxTypeV0Staking:
+		return "v0_staking"
+	case BabylonTxTypeV1Staking:
+		return "v1_staking"
+	case BabylonTxTypeUnbonding:
+		return "unbonding"
+	case BabylonTxTypeSlashing:
+		return "slashing"
+	case BabylonTxTypeStakeExpansion:
+		return "stake_expansion"
+	case BabylonTxTypeTimeLockWithdrawal:
+		return "timelock_withdrawal"
+	case BabylonTxTypeCheckpoint:
+		return "checkpoint"
+	default:
+		return "unknown"
+	}
+}
+
+// SpendPathType is the type of script path of a Babylon output
+type SpendPathType int
+
+const (
+	SpendPathTypeTimeLock SpendPathType = iota
+	SpendPathTypeUnbonding
+	SpendPathTypeSlashing
+)
+
+func (t SpendPathType) String() string {
+	switch t {
+	case SpendPathTypeTimeLock:
+		return "timelock"
+	case SpendPathTypeUnbonding:
+		return "unbonding"
+	case SpendPathTypeSlashing:
+		return "slashing"
+	default:
+		return "unknown"
+	}
+}
+
+// SpentOutputType is the type of Babylon output spent by a transaction. It is
+// derived from the depth of the revealed script in the taproot script tree.
+type SpentOutputType int
+
+const (
+	SpentOutputTypeStaking SpentOutputType = iota
+	SpentOutputTypeUnbonding
+	// SpentOutputTypeStakingOrUnbonding is used for slashing path spends as
+	// slashing path has the same depth in both staking and unbonding outputs
+	SpentOutputTypeStakingOrUnbonding
+	// SpentOutputTypeSlashingChange is the relative timelock output of slashing tx
+	SpentOutputTypeSlashingChange
+)
+
+func (t SpentOutputType) String() string {
+	switch t {
+	case SpentOutputTypeStaking:
+		return "staking"
+	case SpentOutputTypeUnbonding:
+		return "unbonding"
+	case SpentOutputTypeStakingOrUnbonding:
+		return "staking_or_unbonding"
+	case SpentOutputTypeSlashingChange:
+		return "slashing_change"
+	default:
+		return "unknown"
+	}
+}
+
+// ClassifierParams are the parameters necessary to recognize Babylon related
+// transactions. They correspond to the version of Babylon staking parameters
+// in which the transaction was created.
+type ClassifierParams struct {
+	// StakingTag is the tag of identifiable staking transactions. If empty,
+	// identifiable staking transactions are not recognized.
+	StakingTag []byte
+	// CheckpointTag is the tag of Babylon checkpoint transactions. If empty,
+	// checkpoint transactions are not recognized.
+	CheckpointTag []byte
+	// CovenantKeys are the keys of the covenant committee. If empty, only
+	// checkpoint transactions are recognized.
+	CovenantKeys []*btcec.PublicKey
+	// CovenantQuorum is the number of covenant signatures required by scripts
+	CovenantQuorum   uint32
+	SlashingPkScript []byte
+	// StakeExpansion are the parameters of the new staking output of stake
+	// expansion transactions. If nil, stake expansion transactions are not
+	// recognized.
+	StakeExpansion *StakeExpansionParams
+	Net            *chaincfg.Params
+}
+
+// StakeExpansionParams are the parameters of the new staking output created by
+// a stake expansion transaction. The covenant committee corresponds to the
+// version of Babylon staking parameters in force at the BTC height at which the
+// stake expansion is included, which may differ from the version of the spent
+// staking output e.g. after a covenant committee rotation. The finality
+// providers and the staking time cannot be recovered from the taproot output,
+// so they are the ones of the stake expansion registered on Babylon.
+type StakeExpansionParams struct {
+	CovenantKeys   []*btcec.PublicKey
+	CovenantQuorum uint32
+	// CovenantMusig2Unbonding is set if the unbonding path of the new staking
+	// output commits to the MuSig2 aggregated key of the covenant committee
+	CovenantMusig2Unbonding bool
+	FinalityProviderKeys    []*btcec.PublicKey
+	StakingTime             uint16
+}
+
+func (p *StakeExpansionParams) validate() error {
+	if len(p.CovenantKeys) == 0 || p.CovenantQuorum == 0 || int(p.CovenantQuorum) > len(p.CovenantKeys) {
+		return fmt.Errorf("invalid stake expansion covenant quorum %d for %d covenant keys", p.CovenantQuorum, len(p.CovenantKeys))
+	}
+
+	if len(p.FinalityProviderKeys) == 0 {
+		return fmt.Errorf("stake expansion finality provider keys must be specified")
+	}
+
+	if p.StakingTime == 0 {
+		return fmt.Errorf("stake expansion staking time must be positive")
+	}
+
+	return nil
+}
+
+func (p *ClassifierParams) validate() error {
+	if len(p.StakingTag) != 0 && len(p.StakingTag) != TagLen {
+		return fmt.Errorf("invalid staking tag length: %d, expected: %d", len(p.StakingTag), TagLen)
+	}
+
+	if len(p.CheckpointTag) != 0 && len(p.CheckpointTag) != btctxformatter.TagLength {
+		return fmt.Errorf("invalid checkpoint tag length: %d, expected: %d", len(p.CheckpointTag), btctxformatter.TagLength)
+	}
+
+	if len(p.CovenantKeys) > 0 && (p.CovenantQuorum == 0 || int(p.CovenantQuorum) > len(p.CovenantKeys)) {
+		return fmt.Errorf("invalid covenant quorum %d for %d covenant keys", p.CovenantQuorum, len(p.CovenantKeys))
+	}
+
+	if p.StakeExpansion != nil {
+		if err := p.StakeExpansion.validate(); err != nil {
+			return err
+		}
+	}
+
+	if p.Net == nil {
+		return fmt.Errorf("btc network params must be specified")
+	}
+
+	return nil
+}
+
+// ScriptPathSpend describes the spend of a Babylon output through one of its
+// script paths
+type ScriptPathSpend struct {
+	InputIdx    int
+	Path        SpendPathType
+	SpentOutput SpentOutputType
+	StakerKey   *btcec.PublicKey
+	// FinalityProviderKeys are only revealed in the slashing path
+	FinalityProviderKeys []*btcec.PublicKey
+	// CovenantKeys are revealed in the unbonding and slashing paths
+	CovenantKeys   []*btcec.PublicKey
+	CovenantQuorum uint32
+	// AggregatedCovenant is set if the unbonding path commits to the MuSig2
+	// aggregated key of the covenant committee. CovenantKeys then hold the
+	// aggregated key only.
+	AggregatedCovenant bool
+	// LockTime is only revealed in the timelock path
+	LockTime uint16
+}
+
+// CheckpointPart is one of the parts of Babylon checkpoint
+type CheckpointPart struct {
+	OutputIdx int
+	// Index is the index of the part of checkpoint
+	Index uint8
+	// Data is the checkpoint part data without the header
+	Data []byte
+}
+
+// StakeExpansionOutput is the new staking output created by stake expansion
+type StakeExpansionOutput struct {
+	OutputIdx   int
+	StakingTime uint16
+}
+
+// ClassifiedBabylonTx is the description of a Babylon related Bitcoin transaction
+type ClassifiedBabylonTx struct {
+	Type BabylonTxType
+	// StakingTx is set for identifiable staking transactions
+	StakingTx *ParsedStakingTx
+	// Spend is set for transactions spending Babylon outputs
+	Spend *ScriptPathSpend
+	// StakeExpansion is set for stake expansion transactions
+	StakeExpansion *StakeExpansionOutput
+	// SlashingOutputIdx is the index of the output paying to the slashing
+	// pk script in slashing transactions, -1 otherwise
+	SlashingOutputIdx int
+	// Checkpoint is set for checkpoint transactions
+	Checkpoint *CheckpointPart
+}
+
+// ClassifyBabylonTx identifies whether the given transaction is one of the
+// Babylon related transactions. The spending transactions (unbonding, slashing,
+// stake expansion and timelock withdrawal) are recognized from the witness of
+// their first input, so they must be signed i.e as observed on Bitcoin.
+// Transactions which are not Babylon related are classified as BabylonTxTypeUnknown
+// without an error. Error is returned only for invalid arguments.
+func ClassifyBabylonTx(tx *wire.MsgTx, params *ClassifierParams) (*ClassifiedBabylonTx, error) {
+	if tx == nil {
+		return nil, fmt.Errorf("nil tx")
+	}
+
+	if params == nil {
+		return nil, fmt.Errorf("nil classifier params")
+	}
+
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	result := &ClassifiedBabylonTx{
+		Type:              BabylonTxTypeUnknown,
+		SlashingOutputIdx: -1,
+	}
+
+	// 1. transactions spending Babylon outputs always spend them in the first input
+	if len(params.CovenantKeys) > 0 && len(tx.TxIn) > 0 {
+		spend, err := parseScriptPathSpend(tx.TxIn[0].Witness)
+		if err == nil && spend.matchesCovenants(params) {
+			spend.InputIdx = 0
+			result.Spend = spend
+
+			switch spend.Path {
+			case SpendPathTypeTimeLock:
+				result.Type = BabylonTxTypeTimeLockWithdrawal
+				return result, nil
+			case SpendPathTypeUnbonding:
+				if len(tx.TxIn) == 2 {
+					if expansion := matchStakeExpansionOutput(tx, spend, params); expansion != nil {
+						result.Type = BabylonTxTypeStakeExpansion
+						result.StakeExpansion = expansion
+						return result, nil
+					}
+					break
+				}
+				if CheckPreSignedUnbondingTxSanity(stripWitness(tx)) == nil {
+					result.Type = BabylonTxTypeUnbonding
+					return result, nil
+				}
+			case SpendPathTypeSlashing:
+				if CheckPreSignedSlashingTxSanity(stripWitness(tx)) == nil {
+					for i, out := range tx.TxOut {
+						if bytes.Equal(out.PkScript, params.SlashingPkScript) {
+							result.SlashingOutputIdx = i
+							break
+						}
+					}
+					if result.SlashingOutputIdx >= 0 {
+						result.Type = BabylonTxTypeSlashing
+						return result, nil
+					}
+				}
+			}
+
+			// spends Babylon output but does not have expected shape
+			result.Spend = nil
+		}
+	}
+
+	// 2. identifiable staking transaction
+	if len(params.CovenantKeys) > 0 && len(params.StakingTag) > 0 && IsPossibleIdentifiableStakingTx(tx, params.StakingTag) {
+		parsed, err := ParseIdentifiableStakingTx(tx, params.StakingTag, params.CovenantKeys, params.CovenantQuorum, params.Net)
+		if err == nil {
+			result.Type = BabylonTxTypeV0Staking
+			if parsed.Version == V1OpReturnVersion {
+				result.Type = BabylonTxTypeV1Staking
+			}
+			result.StakingTx = parsed
+			return result, nil
+		}
+	}
+
+	// 3. checkpoint transaction
+	if len(params.CheckpointTag) > 0 {
+		for i, out := range tx.TxOut {
+			data, ok := opReturnData(out.PkScript)
+			if !ok {
+				continue
+			}
+
+			bd, err := btctxformatter.IsBabylonCheckpointData(
+				params.CheckpointTag,
+				btctxformatter.CurrentVersion,
+				data,
+			)
+			if err != nil {
+				continue
+			}
+
+			result.Type = BabylonTxTypeCheckpoint
+			result.Checkpoint = &CheckpointPart{
+				OutputIdx: i,
+				Index:     bd.Index,
+				Data:      bd.Data,
+			}
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
+// stripWitness returns a copy of the transaction without witnesses, as
+// pre-signed transactions are validated before signing
+func stripWitness(tx *wire.MsgTx) *wire.MsgTx {
+	txCopy := tx.Copy()
+	for _, in := range txCopy.TxIn {
+		in.Witness = nil
+	}
+	return txCopy
+}
+
+// opReturnData returns data pushed by a standard OP_RETURN script
+func opReturnData(pkScript []byte) ([]byte, bool) {
+	if !txscript.IsNullData(pkScript) || len(pkScript) == 1 {
+		return nil, false
+	}
+
+	pushes, err := txscript.PushedData(pkScript[1:])
+	if err != nil || len(pushes) != 1 {
+		return nil, false
+	}
+
+	return pushes[0], true
+}
+
+func (s *ScriptPathSpend) matchesCovenants(params *ClassifierParams) bool {
+	// timelock path does not reveal covenant keys
+	if s.Path == SpendPathTypeTimeLock {
+		return true
+	}
+
+	if s.matchesCovenantMultisig(params) {
+		return true
+	}
+
+	// unbonding path of MuSig2 mode commits to the aggregated covenant key,
+	// which is parsed as a single covenant key with quorum 1
+	if s.Path == SpendPathTypeUnbonding && len(s.CovenantKeys) == 1 && s.CovenantQuorum == 1 {
+		aggKey, err := AggregateCovenantKeys(params.CovenantKeys)
+		if err == nil && bytes.Equal(schnorr.SerializePubKey(aggKey), schnorr.SerializePubKey(s.CovenantKeys[0])) {
+			s.AggregatedCovenant = true
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *ScriptPathSpend) matchesCovenantMultisig(params *ClassifierParams) bool {
+	if s.CovenantQuorum != params.CovenantQuorum || len(s.CovenantKeys) != len(params.CovenantKeys) {
+		return false
+	}
+
+	expectedKeys := make(map[string]struct{}, len(params.CovenantKeys))
+	for _, k := range params.CovenantKeys {
+		expectedKeys[keyToString(k)] = struct{}{}
+	}
+
+	for _, k := range s.CovenantKeys {
+		if _, ok := expectedKeys[keyToString(k)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// matchStakeExpansionOutput finds the new staking output of the stake expansion
+// tx. The new staking output is built from the staker key revealed in the
+// witness and the stake expansion params, so it is matched regardless of
+// whether the covenant committee or the finality providers changed since the
+// spent staking output was created.
+func matchStakeExpansionOutput(tx *wire.MsgTx, spend *ScriptPathSpend, params *ClassifierParams) *StakeExpansionOutput {
+	expansion := params.StakeExpansion
+	if expansion == nil || spend.SpentOutput != SpentOutputTypeStaking {
+		return nil
+	}
+
+	buildStakingInfo := BuildStakingInfo
+	if expansion.CovenantMusig2Unbonding {
+		buildStakingInfo = BuildStakingInfoWithAggregatedCovenant
+	}
+
+	// staking amount is not committed to in the pk script
+	stakingInfo, err := buildStakingInfo(
+		spend.StakerKey,
+		expansion.FinalityProviderKeys,
+		expansion.CovenantKeys,
+		expansion.CovenantQuorum,
+		expansion.StakingTime,
+		0,
+		params.Net,
+	)
+	if err != nil {
+		return nil
+	}
+
+	for i, out := range tx.TxOut {
+		if bytes.Equal(out.PkScript, stakingInfo.StakingOutput.PkScript) {
+			return &StakeExpansionOutput{
+				OutputIdx:   i,
+				StakingTime: expansion.StakingTime,
+			}
+		}
+	}
+
+	return nil
+}
+
+func parseXOnlyKeys(keys [][]byte) ([]*btcec.PublicKey, error) {
+	parsed := make([]*btcec.PublicKey, len(keys))
+	for i, k := range keys {
+		pk, err := schnorr.ParsePubKey(k)
+		if err != nil {
+			return nil, err
+		}
+		parsed[i] = pk
+	}
+	return parsed, nil
+}
+
+// parseTimeLockScript parses the script built by buildTimeLockScript
+func parseTimeLockScript(script []byte) (*btcec.PublicKey, uint16, error) {
+	tokenizer := txscript.MakeScriptTokenizer(0, script)
+
+	var tokens [][]byte
+	var ops []byte
+	for tokenizer.Next() {
+		tokens = append(tokens, tokenizer.Data())
+		ops = append(ops, tokenizer.Opcode())
+	}
+	if err := tokenizer.Err(); err != nil {
+		return nil, 0, err
+	}
+
+	if len(ops) != 4 || len(tokens[0]) != schnorr.PubKeyBytesLen ||
+		ops[1] != txscript.OP_CHECKSIGVERIFY || ops[3] != txscript.OP_CHECKSEQUENCEVERIFY {
+		return nil, 0, fmt.Errorf("not a timelock script")
+	}
+
+	key, err := schnorr.ParsePubKey(tokens[0])
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var lockTime int64
+	switch {
+	case ops[2] >= txscript.OP_1 && ops[2] <= txscript.OP_16:
+		lockTime = int64(ops[2]-txscript.OP_1) + 1
+	case len(tokens[2]) > 0 && len(tokens[2]) <= 3:
+		for i := len(tokens[2]) - 1; i >= 0; i-- {
+			lockTime = lockTime<<8 | int64(tokens[2][i])
+		}
+	default:
+		return nil, 0, fmt.Errorf("invalid lock time in timelock script")
+	}
+
+	if lockTime <= 0 || lockTime > int64(^uint16(0)) {
+		return nil, 0, fmt.Errorf("invalid lock time %d in timelock script", lockTime)
+	}
+
+	// ensure that script is in canonical form built by Babylon
+	expectedScript, err := buildTimeLockScript(key, uint16(lockTime))
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if !bytes.Equal(expectedScript, script) {
+		return nil, 0, fmt.Errorf("not a timelock script")
+	}
+
+	return key, uint16(lockTime), nil
+}
+
+// parseScriptPathSpend parses the witness of a taproot script path spend of one
+// of Babylon outputs. Only scripts built in the same way as Babylon scripts and
+// committed under Babylon unspendable internal key are recognized.
+func parseScriptPathSpend(witness wire.TxWitness) (*ScriptPathSpend, error) {
+	if len(witness) < 3 {
+		return nil, fmt.Errorf("not a script path spend with signatures")
+	}
+
+	controlBlock, err := txscript.ParseControlBlock(witness[len(witness)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	if !controlBlock.InternalKey.IsEqual(&unspendableKeyPathKey) {
+		return nil, fmt.Errorf("internal key is not Babylon unspendable key")
+	}
+
+	spend, err := parseBabylonScript(witness[len(witness)-2])
+	if err != nil {
+		return nil, err
+	}
+
+	depth := len(controlBlock.InclusionProof) / chainhash.HashSize
+
+	switch {
+	// staking output has 3 leaves with timelock and unbonding leaves at depth 2,
+	// unbonding output has 2 leaves and slashing change output has timelock leaf only
+	case spend.Path == SpendPathTypeTimeLock && depth == 2:
+		spend.SpentOutput = SpentOutputTypeStaking
+	case spend.Path == SpendPathTypeTimeLock && depth == 1:
+		spend.SpentOutput = SpentOutputTypeUnbonding
+	case spend.Path == SpendPathTypeTimeLock && depth == 0:
+		spend.SpentOutput = SpentOutputTypeSlashingChange
+	case spend.Path == SpendPathTypeUnbonding && depth == 2:
+		spend.SpentOutput = SpentOutputTypeStaking
+	case spend.Path == SpendPathTypeSlashing && depth == 1:
+		spend.SpentOutput = SpentOutputTypeStakingOrUnbonding
+	default:
+		return nil, fmt.Errorf("unexpected depth %d of %s script", depth, spend.Path)
+	}
+
+	return spend, nil
+}
+
+// parseBabylonScript parses one of the Babylon leaf scripts and returns the
+// keys it commits to. Only scripts in the canonical form built by
+// newBabylonScriptPaths are recognized.
+func parseBabylonScript(script []byte) (*ScriptPathSpend, error) {
+	if stakerKey, lockTime, err := parseTimeLockScript(script); err == nil {
+		return &ScriptPathSpend{
+			Path:      SpendPathTypeTimeLock,
+			StakerKey: stakerKey,
+			LockTime:  lockTime,
+		}, nil
+	}
+
+	groups, err := scriptSigGroups(script)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) < 2 || !groups[0].isVerify || len(groups[0].xOnlyKeys) != 1 {
+		return nil, fmt.Errorf("not a Babylon script")
+	}
+
+	stakerKeys, err := parseXOnlyKeys(groups[0].xOnlyKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	covenantGroup := groups[len(groups)-1]
+	if covenantGroup.isVerify {
+		return nil, fmt.Errorf("not a Babylon script")
+	}
+
+	covenantKeys, err := parseXOnlyKeys(covenantGroup.xOnlyKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	spend := &ScriptPathSpend{
+		StakerKey:      stakerKeys[0],
+		CovenantKeys:   covenantKeys,
+		CovenantQuorum: uint32(covenantGroup.threshold),
+	}
+
+	switch len(groups) {
+	case 2:
+		spend.Path = SpendPathTypeUnbonding
+	case 3:
+		fpGroup := groups[1]
+		if !fpGroup.isVerify || fpGroup.threshold != 1 {
+			return nil, fmt.Errorf("not a Babylon script")
+		}
+
+		fpKeys, err := parseXOnlyKeys(fpGroup.xOnlyKeys)
+		if err != nil {
+			return nil, err
+		}
+
+		spend.Path = SpendPathTypeSlashing
+		spend.FinalityProviderKeys = fpKeys
+	default:
+		return nil, fmt.Errorf("not a Babylon script")
+	}
+
+	// ensure that script is in canonical form built by Babylon
+	expectedScript, err := buildSpendPathScript(spend)
+	if err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(expectedScript, script) {
+		return nil, fmt.Errorf("not a Babylon script")
+	}
+
+	return spend, nil
+}
+
+// buildSpendPathScript rebuilds the unbonding or slashing path script from the
+// keys revealed in the spend, in the same way as newBabylonScriptPaths
+func buildSpendPathScript(spend *ScriptPathSpend) ([]byte, error) {
+	var fpKeys []*btcec.PublicKey
+	if spend.Path == SpendPathTypeSlashing {
+		fpKeys = spend.FinalityProviderKeys
+	}
+
+	if err := checkForDuplicateKeys(spend.StakerKey, fpKeys, spend.CovenantKeys); err != nil {
+		return nil, err
+	}
+
+	stakerSigScript, err := buildSingleKeySigScript(spend.StakerKey, true)
+	if err != nil {
+		return nil, err
+	}
+
+	covenantMultisigScript, err := buildMultiSigScript(spend.CovenantKeys, spend.CovenantQuorum, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if spend.Path == SpendPathTypeUnbonding {
+		return aggregateScripts(stakerSigScript, covenantMultisigScript), nil
+	}
+
+	fpMultisigScript, err := buildMultiSigScript(fpKeys, 1, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return aggregateScripts(stakerSigScript, fpMultisigScript, covenantMultisigScript), nil
+}
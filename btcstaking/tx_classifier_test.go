@@ -0,0 +1,442 @@
+package btcstaking_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/btcutil"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	"github.com/babylonlabs-io/babylon/v4/btctxformatter"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+)
+
+func classifierParams(t *testing.T, r *rand.Rand, scenario *TestScenario) *btcstaking.ClassifierParams {
+	slashingAddress, err := genRandomBTCAddress(r)
+	require.NoError(t, err)
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	require.NoError(t, err)
+
+	return &btcstaking.ClassifierParams{
+		StakingTag:       datagen.GenRandomByteArray(r, btcstaking.TagLen),
+		CheckpointTag:    datagen.GenRandomByteArray(r, btctxformatter.TagLength),
+		CovenantKeys:     scenario.CovenantPublicKeys(),
+		CovenantQuorum:   scenario.RequiredCovenantSigs,
+		SlashingPkScript: slashingPkScript,
+		Net:              &chaincfg.MainNetParams,
+	}
+}
+
+func classify(t *testing.T, tx *wire.MsgTx, params *btcstaking.ClassifierParams) *btcstaking.ClassifiedBabylonTx {
+	classified, err := btcstaking.ClassifyBabylonTx(tx, params)
+	require.NoError(t, err)
+	return classified
+}
+
+func FuzzClassifyBabylonTx(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		numCovenants := uint32(r.Intn(9) + 1)
+		quorum := uint32(r.Intn(int(numCovenants)) + 1)
+		stakingTime := uint16(r.Intn(60000) + 1)
+		scenario := GenerateTestScenario(r, t, 1, numCovenants, quorum, btcutil.Amount(2*10e8), stakingTime)
+		params := classifierParams(t, r, scenario)
+
+		// staking tx
+		stakingInfo, stakingTx, err := btcstaking.BuildV0IdentifiableStakingOutputsAndTx(
+			params.StakingTag,
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderKeys[0].PubKey(),
+			scenario.CovenantPublicKeys(),
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount,
+			params.Net,
+		)
+		require.NoError(t, err)
+		stakingTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: datagen.GenRandomBtcdHash(r)}, nil, nil))
+
+		classified := classify(t, stakingTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeV0Staking, classified.Type)
+		require.Equal(t, scenario.StakingTime, classified.StakingTx.StakingTime)
+		require.Equal(t, int64(scenario.StakingAmount), classified.StakingTx.StakingOutput.Value)
+
+		// staking tx with other tag is not recognized
+		otherParams := *params
+		otherParams.StakingTag = datagen.GenRandomByteArray(r, btcstaking.TagLen)
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, stakingTx, &otherParams).Type)
+
+		stakingOutput := stakingInfo.StakingOutput
+
+		// unbonding tx
+		unbondingInfo, err := btcstaking.BuildUnbondingInfo(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			scenario.CovenantPublicKeys(),
+			scenario.RequiredCovenantSigs,
+			scenario.StakingTime,
+			scenario.StakingAmount/2,
+			params.Net,
+		)
+		require.NoError(t, err)
+		unbondingTx := createSpendStakeTx(scenario.StakingAmount / 2)
+		unbondingTx.TxOut[0] = unbondingInfo.UnbondingOutput
+		si, err := stakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+		unbondingTx.TxIn[0].Witness = signUnbondingPath(t, scenario, unbondingTx, stakingOutput, si)
+
+		classified = classify(t, unbondingTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeUnbonding, classified.Type)
+		require.Equal(t, btcstaking.SpendPathTypeUnbonding, classified.Spend.Path)
+		require.Equal(t, btcstaking.SpentOutputTypeStaking, classified.Spend.SpentOutput)
+		require.Equal(t, schnorr.SerializePubKey(scenario.StakerKey.PubKey()), schnorr.SerializePubKey(classified.Spend.StakerKey))
+		require.Equal(t, scenario.RequiredCovenantSigs, classified.Spend.CovenantQuorum)
+		require.Len(t, classified.Spend.CovenantKeys, int(numCovenants))
+
+		// unbonding tx with different covenant committee is not recognized
+		otherParams = *params
+		otherParams.CovenantKeys = []*btcec.PublicKey{scenario.StakerKey.PubKey()}
+		otherParams.CovenantQuorum = 1
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, unbondingTx, &otherParams).Type)
+
+		// stake expansion tx spends staking output through unbonding path
+		// together with funding output and creates new staking output
+		newStakingTime := stakingTime + uint16(r.Intn(100)+1)
+		params.StakeExpansion = &btcstaking.StakeExpansionParams{
+			CovenantKeys:         scenario.CovenantPublicKeys(),
+			CovenantQuorum:       scenario.RequiredCovenantSigs,
+			FinalityProviderKeys: scenario.FinalityProviderPublicKeys(),
+			StakingTime:          newStakingTime,
+		}
+		newStakingInfo, err := btcstaking.BuildStakingInfo(
+			scenario.StakerKey.PubKey(),
+			scenario.FinalityProviderPublicKeys(),
+			scenario.CovenantPublicKeys(),
+			scenario.RequiredCovenantSigs,
+			newStakingTime,
+			scenario.StakingAmount*2,
+			params.Net,
+		)
+		require.NoError(t, err)
+		stakeExpansionTx := unbondingTx.Copy()
+		stakeExpansionTx.TxOut[0] = taprootOutputWithValue(t, r, scenario.StakingAmount/4)
+		stakeExpansionTx.AddTxOut(newStakingInfo.StakingOutput)
+		stakeExpansionTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: datagen.GenRandomBtcdHash(r)}, nil, nil))
+		classified = classify(t, stakeExpansionTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeStakeExpansion, classified.Type)
+		require.Equal(t, btcstaking.SpendPathTypeUnbonding, classified.Spend.Path)
+		require.Equal(t, 1, classified.StakeExpansion.OutputIdx)
+		require.Equal(t, newStakingTime, classified.StakeExpansion.StakingTime)
+
+		// two inputs tx spending staking output through unbonding path
+		// without new staking output is not a stake expansion
+		notExpansionTx := unbondingTx.Copy()
+		notExpansionTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: datagen.GenRandomBtcdHash(r)}, nil, nil))
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, notExpansionTx, params).Type)
+
+		// stake expansion with other staking time is not recognized
+		otherParams = *params
+		otherExpansion := *params.StakeExpansion
+		otherExpansion.StakingTime = newStakingTime + 1
+		otherParams.StakeExpansion = &otherExpansion
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, stakeExpansionTx, &otherParams).Type)
+
+		// stake expansion is not recognized without stake expansion params
+		otherParams = *params
+		otherParams.StakeExpansion = nil
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, stakeExpansionTx, &otherParams).Type)
+
+		// slashing tx
+		slashingTx := createSpendStakeTx(scenario.StakingAmount / 2)
+		slashingTx.TxOut[0].PkScript = params.SlashingPkScript
+		slashingTx.AddTxOut(taprootOutputWithValue(t, r, scenario.StakingAmount/4))
+		si, err = stakingInfo.SlashingPathSpendInfo()
+		require.NoError(t, err)
+		slashingTx.TxIn[0].Witness = signSlashingPath(t, scenario, slashingTx, stakingOutput, si)
+
+		classified = classify(t, slashingTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeSlashing, classified.Type)
+		require.Equal(t, 0, classified.SlashingOutputIdx)
+		require.Equal(t, btcstaking.SpentOutputTypeStakingOrUnbonding, classified.Spend.SpentOutput)
+		require.Len(t, classified.Spend.FinalityProviderKeys, 1)
+		require.Equal(t, schnorr.SerializePubKey(scenario.FinalityProviderKeys[0].PubKey()), schnorr.SerializePubKey(classified.Spend.FinalityProviderKeys[0]))
+
+		// slashing path spend not paying to slashing address is not a slashing tx
+		otherParams = *params
+		otherParams.SlashingPkScript = stakingOutput.PkScript
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, slashingTx, &otherParams).Type)
+
+		// timelock withdrawal from staking output
+		withdrawalTx := createSpendStakeTx(scenario.StakingAmount / 2)
+		si, err = stakingInfo.TimeLockPathSpendInfo()
+		require.NoError(t, err)
+		withdrawalTx.TxIn[0].Witness = signTimeLockPath(t, scenario.StakerKey, withdrawalTx, stakingOutput, si)
+
+		classified = classify(t, withdrawalTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeTimeLockWithdrawal, classified.Type)
+		require.Equal(t, btcstaking.SpentOutputTypeStaking, classified.Spend.SpentOutput)
+		require.Equal(t, scenario.StakingTime, classified.Spend.LockTime)
+		require.Equal(t, schnorr.SerializePubKey(scenario.StakerKey.PubKey()), schnorr.SerializePubKey(classified.Spend.StakerKey))
+
+		// timelock withdrawal from unbonding output
+		si, err = unbondingInfo.TimeLockPathSpendInfo()
+		require.NoError(t, err)
+		withdrawalTx.TxIn[0].Witness = signTimeLockPath(t, scenario.StakerKey, withdrawalTx, unbondingInfo.UnbondingOutput, si)
+
+		classified = classify(t, withdrawalTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeTimeLockWithdrawal, classified.Type)
+		require.Equal(t, btcstaking.SpentOutputTypeUnbonding, classified.Spend.SpentOutput)
+
+		// timelock withdrawal from slashing change output
+		changeTimeLock, err := btcstaking.BuildRelativeTimelockTaprootScript(
+			scenario.StakerKey.PubKey(), scenario.StakingTime, params.Net,
+		)
+		require.NoError(t, err)
+		changeOutput := wire.NewTxOut(int64(scenario.StakingAmount/4), changeTimeLock.PkScript)
+		withdrawalTx.TxIn[0].Witness = signTimeLockPath(t, scenario.StakerKey, withdrawalTx, changeOutput, changeTimeLock.SpendInfo)
+
+		classified = classify(t, withdrawalTx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeTimeLockWithdrawal, classified.Type)
+		require.Equal(t, btcstaking.SpentOutputTypeSlashingChange, classified.Spend.SpentOutput)
+		require.Equal(t, scenario.StakingTime, classified.Spend.LockTime)
+	})
+}
+
+func TestClassifyAggregatedCovenantUnbondingTx(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 1000)
+	params := classifierParams(t, r, scenario)
+	params.StakeExpansion = &btcstaking.StakeExpansionParams{
+		CovenantKeys:            scenario.CovenantPublicKeys(),
+		CovenantQuorum:          scenario.RequiredCovenantSigs,
+		CovenantMusig2Unbonding: true,
+		FinalityProviderKeys:    scenario.FinalityProviderPublicKeys(),
+		StakingTime:             1050,
+	}
+
+	stakingInfo, err := btcstaking.BuildStakingInfoWithAggregatedCovenant(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		params.Net,
+	)
+	require.NoError(t, err)
+	si, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	unbondingTx := createSpendStakeTx(scenario.StakingAmount / 2)
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(unbondingTx, stakingInfo.StakingOutput, scenario.StakerKey, si.RevealedLeaf)
+	require.NoError(t, err)
+	// aggregated covenant signature is not verified by the classifier
+	unbondingTx.TxIn[0].Witness, err = si.CreateAggregatedUnbondingPathWitness(stakerSig, stakerSig)
+	require.NoError(t, err)
+
+	classified := classify(t, unbondingTx, params)
+	require.Equal(t, btcstaking.BabylonTxTypeUnbonding, classified.Type)
+	require.True(t, classified.Spend.AggregatedCovenant)
+	require.Equal(t, btcstaking.SpentOutputTypeStaking, classified.Spend.SpentOutput)
+
+	// aggregated key of other covenant committee is not recognized
+	otherParams := *params
+	otherParams.CovenantKeys = otherParams.CovenantKeys[1:]
+	otherParams.CovenantQuorum = 2
+	require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, unbondingTx, &otherParams).Type)
+
+	// stake expansion of staking output in MuSig2 mode
+	newStakingInfo, err := btcstaking.BuildStakingInfoWithAggregatedCovenant(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		1050,
+		scenario.StakingAmount*2,
+		params.Net,
+	)
+	require.NoError(t, err)
+	stakeExpansionTx := unbondingTx.Copy()
+	stakeExpansionTx.TxOut[0] = newStakingInfo.StakingOutput
+	stakeExpansionTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: datagen.GenRandomBtcdHash(r)}, nil, nil))
+
+	classified = classify(t, stakeExpansionTx, params)
+	require.Equal(t, btcstaking.BabylonTxTypeStakeExpansion, classified.Type)
+	require.Equal(t, 0, classified.StakeExpansion.OutputIdx)
+	require.Equal(t, uint16(1050), classified.StakeExpansion.StakingTime)
+}
+
+func TestClassifyStakeExpansionAcrossCovenantRotation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 1000)
+	params := classifierParams(t, r, scenario)
+
+	stakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		scenario.FinalityProviderPublicKeys(),
+		scenario.CovenantPublicKeys(),
+		scenario.RequiredCovenantSigs,
+		scenario.StakingTime,
+		scenario.StakingAmount,
+		params.Net,
+	)
+	require.NoError(t, err)
+	si, err := stakingInfo.UnbondingPathSpendInfo()
+	require.NoError(t, err)
+
+	// the stake expansion is included after a covenant committee rotation
+	// replacing two covenant members, and adds a finality provider
+	newCovenantKeys := append(scenario.CovenantPublicKeys()[2:], genRandomPubKeys(t, r, 2)...)
+	newFpKeys := append(scenario.FinalityProviderPublicKeys(), genRandomPubKeys(t, r, 1)...)
+	newStakingInfo, err := btcstaking.BuildStakingInfo(
+		scenario.StakerKey.PubKey(),
+		newFpKeys,
+		newCovenantKeys,
+		3,
+		2000,
+		scenario.StakingAmount*2,
+		params.Net,
+	)
+	require.NoError(t, err)
+
+	stakeExpansionTx := createSpendStakeTx(scenario.StakingAmount * 2)
+	stakeExpansionTx.TxOut[0] = newStakingInfo.StakingOutput
+	stakeExpansionTx.TxIn[0].Witness = signUnbondingPath(t, scenario, stakeExpansionTx, stakingInfo.StakingOutput, si)
+	stakeExpansionTx.AddTxIn(wire.NewTxIn(&wire.OutPoint{Hash: datagen.GenRandomBtcdHash(r)}, nil, nil))
+
+	// the spent output matches the params of the previous delegation, while
+	// the new staking output matches the params in force at inclusion
+	params.StakeExpansion = &btcstaking.StakeExpansionParams{
+		CovenantKeys:         newCovenantKeys,
+		CovenantQuorum:       3,
+		FinalityProviderKeys: newFpKeys,
+		StakingTime:          2000,
+	}
+	classified := classify(t, stakeExpansionTx, params)
+	require.Equal(t, btcstaking.BabylonTxTypeStakeExpansion, classified.Type)
+	require.Equal(t, 0, classified.StakeExpansion.OutputIdx)
+	require.Equal(t, uint16(2000), classified.StakeExpansion.StakingTime)
+
+	// the previous covenant committee does not match the new staking output
+	params.StakeExpansion.CovenantKeys = scenario.CovenantPublicKeys()
+	require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, stakeExpansionTx, params).Type)
+}
+
+func genRandomPubKeys(t *testing.T, r *rand.Rand, n int) []*btcec.PublicKey {
+	_, keys, err := datagen.GenRandomBTCKeyPairs(r, n)
+	require.NoError(t, err)
+	return keys
+}
+
+func signTimeLockPath(
+	t *testing.T,
+	stakerKey *btcec.PrivateKey,
+	tx *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	si *btcstaking.SpendInfo,
+) wire.TxWitness {
+	sig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(tx, fundingOutput, stakerKey, si.RevealedLeaf)
+	require.NoError(t, err)
+	witness, err := si.CreateTimeLockPathWitness(sig)
+	require.NoError(t, err)
+	return witness
+}
+
+func signUnbondingPath(
+	t *testing.T,
+	scenario *TestScenario,
+	tx *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	si *btcstaking.SpendInfo,
+) wire.TxWitness {
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(tx, fundingOutput, scenario.StakerKey, si.RevealedLeaf)
+	require.NoError(t, err)
+	covSigs := datagen.GenerateSignatures(t, scenario.CovenantKeys, tx, fundingOutput, si.RevealedLeaf)
+	for i := 0; i < len(covSigs)-int(scenario.RequiredCovenantSigs); i++ {
+		covSigs[i] = nil
+	}
+	witness, err := si.CreateUnbondingPathWitness(covSigs, stakerSig)
+	require.NoError(t, err)
+	return witness
+}
+
+func signSlashingPath(
+	t *testing.T,
+	scenario *TestScenario,
+	tx *wire.MsgTx,
+	fundingOutput *wire.TxOut,
+	si *btcstaking.SpendInfo,
+) wire.TxWitness {
+	stakerSig, err := btcstaking.SignTxWithOneScriptSpendInputFromTapLeaf(tx, fundingOutput, scenario.StakerKey, si.RevealedLeaf)
+	require.NoError(t, err)
+	covSigs := datagen.GenerateSignatures(t, scenario.CovenantKeys, tx, fundingOutput, si.RevealedLeaf)
+	for i := 0; i < len(covSigs)-int(scenario.RequiredCovenantSigs); i++ {
+		covSigs[i] = nil
+	}
+	fpSigs := datagen.GenerateSignatures(t, scenario.FinalityProviderKeys, tx, fundingOutput, si.RevealedLeaf)
+	witness, err := si.CreateSlashingPathWitness(covSigs, fpSigs, stakerSig)
+	require.NoError(t, err)
+	return witness
+}
+
+func TestClassifyCheckpointTx(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	scenario := GenerateTestScenario(r, t, 1, 5, 3, btcutil.Amount(2*10e8), 1000)
+	params := classifierParams(t, r, scenario)
+
+	firstHalf, secondHalf, err := btctxformatter.EncodeCheckpointData(
+		params.CheckpointTag,
+		btctxformatter.CurrentVersion,
+		datagen.GetRandomRawBtcCheckpoint(r),
+	)
+	require.NoError(t, err)
+
+	for i, part := range [][]byte{firstHalf, secondHalf} {
+		dataScript, err := txscript.NullDataScript(part)
+		require.NoError(t, err)
+
+		tx := datagen.GenRandomTxWithOutputs(r, 2)
+		tx.TxOut[1] = wire.NewTxOut(0, dataScript)
+
+		classified := classify(t, tx, params)
+		require.Equal(t, btcstaking.BabylonTxTypeCheckpoint, classified.Type)
+		require.Equal(t, 1, classified.Checkpoint.OutputIdx)
+		require.Equal(t, uint8(i), classified.Checkpoint.Index)
+
+		// checkpoint with other tag is not recognized
+		otherParams := *params
+		otherParams.CheckpointTag = datagen.GenRandomByteArray(r, btctxformatter.TagLength)
+		require.Equal(t, btcstaking.BabylonTxTypeUnknown, classify(t, tx, &otherParams).Type)
+	}
+
+	// random tx is not recognized
+	classified := classify(t, datagen.GenRandomTxWithOutputs(r, 3), params)
+	require.Equal(t, btcstaking.BabylonTxTypeUnknown, classified.Type)
+	require.Nil(t, classified.Spend)
+
+	// checkpoint txs are recognized without covenant committee
+	checkpointParams := &btcstaking.ClassifierParams{
+		CheckpointTag: params.CheckpointTag,
+		Net:           params.Net,
+	}
+	dataScript, err := txscript.NullDataScript(firstHalf)
+	require.NoError(t, err)
+	tx := datagen.GenRandomTxWithOutputs(r, 1)
+	tx.TxOut[0] = wire.NewTxOut(0, dataScript)
+	require.Equal(t, btcstaking.BabylonTxTypeCheckpoint, classify(t, tx, checkpointParams).Type)
+
+	// invalid params
+	invalidParams := *params
+	invalidParams.CovenantQuorum = 6
+	_, err = btcstaking.ClassifyBabylonTx(datagen.GenRandomTx(r), &invalidParams)
+	require.Error(t, err)
+}
@@ -387,8 +387,6 @@ func BuildStakingInfo(
 	stakingAmount btcutil.Amount,
 	net *chaincfg.Params,
 ) (*StakingInfo, error) {
-	unspendableKeyPathKey := unspendableKeyPathInternalPubKey()
-
 	babylonScripts, err := newBabylonScriptPaths(
 		stakerKey,
 		fpKeys,
@@ -401,6 +399,18 @@ func BuildStakingInfo(
 		return nil, fmt.Errorf("%s: %w", errBuildingStakingInfo, err)
 	}
 
+	return buildStakingInfoFromScripts(babylonScripts, stakingAmount, net)
+}
+
+// buildStakingInfoFromScripts commits to the provided script paths in
+// the taproot output with unspendable internal key
+func buildStakingInfoFromScripts(
+	babylonScripts *babylonScriptPaths,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*StakingInfo, error) {
+	unspendableKeyPathKey := unspendableKeyPathInternalPubKey()
+
 	var unbondingPaths [][]byte
 	unbondingPaths = append(unbondingPaths, babylonScripts.timeLockPathScript)
 	unbondingPaths = append(unbondingPaths, babylonScripts.unbondingPathScript)
@@ -96,6 +96,104 @@ func (c *QueryClient) FinalityProviderDelegations(fpBtcPkHex string, pagination
 	return resp, err
 }
 
+// DelegationsByStaker queries the BTCStaking module for all delegations of a staker BTC PK under a given status
+func (c *QueryClient) DelegationsByStaker(stakerBtcPkHex string, status btcstakingtypes.BTCDelegationStatus, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryDelegationsByStakerResponse, error) {
+	var resp *btcstakingtypes.QueryDelegationsByStakerResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryDelegationsByStakerRequest{
+			StakerBtcPkHex: stakerBtcPkHex,
+			Status:         status,
+			Pagination:     pagination,
+		}
+		resp, err = queryClient.DelegationsByStaker(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// ExpiringDelegations queries the BTCStaking module for all delegations expiring within the given BTC height range
+func (c *QueryClient) ExpiringDelegations(startHeight, endHeight uint32, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryExpiringDelegationsResponse, error) {
+	var resp *btcstakingtypes.QueryExpiringDelegationsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryExpiringDelegationsRequest{
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+			Pagination:  pagination,
+		}
+		resp, err = queryClient.ExpiringDelegations(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// UnbondingDelegations queries the BTCStaking module for all delegations whose unbonding completes within the given BTC height range
+func (c *QueryClient) UnbondingDelegations(startHeight, endHeight uint32, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryUnbondingDelegationsResponse, error) {
+	var resp *btcstakingtypes.QueryUnbondingDelegationsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryUnbondingDelegationsRequest{
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+			Pagination:  pagination,
+		}
+		resp, err = queryClient.UnbondingDelegations(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CovenantCommittees queries the BTCStaking module for the covenant committees of all the parameters versions
+func (c *QueryClient) CovenantCommittees(pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryCovenantCommitteesResponse, error) {
+	var resp *btcstakingtypes.QueryCovenantCommitteesResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCovenantCommitteesRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.CovenantCommittees(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CovenantCommitteeDelegations queries the BTCStaking module for all delegations bound to the covenant committee of a parameters version under a given status
+func (c *QueryClient) CovenantCommitteeDelegations(paramsVersion uint32, status btcstakingtypes.BTCDelegationStatus, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryCovenantCommitteeDelegationsResponse, error) {
+	var resp *btcstakingtypes.QueryCovenantCommitteeDelegationsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCovenantCommitteeDelegationsRequest{
+			ParamsVersion: paramsVersion,
+			Status:        status,
+			Pagination:    pagination,
+		}
+		resp, err = queryClient.CovenantCommitteeDelegations(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// CovenantStats queries the BTCStaking module for the signature liveness stats of the covenant members
+func (c *QueryClient) CovenantStats(pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryCovenantStatsResponse, error) {
+	var resp *btcstakingtypes.QueryCovenantStatsResponse
+	err := c.QueryBTCStaking(func(ctx context.Context, queryClient btcstakingtypes.QueryClient) error {
+		var err error
+		req := &btcstakingtypes.QueryCovenantStatsRequest{
+			Pagination: pagination,
+		}
+		resp, err = queryClient.CovenantStats(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
 // BTCDelegations queries the BTCStaking module for all delegations under a given status
 func (c *QueryClient) BTCDelegations(status btcstakingtypes.BTCDelegationStatus, pagination *sdkquerytypes.PageRequest) (*btcstakingtypes.QueryBTCDelegationsResponse, error) {
 	var resp *btcstakingtypes.QueryBTCDelegationsResponse
@@ -205,3 +205,49 @@ func (c *QueryClient) VotingPowerDistributionMap(height uint64) (*QueryVotingPow
 		NumActiveFps:      resp.NumActiveFps,
 	}, nil
 }
+
+// LatestFinalizedHeight queries the highest finalized height
+func (c *QueryClient) LatestFinalizedHeight() (*finalitytypes.QueryLatestFinalizedHeightResponse, error) {
+	var resp *finalitytypes.QueryLatestFinalizedHeightResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryLatestFinalizedHeightRequest{}
+		resp, err = queryClient.LatestFinalizedHeight(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// FinalityStatusRange queries the compact finality status of each indexed
+// block in the range [startHeight, endHeight]
+func (c *QueryClient) FinalityStatusRange(startHeight, endHeight uint64) (*finalitytypes.QueryFinalityStatusRangeResponse, error) {
+	var resp *finalitytypes.QueryFinalityStatusRangeResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryFinalityStatusRangeRequest{
+			StartHeight: startHeight,
+			EndHeight:   endHeight,
+		}
+		resp, err = queryClient.FinalityStatusRange(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
+
+// EpochVoteParticipation queries the finality vote participation of each
+// active finality provider in the given epoch
+func (c *QueryClient) EpochVoteParticipation(epochNum uint64) (*finalitytypes.QueryEpochVoteParticipationResponse, error) {
+	var resp *finalitytypes.QueryEpochVoteParticipationResponse
+	err := c.QueryFinality(func(ctx context.Context, queryClient finalitytypes.QueryClient) error {
+		var err error
+		req := &finalitytypes.QueryEpochVoteParticipationRequest{
+			EpochNum: epochNum,
+		}
+		resp, err = queryClient.EpochVoteParticipation(ctx, req)
+		return err
+	})
+
+	return resp, err
+}
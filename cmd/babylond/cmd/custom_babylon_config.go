@@ -19,6 +19,10 @@ import (
 
 type BtcConfig struct {
 	Network string `mapstructure:"network"`
+
+	SignetChallenge string `mapstructure:"signet-challenge"`
+
+	SignetGenesisHash string `mapstructure:"signet-genesis-hash"`
 }
 
 func defaultBabylonBtcConfig() BtcConfig {
@@ -92,9 +96,18 @@ bls-key-file = "{{ .BlsConfig.BlsKeyFile }}"
 [btc-config]
 
 # Configures which bitcoin network should be used for checkpointing
-# valid values are: [mainnet, testnet, simnet, signet, regtest]
+# valid values are: [mainnet, testnet, testnet4, simnet, signet, regtest]
 network = "{{ .BtcConfig.Network }}"
 
+# Hex encoded challenge script of a custom signet. Only valid if network is
+# signet. If empty, the default signet is used. The challenge only determines
+# the network magic, the signet block signatures are not verified.
+signet-challenge = "{{ .BtcConfig.SignetChallenge }}"
+
+# Genesis block hash of a custom signet. Only valid if signet-challenge is set.
+# If empty, the genesis block hash of the default signet is used.
+signet-genesis-hash = "{{ .BtcConfig.SignetGenesisHash }}"
+
 ###############################################################################
 ###                      Babylon Mempool Configuration                      ###
 ###############################################################################
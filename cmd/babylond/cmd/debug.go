@@ -42,6 +42,9 @@ func DebugCmd() *cobra.Command {
 
 		return PrintBip340(cmd, args)
 	}
+
+	debugCmd.AddCommand(DecodeBtcTxCmd())
+
 	return debugCmd
 }
 
@@ -0,0 +1,333 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	btcstktypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+const (
+	flagParamsFile           = "params-file"
+	flagStakingTag           = "staking-tag"
+	flagExpansionParamsFile  = "expansion-params-file"
+	flagExpansionFpPks       = "expansion-fp-pks"
+	flagExpansionStakingTime = "expansion-staking-time"
+)
+
+// DecodedBtcTx is the output of decode-btc-tx command
+type DecodedBtcTx struct {
+	TxHash     string             `json:"tx_hash"`
+	Type       string             `json:"type"`
+	Staking    *DecodedStakingTx  `json:"staking,omitempty"`
+	Spend      *DecodedSpend      `json:"spend,omitempty"`
+	Expansion  *DecodedExpansion  `json:"stake_expansion,omitempty"`
+	Slashing   *DecodedSlashingTx `json:"slashing,omitempty"`
+	Checkpoint *DecodedCheckpoint `json:"checkpoint,omitempty"`
+}
+
+type DecodedStakingTx struct {
+	StakingOutputIdx    int              `json:"staking_output_idx"`
+	StakingAmount       int64            `json:"staking_amount"`
+	StakerPk            string           `json:"staker_pk"`
+	FinalityProviderPks []string         `json:"finality_provider_pks"`
+	StakingTime         uint16           `json:"staking_time"`
+	OpReturnOutputIdx   int              `json:"op_return_output_idx"`
+	OpReturnDataVersion byte             `json:"op_return_data_version"`
+	TLVs                []DecodedTLVData `json:"tlvs,omitempty"`
+}
+
+type DecodedTLVData struct {
+	Type  byte   `json:"type"`
+	Value string `json:"value"`
+}
+
+type DecodedSpend struct {
+	InputIdx            int      `json:"input_idx"`
+	SpentOutpoint       string   `json:"spent_outpoint"`
+	Path                string   `json:"path"`
+	SpentOutput         string   `json:"spent_output"`
+	StakerPk            string   `json:"staker_pk"`
+	FinalityProviderPks []string `json:"finality_provider_pks,omitempty"`
+	CovenantPks         []string `json:"covenant_pks,omitempty"`
+	CovenantQuorum      uint32   `json:"covenant_quorum,omitempty"`
+	AggregatedCovenant  bool     `json:"aggregated_covenant,omitempty"`
+	LockTime            uint16   `json:"lock_time,omitempty"`
+}
+
+type DecodedExpansion struct {
+	StakingOutputIdx int    `json:"staking_output_idx"`
+	StakingAmount    int64  `json:"staking_amount"`
+	StakingTime      uint16 `json:"staking_time"`
+}
+
+type DecodedSlashingTx struct {
+	SlashingOutputIdx int   `json:"slashing_output_idx"`
+	SlashedAmount     int64 `json:"slashed_amount"`
+}
+
+type DecodedCheckpoint struct {
+	OutputIdx int    `json:"output_idx"`
+	PartIndex uint8  `json:"part_index"`
+	Data      string `json:"data"`
+}
+
+// DecodeBtcTxCmd returns the command decoding Babylon related Bitcoin transactions
+func DecodeBtcTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-btc-tx [tx-hex]",
+		Short: "Decode a Babylon related Bitcoin transaction",
+		Long: `Decode a raw Bitcoin transaction and identify whether it is an identifiable staking (V0 or V1),
+unbonding, slashing, stake expansion, timelock withdrawal or checkpoint transaction.
+
+The BTC staking parameters version in which the transaction was created is provided
+in a JSON file, either as the output of 'babylond query btcstaking params' or as the params object.
+Without the parameters only checkpoint transactions are recognized.
+Spending transactions are recognized from their witness, so they must be signed.
+
+The new staking output of stake expansion commits to finality providers and a staking time
+which cannot be recovered from the transaction, so stake expansion is only recognized if they
+are provided. Its covenant committee is taken from the parameters in force at the BTC height
+at which the stake expansion is included, provided with --expansion-params-file e.g. as the
+output of the params by BTC height query, which defaults to --params-file.`,
+		Example: `babylond debug decode-btc-tx 0200000001... --params-file params.json --staking-tag 62627431 --checkpoint-tag 62627434 --btc-network mainnet`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			txBytes, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid tx hex: %w", err)
+			}
+
+			var tx wire.MsgTx
+			if err := tx.Deserialize(bytes.NewReader(txBytes)); err != nil {
+				return fmt.Errorf("invalid btc tx: %w", err)
+			}
+
+			network, _ := cmd.Flags().GetString(flagBtcNetwork)
+			net, err := bbn.GetBtcNetParams(network)
+			if err != nil {
+				return err
+			}
+
+			stakingTagHex, _ := cmd.Flags().GetString(flagStakingTag)
+			stakingTag, err := hex.DecodeString(stakingTagHex)
+			if err != nil {
+				return fmt.Errorf("invalid staking tag: %w", err)
+			}
+
+			checkpointTagHex, _ := cmd.Flags().GetString(flagCheckpointTag)
+			checkpointTag, err := hex.DecodeString(checkpointTagHex)
+			if err != nil {
+				return fmt.Errorf("invalid checkpoint tag: %w", err)
+			}
+
+			classifierParams := &btcstaking.ClassifierParams{
+				StakingTag:    stakingTag,
+				CheckpointTag: checkpointTag,
+				Net:           net,
+			}
+
+			// without staking params only checkpoint txs can be recognized
+			if paramsFile, _ := cmd.Flags().GetString(flagParamsFile); paramsFile != "" {
+				params, err := readBtcStakingParams(clientCtx, paramsFile)
+				if err != nil {
+					return err
+				}
+
+				covenantKeys, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+				if err != nil {
+					return fmt.Errorf("invalid covenant keys in params: %w", err)
+				}
+
+				classifierParams.CovenantKeys = covenantKeys
+				classifierParams.CovenantQuorum = params.CovenantQuorum
+				classifierParams.SlashingPkScript = params.SlashingPkScript
+
+				classifierParams.StakeExpansion, err = stakeExpansionParamsFromFlags(cmd, clientCtx, params)
+				if err != nil {
+					return err
+				}
+			}
+
+			classified, err := btcstaking.ClassifyBabylonTx(&tx, classifierParams)
+			if err != nil {
+				return err
+			}
+
+			out, err := json.MarshalIndent(newDecodedBtcTx(&tx, classified), "", "  ")
+			if err != nil {
+				return err
+			}
+
+			cmd.Println(string(out))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagParamsFile, "", "path to the JSON file with BTC staking parameters, required to recognize staking and spending transactions")
+	cmd.Flags().String(flagStakingTag, "", "hex encoded tag of identifiable staking transactions")
+	cmd.Flags().String(flagCheckpointTag, "", "hex encoded tag of checkpoint transactions")
+	cmd.Flags().String(flagExpansionParamsFile, "", "path to the JSON file with BTC staking parameters in force at the inclusion height of the stake expansion, defaults to --params-file")
+	cmd.Flags().StringSlice(flagExpansionFpPks, nil, "hex encoded BTC public keys of the finality providers of the stake expansion, required to recognize stake expansion transactions")
+	cmd.Flags().Uint16(flagExpansionStakingTime, 0, "staking time of the stake expansion, required to recognize stake expansion transactions")
+	cmd.Flags().String(flagBtcNetwork, string(bbn.BtcMainnet), "Bitcoin network of the transaction. Available networks: simnet, testnet, testnet4, regtest, signet, mainnet")
+
+	return cmd
+}
+
+// readBtcStakingParams reads the BTC staking parameters either in the format
+// of the params query response or as the params object
+func readBtcStakingParams(clientCtx client.Context, path string) (*btcstktypes.Params, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read params file: %w", err)
+	}
+
+	var resp btcstktypes.QueryParamsResponse
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &resp); err == nil && len(resp.Params.CovenantPks) > 0 {
+		return &resp.Params, nil
+	}
+
+	var params btcstktypes.Params
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &params); err != nil {
+		return nil, fmt.Errorf("failed to parse params file: %w", err)
+	}
+
+	return &params, nil
+}
+
+// stakeExpansionParamsFromFlags returns the parameters of the new staking output
+// of stake expansion, or nil if the stake expansion flags are not provided
+func stakeExpansionParamsFromFlags(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	params *btcstktypes.Params,
+) (*btcstaking.StakeExpansionParams, error) {
+	fpPksHex, _ := cmd.Flags().GetStringSlice(flagExpansionFpPks)
+	stakingTime, _ := cmd.Flags().GetUint16(flagExpansionStakingTime)
+	if len(fpPksHex) == 0 || stakingTime == 0 {
+		return nil, nil
+	}
+
+	fpKeys := make([]*btcec.PublicKey, len(fpPksHex))
+	for i, fpPkHex := range fpPksHex {
+		fpPk, err := bbn.NewBIP340PubKeyFromHex(fpPkHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid stake expansion finality provider key: %w", err)
+		}
+		fpKeys[i] = fpPk.MustToBTCPK()
+	}
+
+	if paramsFile, _ := cmd.Flags().GetString(flagExpansionParamsFile); paramsFile != "" {
+		expansionParams, err := readBtcStakingParams(clientCtx, paramsFile)
+		if err != nil {
+			return nil, err
+		}
+		params = expansionParams
+	}
+
+	covenantKeys, err := bbn.NewBTCPKsFromBIP340PKs(params.CovenantPks)
+	if err != nil {
+		return nil, fmt.Errorf("invalid covenant keys in stake expansion params: %w", err)
+	}
+
+	return &btcstaking.StakeExpansionParams{
+		CovenantKeys:            covenantKeys,
+		CovenantQuorum:          params.CovenantQuorum,
+		CovenantMusig2Unbonding: params.CovenantMusig2Unbonding,
+		FinalityProviderKeys:    fpKeys,
+		StakingTime:             stakingTime,
+	}, nil
+}
+
+func pksToHex(keys []*btcec.PublicKey) []string {
+	hexKeys := make([]string, len(keys))
+	for i, k := range keys {
+		hexKeys[i] = hex.EncodeToString(schnorr.SerializePubKey(k))
+	}
+	return hexKeys
+}
+
+func newDecodedBtcTx(tx *wire.MsgTx, classified *btcstaking.ClassifiedBabylonTx) *DecodedBtcTx {
+	decoded := &DecodedBtcTx{
+		TxHash: tx.TxHash().String(),
+		Type:   classified.Type.String(),
+	}
+
+	if parsed := classified.StakingTx; parsed != nil {
+		fpPks := make([]string, len(parsed.FinalityProviderPublicKeys))
+		for i, fpPk := range parsed.FinalityProviderPublicKeys {
+			fpPks[i] = hex.EncodeToString(fpPk.Marshall())
+		}
+
+		decoded.Staking = &DecodedStakingTx{
+			StakingOutputIdx:    parsed.StakingOutputIdx,
+			StakingAmount:       parsed.StakingOutput.Value,
+			StakerPk:            hex.EncodeToString(parsed.StakerPublicKey.Marshall()),
+			FinalityProviderPks: fpPks,
+			StakingTime:         parsed.StakingTime,
+			OpReturnOutputIdx:   parsed.OpReturnOutputIdx,
+			OpReturnDataVersion: parsed.Version,
+		}
+
+		for _, tlv := range parsed.TLVs {
+			decoded.Staking.TLVs = append(decoded.Staking.TLVs, DecodedTLVData{
+				Type:  tlv.Type,
+				Value: hex.EncodeToString(tlv.Value),
+			})
+		}
+	}
+
+	if spend := classified.Spend; spend != nil {
+		decoded.Spend = &DecodedSpend{
+			InputIdx:            spend.InputIdx,
+			SpentOutpoint:       tx.TxIn[spend.InputIdx].PreviousOutPoint.String(),
+			Path:                spend.Path.String(),
+			SpentOutput:         spend.SpentOutput.String(),
+			StakerPk:            hex.EncodeToString(schnorr.SerializePubKey(spend.StakerKey)),
+			FinalityProviderPks: pksToHex(spend.FinalityProviderKeys),
+			CovenantPks:         pksToHex(spend.CovenantKeys),
+			CovenantQuorum:      spend.CovenantQuorum,
+			AggregatedCovenant:  spend.AggregatedCovenant,
+			LockTime:            spend.LockTime,
+		}
+	}
+
+	if expansion := classified.StakeExpansion; expansion != nil {
+		decoded.Expansion = &DecodedExpansion{
+			StakingOutputIdx: expansion.OutputIdx,
+			StakingAmount:    tx.TxOut[expansion.OutputIdx].Value,
+			StakingTime:      expansion.StakingTime,
+		}
+	}
+
+	if classified.SlashingOutputIdx >= 0 {
+		decoded.Slashing = &DecodedSlashingTx{
+			SlashingOutputIdx: classified.SlashingOutputIdx,
+			SlashedAmount:     tx.TxOut[classified.SlashingOutputIdx].Value,
+		}
+	}
+
+	if ckpt := classified.Checkpoint; ckpt != nil {
+		decoded.Checkpoint = &DecodedCheckpoint{
+			OutputIdx: ckpt.OutputIdx,
+			PartIndex: ckpt.Index,
+			Data:      hex.EncodeToString(ckpt.Data),
+		}
+	}
+
+	return decoded
+}
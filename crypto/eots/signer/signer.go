@@ -0,0 +1,238 @@
+// Package signer implements a reference EOTS signer for finality providers
+// with double-sign protection.
+//
+// Signing two different messages with the same EOTS public randomness leaks
+// the EOTS private key of the finality provider, see eots.Extract, and gets
+// the finality provider slashed. The signer persists a record of each
+// signature before releasing it, and refuses to sign any message that
+// conflicts with a persisted record, including after a crash or restart.
+package signer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+
+	"github.com/babylonlabs-io/babylon/v4/crypto/eots"
+)
+
+const (
+	// DefaultSignStateDBName is the default name of the database of the
+	// sign state
+	DefaultSignStateDBName = "eots_sign_state"
+
+	msgHashLen = sha256.Size
+	sigLen     = 32
+)
+
+var (
+	// lastSignedHeightKeyPrefix is the key prefix of the last signed height
+	// of each EOTS public key
+	lastSignedHeightKeyPrefix = []byte{0x01}
+	// signRecordKeyPrefix is the key prefix of the sign record of each pair
+	// of EOTS public key and public randomness
+	signRecordKeyPrefix = []byte{0x02}
+)
+
+var (
+	// ErrDoubleSign is returned when signing a message with a public
+	// randomness that has been used to sign a different message
+	ErrDoubleSign = errors.New("the public randomness has been used to sign a different message")
+	// ErrHeightRegression is returned when signing a message at a height
+	// that is not higher than the last signed height
+	ErrHeightRegression = errors.New("the height is not higher than the last signed height")
+)
+
+// SignRecord is the record of an EOTS signature
+type SignRecord struct {
+	// Height is the height of the signed message
+	Height uint64
+	// MsgHash is the SHA256 hash of the signed message
+	MsgHash [msgHashLen]byte
+	// Signature is the EOTS signature
+	Signature [sigLen]byte
+}
+
+// Signer signs messages with EOTS keys, and persists the last signed height
+// of each EOTS public key and the sign record of each public randomness in a
+// database, so that it never signs conflicting messages
+type Signer struct {
+	mu sync.Mutex
+	db dbm.DB
+}
+
+// NewSigner returns a new Signer persisting the sign state in the given
+// database
+func NewSigner(db dbm.DB) *Signer {
+	return &Signer{db: db}
+}
+
+// OpenSigner returns a new Signer persisting the sign state in a LevelDB
+// database in the given directory, which is created if it does not exist
+func OpenSigner(dir string) (*Signer, error) {
+	db, err := dbm.NewGoLevelDB(DefaultSignStateDBName, dir, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the sign state database: %w", err)
+	}
+	return NewSigner(db), nil
+}
+
+// Close closes the database of the sign state
+func (s *Signer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.db.Close()
+}
+
+// Sign signs the given message at the given height with the given EOTS private
+// key and private randomness. Signing is idempotent, i.e., signing the same
+// message at the same height with the same private randomness returns the
+// persisted signature. Otherwise, it returns
+//   - ErrDoubleSign if the public randomness has been used to sign a
+//     different message, or the same message at a different height, and
+//   - ErrHeightRegression if the height is not higher than the last signed
+//     height of the EOTS public key.
+//
+// The sign record is persisted with a synced write before the signature is
+// returned, so that the signature is never released without being recorded.
+func (s *Signer) Sign(sk *eots.PrivateKey, privRand *eots.PrivateRand, height uint64, msg []byte) (*eots.Signature, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pk := pubKeyBytes(eots.PubGen(sk))
+	pubRand := pubRandBytes(privRand)
+	msgHash := sha256.Sum256(msg)
+
+	record, err := s.getSignRecord(pk, pubRand)
+	if err != nil {
+		return nil, err
+	}
+	if record != nil {
+		if record.Height != height || !bytes.Equal(record.MsgHash[:], msgHash[:]) {
+			return nil, fmt.Errorf("%w: signed height %d, requested height %d", ErrDoubleSign, record.Height, height)
+		}
+		var sig eots.Signature
+		sig.SetBytes(&record.Signature)
+		return &sig, nil
+	}
+
+	lastSignedHeight, found, err := s.getLastSignedHeight(pk)
+	if err != nil {
+		return nil, err
+	}
+	if found && height <= lastSignedHeight {
+		return nil, fmt.Errorf("%w: last signed height %d, requested height %d", ErrHeightRegression, lastSignedHeight, height)
+	}
+
+	sig, err := eots.Sign(sk, privRand, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	record = &SignRecord{
+		Height:    height,
+		MsgHash:   msgHash,
+		Signature: sig.Bytes(),
+	}
+	batch := s.db.NewBatch()
+	defer batch.Close()
+	if err := batch.Set(signRecordKey(pk, pubRand), record.marshal()); err != nil {
+		return nil, err
+	}
+	if err := batch.Set(lastSignedHeightKey(pk), binary.BigEndian.AppendUint64(nil, height)); err != nil {
+		return nil, err
+	}
+	if err := batch.WriteSync(); err != nil {
+		return nil, fmt.Errorf("failed to persist the sign record: %w", err)
+	}
+
+	return sig, nil
+}
+
+// LastSignedHeight returns the last signed height of the given EOTS public
+// key, and whether the EOTS public key has signed any message
+func (s *Signer) LastSignedHeight(pk *eots.PublicKey) (uint64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getLastSignedHeight(pubKeyBytes(pk))
+}
+
+// GetSignRecord returns the sign record of the given EOTS public key and
+// public randomness, or nil if the public randomness has not been used.
+// Precondition: pubRand must be normalized
+func (s *Signer) GetSignRecord(pk *eots.PublicKey, pubRand *eots.PublicRand) (*SignRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.getSignRecord(pubKeyBytes(pk), pubRand.Bytes()[:])
+}
+
+func (s *Signer) getLastSignedHeight(pk []byte) (uint64, bool, error) {
+	bz, err := s.db.Get(lastSignedHeightKey(pk))
+	if err != nil {
+		return 0, false, err
+	}
+	if bz == nil {
+		return 0, false, nil
+	}
+	if len(bz) != 8 {
+		return 0, false, fmt.Errorf("invalid last signed height with length %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), true, nil
+}
+
+func (s *Signer) getSignRecord(pk []byte, pubRand []byte) (*SignRecord, error) {
+	bz, err := s.db.Get(signRecordKey(pk, pubRand))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, nil
+	}
+	return unmarshalSignRecord(bz)
+}
+
+// marshal encodes the sign record as (height || msg hash || signature)
+func (r *SignRecord) marshal() []byte {
+	bz := make([]byte, 0, 8+msgHashLen+sigLen)
+	bz = binary.BigEndian.AppendUint64(bz, r.Height)
+	bz = append(bz, r.MsgHash[:]...)
+	return append(bz, r.Signature[:]...)
+}
+
+func unmarshalSignRecord(bz []byte) (*SignRecord, error) {
+	if len(bz) != 8+msgHashLen+sigLen {
+		return nil, fmt.Errorf("invalid sign record with length %d", len(bz))
+	}
+	record := &SignRecord{Height: binary.BigEndian.Uint64(bz[:8])}
+	copy(record.MsgHash[:], bz[8:8+msgHashLen])
+	copy(record.Signature[:], bz[8+msgHashLen:])
+	return record, nil
+}
+
+func lastSignedHeightKey(pk []byte) []byte {
+	return append(append([]byte{}, lastSignedHeightKeyPrefix...), pk...)
+}
+
+func signRecordKey(pk []byte, pubRand []byte) []byte {
+	key := append(append([]byte{}, signRecordKeyPrefix...), pk...)
+	return append(key, pubRand...)
+}
+
+// pubKeyBytes returns the BIP-340 encoding of the EOTS public key
+func pubKeyBytes(pk *eots.PublicKey) []byte {
+	return pk.SerializeCompressed()[1:]
+}
+
+// pubRandBytes returns the public randomness of the given private randomness,
+// which is the x-coordinate of the corresponding point as in eots.RandGen
+func pubRandBytes(privRand *eots.PrivateRand) []byte {
+	var j secp256k1.JacobianPoint
+	secp256k1.NewPrivateKey(privRand).PubKey().AsJacobian(&j)
+	return j.X.Bytes()[:]
+}
@@ -0,0 +1,180 @@
+package signer_test
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/crypto/eots"
+	"github.com/babylonlabs-io/babylon/v4/crypto/eots/signer"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+)
+
+func FuzzSign(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		dir := t.TempDir()
+
+		s, err := signer.OpenSigner(dir)
+		require.NoError(t, err)
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		pk := eots.PubGen(sk)
+		_, found, err := s.LastSignedHeight(pk)
+		require.NoError(t, err)
+		require.False(t, found)
+
+		height := datagen.RandomInt(r, 1000) + 1
+		sr, pr, err := eots.RandGen(r)
+		require.NoError(t, err)
+		msg := datagen.GenRandomByteArray(r, 32)
+		sig, err := s.Sign(sk, sr, height, msg)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(pk, pr, msg, sig))
+
+		lastSignedHeight, found, err := s.LastSignedHeight(pk)
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, height, lastSignedHeight)
+		record, err := s.GetSignRecord(pk, pr)
+		require.NoError(t, err)
+		require.NotNil(t, record)
+		require.Equal(t, height, record.Height)
+		require.Equal(t, sig.Bytes(), record.Signature)
+
+		// the signer is restarted
+		require.NoError(t, s.Close())
+		s, err = signer.OpenSigner(dir)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, s.Close())
+		}()
+
+		// signing the same message again returns the same signature
+		sig2, err := s.Sign(sk, sr, height, msg)
+		require.NoError(t, err)
+		require.True(t, sig.Equals(sig2))
+
+		// signing a different message with the same public randomness is
+		// refused, at any height
+		_, err = s.Sign(sk, sr, height, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, signer.ErrDoubleSign)
+		_, err = s.Sign(sk, sr, height+datagen.RandomInt(r, 10)+1, msg)
+		require.ErrorIs(t, err, signer.ErrDoubleSign)
+
+		// signing at a height not higher than the last signed height is
+		// refused
+		sr2, pr2, err := eots.RandGen(r)
+		require.NoError(t, err)
+		_, err = s.Sign(sk, sr2, height-datagen.RandomInt(r, int(height)), msg)
+		require.ErrorIs(t, err, signer.ErrHeightRegression)
+		record, err = s.GetSignRecord(pk, pr2)
+		require.NoError(t, err)
+		require.Nil(t, record)
+
+		// signing at a higher height with a fresh public randomness succeeds
+		msg2 := datagen.GenRandomByteArray(r, 32)
+		sig3, err := s.Sign(sk, sr2, height+1, msg2)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(pk, pr2, msg2, sig3))
+
+		// the sign state is per EOTS key
+		sk2, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		sig4, err := s.Sign(sk2, sr, height, msg2)
+		require.NoError(t, err)
+		require.NoError(t, eots.Verify(eots.PubGen(sk2), pr, msg2, sig4))
+	})
+}
+
+// FuzzSignNoEquivocation signs random messages with random reuses of public
+// randomness and random restarts of the signer, and checks that no two
+// released signatures allow extracting the EOTS private key
+func FuzzSignNoEquivocation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		dir := t.TempDir()
+
+		s, err := signer.OpenSigner(dir)
+		require.NoError(t, err)
+		defer func() {
+			require.NoError(t, s.Close())
+		}()
+
+		sk, err := eots.KeyGen(r)
+		require.NoError(t, err)
+		pk := eots.PubGen(sk)
+
+		type signed struct {
+			pubRand *eots.PublicRand
+			msg     []byte
+			sig     *eots.Signature
+		}
+		type randPair struct {
+			sr *eots.PrivateRand
+			pr *eots.PublicRand
+		}
+		var (
+			rands   []randPair
+			msgs    [][]byte
+			sigs    []signed
+			highest uint64
+		)
+		for i := 0; i < 50; i++ {
+			if len(rands) == 0 || r.Intn(2) == 0 {
+				sr, pr, err := eots.RandGen(r)
+				require.NoError(t, err)
+				rands = append(rands, randPair{sr, pr})
+			}
+			if len(msgs) == 0 || r.Intn(2) == 0 {
+				msgs = append(msgs, datagen.GenRandomByteArray(r, 32))
+			}
+			rp := rands[r.Intn(len(rands))]
+			msg := msgs[r.Intn(len(msgs))]
+			height := datagen.RandomInt(r, 20) + 1
+
+			sig, err := s.Sign(sk, rp.sr, height, msg)
+			if err != nil {
+				require.True(t, errors.Is(err, signer.ErrDoubleSign) || errors.Is(err, signer.ErrHeightRegression), err)
+			} else {
+				require.NoError(t, eots.Verify(pk, rp.pr, msg, sig))
+				sigs = append(sigs, signed{rp.pr, msg, sig})
+				highest = max(highest, height)
+			}
+
+			// the signer is restarted at random
+			if r.Intn(5) == 0 {
+				require.NoError(t, s.Close())
+				s, err = signer.OpenSigner(dir)
+				require.NoError(t, err)
+			}
+		}
+
+		if len(sigs) > 0 {
+			lastSignedHeight, found, err := s.LastSignedHeight(pk)
+			require.NoError(t, err)
+			require.True(t, found)
+			require.Equal(t, highest, lastSignedHeight)
+		}
+
+		// all released signatures with the same public randomness are over
+		// the same message, thus the EOTS private key cannot be extracted
+		for i := range sigs {
+			for j := i + 1; j < len(sigs); j++ {
+				if !sigs[i].pubRand.Equals(sigs[j].pubRand) {
+					continue
+				}
+				require.True(t, bytes.Equal(sigs[i].msg, sigs[j].msg))
+				require.True(t, sigs[i].sig.Equals(sigs[j].sig))
+			}
+		}
+	})
+}
@@ -82,7 +82,7 @@ const (
 )
 
 var (
-	DefaultGasLimit                  = uint64(1_000_000)
+	DefaultGasLimit                  = uint64(2_000_000)
 	defaultFeeCoin                   = sdk.NewCoin("ubbn", math.NewInt(defaultFee))
 	BtcParams                        = &chaincfg.SimNetParams
 	covenantSKs, pks, CovenantQuorum = bstypes.LargeDefaultCovenantCommittee()
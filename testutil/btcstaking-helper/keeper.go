@@ -406,6 +406,93 @@ func (h *Helper) CreateDelegationWithBtcBlockHeight(
 	addToAllowList bool,
 	stakingTransactionInclusionHeight uint32,
 	lightClientTipHeight uint32,
+) (string, *types.MsgCreateBTCDelegation, *types.BTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *UnbondingTxInfo, error) {
+	// random signer
+	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
+
+	return h.CreateDelegationFromStaker(
+		r,
+		staker,
+		delSK,
+		fpPK,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+		usePreApproval,
+		addToAllowList,
+		stakingTransactionInclusionHeight,
+		lightClientTipHeight,
+	)
+}
+
+// CreateDelegationFromStaker creates a BTC delegation like
+// CreateDelegationWithBtcBlockHeight, staked from the given Babylon address.
+// The scripts of the BTC delegation commit to the EOTS PK of the finality
+// provider at the current height
+func (h *Helper) CreateDelegationFromStaker(
+	r *rand.Rand,
+	staker sdk.AccAddress,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+	usePreApproval bool,
+	addToAllowList bool,
+	stakingTransactionInclusionHeight uint32,
+	lightClientTipHeight uint32,
+) (string, *types.MsgCreateBTCDelegation, *types.BTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *UnbondingTxInfo, error) {
+	fpScriptPK := fpPK
+	if fp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, bbn.NewBIP340PubKeyFromBTCPK(fpPK).MustMarshal()); err == nil {
+		fpScriptPK = fp.EotsPkAtHeight(uint64(h.Ctx.HeaderInfo().Height)).MustToBTCPK()
+	}
+	return h.createDelegation(
+		r,
+		staker,
+		delSK,
+		fpPK,
+		fpScriptPK,
+		stakingValue,
+		stakingTime,
+		unbondingValue,
+		unbondingTime,
+		usePreApproval,
+		addToAllowList,
+		stakingTransactionInclusionHeight,
+		lightClientTipHeight,
+	)
+}
+
+// CreateDelegationWithFpScriptPK creates a pre-approval BTC delegation to
+// the given finality provider whose scripts commit to the given PK
+func (h *Helper) CreateDelegationWithFpScriptPK(
+	r *rand.Rand,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	fpScriptPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+) (string, *types.MsgCreateBTCDelegation, *types.BTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *UnbondingTxInfo, error) {
+	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
+	return h.createDelegation(r, staker, delSK, fpPK, fpScriptPK, stakingValue, stakingTime, 0, 0, true, false, 10, 10)
+}
+
+func (h *Helper) createDelegation(
+	r *rand.Rand,
+	staker sdk.AccAddress,
+	delSK *btcec.PrivateKey,
+	fpPK *btcec.PublicKey,
+	fpScriptPK *btcec.PublicKey,
+	stakingValue int64,
+	stakingTime uint16,
+	unbondingValue int64,
+	unbondingTime uint16,
+	usePreApproval bool,
+	addToAllowList bool,
+	stakingTransactionInclusionHeight uint32,
+	lightClientTipHeight uint32,
 ) (string, *types.MsgCreateBTCDelegation, *types.BTCDelegation, *btclctypes.BTCHeaderInfo, *types.InclusionProof, *UnbondingTxInfo, error) {
 	stakingTimeBlocks := stakingTime
 	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
@@ -427,7 +514,7 @@ func (h *Helper) CreateDelegationWithBtcBlockHeight(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		[]*btcec.PublicKey{fpScriptPK},
 		covPKs,
 		bsParams.CovenantQuorum,
 		stakingTimeBlocks,
@@ -439,9 +526,6 @@ func (h *Helper) CreateDelegationWithBtcBlockHeight(
 	h.NoError(err)
 	stakingTxHash := testStakingInfo.StakingTx.TxHash().String()
 
-	// random signer
-	staker := sdk.MustAccAddressFromBech32(datagen.GenRandomAccount().Address)
-
 	// PoP
 	pop, err := datagen.NewPoPBTC(staker, delSK)
 	h.NoError(err)
@@ -481,7 +565,7 @@ func (h *Helper) CreateDelegationWithBtcBlockHeight(
 		h.t,
 		h.Net,
 		delSK,
-		[]*btcec.PublicKey{fpPK},
+		[]*btcec.PublicKey{fpScriptPK},
 		covPKs,
 		bsParams.CovenantQuorum,
 		wire.NewOutPoint(&stkTxHash, stkOutputIdx),
@@ -580,7 +664,7 @@ func (h *Helper) GenerateCovenantSignaturesMessages(
 
 	bsParams := h.BTCStakingKeeper.GetParams(h.Ctx)
 
-	vPKs, err := bbn.NewBTCPKsFromBIP340PKs(del.FpBtcPkList)
+	vPKs, err := bbn.NewBTCPKsFromBIP340PKs(del.FpScriptPks())
 	h.NoError(err)
 
 	stakingInfo, err := del.GetStakingInfo(&bsParams, h.Net)
@@ -3,6 +3,7 @@ package datagen
 import (
 	"errors"
 	"math/rand"
+	"time"
 
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
@@ -100,6 +101,38 @@ func NewMsgAddFinalitySig(
 	return msg, nil
 }
 
+// NewMsgAddFinalitySigs returns a MsgAddFinalitySigs with the votes of the
+// given finality provider on the given blocks
+func NewMsgAddFinalitySigs(
+	signer string,
+	sk *btcec.PrivateKey,
+	startHeight uint64,
+	blockHeights []uint64,
+	randListInfo *RandListInfo,
+	blockAppHashes [][]byte,
+) (*ftypes.MsgAddFinalitySigs, error) {
+	msg := &ftypes.MsgAddFinalitySigs{
+		Signer:  signer,
+		FpBtcPk: bbn.NewBIP340PubKeyFromBTCPK(sk.PubKey()),
+		Sigs:    make([]*ftypes.FinalitySigEntry, 0, len(blockHeights)),
+	}
+	for i, blockHeight := range blockHeights {
+		item, err := NewMsgAddFinalitySig(signer, sk, startHeight, blockHeight, randListInfo, blockAppHashes[i])
+		if err != nil {
+			return nil, err
+		}
+		msg.Sigs = append(msg.Sigs, &ftypes.FinalitySigEntry{
+			BlockHeight:  item.BlockHeight,
+			PubRand:      item.PubRand,
+			Proof:        item.Proof,
+			BlockAppHash: item.BlockAppHash,
+			FinalitySig:  item.FinalitySig,
+		})
+	}
+
+	return msg, nil
+}
+
 func GenRandomEvidence(r *rand.Rand, sk *btcec.PrivateKey, height uint64) (*ftypes.Evidence, error) {
 	pk := sk.PubKey()
 	bip340PK := bbn.NewBIP340PubKeyFromBTCPK(pk)
@@ -164,6 +197,7 @@ func GenRandomFinalityGenesisState(r *rand.Rand) (*ftypes.GenesisState, error) {
 		pubRandCommIdx = make([]*ftypes.PubRandCommitIdx, entriesCount)
 		signInfo       = make([]ftypes.SigningInfo, entriesCount)
 		missedBlocks   = make([]ftypes.FinalityProviderMissedBlocks, entriesCount)
+		jailRecords    = make([]*ftypes.JailRecord, entriesCount)
 		votingPowers   = make([]*ftypes.VotingPowerFP, entriesCount)
 		vpDistCache    = make([]*ftypes.VotingPowerDistCacheBlkHeight, entriesCount)
 		params         = ftypes.DefaultParams()
@@ -234,6 +268,11 @@ func GenRandomFinalityGenesisState(r *rand.Rand) (*ftypes.GenesisState, error) {
 			FpSigningInfo: ftypes.NewFinalityProviderSigningInfo(btcPk, int64(blockHeight), int64(RandomInt(r, 10000))),
 		}
 
+		// populate jail records
+		jailedAt := time.Unix(int64(RandomInt(r, 1000000000)), 0).UTC()
+		jailRecord := signInfo[i].FpSigningInfo.Jail(int64(blockHeight), jailedAt, params)
+		jailRecords[i] = &jailRecord
+
 		// populate missed blocks
 		missedBlocks[i] = ftypes.FinalityProviderMissedBlocks{
 			FpBtcPk: btcPk,
@@ -270,6 +309,7 @@ func GenRandomFinalityGenesisState(r *rand.Rand) (*ftypes.GenesisState, error) {
 		PubRandCommit:        pubRandComm,
 		SigningInfos:         signInfo,
 		MissedBlocks:         missedBlocks,
+		JailRecords:          jailRecords,
 		VotingPowers:         votingPowers,
 		VpDstCache:           vpDistCache,
 		NextHeightToFinalize: uint64(entriesCount),
@@ -277,3 +317,38 @@ func GenRandomFinalityGenesisState(r *rand.Rand) (*ftypes.GenesisState, error) {
 		PubRandCommitIndexes: pubRandCommIdx,
 	}, nil
 }
+
+// NewMsgRotateFinalityProviderKey returns a MsgRotateFinalityProviderKey
+// rotating the EOTS key of the given finality provider from currentSK to
+// newSK at the given activation height on the given chain
+func NewMsgRotateFinalityProviderKey(
+	chainID string,
+	signer string,
+	fpBtcPk *bbn.BIP340PubKey,
+	currentSK *btcec.PrivateKey,
+	newSK *btcec.PrivateKey,
+	activationHeight uint64,
+) (*ftypes.MsgRotateFinalityProviderKey, error) {
+	msg := &ftypes.MsgRotateFinalityProviderKey{
+		Signer:           signer,
+		FpBtcPk:          fpBtcPk,
+		NewEotsPk:        bbn.NewBIP340PubKeyFromBTCPK(newSK.PubKey()),
+		ActivationHeight: activationHeight,
+	}
+	hash, err := msg.HashToSign(chainID)
+	if err != nil {
+		return nil, err
+	}
+	currentKeySig, err := schnorr.Sign(currentSK, hash)
+	if err != nil {
+		return nil, err
+	}
+	newKeySig, err := schnorr.Sign(newSK, hash)
+	if err != nil {
+		return nil, err
+	}
+	msg.CurrentKeySig = bbn.NewBIP340SignatureFromBTCSig(currentKeySig)
+	msg.NewKeySig = bbn.NewBIP340SignatureFromBTCSig(newKeySig)
+
+	return msg, nil
+}
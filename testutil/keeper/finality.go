@@ -41,9 +41,11 @@ func FinalityKeeperWithStoreKey(
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 
+	storeQuerier, _ := stateStore.(storetypes.Queryable)
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
+		storeQuerier,
 		bsKeeper,
 		iKeeper,
 		ckptKeeper,
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"fmt"
 	"math/big"
 
 	"github.com/btcsuite/btcd/chaincfg"
@@ -15,11 +16,12 @@ type BtcConfig struct {
 }
 
 const (
-	BtcMainnet SupportedBtcNetwork = "mainnet"
-	BtcTestnet SupportedBtcNetwork = "testnet"
-	BtcSimnet  SupportedBtcNetwork = "simnet"
-	BtcRegtest SupportedBtcNetwork = "regtest"
-	BtcSignet  SupportedBtcNetwork = "signet"
+	BtcMainnet  SupportedBtcNetwork = "mainnet"
+	BtcTestnet  SupportedBtcNetwork = "testnet"
+	BtcTestnet4 SupportedBtcNetwork = "testnet4"
+	BtcSimnet   SupportedBtcNetwork = "simnet"
+	BtcRegtest  SupportedBtcNetwork = "regtest"
+	BtcSignet   SupportedBtcNetwork = "signet"
 )
 
 func getParams(opts servertypes.AppOptions) *chaincfg.Params {
@@ -35,19 +37,60 @@ func getParams(opts servertypes.AppOptions) *chaincfg.Params {
 		panic("Bitcoin network config should be valid string")
 	}
 
+	signetChallenge, err := cast.ToStringE(opts.Get("btc-config.signet-challenge"))
+	if err != nil {
+		panic("Bitcoin signet challenge config should be valid string")
+	}
+
+	signetGenesisHash, err := cast.ToStringE(opts.Get("btc-config.signet-genesis-hash"))
+	if err != nil {
+		panic("Bitcoin signet genesis hash config should be valid string")
+	}
+
+	params, err := getBtcNetParamsWithSignetConfig(network, signetChallenge, signetGenesisHash)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return params
+}
+
+// getBtcNetParamsWithSignetConfig returns the Bitcoin network parameters of
+// the given supported network. If the network is signet and the challenge is
+// not empty, the parameters of the custom signet are returned.
+func getBtcNetParamsWithSignetConfig(network, signetChallenge, signetGenesisHash string) (*chaincfg.Params, error) {
+	if signetChallenge == "" && signetGenesisHash == "" {
+		return GetBtcNetParams(network)
+	}
+
+	if network != string(BtcSignet) {
+		return nil, fmt.Errorf("signet challenge and genesis hash can only be set for the signet network, got %s", network)
+	}
+	if signetChallenge == "" {
+		return nil, fmt.Errorf("signet challenge should be provided for a custom signet")
+	}
+
+	return NewCustomSignetParams(signetChallenge, signetGenesisHash)
+}
+
+// GetBtcNetParams returns the Bitcoin network parameters of the given
+// supported network
+func GetBtcNetParams(network string) (*chaincfg.Params, error) {
 	switch network {
 	case string(BtcMainnet):
-		return &chaincfg.MainNetParams
+		return &chaincfg.MainNetParams, nil
 	case string(BtcTestnet):
-		return &chaincfg.TestNet3Params
+		return &chaincfg.TestNet3Params, nil
+	case string(BtcTestnet4):
+		return &TestNet4Params, nil
 	case string(BtcSimnet):
-		return &chaincfg.SimNetParams
+		return &chaincfg.SimNetParams, nil
 	case string(BtcRegtest):
-		return &chaincfg.RegressionNetParams
+		return &chaincfg.RegressionNetParams, nil
 	case string(BtcSignet):
-		return &chaincfg.SigNetParams
+		return &chaincfg.SigNetParams, nil
 	default:
-		panic("Bitcoin network should be one of [mainet, testnet, simnet, regtest, signet]")
+		return nil, fmt.Errorf("bitcoin network should be one of [mainnet, testnet, testnet4, simnet, regtest, signet]")
 	}
 }
 
@@ -0,0 +1,128 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// TestNet4Net represents the test network (version 4), i.e., the network
+// magic of testnet4 defined in BIP-94.
+const TestNet4Net wire.BitcoinNet = 0x283f161c
+
+// testNet4GenesisCoinbaseTx is the coinbase transaction of the genesis block
+// of testnet4.
+var testNet4GenesisCoinbaseTx = wire.MsgTx{
+	Version: 1,
+	TxIn: []*wire.TxIn{
+		{
+			PreviousOutPoint: wire.OutPoint{
+				Hash:  chainhash.Hash{},
+				Index: 0xffffffff,
+			},
+			SignatureScript: append(
+				[]byte{0x04, 0xff, 0xff, 0x00, 0x1d, 0x01, 0x04, 0x4c, 0x4c},
+				[]byte("03/May/2024 000000000000000000001ebd58c244970b3aa9d783bb001011fbe8ea8e98e00e")...,
+			),
+			Sequence: 0xffffffff,
+		},
+	},
+	TxOut: []*wire.TxOut{
+		{
+			Value: 0x12a05f200,
+			// OP_DATA_33 <33 zero bytes> OP_CHECKSIG
+			PkScript: append(append([]byte{0x21}, make([]byte, 33)...), 0xac),
+		},
+	},
+	LockTime: 0,
+}
+
+// testNet4GenesisMerkleRoot is the hash of the first transaction in the
+// genesis block of testnet4.
+var testNet4GenesisMerkleRoot = testNet4GenesisCoinbaseTx.TxHash()
+
+// testNet4GenesisBlock defines the genesis block of testnet4.
+var testNet4GenesisBlock = wire.MsgBlock{
+	Header: wire.BlockHeader{
+		Version:    1,
+		PrevBlock:  chainhash.Hash{},
+		MerkleRoot: testNet4GenesisMerkleRoot,
+		Timestamp:  time.Unix(1714777860, 0), // 2024-05-03 23:11:00 +0000 UTC
+		Bits:       0x1d00ffff,
+		Nonce:      393743547,
+	},
+	Transactions: []*wire.MsgTx{&testNet4GenesisCoinbaseTx},
+}
+
+// testNet4GenesisHash is the hash of the genesis block of testnet4.
+var testNet4GenesisHash = testNet4GenesisBlock.BlockHash()
+
+// TestNet4Params defines the network parameters for the test Bitcoin network
+// (version 4) defined in BIP-94. Address encodings and proof of work limits
+// are the same as those of testnet3.
+//
+// Note that testnet4 enforces the BIP-94 difficulty and timewarp rules, which
+// cannot be expressed by chaincfg.Params. Use EnforceBIP94 to check whether
+// a network is subject to these rules.
+var TestNet4Params = newTestNet4Params()
+
+func newTestNet4Params() chaincfg.Params {
+	params := chaincfg.TestNet3Params
+	params.Name = string(BtcTestnet4)
+	params.Net = TestNet4Net
+	params.DefaultPort = "48333"
+	params.DNSSeeds = []chaincfg.DNSSeed{
+		{Host: "seed.testnet4.bitcoin.sprovoost.nl", HasFiltering: true},
+		{Host: "seed.testnet4.wiz.biz", HasFiltering: false},
+	}
+	params.GenesisBlock = &testNet4GenesisBlock
+	params.GenesisHash = &testNet4GenesisHash
+	params.BIP0034Height = 1
+	params.BIP0065Height = 1
+	params.BIP0066Height = 1
+	params.Checkpoints = nil
+	return params
+}
+
+// EnforceBIP94 returns whether the given network enforces the BIP-94 rules,
+// i.e.,
+//   - the difficulty retarget is based on the difficulty of the first block of
+//     the difficulty period rather than the last one, and
+//   - the timestamp of the first block of a difficulty period cannot be more
+//     than 600 seconds before the timestamp of its parent.
+func EnforceBIP94(params *chaincfg.Params) bool {
+	return params.Net == TestNet4Net
+}
+
+// NewCustomSignetParams returns the network parameters of a custom signet
+// with the given hex encoded challenge script. If the hex encoded genesis
+// hash is not empty, it overrides the genesis hash of the default signet.
+//
+// Note that the challenge only determines the network magic. The signet
+// block signatures satisfying the challenge are part of the coinbase
+// transaction, so they cannot be verified from the headers alone.
+func NewCustomSignetParams(challengeHex string, genesisHashHex string) (*chaincfg.Params, error) {
+	challenge, err := hex.DecodeString(challengeHex)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signet challenge %s: %w", challengeHex, err)
+	}
+	if len(challenge) == 0 {
+		return nil, fmt.Errorf("signet challenge cannot be empty")
+	}
+
+	params := chaincfg.CustomSignetParams(challenge, nil)
+
+	if genesisHashHex != "" {
+		genesisHash, err := chainhash.NewHashFromStr(genesisHashHex)
+		if err != nil {
+			return nil, fmt.Errorf("invalid signet genesis hash %s: %w", genesisHashHex, err)
+		}
+		params.GenesisHash = genesisHash
+	}
+
+	return &params, nil
+}
@@ -0,0 +1,109 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/stretchr/testify/require"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+)
+
+// signetChallenge is the challenge script of the default signet
+const signetChallenge = "512103ad5e0edad18cb1f0fc0d28a3d4f1f3e445640337489abb10404f2d1e086be430210359ef5021964fe22d6f8e05b2463c9540ce96883fe3b278760f048f5189f2e6c452ae"
+
+type testAppOptions map[string]interface{}
+
+func (o testAppOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+func TestTestNet4Params(t *testing.T) {
+	params, err := bbn.GetBtcNetParams(string(bbn.BtcTestnet4))
+	require.NoError(t, err)
+
+	require.Equal(t, "00000000da84f2bafbbc53dee25a72ae507ff4914b867c565be350b0da8bf043", params.GenesisHash.String())
+	genesisHash := params.GenesisBlock.BlockHash()
+	require.Equal(t, params.GenesisHash.String(), genesisHash.String())
+	require.Equal(t, "7aa0a7ae1e223414cb807e40cd57e667b718e42aaf9306db9102fe28912b7b4e", params.GenesisBlock.Header.MerkleRoot.String())
+	require.True(t, params.ReduceMinDifficulty)
+	require.True(t, bbn.EnforceBIP94(params))
+
+	// testnet3 has the same address encodings but does not enforce BIP-94
+	require.Equal(t, chaincfg.TestNet3Params.Bech32HRPSegwit, params.Bech32HRPSegwit)
+	require.False(t, bbn.EnforceBIP94(&chaincfg.TestNet3Params))
+}
+
+func TestNewCustomSignetParams(t *testing.T) {
+	// the default signet challenge results in the default signet
+	params, err := bbn.NewCustomSignetParams(signetChallenge, "")
+	require.NoError(t, err)
+	require.Equal(t, chaincfg.SigNetParams.Net, params.Net)
+	require.Equal(t, chaincfg.SigNetParams.GenesisHash, params.GenesisHash)
+
+	// a custom challenge results in a different network magic
+	params, err = bbn.NewCustomSignetParams("51", "")
+	require.NoError(t, err)
+	require.NotEqual(t, chaincfg.SigNetParams.Net, params.Net)
+
+	// the genesis hash can be overridden
+	genesisHash := "0000012965e5c1ef5a5a8b3dc36e0b4a4c50d5a4b6ae6a2a2fc3b6e7e4d8a2f1"
+	params, err = bbn.NewCustomSignetParams("51", genesisHash)
+	require.NoError(t, err)
+	require.Equal(t, genesisHash, params.GenesisHash.String())
+
+	_, err = bbn.NewCustomSignetParams("", "")
+	require.Error(t, err)
+	_, err = bbn.NewCustomSignetParams("not-hex", "")
+	require.Error(t, err)
+	_, err = bbn.NewCustomSignetParams("51", "not-hex")
+	require.Error(t, err)
+}
+
+func TestParseBtcOptionsFromConfig(t *testing.T) {
+	t.Run("testnet4", func(t *testing.T) {
+		cfg := bbn.ParseBtcOptionsFromConfig(testAppOptions{
+			"btc-config.network": string(bbn.BtcTestnet4),
+		})
+		require.Equal(t, &bbn.TestNet4Params, cfg.NetParams())
+		require.True(t, bbn.EnforceBIP94(cfg.NetParams()))
+	})
+
+	t.Run("default signet", func(t *testing.T) {
+		cfg := bbn.ParseBtcOptionsFromConfig(testAppOptions{
+			"btc-config.network": string(bbn.BtcSignet),
+		})
+		require.Equal(t, &chaincfg.SigNetParams, cfg.NetParams())
+		require.False(t, bbn.EnforceBIP94(cfg.NetParams()))
+	})
+
+	t.Run("custom signet", func(t *testing.T) {
+		challenge := hex.EncodeToString([]byte{0x51})
+		cfg := bbn.ParseBtcOptionsFromConfig(testAppOptions{
+			"btc-config.network":          string(bbn.BtcSignet),
+			"btc-config.signet-challenge": challenge,
+		})
+		expected, err := bbn.NewCustomSignetParams(challenge, "")
+		require.NoError(t, err)
+		require.Equal(t, expected.Net, cfg.NetParams().Net)
+	})
+
+	t.Run("signet challenge on other networks", func(t *testing.T) {
+		require.Panics(t, func() {
+			bbn.ParseBtcOptionsFromConfig(testAppOptions{
+				"btc-config.network":          string(bbn.BtcTestnet4),
+				"btc-config.signet-challenge": "51",
+			})
+		})
+	})
+
+	t.Run("signet genesis hash without challenge", func(t *testing.T) {
+		require.Panics(t, func() {
+			bbn.ParseBtcOptionsFromConfig(testAppOptions{
+				"btc-config.network":             string(bbn.BtcSignet),
+				"btc-config.signet-genesis-hash": chaincfg.SigNetParams.GenesisHash.String(),
+			})
+		})
+	})
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/wire"
+)
+
+// maxTimewarp is the maximum number of seconds that the timestamp of the
+// first block of a difficulty period can be before the timestamp of its
+// parent, as defined in BIP-94.
+const maxTimewarp = 600
+
+// bip94HeaderCtx wraps the parent of a header on networks enforcing BIP-94 so
+// that the difficulty calculation of btcd follows the BIP-94 rules. Upon
+// retarget, BIP-94 computes the new difficulty from the difficulty of the
+// first block of the difficulty period, which cannot use the minimum
+// difficulty exception, rather than from the difficulty of the last block.
+// Between retargets, the rules of btcd and BIP-94 are the same.
+type bip94HeaderCtx struct {
+	blockchain.HeaderCtx
+	c blockchain.ChainCtx
+}
+
+var _ blockchain.HeaderCtx = (*bip94HeaderCtx)(nil)
+
+func newBIP94HeaderCtx(prevNode blockchain.HeaderCtx, c blockchain.ChainCtx) *bip94HeaderCtx {
+	return &bip94HeaderCtx{HeaderCtx: prevNode, c: c}
+}
+
+// Bits returns the difficulty of the first block of the difficulty period if
+// the next block is at a difficulty retarget interval, and the difficulty of
+// the wrapped header otherwise.
+func (h *bip94HeaderCtx) Bits() uint32 {
+	blocksPerRetarget := h.c.BlocksPerRetarget()
+	if (h.Height()+1)%blocksPerRetarget != 0 {
+		return h.HeaderCtx.Bits()
+	}
+	firstNode := h.RelativeAncestorCtx(blocksPerRetarget - 1)
+	if firstNode == nil {
+		// btcd fails to obtain the previous retarget block as well
+		return h.HeaderCtx.Bits()
+	}
+	return firstNode.Bits()
+}
+
+// checkBIP94Timewarp checks that the first block of a difficulty period is not
+// more than maxTimewarp seconds before its parent, as defined in BIP-94.
+func checkBIP94Timewarp(
+	header *wire.BlockHeader,
+	prevNode blockchain.HeaderCtx,
+	c blockchain.ChainCtx,
+) error {
+	if (prevNode.Height()+1)%c.BlocksPerRetarget() == 0 &&
+		header.Timestamp.Unix() < prevNode.Timestamp()-maxTimewarp {
+		return fmt.Errorf("block timestamp of %v is more than %d seconds before the timestamp of its parent %v",
+			header.Timestamp, maxTimewarp, time.Unix(prevNode.Timestamp(), 0))
+	}
+	return nil
+}
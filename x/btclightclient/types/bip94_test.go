@@ -0,0 +1,135 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/btcsuite/btcd/blockchain"
+	"github.com/btcsuite/btcd/wire"
+	"github.com/stretchr/testify/require"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+)
+
+// testHeaderCtx is a blockchain.HeaderCtx backed by an in-memory chain
+type testHeaderCtx struct {
+	chain  []*wire.BlockHeader
+	height int32
+}
+
+var _ blockchain.HeaderCtx = (*testHeaderCtx)(nil)
+
+func (h *testHeaderCtx) Height() int32    { return h.height }
+func (h *testHeaderCtx) Bits() uint32     { return h.chain[h.height].Bits }
+func (h *testHeaderCtx) Timestamp() int64 { return h.chain[h.height].Timestamp.Unix() }
+func (h *testHeaderCtx) Parent() blockchain.HeaderCtx {
+	return h.RelativeAncestorCtx(1)
+}
+func (h *testHeaderCtx) RelativeAncestorCtx(distance int32) blockchain.HeaderCtx {
+	if h.height-distance < 0 {
+		return nil
+	}
+	return &testHeaderCtx{chain: h.chain, height: h.height - distance}
+}
+
+// genTestnet4Chain generates a chain of numBlocks headers mined every 10
+// minutes at the given difficulty
+func genTestnet4Chain(numBlocks int, bits uint32) []*wire.BlockHeader {
+	chain := make([]*wire.BlockHeader, numBlocks)
+	start := bbn.TestNet4Params.GenesisBlock.Header.Timestamp
+	for i := range chain {
+		chain[i] = &wire.BlockHeader{
+			Version:   4,
+			Bits:      bits,
+			Timestamp: start.Add(time.Duration(i) * bbn.TestNet4Params.TargetTimePerBlock),
+		}
+	}
+	return chain
+}
+
+func TestBIP94HeaderCtxDifficulty(t *testing.T) {
+	params := &bbn.TestNet4Params
+	ctx := newLightChainCtxFromParams(params)
+	blocksPerRetarget := int(ctx.BlocksPerRetarget())
+	realBits := uint32(0x1c7fffff)
+
+	t.Run("retarget is based on the first block of the period", func(t *testing.T) {
+		chain := genTestnet4Chain(blocksPerRetarget, realBits)
+		// the last block of the period is mined with the minimum difficulty
+		lastHeader := chain[blocksPerRetarget-1]
+		lastHeader.Bits = params.PowLimitBits
+		lastHeader.Timestamp = chain[blocksPerRetarget-2].Timestamp.Add(params.MinDiffReductionTime + time.Second)
+		lastNode := &testHeaderCtx{chain: chain, height: int32(blocksPerRetarget - 1)}
+
+		newBlockTime := lastHeader.Timestamp.Add(params.TargetTimePerBlock)
+		// the difficulty is adjusted from the one of the first block rather
+		// than the minimum one
+		actualTimespan := lastHeader.Timestamp.Unix() - chain[0].Timestamp.Unix()
+		expectedTarget := new(big.Int).Mul(blockchain.CompactToBig(realBits), big.NewInt(actualTimespan))
+		expectedTarget.Div(expectedTarget, big.NewInt(int64(params.TargetTimespan/time.Second)))
+		expectedBits := blockchain.BigToCompact(expectedTarget)
+		require.NotEqual(t, params.PowLimitBits, expectedBits)
+
+		bip94Node := newBIP94HeaderCtx(lastNode, ctx)
+		require.NoError(t, checkHeaderContext(expectedBits, newBlockTime, bip94Node, ctx))
+		require.Error(t, checkHeaderContext(params.PowLimitBits, newBlockTime, bip94Node, ctx))
+
+		// btcd, which does not enforce BIP-94, retargets from the minimum
+		// difficulty of the last block
+		require.NoError(t, checkHeaderContext(params.PowLimitBits, newBlockTime, lastNode, ctx))
+		require.Error(t, checkHeaderContext(expectedBits, newBlockTime, lastNode, ctx))
+	})
+
+	t.Run("minimum difficulty after 20 minutes without block", func(t *testing.T) {
+		chain := genTestnet4Chain(10, realBits)
+		lastNode := newBIP94HeaderCtx(&testHeaderCtx{chain: chain, height: 9}, ctx)
+
+		newBlockTime := chain[9].Timestamp.Add(params.TargetTimePerBlock)
+		require.NoError(t, checkHeaderContext(realBits, newBlockTime, lastNode, ctx))
+		require.Error(t, checkHeaderContext(params.PowLimitBits, newBlockTime, lastNode, ctx))
+
+		newBlockTime = chain[9].Timestamp.Add(params.MinDiffReductionTime + time.Second)
+		require.NoError(t, checkHeaderContext(params.PowLimitBits, newBlockTime, lastNode, ctx))
+		require.Error(t, checkHeaderContext(realBits, newBlockTime, lastNode, ctx))
+	})
+
+	t.Run("outdated block versions are rejected", func(t *testing.T) {
+		chain := genTestnet4Chain(10, realBits)
+		lastNode := newBIP94HeaderCtx(&testHeaderCtx{chain: chain, height: 9}, ctx)
+
+		header := &wire.BlockHeader{Version: 1, Bits: realBits, Timestamp: chain[9].Timestamp.Add(params.TargetTimePerBlock)}
+		require.Error(t, blockchain.CheckBlockHeaderContext(header, lastNode, blockchain.BFNone, ctx, true))
+	})
+}
+
+func TestCheckBIP94Timewarp(t *testing.T) {
+	params := &bbn.TestNet4Params
+	ctx := newLightChainCtxFromParams(params)
+	blocksPerRetarget := int(ctx.BlocksPerRetarget())
+
+	chain := genTestnet4Chain(blocksPerRetarget, uint32(0x1c7fffff))
+	prevNode := &testHeaderCtx{chain: chain, height: int32(blocksPerRetarget - 1)}
+	prevTimestamp := chain[blocksPerRetarget-1].Timestamp
+
+	newHeader := func(timestamp time.Time) *wire.BlockHeader {
+		return &wire.BlockHeader{Version: 4, Timestamp: timestamp}
+	}
+
+	// the first block of a difficulty period can be up to 600 seconds
+	// before its parent
+	require.NoError(t, checkBIP94Timewarp(newHeader(prevTimestamp.Add(-maxTimewarp*time.Second)), prevNode, ctx))
+	require.Error(t, checkBIP94Timewarp(newHeader(prevTimestamp.Add(-(maxTimewarp+1)*time.Second)), prevNode, ctx))
+
+	// the timewarp rule does not apply to other blocks
+	prevNode = &testHeaderCtx{chain: chain, height: int32(blocksPerRetarget - 2)}
+	prevTimestamp = chain[blocksPerRetarget-2].Timestamp
+	require.NoError(t, checkBIP94Timewarp(newHeader(prevTimestamp.Add(-(maxTimewarp+1)*time.Second)), prevNode, ctx))
+}
+
+// checkHeaderContext checks a header with the given difficulty and timestamp
+// against its parent with the header validation of btcd
+func checkHeaderContext(bits uint32, timestamp time.Time, prevNode blockchain.HeaderCtx, c blockchain.ChainCtx) error {
+	header := &wire.BlockHeader{Version: 4, Bits: bits, Timestamp: timestamp}
+	return blockchain.CheckBlockHeaderContext(header, prevNode, blockchain.BFNone, c, true)
+}
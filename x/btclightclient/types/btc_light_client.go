@@ -413,15 +413,24 @@ func (l *BtcLightClient) InsertHeaders(readStore BtcChainReadStore, headers []*w
 // One critical condition is that to properly validate difficulty adjustments
 // we should have at least one header which is at difficulty adjustment boundary
 // in store.
+// For networks enforcing BIP-94 (i.e., testnet4), the difficulty is computed
+// following the BIP-94 rules and the timewarp rule is checked as well.
 func (l *BtcLightClient) checkHeader(
 	s *storeWithExtensionChain,
 	parentHeaderInfo *localHeaderInfo,
 	blockHeader *wire.BlockHeader,
 ) error {
-	parentHeaderCtx := newLightHeaderCtx(
+	var parentHeaderCtx blockchain.HeaderCtx = newLightHeaderCtx(
 		parentHeaderInfo.height, parentHeaderInfo.header, s,
 	)
 
+	if bbn.EnforceBIP94(l.params) {
+		if err := checkBIP94Timewarp(blockHeader, parentHeaderCtx, l.ctx); err != nil {
+			return err
+		}
+		parentHeaderCtx = newBIP94HeaderCtx(parentHeaderCtx, l.ctx)
+	}
+
 	var emptyFlags blockchain.BehaviorFlags
 	err := blockchain.CheckBlockHeaderContext(
 		blockHeader, parentHeaderCtx, emptyFlags, l.ctx, true,
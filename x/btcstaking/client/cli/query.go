@@ -28,7 +28,14 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdFinalityProviders())
 	cmd.AddCommand(CmdBTCDelegations())
 	cmd.AddCommand(CmdFinalityProviderDelegations())
+	cmd.AddCommand(CmdDelegationsByStaker())
+	cmd.AddCommand(CmdExpiringDelegations())
+	cmd.AddCommand(CmdUnbondingDelegations())
+	cmd.AddCommand(CmdCovenantCommittees())
+	cmd.AddCommand(CmdCovenantCommitteeDelegations())
+	cmd.AddCommand(CmdCovenantStats())
 	cmd.AddCommand(CmdDelegation())
+	cmd.AddCommand(CmdCovenantMuSig2Session())
 	cmd.AddCommand(CmdQueryParamsByVersion())
 	cmd.AddCommand(CmdQueryLargestBtcReOrg())
 
@@ -91,6 +98,34 @@ func CmdDelegation() *cobra.Command {
 	return cmd
 }
 
+func CmdCovenantMuSig2Session() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-musig2-session [staking_tx_hash_hex]",
+		Short: "retrieve the covenant MuSig2 signing session of the unbonding tx of a BTC delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CovenantMuSig2Session(
+				cmd.Context(),
+				&types.QueryCovenantMuSig2SessionRequest{
+					StakingTxHashHex: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdFinalityProviders() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "finality-providers",
@@ -193,6 +228,250 @@ func CmdFinalityProviderDelegations() *cobra.Command {
 	return cmd
 }
 
+func CmdDelegationsByStaker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "staker-delegations [staker_pk_hex] [status]",
+		Short: "retrieve all BTC delegations of a given staker BTC PK, optionally under the given status (pending, verified, active, unbonded, expired, any)",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status := types.BTCDelegationStatus_ANY
+			if len(args) > 1 {
+				status, err = types.NewBTCDelegationStatusFromString(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.DelegationsByStaker(cmd.Context(), &types.QueryDelegationsByStakerRequest{
+				StakerBtcPkHex: args[0],
+				Status:         status,
+				Pagination:     pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "staker-delegations")
+
+	return cmd
+}
+
+func CmdExpiringDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "expiring-delegations [start_height] [end_height]",
+		Short: "retrieve all BTC delegations expiring within the given BTC height range (inclusive)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, endHeight, err := parseBTCHeightRange(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ExpiringDelegations(cmd.Context(), &types.QueryExpiringDelegationsRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "expiring-delegations")
+
+	return cmd
+}
+
+func CmdUnbondingDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-delegations [start_height] [end_height]",
+		Short: "retrieve all BTC delegations whose early unbonding completes within the given BTC height range (inclusive)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, endHeight, err := parseBTCHeightRange(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingDelegations(cmd.Context(), &types.QueryUnbondingDelegationsRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+				Pagination:  pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding-delegations")
+
+	return cmd
+}
+
+func CmdCovenantCommittees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-committees",
+		Short: "retrieve the covenant committees of all the parameters versions and their status at the current BTC tip",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CovenantCommittees(cmd.Context(), &types.QueryCovenantCommitteesRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "covenant-committees")
+
+	return cmd
+}
+
+func CmdCovenantCommitteeDelegations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-committee-delegations [params_version] [status]",
+		Short: "retrieve all BTC delegations bound to the covenant committee of a given parameters version, optionally under the given status (pending, verified, active, unbonded, expired, any)",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			version, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return fmt.Errorf("version must be a positive 32-bit integer: %w", err)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			status := types.BTCDelegationStatus_ANY
+			if len(args) > 1 {
+				status, err = types.NewBTCDelegationStatusFromString(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.CovenantCommitteeDelegations(cmd.Context(), &types.QueryCovenantCommitteeDelegationsRequest{
+				ParamsVersion: uint32(version),
+				Status:        status,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "covenant-committee-delegations")
+
+	return cmd
+}
+
+func CmdCovenantStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "covenant-stats",
+		Short: "retrieve the signature liveness stats of the covenant members",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CovenantStats(cmd.Context(), &types.QueryCovenantStatsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "covenant-stats")
+
+	return cmd
+}
+
+// parseBTCHeightRange parses the given start and end BTC heights
+func parseBTCHeightRange(startArg, endArg string) (uint32, uint32, error) {
+	startHeight, err := strconv.ParseUint(startArg, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("start height must be a positive 32-bit integer: %w", err)
+	}
+	endHeight, err := strconv.ParseUint(endArg, 10, 32)
+	if err != nil {
+		return 0, 0, fmt.Errorf("end height must be a positive 32-bit integer: %w", err)
+	}
+	return uint32(startHeight), uint32(endHeight), nil
+}
+
 // CmdQueryParamsByVersion implements the query params by version command.
 func CmdQueryParamsByVersion() *cobra.Command {
 	cmd := &cobra.Command{
@@ -29,6 +29,8 @@ const (
 	FlagCommissionRate          = "commission-rate"
 	FlagCommissionMaxRate       = "commission-max-rate"
 	FlagCommissionMaxChangeRate = "commission-max-change-rate"
+
+	FlagMaxDelegatedSats = "max-delegated-sats"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -46,6 +48,8 @@ func GetTxCmd() *cobra.Command {
 		NewEditFinalityProviderCmd(),
 		NewCreateBTCDelegationCmd(),
 		NewAddCovenantSigsCmd(),
+		NewAddCovenantMuSig2NonceCmd(),
+		NewAddCovenantMuSig2PartialSigCmd(),
 		NewBTCUndelegateCmd(),
 		NewSelectiveSlashingEvidenceCmd(),
 		NewAddBTCDelegationInclusionProofCmd(),
@@ -179,6 +183,16 @@ func NewEditFinalityProviderCmd() *cobra.Command {
 				Commission:  &rate,
 			}
 
+			// get the (optional) cap on the delegated satoshis
+			maxDelegatedSatsStr, _ := fs.GetString(FlagMaxDelegatedSats)
+			if maxDelegatedSatsStr != "" {
+				maxDelegatedSats, ok := sdkmath.NewIntFromString(maxDelegatedSatsStr)
+				if !ok {
+					return fmt.Errorf("invalid max delegated sats: %s", maxDelegatedSatsStr)
+				}
+				msg.MaxDelegatedSats = &maxDelegatedSats
+			}
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
@@ -190,6 +204,7 @@ func NewEditFinalityProviderCmd() *cobra.Command {
 	fs.String(FlagDetails, "", "The finality provider's (optional) details")
 	fs.String(FlagIdentity, "", "The (optional) identity signature (ex. UPort or Keybase)")
 	fs.String(FlagCommissionRate, "0", "The initial commission rate percentage")
+	fs.String(FlagMaxDelegatedSats, "", "The (optional) maximum amount of satoshis delegated to the finality provider, 0 removes the cap")
 
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -390,6 +405,80 @@ func NewAddCovenantSigsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewAddCovenantMuSig2NonceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-covenant-musig2-nonce [covenant_pk] [staking_tx_hash] [pub_nonce]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Add a covenant MuSig2 public nonce for signing the unbonding tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			covPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+
+			pubNonce, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid public nonce: %w", err)
+			}
+
+			msg := types.MsgAddCovenantMuSig2Nonce{
+				Signer:        clientCtx.FromAddress.String(),
+				Pk:            covPK,
+				StakingTxHash: args[1],
+				PubNonce:      pubNonce,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddCovenantMuSig2PartialSigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-covenant-musig2-partial-sig [covenant_pk] [staking_tx_hash] [partial_sig]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Add a covenant MuSig2 partial signature on the unbonding tx",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			covPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid public key: %w", err)
+			}
+
+			partialSig, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid partial signature: %w", err)
+			}
+
+			msg := types.MsgAddCovenantMuSig2PartialSig{
+				Signer:        clientCtx.FromAddress.String(),
+				Pk:            covPK,
+				StakingTxHash: args[1],
+				PartialSig:    partialSig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewBTCUndelegateCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "btc-undelegate [staking_tx_hash] [spend_stake_tx] [spend_stake_tx_inclusion_proof] [funding_tx1],[funding_tx2],...",
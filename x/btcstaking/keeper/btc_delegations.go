@@ -43,7 +43,9 @@ func (k Keeper) CreateBTCDelegation(ctx sdk.Context, parsedMsg *types.ParsedCrea
 	// - are known to Babylon
 	// - there's only 1 Babylon finality provider
 	// - is not slashed
-	err := k.validateStakedFPs(ctx, parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat)
+	// and get the EOTS PKs the staking, slashing and unbonding scripts have to
+	// commit to
+	fpScriptPks, err := k.validateStakedFPs(ctx, parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat)
 	if err != nil {
 		return err
 	}
@@ -54,13 +56,29 @@ func (k Keeper) CreateBTCDelegation(ctx sdk.Context, parsedMsg *types.ParsedCrea
 		return err
 	}
 
-	// 6. Validate the staking tx against the params
-	paramsValidationResult, err := types.ValidateParsedMessageAgainstTheParams(parsedMsg, params, k.btcNet)
+	// 6. unbonding through the MuSig2 aggregated covenant key requires every
+	// covenant member to sign, so a single unresponsive covenant member would
+	// leave the staker unable to unbond early. BTC delegations under such
+	// params can only be created before the staking tx is included on BTC, so
+	// that the staker locks its BTC only once the unbonding tx is signed
+	if params.CovenantMusig2Unbonding && parsedMsg.IsIncludedOnBTC() {
+		return types.ErrInvalidStakingTx.Wrap("BTC delegations with covenant MuSig2 unbonding must be created before the staking tx is included on BTC")
+	}
+
+	// 7. Validate the staking tx against the params. The scripts commit to the
+	// EOTS PKs of the finality providers at the current height, which are not
+	// their BTC PKs once they rotated their EOTS keys
+	scriptMsg := *parsedMsg
+	scriptMsg.FinalityProviderKeys, err = types.NewParsedPublicKeyList(fpScriptPks)
+	if err != nil {
+		return err
+	}
+	paramsValidationResult, err := types.ValidateParsedMessageAgainstTheParams(&scriptMsg, params, k.btcNet)
 	if err != nil {
 		return err
 	}
 
-	// 7. if allow list is enabled we need to check whether staking transactions hash
+	// 8. if allow list is enabled we need to check whether staking transactions hash
 	// is in the allow list
 	if isAllowListEnabled(ctx, params) {
 		if !k.IsStakingTransactionAllowed(ctx, &stakingTxHash) {
@@ -68,13 +86,32 @@ func (k Keeper) CreateBTCDelegation(ctx sdk.Context, parsedMsg *types.ParsedCrea
 		}
 	}
 
+	// 9. ensure the finality provider has self-bonded enough satoshis and the
+	// satoshis delegated to it do not exceed its cap and the max ratio of the
+	// total staked BTC. For a stake expansion, the previous delegation is
+	// replaced by the new one
+	var replacedSats uint64
+	if parsedMsg.StkExp != nil {
+		replacedSats = k.stakeExpansionReplacedSats(ctx, parsedMsg.StkExp.PreviousActiveStkTxHash)
+	}
+	if err := k.checkDelegatedSatsCaps(
+		ctx,
+		params,
+		&parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat[0],
+		parsedMsg.StakerAddress.String(),
+		uint64(parsedMsg.StakingValue),
+		replacedSats,
+	); err != nil {
+		return err
+	}
+
 	// everything is good, if the staking tx is not included on BTC consume additinal
 	// gas
 	if !parsedMsg.IsIncludedOnBTC() {
 		ctx.GasMeter().ConsumeGas(params.DelegationCreationBaseGasFee, "delegation creation fee")
 	}
 
-	// 7.all good, construct BTCDelegation and insert BTC delegation
+	// 10. all good, construct BTCDelegation and insert BTC delegation
 	// NOTE: the BTC delegation does not have voting power yet. It will
 	// have voting power only when it receives a covenant signatures
 	newBTCDel := &types.BTCDelegation{
@@ -104,6 +141,10 @@ func (k Keeper) CreateBTCDelegation(ctx sdk.Context, parsedMsg *types.ParsedCrea
 		BtcTipHeight:  timeInfo.TipHeight, // height of the BTC light client tip at the time of the delegation creation
 	}
 
+	if fpBtcPk := &parsedMsg.FinalityProviderKeys.PublicKeysBbnFormat[0]; !fpScriptPks[0].Equals(fpBtcPk) {
+		newBTCDel.FpEotsPk = &fpScriptPks[0]
+	}
+
 	newBTCDel.StkExp, err = buildStakeExpansion(parsedMsg.StkExp)
 	if err != nil {
 		return fmt.Errorf("error building stake expansion: %w", err)
@@ -119,6 +160,10 @@ func (k Keeper) CreateBTCDelegation(ctx sdk.Context, parsedMsg *types.ParsedCrea
 
 // AddBTCDelegation adds a BTC delegation post verification to the system, including
 // - indexing the given BTC delegation in the BTC delegator store,
+// - indexing the given BTC delegation by its staker BTC PK,
+// - indexing the given BTC delegation by its parameters version,
+// - indexing the given BTC delegation by its expiry height and adding it to
+// the delegated satoshis if it is already included on BTC,
 // - saving it under BTC delegation store, and
 // - emit events about this BTC delegation.
 func (k Keeper) AddBTCDelegation(
@@ -146,6 +191,22 @@ func (k Keeper) AddBTCDelegation(
 		k.setBTCDelegatorDelegationIndex(ctx, &fpBTCPK, btcDel.BtcPk, btcDelIndex)
 	}
 
+	if err := k.indexBTCDelegationByStaker(ctx, btcDel.BtcPk, stakingTxHash); err != nil {
+		return err
+	}
+	if err := k.indexBTCDelegationByVersion(ctx, btcDel.ParamsVersion, stakingTxHash); err != nil {
+		return err
+	}
+	if err := k.indexBTCDelegationExpiry(ctx, btcDel, stakingTxHash); err != nil {
+		return err
+	}
+	if err := k.addDelegatedSats(ctx, btcDel); err != nil {
+		return err
+	}
+	if err := k.trackCovenantSigs(ctx, btcDel, stakingTxHash); err != nil {
+		return err
+	}
+
 	// save this BTC delegation
 	k.setBTCDelegation(ctx, btcDel)
 
@@ -224,37 +285,47 @@ func (k Keeper) addCovenantSigsToBTCDelegation(
 		panic(fmt.Errorf("failed to emit EventCovenantSignatureRecevied for the new active BTC delegation: %w", err))
 	}
 
+	if err := k.recordCovenantSig(ctx, btcDel, delInfo.Params, covPK); err != nil {
+		panic(fmt.Errorf("failed to record the covenant signature in the covenant stats: %w", err))
+	}
+
 	// If reaching the covenant quorum after this msg, the BTC delegation becomes
 	// active. Then, record and emit this event
 	// We only emit power distribution events, and external quorum events if it
 	// is the first time the quorum is reached
 	if !hadQuorum && btcDel.HasCovenantQuorums(delInfo.Params.CovenantQuorum, quorumPreviousStk) {
-		if btcDel.HasInclusionProof() {
-			quorumReachedEvent := types.NewCovenantQuorumReachedEvent(
-				btcDel,
-				types.BTCDelegationStatus_ACTIVE,
-			)
-			if err := ctx.EventManager().EmitTypedEvent(quorumReachedEvent); err != nil {
-				panic(fmt.Errorf("failed to emit emit for the new verified BTC delegation: %w", err))
-			}
-
-			// record event that the BTC delegation becomes active at this height
-			activeEvent := types.NewEventPowerDistUpdateWithBTCDel(
-				&types.EventBTCDelegationStateUpdate{
-					StakingTxHash: btcDel.MustGetStakingTxHash().String(),
-					NewState:      types.BTCDelegationStatus_ACTIVE,
-				},
-			)
-			k.addPowerDistUpdateEvent(ctx, btcTipHeight, activeEvent)
-		} else {
-			quorumReachedEvent := types.NewCovenantQuorumReachedEvent(
-				btcDel,
-				types.BTCDelegationStatus_VERIFIED,
-			)
-
-			if err := ctx.EventManager().EmitTypedEvent(quorumReachedEvent); err != nil {
-				panic(fmt.Errorf("failed to emit emit for the new verified BTC delegation: %w", err))
-			}
+		k.onCovenantQuorumReached(ctx, btcDel, btcTipHeight)
+	}
+}
+
+// onCovenantQuorumReached emits the events of the BTC delegation reaching the
+// covenant quorum, which makes it active if it is already included on BTC
+func (k Keeper) onCovenantQuorumReached(ctx sdk.Context, btcDel *types.BTCDelegation, btcTipHeight uint32) {
+	if btcDel.HasInclusionProof() {
+		quorumReachedEvent := types.NewCovenantQuorumReachedEvent(
+			btcDel,
+			types.BTCDelegationStatus_ACTIVE,
+		)
+		if err := ctx.EventManager().EmitTypedEvent(quorumReachedEvent); err != nil {
+			panic(fmt.Errorf("failed to emit emit for the new verified BTC delegation: %w", err))
+		}
+
+		// record event that the BTC delegation becomes active at this height
+		activeEvent := types.NewEventPowerDistUpdateWithBTCDel(
+			&types.EventBTCDelegationStateUpdate{
+				StakingTxHash: btcDel.MustGetStakingTxHash().String(),
+				NewState:      types.BTCDelegationStatus_ACTIVE,
+			},
+		)
+		k.addPowerDistUpdateEvent(ctx, btcTipHeight, activeEvent)
+	} else {
+		quorumReachedEvent := types.NewCovenantQuorumReachedEvent(
+			btcDel,
+			types.BTCDelegationStatus_VERIFIED,
+		)
+
+		if err := ctx.EventManager().EmitTypedEvent(quorumReachedEvent); err != nil {
+			panic(fmt.Errorf("failed to emit emit for the new verified BTC delegation: %w", err))
 		}
 	}
 }
@@ -275,7 +346,10 @@ func (k Keeper) BtcUndelegate(
 	btcDel *types.BTCDelegation,
 	u *types.DelegatorUnbondingInfo,
 ) {
-	k.btcUndelegate(ctx, btcDel, u)
+	// the stake spending tx of a forced unbonding is not verified, so the
+	// unbonding is considered to happen at the current BTC tip height
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	k.btcUndelegate(ctx, btcDel, u, btcTip.Height)
 }
 
 // IterateBTCDelegations iterates over every BTCDelegation in the store and
@@ -299,15 +373,32 @@ func (k Keeper) IterateBTCDelegations(ctx context.Context, fn func(btcDel *types
 }
 
 // btcUndelegate adds the signature of the unbonding tx signed by the staker
-// to the given BTC delegation
+// to the given BTC delegation, indexes the BTC height at which its unbonding
+// completes given the inclusion height of the stake spending tx, and deducts
+// it from the delegated satoshis
 func (k Keeper) btcUndelegate(
 	ctx sdk.Context,
 	btcDel *types.BTCDelegation,
 	u *types.DelegatorUnbondingInfo,
+	spendStakeTxHeight uint32,
 ) {
 	btcDel.BtcUndelegation.DelegatorUnbondingInfo = u
 	k.setBTCDelegation(ctx, btcDel)
 
+	if err := k.indexBTCDelegationUnbonding(ctx, btcDel, btcDel.MustGetStakingTxHash(), spendStakeTxHeight); err != nil {
+		panic(fmt.Errorf("failed to index the unbonding of the BTC delegation: %w", err))
+	}
+	if err := k.deductDelegatedSats(ctx, btcDel); err != nil {
+		panic(fmt.Errorf("failed to deduct the unbonded BTC delegation from the delegated sats: %w", err))
+	}
+	if err := k.untrackCovenantSigs(ctx, btcDel.MustGetStakingTxHash()); err != nil {
+		panic(fmt.Errorf("failed to stop tracking the covenant signatures of the unbonded BTC delegation: %w", err))
+	}
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	if err := k.clearCovenantMuSig2Session(ctx, stakingTxHash[:]); err != nil {
+		panic(fmt.Errorf("failed to clear the covenant MuSig2 signing session of the unbonded BTC delegation: %w", err))
+	}
+
 	if !btcDel.HasInclusionProof() {
 		return
 	}
@@ -544,21 +635,24 @@ func (k Keeper) BtcDelHasCovenantQuorums(
 // - are known to Babylon
 // - are not slashed
 // - are not deleted
-func (k Keeper) validateStakedFPs(ctx sdk.Context, fpBTCPKs []bbn.BIP340PubKey) error {
+// and returns their EOTS PKs at the current height
+func (k Keeper) validateStakedFPs(ctx sdk.Context, fpBTCPKs []bbn.BIP340PubKey) ([]bbn.BIP340PubKey, error) {
+	eotsPks := make([]bbn.BIP340PubKey, len(fpBTCPKs))
 	for i := range fpBTCPKs {
 		fpBTCPK := fpBTCPKs[i]
 		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
 		if err != nil {
-			return types.ErrFpNotFound.Wrapf("finality provider pk %s is not found: %v", fpBTCPK.MarshalHex(), err)
+			return nil, types.ErrFpNotFound.Wrapf("finality provider pk %s is not found: %v", fpBTCPK.MarshalHex(), err)
 		}
 		if fp.IsSlashed() {
-			return types.ErrFpAlreadySlashed.Wrapf("finality key: %s", fpBTCPK.MarshalHex())
+			return nil, types.ErrFpAlreadySlashed.Wrapf("finality key: %s", fpBTCPK.MarshalHex())
 		}
 		if k.IsFinalityProviderDeleted(ctx, &fpBTCPK) {
-			return types.ErrFinalityProviderIsDeleted.Wrapf("finality provider pk %s has been deleted", fpBTCPK.MarshalHex())
+			return nil, types.ErrFinalityProviderIsDeleted.Wrapf("finality provider pk %s has been deleted", fpBTCPK.MarshalHex())
 		}
+		eotsPks[i] = *fp.EotsPkAtHeight(uint64(ctx.HeaderInfo().Height))
 	}
-	return nil
+	return eotsPks, nil
 }
 
 func buildStakeExpansion(stkExp *types.ParsedCreateDelStkExp) (*types.StakeExpansion, error) {
@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// btcDelegationsByHeight is an index of BTC delegations keyed by
+// (BTC height, staking tx hash)
+type btcDelegationsByHeight = collections.Map[collections.Pair[uint32, []byte], collections.NoValue]
+
+// indexBTCDelegationExpiry indexes the BTC delegation with the given staking
+// tx hash under the BTC height at which it expires. Delegations without an
+// inclusion proof do not have an end height yet and are thus not indexed.
+func (k Keeper) indexBTCDelegationExpiry(ctx context.Context, btcDel *types.BTCDelegation, stakingTxHash chainhash.Hash) error {
+	if !btcDel.HasInclusionProof() {
+		return nil
+	}
+	return k.btcDelegationsByExpiry.Set(ctx, collections.Join(btcDel.EndHeight-btcDel.UnbondingTime, stakingTxHash[:]), collections.NoValue{})
+}
+
+// indexBTCDelegationUnbonding moves the BTC delegation with the given staking
+// tx hash from the expiry index to the index of unbonding delegations. The
+// unbonding completes UnbondingTime blocks after the inclusion of the
+// registered unbonding tx, or right at the inclusion height if the staking
+// output is spent by any other tx.
+func (k Keeper) indexBTCDelegationUnbonding(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	stakingTxHash chainhash.Hash,
+	spendStakeTxHeight uint32,
+) error {
+	if btcDel.HasInclusionProof() {
+		if err := k.btcDelegationsByExpiry.Remove(ctx, collections.Join(btcDel.EndHeight-btcDel.UnbondingTime, stakingTxHash[:])); err != nil {
+			return err
+		}
+	}
+
+	unbondedHeight := spendStakeTxHeight
+	if len(btcDel.BtcUndelegation.DelegatorUnbondingInfo.SpendStakeTx) == 0 {
+		unbondedHeight += btcDel.UnbondingTime
+	}
+	return k.btcDelegationsByUnbonded.Set(ctx, collections.Join(unbondedHeight, stakingTxHash[:]), collections.NoValue{})
+}
+
+// paginateBTCDelegationsByHeight paginates the BTC delegations of the given
+// index whose BTC height falls within [startHeight, endHeight]
+func (k Keeper) paginateBTCDelegationsByHeight(
+	ctx context.Context,
+	index btcDelegationsByHeight,
+	startHeight, endHeight uint32,
+	pageReq *query.PageRequest,
+) ([]*types.BTCDelegationAtHeightResponse, *query.PageResponse, error) {
+	keyCodec := index.KeyCodec()
+	lower, err := collections.EncodeKeyWithPrefix(nil, keyCodec, collections.Join(startHeight, []byte{}))
+	if err != nil {
+		return nil, nil, err
+	}
+	var upper []byte
+	if endHeight < math.MaxUint32 {
+		upper, err = collections.EncodeKeyWithPrefix(nil, keyCodec, collections.Join(endHeight+1, []byte{}))
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	return query.CollectionPaginate(
+		ctx,
+		heightRangeCollection{index, lower, upper},
+		pageReq,
+		func(key collections.Pair[uint32, []byte], _ collections.NoValue) (*types.BTCDelegationAtHeightResponse, error) {
+			stakingTxHash, err := chainhash.NewHash(key.K2())
+			if err != nil {
+				return nil, err
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				return nil, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+			}
+
+			params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			status, err := k.BtcDelStatus(ctx, btcDel, params.CovenantQuorum, btcTipHeight)
+			if err != nil {
+				return nil, err
+			}
+			return &types.BTCDelegationAtHeightResponse{
+				BtcHeight:     key.K1(),
+				BtcDelegation: types.NewBTCDelegationResponse(btcDel, status),
+			}, nil
+		},
+	)
+}
+
+// heightRangeCollection restricts the iteration over an index of BTC
+// delegations by BTC height to the raw key range [lower, upper), so that
+// it can be paginated with the standard collection pagination.
+// A nil upper bound means the range is unbounded above.
+type heightRangeCollection struct {
+	btcDelegationsByHeight
+	lower, upper []byte
+}
+
+func (c heightRangeCollection) IterateRaw(
+	ctx context.Context,
+	start, end []byte,
+	order collections.Order,
+) (collections.Iterator[collections.Pair[uint32, []byte], collections.NoValue], error) {
+	if start == nil || bytes.Compare(start, c.lower) < 0 {
+		start = c.lower
+	}
+	if c.upper != nil && (end == nil || bytes.Compare(end, c.upper) > 0) {
+		end = c.upper
+	}
+	return c.btcDelegationsByHeight.IterateRaw(ctx, start, end, order)
+}
@@ -0,0 +1,16 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+)
+
+// indexBTCDelegationByStaker indexes the BTC delegation with the given staking
+// tx hash under the given staker BTC PK
+func (k Keeper) indexBTCDelegationByStaker(ctx context.Context, stakerBTCPK *bbn.BIP340PubKey, stakingTxHash chainhash.Hash) error {
+	return k.btcDelegationsByStaker.Set(ctx, collections.Join(stakerBTCPK.MustMarshal(), stakingTxHash[:]), collections.NoValue{})
+}
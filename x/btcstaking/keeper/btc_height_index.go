@@ -5,6 +5,7 @@ import (
 	"math"
 
 	"cosmossdk.io/store/prefix"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
 	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,8 +13,13 @@ import (
 
 // IndexBTCHeight indexes the current BTC height, and saves it to KVStore
 func (k Keeper) IndexBTCHeight(ctx context.Context) {
+	k.indexBTCHeight(ctx, k.btclcKeeper.GetTipInfo(ctx))
+}
+
+// indexBTCHeight indexes the height of the given BTC tip at the current
+// Babylon height
+func (k Keeper) indexBTCHeight(ctx context.Context, btcTip *btclctypes.BTCHeaderInfo) {
 	babylonHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
-	btcTip := k.btclcKeeper.GetTipInfo(ctx)
 	if btcTip == nil {
 		return
 	}
@@ -0,0 +1,229 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// indexBTCDelegationByVersion indexes the BTC delegation with the given
+// staking tx hash under the version of the parameters, and thus of the
+// covenant committee, it is bound to
+func (k Keeper) indexBTCDelegationByVersion(ctx context.Context, paramsVersion uint32, stakingTxHash chainhash.Hash) error {
+	return k.btcDelegationsByVersion.Set(ctx, collections.Join(paramsVersion, stakingTxHash[:]), collections.NoValue{})
+}
+
+// maxCovenantRotationScannedDelegations is the maximum number of BTC
+// delegations scanned to find the covenant committees that still govern BTC
+// delegations upon a rotation of the covenant committee, so that the
+// execution of a MsgUpdateParams remains bounded
+const maxCovenantRotationScannedDelegations = 10_000
+
+// validateCovenantRotation ensures that, if the given new parameters rotate
+// the covenant committee of the latest parameters,
+// - the new committee is activated at a BTC height after the current BTC
+// tip, so that the BTC delegations created until then remain bound to the
+// current committee, and
+// - unless skipOverlapCheck is set by the governance, e.g., to replace a
+// compromised committee, the new committee keeps at least a quorum of members
+// of every committee that still governs BTC delegations, i.e., the current
+// committee and the committees of previous parameters versions with BTC
+// delegations that are neither unbonded nor expired, so that the pending BTC
+// delegations bound to these committees can still collect a quorum of
+// covenant signatures from the members that keep operating after the
+// rotation.
+func (k Keeper) validateCovenantRotation(ctx context.Context, newParams *types.Params, skipOverlapCheck bool) error {
+	currParams := k.GetParams(ctx)
+	if isSameCovenantCommittee(&currParams, newParams) {
+		return nil
+	}
+
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+	if newParams.BtcActivationHeight <= btcTip.Height {
+		return types.ErrInvalidCovenantRotation.Wrapf(
+			"the new covenant committee must be activated after the current BTC tip %d, got activation height %d",
+			btcTip.Height, newParams.BtcActivationHeight,
+		)
+	}
+	if skipOverlapCheck {
+		return nil
+	}
+
+	governingParams, err := k.governingCovenantCommittees(ctx, btcTip.Height)
+	if err != nil {
+		return err
+	}
+	for _, sp := range governingParams {
+		if !hasSufficientCovenantOverlap(sp.Params.CovenantPks, newParams.CovenantPks, sp.Params.CovenantQuorum) {
+			return types.ErrInvalidCovenantRotation.Wrapf(
+				"the new covenant committee must keep at least %d members of the covenant committee of params version %d",
+				sp.Params.CovenantQuorum, sp.Version,
+			)
+		}
+	}
+
+	return nil
+}
+
+// governingCovenantCommittees returns the stored parameters of the distinct
+// covenant committees that still govern BTC delegations at the given BTC tip
+// height, i.e., the committee of the latest parameters, which BTC delegations
+// can still be bound to, and the committees of the previous parameters with
+// BTC delegations that are neither unbonded nor expired. These BTC
+// delegations are found in the index of BTC delegations by expiry height,
+// which only keeps the included BTC delegations that are not unbonded, and in
+// the index of BTC delegations waiting for covenant signatures. At most
+// maxCovenantRotationScannedDelegations BTC delegations are scanned, and the
+// committees whose BTC delegations are not all scanned are considered as
+// governing.
+func (k Keeper) governingCovenantCommittees(ctx context.Context, btcTipHeight uint32) ([]*types.StoredParams, error) {
+	var allParams []*types.StoredParams
+	it := k.paramsStore(ctx).Iterator(nil, nil)
+	for ; it.Valid(); it.Next() {
+		var sp types.StoredParams
+		k.cdc.MustUnmarshal(it.Value(), &sp)
+		allParams = append(allParams, &sp)
+	}
+	if err := it.Close(); err != nil {
+		return nil, err
+	}
+	if len(allParams) == 0 {
+		return nil, nil
+	}
+
+	// the versions of the previous parameters whose committee differs from
+	// the latest one, until BTC delegations bound to them are found
+	latest := allParams[len(allParams)-1]
+	candidates := make(map[uint32]struct{})
+	for _, sp := range allParams[:len(allParams)-1] {
+		if !isSameCovenantCommittee(&latest.Params, &sp.Params) {
+			candidates[sp.Version] = struct{}{}
+		}
+	}
+	governingVersions := make(map[uint32]struct{})
+
+	numScanned := 0
+	visit := func(stakingTxHashBytes []byte) (bool, error) {
+		if len(candidates) == 0 || numScanned >= maxCovenantRotationScannedDelegations {
+			return true, nil
+		}
+		numScanned++
+		stakingTxHash, err := chainhash.NewHash(stakingTxHashBytes)
+		if err != nil {
+			return true, err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return true, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+		if _, ok := candidates[btcDel.ParamsVersion]; ok {
+			delete(candidates, btcDel.ParamsVersion)
+			governingVersions[btcDel.ParamsVersion] = struct{}{}
+		}
+		return false, nil
+	}
+
+	if len(candidates) > 0 {
+		// the BTC delegations with an expiry height above the BTC tip are not
+		// expired yet
+		rng := new(collections.Range[collections.Pair[uint32, []byte]]).
+			StartInclusive(collections.Join(btcTipHeight+1, []byte{}))
+		if err := k.btcDelegationsByExpiry.Walk(ctx, rng, func(key collections.Pair[uint32, []byte], _ collections.NoValue) (bool, error) {
+			return visit(key.K2())
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if len(candidates) > 0 {
+		if err := k.covenantSigWaitingByHeight.Walk(ctx, nil, func(key collections.Pair[uint64, []byte], _ collections.NoValue) (bool, error) {
+			return visit(key.K2())
+		}); err != nil {
+			return nil, err
+		}
+	}
+	if numScanned >= maxCovenantRotationScannedDelegations {
+		for version := range candidates {
+			governingVersions[version] = struct{}{}
+		}
+	}
+
+	governing := []*types.StoredParams{latest}
+	// iterate from the latest version, so that a committee shared by several
+	// versions is only returned once
+	for i := len(allParams) - 2; i >= 0; i-- {
+		sp := allParams[i]
+		if _, ok := governingVersions[sp.Version]; !ok {
+			continue
+		}
+		isIncluded := false
+		for _, g := range governing {
+			if isSameCovenantCommittee(&g.Params, &sp.Params) {
+				isIncluded = true
+				break
+			}
+		}
+		if !isIncluded {
+			governing = append(governing, sp)
+		}
+	}
+	return governing, nil
+}
+
+// isSameCovenantCommittee returns whether the given parameters define the
+// same covenant members and quorum, regardless of the order of the members
+func isSameCovenantCommittee(p1, p2 *types.Params) bool {
+	if p1.CovenantQuorum != p2.CovenantQuorum || len(p1.CovenantPks) != len(p2.CovenantPks) {
+		return false
+	}
+	return len(covenantPksNotIn(p1.CovenantPks, p2.CovenantPks)) == 0
+}
+
+// covenantPksNotIn returns the covenant PKs of the first committee that are
+// not members of the second one
+func covenantPksNotIn(covCommittee1, covCommittee2 []bbn.BIP340PubKey) []bbn.BIP340PubKey {
+	set := make(map[string]struct{}, len(covCommittee2))
+	for _, pk := range covCommittee2 {
+		set[pk.MarshalHex()] = struct{}{}
+	}
+
+	var diff []bbn.BIP340PubKey
+	for _, pk := range covCommittee1 {
+		if _, found := set[pk.MarshalHex()]; !found {
+			diff = append(diff, pk)
+		}
+	}
+	return diff
+}
+
+// newCovenantCommitteeResponse returns the covenant committee of the given
+// stored parameters, along with its status given the parameters active at the
+// current BTC tip
+func newCovenantCommitteeResponse(
+	sp *types.StoredParams,
+	activeParams *types.Params,
+	btcTipHeight uint32,
+) *types.CovenantCommitteeResponse {
+	resp := &types.CovenantCommitteeResponse{
+		ParamsVersion:       sp.Version,
+		BtcActivationHeight: sp.Params.BtcActivationHeight,
+		CovenantPksHex:      sp.Params.CovenantPksHex(),
+		CovenantQuorum:      sp.Params.CovenantQuorum,
+	}
+
+	switch {
+	case isSameCovenantCommittee(&sp.Params, activeParams):
+		resp.Status = types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_ACTIVE
+	case sp.Params.BtcActivationHeight > btcTipHeight:
+		resp.Status = types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_SCHEDULED
+	default:
+		resp.Status = types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_RETIRED
+		for _, pk := range covenantPksNotIn(sp.Params.CovenantPks, activeParams.CovenantPks) {
+			resp.RetiringCovenantPksHex = append(resp.RetiringCovenantPksHex, pk.MarshalHex())
+		}
+	}
+	return resp
+}
@@ -0,0 +1,278 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// getCovenantMuSig2Delegation returns the BTC delegation whose unbonding tx is
+// signed by the covenant committee through MuSig2. It ensures that the
+// delegation was created under parameters with covenant MuSig2 unbonding
+// enabled, the given covenant member belongs to the committee and the
+// delegation is not unbonded yet
+func (k Keeper) getCovenantMuSig2Delegation(
+	ctx context.Context,
+	stakingTxHash string,
+	covPK *bbn.BIP340PubKey,
+) (*btcDelegationWithParams, error) {
+	delInfo, err := k.getBTCDelWithParams(ctx, stakingTxHash)
+	if err != nil {
+		return nil, err
+	}
+
+	if !delInfo.Params.CovenantMusig2Unbonding {
+		return nil, types.ErrCovenantMuSig2Disabled.Wrapf("params version: %d", delInfo.Delegation.ParamsVersion)
+	}
+
+	if !delInfo.Params.HasCovenantPK(covPK) {
+		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", covPK.MarshalHex())
+	}
+
+	status, _, err := k.BtcDelStatusWithTip(ctx, delInfo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get BTC delegation status: %w", err)
+	}
+	if status == types.BTCDelegationStatus_UNBONDED || status == types.BTCDelegationStatus_EXPIRED {
+		return nil, types.ErrInvalidCovenantMuSig2.Wrap("the BTC delegation is already unbonded")
+	}
+
+	return delInfo, nil
+}
+
+// covenantMuSig2Message returns the message signed by the covenant committee,
+// i.e. the sighash of the unbonding tx spending the staking output through
+// the unbonding path
+func (k Keeper) covenantMuSig2Message(delInfo *btcDelegationWithParams) ([32]byte, error) {
+	stakingInfo, err := delInfo.Delegation.GetStakingInfo(delInfo.Params, k.btcNet)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	unbondingTx, err := bbn.NewBTCTxFromBytes(delInfo.Delegation.BtcUndelegation.UnbondingTx)
+	if err != nil {
+		return [32]byte{}, err
+	}
+
+	return btcstaking.UnbondingSigHash(unbondingTx, stakingInfo.StakingOutput, unbondingSpendInfo.GetPkScriptPath())
+}
+
+// covenantMuSig2Session builds the MuSig2 signing session of the covenant
+// committee. It returns an error if not all covenant members committed to
+// their nonces yet
+func (k Keeper) covenantMuSig2Session(
+	ctx context.Context,
+	delInfo *btcDelegationWithParams,
+	stakingTxHash []byte,
+) (*btcstaking.CovenantMuSig2Session, error) {
+	covenantPks := delInfo.Params.CovenantPks
+	pubNonces := make([][btcstaking.MuSig2PubNonceSize]byte, len(covenantPks))
+	for i, covPK := range covenantPks {
+		nonce, err := k.covenantMuSig2Nonces.Get(ctx, collections.Join(stakingTxHash, covPK.MustMarshal()))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, types.ErrInvalidCovenantMuSig2.Wrapf("missing nonce of covenant member %s", covPK.MarshalHex())
+			}
+			return nil, err
+		}
+		copy(pubNonces[i][:], nonce)
+	}
+
+	msg, err := k.covenantMuSig2Message(delInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	return btcstaking.NewCovenantMuSig2Session(delInfo.Params.MustGetCovenantPks(), pubNonces, msg)
+}
+
+// addCovenantMuSig2Nonce records the public nonce of the covenant member. If
+// the covenant member already committed to another nonce, the signing session
+// is reset, i.e. all nonces and partial signatures are discarded and every
+// covenant member has to commit to a fresh nonce. This allows to restart a
+// stalled session, e.g. after a covenant member lost its secret nonce. It
+// returns whether the session was reset.
+func (k Keeper) addCovenantMuSig2Nonce(
+	ctx context.Context,
+	delInfo *btcDelegationWithParams,
+	covPK *bbn.BIP340PubKey,
+	pubNonce []byte,
+) (bool, error) {
+	if _, err := btcstaking.ParseMuSig2PubNonce(pubNonce); err != nil {
+		return false, types.ErrInvalidCovenantMuSig2.Wrap(err.Error())
+	}
+
+	if delInfo.Delegation.BtcUndelegation.CovenantUnbondingAggSig != nil {
+		return false, types.ErrDuplicatedCovenantMuSig2.Wrap("the unbonding tx is already signed by the covenant committee")
+	}
+
+	stakingTxHash := delInfo.Delegation.MustGetStakingTxHash()
+	key := collections.Join(stakingTxHash[:], covPK.MustMarshal())
+
+	storedNonce, err := k.covenantMuSig2Nonces.Get(ctx, key)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+		return false, k.covenantMuSig2Nonces.Set(ctx, key, pubNonce)
+	case err != nil:
+		return false, err
+	case bytes.Equal(storedNonce, pubNonce):
+		return false, types.ErrDuplicatedCovenantMuSig2.Wrapf("nonce of covenant member %s", covPK.MarshalHex())
+	}
+
+	// the covenant member replaces its nonce, so partial signatures over the
+	// current aggregated nonce are discarded together with nonces of other
+	// covenant members, which must never be used for another signature
+	if err := k.clearCovenantMuSig2Session(ctx, stakingTxHash[:]); err != nil {
+		return false, err
+	}
+	if err := k.covenantMuSig2Nonces.Set(ctx, key, pubNonce); err != nil {
+		return false, err
+	}
+
+	return true, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(
+		types.NewCovenantMuSig2SessionResetEvent(delInfo.Delegation, covPK),
+	)
+}
+
+// addCovenantMuSig2PartialSig verifies and records the partial signature of
+// the covenant member. Once partial signatures of all covenant members are
+// received, they are aggregated into the covenant signature on the unbonding
+// tx, which completes the covenant quorum of the BTC delegation, and the
+// signing session is cleared
+func (k Keeper) addCovenantMuSig2PartialSig(
+	ctx context.Context,
+	delInfo *btcDelegationWithParams,
+	covPK *bbn.BIP340PubKey,
+	partialSigBytes []byte,
+) error {
+	partialSig, err := btcstaking.ParseMuSig2PartialSig(partialSigBytes)
+	if err != nil {
+		return types.ErrInvalidCovenantMuSig2.Wrap(err.Error())
+	}
+
+	btcDel := delInfo.Delegation
+	if btcDel.BtcUndelegation.CovenantUnbondingAggSig != nil {
+		return types.ErrDuplicatedCovenantMuSig2.Wrap("the unbonding tx is already signed by the covenant committee")
+	}
+
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	key := collections.Join(stakingTxHash[:], covPK.MustMarshal())
+
+	has, err := k.covenantMuSig2PartialSigs.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	if has {
+		return types.ErrDuplicatedCovenantMuSig2.Wrapf("partial signature of covenant member %s", covPK.MarshalHex())
+	}
+
+	session, err := k.covenantMuSig2Session(ctx, delInfo, stakingTxHash[:])
+	if err != nil {
+		return err
+	}
+
+	covIdx := -1
+	for i, pk := range delInfo.Params.CovenantPks {
+		if pk.Equals(covPK) {
+			covIdx = i
+			break
+		}
+	}
+	if err := session.VerifyPartialSig(covIdx, partialSig); err != nil {
+		return types.ErrInvalidCovenantMuSig2.Wrap(err.Error())
+	}
+
+	if err := k.covenantMuSig2PartialSigs.Set(ctx, key, partialSigBytes); err != nil {
+		return err
+	}
+
+	// aggregate once all covenant members submitted their partial signatures
+	partialSigs := make([]*musig2.PartialSignature, 0, len(delInfo.Params.CovenantPks))
+	for _, pk := range delInfo.Params.CovenantPks {
+		sigBytes, err := k.covenantMuSig2PartialSigs.Get(ctx, collections.Join(stakingTxHash[:], pk.MustMarshal()))
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil
+			}
+			return err
+		}
+		sig, err := btcstaking.ParseMuSig2PartialSig(sigBytes)
+		if err != nil {
+			return fmt.Errorf("failed to parse stored covenant partial signature: %w", err)
+		}
+		partialSigs = append(partialSigs, sig)
+	}
+
+	aggSig, err := session.AggregatePartialSigs(partialSigs)
+	if err != nil {
+		return types.ErrInvalidCovenantMuSig2.Wrapf("failed to aggregate covenant partial signatures: %v", err)
+	}
+	bip340AggSig := bbn.NewBIP340SignatureFromBTCSig(aggSig)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var quorumPreviousStk uint32
+	if btcDel.IsStakeExpansion() {
+		quorumPreviousStk = delInfo.PrevParams.CovenantQuorum
+	}
+	hadQuorum := btcDel.HasCovenantQuorums(delInfo.Params.CovenantQuorum, quorumPreviousStk)
+
+	btcDel.BtcUndelegation.CovenantUnbondingAggSig = bip340AggSig
+	k.setBTCDelegation(sdkCtx, btcDel)
+
+	if err := k.clearCovenantMuSig2Session(ctx, stakingTxHash[:]); err != nil {
+		return err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(
+		types.NewCovenantMuSig2SigAggregatedEvent(btcDel, bip340AggSig),
+	); err != nil {
+		return err
+	}
+
+	// the aggregated signature may be the last one the BTC delegation waits for
+	if !hadQuorum && btcDel.HasCovenantQuorums(delInfo.Params.CovenantQuorum, quorumPreviousStk) {
+		btcTip := k.btclcKeeper.GetTipInfo(ctx)
+		k.onCovenantQuorumReached(sdkCtx, btcDel, btcTip.Height)
+	}
+
+	return nil
+}
+
+// clearCovenantMuSig2Session removes nonces and partial signatures of the
+// signing session
+func (k Keeper) clearCovenantMuSig2Session(ctx context.Context, stakingTxHash []byte) error {
+	rng := collections.NewPrefixedPairRange[[]byte, []byte](stakingTxHash)
+	if err := k.covenantMuSig2Nonces.Clear(ctx, rng); err != nil {
+		return err
+	}
+	return k.covenantMuSig2PartialSigs.Clear(ctx, rng)
+}
+
+// GetCovenantMuSig2AggSig returns the aggregated covenant signature on the
+// unbonding tx of the BTC delegation, if all covenant members submitted their
+// partial signatures
+func (k Keeper) GetCovenantMuSig2AggSig(ctx context.Context, stakingTxHash *chainhash.Hash) (*bbn.BIP340Signature, error) {
+	btcDel, err := k.GetBTCDelegation(ctx, stakingTxHash.String())
+	if err != nil {
+		return nil, err
+	}
+	if btcDel.BtcUndelegation.CovenantUnbondingAggSig == nil {
+		return nil, types.ErrInvalidCovenantMuSig2.Wrap("the unbonding tx is not signed by the covenant committee yet")
+	}
+	return btcDel.BtcUndelegation.CovenantUnbondingAggSig, nil
+}
@@ -0,0 +1,300 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/btcsuite/btcd/btcec/v2/schnorr/musig2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
+	testutil "github.com/babylonlabs-io/babylon/v4/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+func FuzzCovenantMuSig2Unbonding(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+		covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		genDelegation := func(params *types.Params, version uint32) *types.BTCDelegation {
+			t.Helper()
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				h.Net,
+				[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				params.CovenantQuorum,
+				params.SlashingPkScript,
+				1000, 10, 1010, 10000,
+				params.SlashingRate,
+				uint16(params.UnbondingTimeBlocks),
+			)
+			require.NoError(t, err)
+			btcDel.ParamsVersion = version
+			if params.CovenantMusig2Unbonding {
+				// covenant members sign the unbonding tx only through MuSig2
+				btcDel.BtcUndelegation.CovenantUnbondingSigList = nil
+			}
+			require.NoError(t, h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, btcDel))
+			return btcDel
+		}
+
+		// covenant MuSig2 signing is rejected under params with the feature
+		// disabled
+		storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+		params := storedParams.Params
+		disabledDel := genDelegation(&params, storedParams.Version)
+		nonces, err := btcstaking.NewCovenantMuSig2Nonces(covenantSKs[0], [32]byte{})
+		require.NoError(t, err)
+		_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+			Signer:        datagen.GenRandomAccount().Address,
+			Pk:            &params.CovenantPks[0],
+			StakingTxHash: disabledDel.MustGetStakingTxHash().String(),
+			PubNonce:      nonces.PubNonce[:],
+		})
+		require.ErrorIs(t, err, types.ErrCovenantMuSig2Disabled)
+
+		// MuSig2 is n-of-n, so the feature requires the covenant quorum to be
+		// the whole covenant committee
+		params.CovenantMusig2Unbonding = true
+		params.BtcActivationHeight++
+		require.Error(t, h.BTCStakingKeeper.SetParams(h.Ctx, params))
+		params.CovenantQuorum = uint32(len(params.CovenantPks))
+		require.NoError(t, h.BTCStakingKeeper.SetParams(h.Ctx, params))
+		btcDel := genDelegation(&params, storedParams.Version+1)
+		stakingTxHash := btcDel.MustGetStakingTxHash()
+
+		// sign the unbonding tx spending the staking output through the
+		// unbonding path committing to the aggregated covenant key
+		stakingInfo, err := btcDel.GetStakingInfo(&params, h.Net)
+		require.NoError(t, err)
+		unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+		require.NoError(t, err)
+		unbondingTx, err := bbn.NewBTCTxFromBytes(btcDel.BtcUndelegation.UnbondingTx)
+		require.NoError(t, err)
+		msg, err := btcstaking.UnbondingSigHash(unbondingTx, stakingInfo.StakingOutput, unbondingSpendInfo.GetPkScriptPath())
+		require.NoError(t, err)
+
+		// covenant SKs are in the same order as covenant PKs in params
+		covNonces := make([]*musig2.Nonces, len(covenantSKs))
+		pubNonces := make([][btcstaking.MuSig2PubNonceSize]byte, len(covenantSKs))
+		for i, covSK := range covenantSKs {
+			covNonces[i], err = btcstaking.NewCovenantMuSig2Nonces(covSK, msg)
+			require.NoError(t, err)
+			pubNonces[i] = covNonces[i].PubNonce
+
+			_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+				Signer:        datagen.GenRandomAccount().Address,
+				Pk:            &params.CovenantPks[i],
+				StakingTxHash: stakingTxHash.String(),
+				PubNonce:      covNonces[i].PubNonce[:],
+			})
+			require.NoError(t, err)
+		}
+
+		// the same nonce cannot be submitted twice
+		_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+			Signer:        datagen.GenRandomAccount().Address,
+			Pk:            &params.CovenantPks[0],
+			StakingTxHash: stakingTxHash.String(),
+			PubNonce:      covNonces[0].PubNonce[:],
+		})
+		require.ErrorIs(t, err, types.ErrDuplicatedCovenantMuSig2)
+
+		// a different nonce resets the signing session, so that a stalled
+		// session can be retried
+		covNonces[0], err = btcstaking.NewCovenantMuSig2Nonces(covenantSKs[0], msg)
+		require.NoError(t, err)
+		pubNonces[0] = covNonces[0].PubNonce
+		_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+			Signer:        datagen.GenRandomAccount().Address,
+			Pk:            &params.CovenantPks[0],
+			StakingTxHash: stakingTxHash.String(),
+			PubNonce:      covNonces[0].PubNonce[:],
+		})
+		require.NoError(t, err)
+		sessionResp, err := h.BTCStakingKeeper.CovenantMuSig2Session(h.Ctx, &types.QueryCovenantMuSig2SessionRequest{
+			StakingTxHashHex: stakingTxHash.String(),
+		})
+		require.NoError(t, err)
+		require.Len(t, sessionResp.Entries, 1)
+		require.Empty(t, sessionResp.AggregatedNonceHex)
+
+		for i := 1; i < len(covenantSKs); i++ {
+			_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+				Signer:        datagen.GenRandomAccount().Address,
+				Pk:            &params.CovenantPks[i],
+				StakingTxHash: stakingTxHash.String(),
+				PubNonce:      covNonces[i].PubNonce[:],
+			})
+			require.NoError(t, err)
+		}
+
+		session, err := btcstaking.NewCovenantMuSig2Session(params.MustGetCovenantPks(), pubNonces, msg)
+		require.NoError(t, err)
+
+		partialSigs := make([][]byte, len(covenantSKs))
+		for i, covSK := range covenantSKs {
+			partialSig, err := session.Sign(covNonces[i].SecNonce, covSK)
+			require.NoError(t, err)
+			partialSigs[i] = btcstaking.SerializeMuSig2PartialSig(partialSig)
+		}
+
+		// partial signature of another covenant member is rejected
+		_, err = h.MsgServer.AddCovenantMuSig2PartialSig(h.Ctx, &types.MsgAddCovenantMuSig2PartialSig{
+			Signer:        datagen.GenRandomAccount().Address,
+			Pk:            &params.CovenantPks[0],
+			StakingTxHash: stakingTxHash.String(),
+			PartialSig:    partialSigs[1],
+		})
+		require.ErrorIs(t, err, types.ErrInvalidCovenantMuSig2)
+
+		// the BTC delegation does not reach the covenant quorum on the unbonding
+		// tx until the covenant committee aggregated its signature
+		delInfo, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash.String())
+		require.NoError(t, err)
+		require.False(t, delInfo.BtcUndelegation.HasCovenantQuorumOnUnbonding(params.CovenantQuorum))
+
+		for i := range covenantSKs {
+			_, err = h.MsgServer.AddCovenantMuSig2PartialSig(h.Ctx, &types.MsgAddCovenantMuSig2PartialSig{
+				Signer:        datagen.GenRandomAccount().Address,
+				Pk:            &params.CovenantPks[i],
+				StakingTxHash: stakingTxHash.String(),
+				PartialSig:    partialSigs[i],
+			})
+			require.NoError(t, err)
+		}
+
+		aggSig, err := h.BTCStakingKeeper.GetCovenantMuSig2AggSig(h.Ctx, &stakingTxHash)
+		require.NoError(t, err)
+		delInfo, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash.String())
+		require.NoError(t, err)
+		require.True(t, delInfo.BtcUndelegation.HasCovenantQuorumOnUnbonding(params.CovenantQuorum))
+
+		resp, err := h.BTCStakingKeeper.CovenantMuSig2Session(h.Ctx, &types.QueryCovenantMuSig2SessionRequest{
+			StakingTxHashHex: stakingTxHash.String(),
+		})
+		require.NoError(t, err)
+		require.Equal(t, aggSig.ToHexStr(), resp.AggregatedSigHex)
+		require.Empty(t, resp.Entries)
+
+		// the signing session is over once the signature is aggregated
+		_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+			Signer:        datagen.GenRandomAccount().Address,
+			Pk:            &params.CovenantPks[0],
+			StakingTxHash: stakingTxHash.String(),
+			PubNonce:      covNonces[0].PubNonce[:],
+		})
+		require.ErrorIs(t, err, types.ErrDuplicatedCovenantMuSig2)
+
+		aggKey, err := btcstaking.AggregateCovenantKeys(params.MustGetCovenantPks())
+		require.NoError(t, err)
+		err = btcstaking.VerifyTransactionSigWithOutput(
+			unbondingTx,
+			stakingInfo.StakingOutput,
+			unbondingSpendInfo.GetPkScriptPath(),
+			aggKey,
+			*aggSig,
+		)
+		require.NoError(t, err)
+	})
+}
+
+func TestCovenantMuSig2DelegationLifecycle(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+	covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.CovenantMusig2Unbonding = true
+	params.CovenantQuorum = uint32(len(params.CovenantPks))
+	params.BtcActivationHeight++
+	require.NoError(t, h.BTCStakingKeeper.SetParams(h.Ctx, params))
+
+	// the staker must not lock BTC before the unbonding tx is signed by the
+	// whole covenant committee, so BTC delegations with an inclusion proof
+	// are rejected
+	_, _, _, _, _, _, err = h.CreateDelegationWithBtcBlockHeight(
+		r, delSK, fpPK, 100000, 1000, 0, 0, false, false, 10, 30,
+	)
+	require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+	require.ErrorContains(t, err, "must be created before the staking tx is included on BTC")
+
+	tipHeight := uint32(10)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).DoAndReturn(func(_ any) *btclctypes.BTCHeaderInfo {
+		return &btclctypes.BTCHeaderInfo{Height: tipHeight}
+	}).AnyTimes()
+
+	btcDel, err := datagen.GenRandomBTCDelegation(
+		r,
+		t,
+		h.Net,
+		[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+		delSK,
+		covenantSKs,
+		covenantPKs,
+		params.CovenantQuorum,
+		params.SlashingPkScript,
+		1000, 10, 1010, 10000,
+		params.SlashingRate,
+		uint16(params.UnbondingTimeBlocks),
+	)
+	require.NoError(t, err)
+	btcDel.ParamsVersion = h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx).Version
+	btcDel.BtcUndelegation.CovenantUnbondingSigList = nil
+	require.NoError(t, h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, btcDel))
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+
+	// a covenant member starts the signing session
+	nonces, err := btcstaking.NewCovenantMuSig2Nonces(covenantSKs[0], [32]byte{})
+	require.NoError(t, err)
+	_, err = h.MsgServer.AddCovenantMuSig2Nonce(h.Ctx, &types.MsgAddCovenantMuSig2Nonce{
+		Signer:        datagen.GenRandomAccount().Address,
+		Pk:            &params.CovenantPks[0],
+		StakingTxHash: stakingTxHash.String(),
+		PubNonce:      nonces.PubNonce[:],
+	})
+	require.NoError(t, err)
+	sessionReq := &types.QueryCovenantMuSig2SessionRequest{StakingTxHashHex: stakingTxHash.String()}
+	resp, err := h.BTCStakingKeeper.CovenantMuSig2Session(h.Ctx, sessionReq)
+	require.NoError(t, err)
+	require.Len(t, resp.Entries, 1)
+
+	// the signing session is discarded once the BTC delegation expires
+	tipHeight = btcDel.EndHeight - btcDel.UnbondingTime
+	require.NoError(t, h.BTCStakingKeeper.BeginBlocker(h.Ctx))
+	resp, err = h.BTCStakingKeeper.CovenantMuSig2Session(h.Ctx, sessionReq)
+	require.NoError(t, err)
+	require.Empty(t, resp.Entries)
+}
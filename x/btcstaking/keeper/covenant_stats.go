@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// GetCovenantStats returns the signature liveness stats of the given
+// covenant member
+func (k Keeper) GetCovenantStats(ctx context.Context, covPK *bbn.BIP340PubKey) types.CovenantStats {
+	stats, err := k.covenantStats.Get(ctx, covPK.MustMarshal())
+	if err != nil {
+		return types.CovenantStats{}
+	}
+	return stats
+}
+
+// trackCovenantSigs starts tracking the covenant signatures of the given
+// newly created BTC delegation. BTC delegations added along with covenant
+// signatures are not tracked.
+func (k Keeper) trackCovenantSigs(ctx context.Context, btcDel *types.BTCDelegation, stakingTxHash chainhash.Hash) error {
+	if len(btcDel.CovenantSigs) > 0 {
+		return nil
+	}
+	createdHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if err := k.covenantSigWaiting.Set(ctx, stakingTxHash[:], createdHeight); err != nil {
+		return err
+	}
+	return k.covenantSigWaitingByHeight.Set(ctx, collections.Join(createdHeight, stakingTxHash[:]), collections.NoValue{})
+}
+
+// untrackCovenantSigs stops tracking the covenant signatures of the BTC
+// delegation with the given staking tx hash, if tracked
+func (k Keeper) untrackCovenantSigs(ctx context.Context, stakingTxHash chainhash.Hash) error {
+	createdHeight, err := k.covenantSigWaiting.Get(ctx, stakingTxHash[:])
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.covenantSigWaiting.Remove(ctx, stakingTxHash[:]); err != nil {
+		return err
+	}
+	if err := k.covenantSigWaitingByHeight.Remove(ctx, collections.Join(createdHeight, stakingTxHash[:])); err != nil {
+		return err
+	}
+	return k.covenantSigLateByHeight.Remove(ctx, collections.Join(createdHeight, stakingTxHash[:]))
+}
+
+// recordCovenantSig records the signature of the given covenant member on the
+// given BTC delegation in the stats of the covenant member, if the covenant
+// signatures of the BTC delegation are tracked. The signature is recorded as
+// late if the BTC delegation already timed out, in which case the covenant
+// member is no longer counted as having missed it. The tracking stops once all
+// the covenant members signed the BTC delegation.
+func (k Keeper) recordCovenantSig(
+	ctx context.Context,
+	btcDel *types.BTCDelegation,
+	params *types.Params,
+	covPK *bbn.BIP340PubKey,
+) error {
+	stakingTxHash := btcDel.MustGetStakingTxHash()
+	createdHeight, err := k.covenantSigWaiting.Get(ctx, stakingTxHash[:])
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// the BTC delegation is no longer indexed by creation height once it
+	// timed out
+	isWaiting, err := k.covenantSigWaitingByHeight.Has(ctx, collections.Join(createdHeight, stakingTxHash[:]))
+	if err != nil {
+		return err
+	}
+
+	stats := k.GetCovenantStats(ctx, covPK)
+	if isWaiting {
+		latency := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height) - createdHeight
+		stats.NumSignatures++
+		stats.TotalLatencyBlocks += latency
+		if latency > stats.MaxLatencyBlocks {
+			stats.MaxLatencyBlocks = latency
+		}
+	} else {
+		// the covenant member was counted as missing the signature upon the
+		// timeout
+		stats.NumLate++
+		if stats.NumMissed > 0 {
+			stats.NumMissed--
+		}
+	}
+	if err := k.covenantStats.Set(ctx, covPK.MustMarshal(), stats); err != nil {
+		return err
+	}
+
+	if len(missingCovenantPks(btcDel, params)) == 0 {
+		return k.untrackCovenantSigs(ctx, stakingTxHash)
+	}
+	return nil
+}
+
+// processCovenantSigTimeouts records the covenant members that did not sign
+// the BTC delegations created more than `covenant_sig_timeout_blocks` Babylon
+// blocks ago as missing them, emits an event for each of these BTC
+// delegations and stops waiting for their covenant signatures. The covenant
+// signatures of these BTC delegations keep being tracked for another
+// `covenant_sig_timeout_blocks` Babylon blocks, so that the signatures
+// submitted within this window after the timeout are recorded as late.
+func (k Keeper) processCovenantSigTimeouts(ctx context.Context) error {
+	timeout := uint64(k.GetParams(ctx).CovenantSigTimeoutBlocks)
+	height := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if timeout == 0 || height <= timeout {
+		return nil
+	}
+
+	if height > 2*timeout {
+		if err := k.untrackLateCovenantSigs(ctx, height-2*timeout); err != nil {
+			return err
+		}
+	}
+
+	// collect the timed out delegations first, so that the tracking is not
+	// updated while iterating the index
+	var timedOut []collections.Pair[uint64, []byte]
+	rng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		EndExclusive(collections.Join(height-timeout, []byte{}))
+	if err := k.covenantSigWaitingByHeight.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], _ collections.NoValue) (bool, error) {
+		timedOut = append(timedOut, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range timedOut {
+		stakingTxHash, err := chainhash.NewHash(key.K2())
+		if err != nil {
+			return err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+
+		params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+		if params == nil {
+			return types.ErrParamsNotFound.Wrapf("params version: %d", btcDel.ParamsVersion)
+		}
+		missing := missingCovenantPks(btcDel, params)
+		for _, covPK := range missing {
+			stats := k.GetCovenantStats(ctx, &covPK)
+			stats.NumMissed++
+			if err := k.covenantStats.Set(ctx, covPK.MustMarshal(), stats); err != nil {
+				return err
+			}
+		}
+
+		if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(types.NewCovenantSignatureTimeoutEvent(
+			stakingTxHash,
+			key.K1(),
+			height-key.K1(),
+			missing,
+		)); err != nil {
+			return fmt.Errorf("failed to emit EventCovenantSignatureTimeout: %w", err)
+		}
+
+		if err := k.covenantSigWaitingByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+		if err := k.covenantSigLateByHeight.Set(ctx, key, collections.NoValue{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// untrackLateCovenantSigs stops tracking the covenant signatures of the timed
+// out BTC delegations created before the given Babylon height, so that the
+// covenant signatures are tracked for a bounded number of blocks
+func (k Keeper) untrackLateCovenantSigs(ctx context.Context, createdBefore uint64) error {
+	// collect the delegations first, so that the tracking is not updated
+	// while iterating the index
+	var expired []collections.Pair[uint64, []byte]
+	rng := new(collections.Range[collections.Pair[uint64, []byte]]).
+		EndExclusive(collections.Join(createdBefore, []byte{}))
+	if err := k.covenantSigLateByHeight.Walk(ctx, rng, func(key collections.Pair[uint64, []byte], _ collections.NoValue) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.covenantSigWaiting.Remove(ctx, key.K2()); err != nil {
+			return err
+		}
+		if err := k.covenantSigLateByHeight.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// missingCovenantPks returns the covenant members of the given parameters
+// that did not sign the given BTC delegation
+func missingCovenantPks(btcDel *types.BTCDelegation, params *types.Params) []bbn.BIP340PubKey {
+	var missing []bbn.BIP340PubKey
+	for _, covPK := range params.CovenantPks {
+		if !btcDel.IsSignedByCovMember(&covPK) {
+			missing = append(missing, covPK)
+		}
+	}
+	return missing
+}
@@ -49,6 +49,11 @@ func (k Keeper) AddFinalityProvider(goCtx context.Context, msg *types.MsgCreateF
 		return types.ErrFpRegistered
 	}
 
+	// ensure the BTC PK is not used as EOTS PK by another finality provider
+	if k.isRotatedEotsPk(ctx, msg.BtcPk) {
+		return types.ErrEotsPkInUse.Wrapf("the BTC PK %s is used as EOTS PK by another finality provider", msg.BtcPk.MarshalHex())
+	}
+
 	// all good, add this finality provider
 	fp := types.FinalityProvider{
 		Description:    msg.Description,
@@ -171,6 +176,12 @@ func (k Keeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	fp.SlashedBtcHeight = btcTip.Height
 	k.SetFinalityProvider(ctx, fp)
 
+	// a slashed finality provider does not count in the total delegated
+	// satoshis anymore
+	if err := k.deductSlashedFpDelegatedSats(ctx, fp.BtcPk); err != nil {
+		return err
+	}
+
 	// record slashed event. The next `BeginBlock` will consume this
 	// event for updating the finality provider set
 	powerUpdateEvent := types.NewEventPowerDistUpdateWithSlashedFP(fp.BtcPk)
@@ -0,0 +1,297 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// GetFpDelegatedSats returns the satoshis of the BTC delegations to the given
+// finality provider that are included on BTC and neither unbonded nor expired
+func (k Keeper) GetFpDelegatedSats(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) uint64 {
+	sats, err := k.fpDelegatedSats.Get(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return 0
+	}
+	return sats
+}
+
+// GetFpSelfBondedSats returns the delegated satoshis of the given finality
+// provider that are staked from its own Babylon address
+func (k Keeper) GetFpSelfBondedSats(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) uint64 {
+	sats, err := k.fpSelfBondedSats.Get(ctx, fpBTCPK.MustMarshal())
+	if err != nil {
+		return 0
+	}
+	return sats
+}
+
+// GetTotalDelegatedSats returns the sum of the delegated satoshis of all the
+// finality providers that are not slashed
+func (k Keeper) GetTotalDelegatedSats(ctx context.Context) uint64 {
+	sats, err := k.totalDelegatedSats.Get(ctx)
+	if err != nil {
+		return 0
+	}
+	return sats
+}
+
+// getDelegatedSatsBtcHeight returns the BTC height up to which the expired
+// BTC delegations are deducted from the delegated satoshis
+func (k Keeper) getDelegatedSatsBtcHeight(ctx context.Context) (uint32, error) {
+	height, err := k.delegatedSatsBtcHeight.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return height, err
+}
+
+// isCountedInDelegatedSats returns whether the given BTC delegation is
+// counted in the delegated satoshis, i.e., whether it is included on BTC and
+// its expiry has not been deducted yet
+func (k Keeper) isCountedInDelegatedSats(ctx context.Context, btcDel *types.BTCDelegation) (bool, error) {
+	if !btcDel.HasInclusionProof() {
+		return false, nil
+	}
+	height, err := k.getDelegatedSatsBtcHeight(ctx)
+	if err != nil {
+		return false, err
+	}
+	return btcDel.EndHeight-btcDel.UnbondingTime > height, nil
+}
+
+// updateDelegatedSats adds the satoshis of the given BTC delegation to, or
+// removes them from, the delegated satoshis of its non-slashed finality
+// providers, and from their self-bonded satoshis if the delegation is staked
+// from the finality provider's own Babylon address
+func (k Keeper) updateDelegatedSats(ctx context.Context, btcDel *types.BTCDelegation, add bool) error {
+	counted, err := k.isCountedInDelegatedSats(ctx, btcDel)
+	if err != nil || !counted {
+		return err
+	}
+
+	total := k.GetTotalDelegatedSats(ctx)
+	for _, fpBTCPK := range btcDel.FpBtcPkList {
+		// the delegated satoshis of a slashed finality provider are deducted
+		// at once upon slashing
+		fp, err := k.GetFinalityProvider(ctx, fpBTCPK)
+		if err != nil && !errors.Is(err, types.ErrFpNotFound) {
+			return err
+		}
+		if fp != nil && fp.IsSlashed() {
+			continue
+		}
+
+		fpSats := k.GetFpDelegatedSats(ctx, &fpBTCPK)
+		if add {
+			fpSats += btcDel.TotalSat
+			total += btcDel.TotalSat
+		} else {
+			fpSats -= btcDel.TotalSat
+			total -= btcDel.TotalSat
+		}
+		if err := k.fpDelegatedSats.Set(ctx, fpBTCPK.MustMarshal(), fpSats); err != nil {
+			return err
+		}
+
+		if fp == nil || btcDel.StakerAddr != fp.Addr {
+			continue
+		}
+		selfBondedSats := k.GetFpSelfBondedSats(ctx, &fpBTCPK)
+		if add {
+			selfBondedSats += btcDel.TotalSat
+		} else {
+			selfBondedSats -= btcDel.TotalSat
+		}
+		if err := k.fpSelfBondedSats.Set(ctx, fpBTCPK.MustMarshal(), selfBondedSats); err != nil {
+			return err
+		}
+	}
+	return k.totalDelegatedSats.Set(ctx, total)
+}
+
+// addDelegatedSats adds the satoshis of the given BTC delegation to the
+// delegated satoshis once its staking tx is included on BTC
+func (k Keeper) addDelegatedSats(ctx context.Context, btcDel *types.BTCDelegation) error {
+	return k.updateDelegatedSats(ctx, btcDel, true)
+}
+
+// deductDelegatedSats deducts the satoshis of the given BTC delegation from
+// the delegated satoshis upon its unbonding
+func (k Keeper) deductDelegatedSats(ctx context.Context, btcDel *types.BTCDelegation) error {
+	return k.updateDelegatedSats(ctx, btcDel, false)
+}
+
+// deductSlashedFpDelegatedSats deducts the delegated satoshis of the given
+// slashed finality provider from the total delegated satoshis
+func (k Keeper) deductSlashedFpDelegatedSats(ctx context.Context, fpBTCPK *bbn.BIP340PubKey) error {
+	fpSats := k.GetFpDelegatedSats(ctx, fpBTCPK)
+	if err := k.fpDelegatedSats.Remove(ctx, fpBTCPK.MustMarshal()); err != nil {
+		return err
+	}
+	if err := k.fpSelfBondedSats.Remove(ctx, fpBTCPK.MustMarshal()); err != nil {
+		return err
+	}
+	return k.totalDelegatedSats.Set(ctx, k.GetTotalDelegatedSats(ctx)-fpSats)
+}
+
+// processExpiredBTCDelegations deducts the satoshis of the BTC delegations
+// that expired since the last processed BTC height up to the given BTC tip
+// from the delegated satoshis, discards their covenant MuSig2 signing
+// sessions as their unbonding tx can no longer be used, and stops tracking
+// their covenant signatures
+func (k Keeper) processExpiredBTCDelegations(ctx context.Context, btcTip *btclctypes.BTCHeaderInfo) error {
+	lastHeight, err := k.getDelegatedSatsBtcHeight(ctx)
+	if err != nil {
+		return err
+	}
+	if btcTip == nil || btcTip.Height <= lastHeight {
+		return nil
+	}
+
+	// collect the expired delegations first, so that the store is not
+	// written while iterating the index
+	var expired []*types.BTCDelegation
+	rng := new(collections.Range[collections.Pair[uint32, []byte]]).
+		StartInclusive(collections.Join(lastHeight+1, []byte{})).
+		EndExclusive(collections.Join(btcTip.Height+1, []byte{}))
+	if err := k.btcDelegationsByExpiry.Walk(ctx, rng, func(key collections.Pair[uint32, []byte], _ collections.NoValue) (bool, error) {
+		stakingTxHash, err := chainhash.NewHash(key.K2())
+		if err != nil {
+			return true, err
+		}
+		btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+		if btcDel == nil {
+			return true, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+		}
+		expired = append(expired, btcDel)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, btcDel := range expired {
+		if err := k.deductDelegatedSats(ctx, btcDel); err != nil {
+			return err
+		}
+		stakingTxHash := btcDel.MustGetStakingTxHash()
+		if err := k.clearCovenantMuSig2Session(ctx, stakingTxHash[:]); err != nil {
+			return err
+		}
+		if err := k.untrackCovenantSigs(ctx, stakingTxHash); err != nil {
+			return err
+		}
+	}
+	return k.delegatedSatsBtcHeight.Set(ctx, btcTip.Height)
+}
+
+// InitDelegatedSats initialises the delegated satoshis with the existing BTC
+// delegations that are included on BTC, not unbonded early and not expired
+// at the given BTC height
+func (k Keeper) InitDelegatedSats(ctx context.Context, btcHeight uint32) error {
+	if err := k.delegatedSatsBtcHeight.Set(ctx, btcHeight); err != nil {
+		return err
+	}
+
+	// collect the delegations first, so that the delegated satoshis are not
+	// written while iterating the store
+	var dels []*types.BTCDelegation
+	if err := k.IterateBTCDelegations(ctx, func(btcDel *types.BTCDelegation) error {
+		if !btcDel.IsUnbondedEarly() {
+			dels = append(dels, btcDel)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	for _, btcDel := range dels {
+		if err := k.addDelegatedSats(ctx, btcDel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stakeExpansionReplacedSats returns the satoshis of the BTC delegation
+// being expanded by the given BTC delegation, which are replaced by the
+// satoshis of the stake expansion once it is included on BTC
+func (k Keeper) stakeExpansionReplacedSats(ctx context.Context, prevStakingTxHash *chainhash.Hash) uint64 {
+	prevBtcDel := k.getBTCDelegation(ctx, *prevStakingTxHash)
+	if prevBtcDel == nil {
+		return 0
+	}
+	return prevBtcDel.TotalSat
+}
+
+// checkDelegatedSatsCaps ensures that delegating the given amount of
+// satoshis from the given staker to the given finality provider, in place of
+// the given amount of satoshis of a BTC delegation being expanded
+// - is staked from the finality provider's own Babylon address, or the
+// finality provider has self-bonded the minimum amount of satoshis set in
+// the parameters,
+// - does not exceed the maximum amount of satoshis accepted by the finality
+// provider, and
+// - does not exceed the maximum ratio of the total staked BTC delegated to a
+// single finality provider set in the parameters, once the total staked BTC
+// reaches the amount from which the ratio is enforced.
+//
+// As the delegated satoshis only count the BTC delegations included on BTC,
+// the caps are checked both upon the creation of a BTC delegation and upon
+// the inclusion of its staking tx on BTC.
+func (k Keeper) checkDelegatedSatsCaps(
+	ctx context.Context,
+	params *types.Params,
+	fpBTCPK *bbn.BIP340PubKey,
+	stakerAddr string,
+	stakingValue uint64,
+	replacedValue uint64,
+) error {
+	fp, err := k.GetFinalityProvider(ctx, *fpBTCPK)
+	if err != nil {
+		return err
+	}
+
+	if params.MinFpSelfBondSats > 0 && stakerAddr != fp.Addr {
+		selfBondedSats := k.GetFpSelfBondedSats(ctx, fpBTCPK)
+		if selfBondedSats < params.MinFpSelfBondSats {
+			return types.ErrFpSelfBondTooLow.Wrapf(
+				"finality provider %s self-bonded %d sats, below the minimum of %d sats",
+				fpBTCPK.MarshalHex(), selfBondedSats, params.MinFpSelfBondSats,
+			)
+		}
+	}
+
+	fpSats := k.GetFpDelegatedSats(ctx, fpBTCPK) + stakingValue - replacedValue
+	if fp.MaxDelegatedSats > 0 && fpSats > fp.MaxDelegatedSats {
+		return types.ErrFpDelegationCapExceeded.Wrapf(
+			"finality provider %s would be delegated %d sats, above its cap of %d sats",
+			fpBTCPK.MarshalHex(), fpSats, fp.MaxDelegatedSats,
+		)
+	}
+
+	// the ratio is not enforced while the total staked BTC is below the
+	// minimum, as the first BTC delegations would otherwise always exceed it
+	totalSats := k.GetTotalDelegatedSats(ctx)
+	if params.IsFpStakeRatioCapped() && totalSats >= params.FpStakeRatioMinTotalSats {
+		totalSats = totalSats + stakingValue - replacedValue
+		maxFpSats := sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(totalSats)).
+			Mul(params.MaxFpStakeRatio).
+			TruncateInt()
+		if sdkmath.NewIntFromUint64(fpSats).GT(maxFpSats) {
+			return types.ErrFpStakeRatioExceeded.Wrapf(
+				"finality provider %s would be delegated %d sats out of %d total staked sats, above the max ratio of %s",
+				fpBTCPK.MarshalHex(), fpSats, totalSats, params.MaxFpStakeRatio.String(),
+			)
+		}
+	}
+
+	return nil
+}
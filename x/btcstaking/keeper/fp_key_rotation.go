@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// RotateFinalityProviderKey registers the successor EOTS key of the given
+// finality provider, which is used from the given activation height. The
+// finality provider keeps being identified by its BTC PK. Signatures
+// authorizing the rotation are verified by the caller.
+//
+// Existing BTC delegations keep committing to the previous key, and stay
+// slashable on Bitcoin for equivocations at heights before the activation
+// height. BTC delegations created from the activation height on commit to
+// the successor key.
+func (k Keeper) RotateFinalityProviderKey(
+	goCtx context.Context,
+	fpBtcPk *bbn.BIP340PubKey,
+	newEotsPk *bbn.BIP340PubKey,
+	activationHeight uint64,
+) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	fp, err := k.GetFinalityProvider(ctx, fpBtcPk.MustMarshal())
+	if err != nil {
+		return err
+	}
+	if k.IsFinalityProviderDeleted(ctx, fpBtcPk) {
+		return types.ErrFinalityProviderIsDeleted.Wrapf("fp_btc_pk_hex: %s", fpBtcPk.MarshalHex())
+	}
+	if fp.IsSlashed() {
+		return types.ErrFpAlreadySlashed.Wrapf("finality provider public key: %s", fpBtcPk.MarshalHex())
+	}
+
+	currentHeight := uint64(ctx.HeaderInfo().Height)
+	if activationHeight <= currentHeight {
+		return types.ErrInvalidFpKeyRotation.Wrapf("activation height %d is not after the current height %d", activationHeight, currentHeight)
+	}
+	if fp.HasPendingKeyRotation(currentHeight) {
+		return types.ErrInvalidFpKeyRotation.Wrapf("the finality provider %s already has a pending key rotation", fpBtcPk.MarshalHex())
+	}
+
+	// ensure the successor key was never used by any finality provider, so
+	// that each EOTS key identifies a single finality provider
+	if k.HasFinalityProvider(ctx, newEotsPk.MustMarshal()) || k.isRotatedEotsPk(ctx, newEotsPk) {
+		return types.ErrEotsPkInUse.Wrapf("EOTS PK: %s", newEotsPk.MarshalHex())
+	}
+
+	rotation := &types.FinalityProviderKeyRotation{
+		NewEotsPk:        newEotsPk,
+		ActivationHeight: activationHeight,
+	}
+	fp.KeyRotations = append(fp.KeyRotations, rotation)
+	k.SetFinalityProvider(ctx, fp)
+	if err := k.fpEotsPks.Set(ctx, newEotsPk.MustMarshal(), fpBtcPk.MustMarshal()); err != nil {
+		return err
+	}
+
+	return ctx.EventManager().EmitTypedEvent(types.NewEventFinalityProviderKeyRotated(fp, rotation))
+}
+
+// isRotatedEotsPk returns whether the given PK is the EOTS PK of a key
+// rotation of any finality provider
+func (k Keeper) isRotatedEotsPk(ctx context.Context, pk *bbn.BIP340PubKey) bool {
+	has, err := k.fpEotsPks.Has(ctx, pk.MustMarshal())
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	btctest "github.com/babylonlabs-io/babylon/v4/testutil/bitcoin"
+	testutil "github.com/babylonlabs-io/babylon/v4/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+func FuzzRotateFinalityProviderKey(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+		covenantSKs, _ := h.GenAndApplyParams(r)
+
+		fpSK, _, fp := h.CreateFinalityProvider(r)
+		_, _, otherFp := h.CreateFinalityProvider(r)
+
+		currentHeight := datagen.RandomInt(r, 100) + 1
+		h.SetCtxHeight(currentHeight)
+		activationHeight := currentHeight + datagen.RandomInt(r, 100) + 1
+
+		newSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		newEotsPk := bbn.NewBIP340PubKeyFromBTCPK(newSK.PubKey())
+
+		// the activation height has to be in the future
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, fp.BtcPk, newEotsPk, currentHeight)
+		require.ErrorIs(t, err, types.ErrInvalidFpKeyRotation)
+
+		// the new key cannot be the key of another finality provider
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, fp.BtcPk, otherFp.BtcPk, activationHeight)
+		require.ErrorIs(t, err, types.ErrEotsPkInUse)
+
+		// unknown finality provider
+		unknownPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, unknownPk, newEotsPk, activationHeight)
+		require.ErrorIs(t, err, types.ErrFpNotFound)
+
+		// successful rotation
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, fp.BtcPk, newEotsPk, activationHeight)
+		require.NoError(t, err)
+
+		actualFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		require.Len(t, actualFp.KeyRotations, 1)
+		require.True(t, fp.BtcPk.Equals(actualFp.EotsPkAtHeight(activationHeight-1)))
+		require.True(t, newEotsPk.Equals(actualFp.EotsPkAtHeight(activationHeight)))
+
+		// a second rotation is rejected while the first one is pending
+		anotherEotsPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, fp.BtcPk, anotherEotsPk, activationHeight+1)
+		require.ErrorIs(t, err, types.ErrInvalidFpKeyRotation)
+
+		// the rotated key cannot be reused by another finality provider,
+		// neither through a rotation nor through a registration
+		h.SetCtxHeight(activationHeight)
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, otherFp.BtcPk, newEotsPk, activationHeight+1)
+		require.ErrorIs(t, err, types.ErrEotsPkInUse)
+
+		newFp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		err = h.BTCStakingKeeper.AddFinalityProvider(h.Ctx, &types.MsgCreateFinalityProvider{
+			Addr:        newFp.Addr,
+			Description: newFp.Description,
+			Commission: types.NewCommissionRates(
+				*newFp.Commission,
+				newFp.CommissionInfo.MaxRate,
+				newFp.CommissionInfo.MaxChangeRate,
+			),
+			BtcPk: newEotsPk,
+			Pop:   newFp.Pop,
+		})
+		require.ErrorIs(t, err, types.ErrEotsPkInUse)
+
+		// once activated, the finality provider can rotate its key again
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, fp.BtcPk, anotherEotsPk, activationHeight+1)
+		require.NoError(t, err)
+		actualFp, err = h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		require.Len(t, actualFp.KeyRotations, 2)
+		require.True(t, anotherEotsPk.Equals(actualFp.LatestEotsPk()))
+
+		// BTC delegations to a finality provider that rotated its key commit
+		// to its EOTS key at the current height, so that its equivocations
+		// reveal the secret key their slashing tx commits to
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		_, _, _, _, _, _, err = h.CreateDelegationWithFpScriptPK(
+			r, delSK, fp.BtcPk.MustToBTCPK(), fp.BtcPk.MustToBTCPK(), 100000, 1000,
+		)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+		_, _, _, _, _, _, err = h.CreateDelegationWithFpScriptPK(
+			r, delSK, fp.BtcPk.MustToBTCPK(), anotherEotsPk.MustToBTCPK(), 100000, 1000,
+		)
+		require.ErrorIs(t, err, types.ErrInvalidStakingTx)
+		stakingTxHash, msgCreateBTCDel, btcDel, _, _, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r, delSK, fp.BtcPk.MustToBTCPK(), 100000, 1000, 0, 0, true, false, 10, 10,
+		)
+		require.NoError(t, err)
+		require.True(t, fp.BtcPk.Equals(&btcDel.FpBtcPkList[0]))
+		require.True(t, newEotsPk.Equals(btcDel.FpEotsPk))
+		require.NoError(t, btcDel.ValidateBasic())
+
+		// the covenant signatures are encrypted by the successor key, whose
+		// secret key signs the slashing tx of the BTC delegation
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, btcDel, 10)
+		btcDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		stakingInfo, err := btcDel.GetStakingInfo(&params, h.Net)
+		require.NoError(t, err)
+		slashingTx, err := btcDel.BuildSlashingTxWithWitness(&params, h.Net, newSK)
+		require.NoError(t, err)
+		btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, slashingTx)
+		_, err = btcDel.BuildSlashingTxWithWitness(&params, h.Net, fpSK)
+		require.Error(t, err)
+
+		// slashed finality providers cannot rotate their key
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 10}).AnyTimes()
+		err = h.BTCStakingKeeper.SlashFinalityProvider(h.Ctx, otherFp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		yetAnotherEotsPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		err = h.BTCStakingKeeper.RotateFinalityProviderKey(h.Ctx, otherFp.BtcPk, yetAnotherEotsPk, activationHeight+1)
+		require.ErrorIs(t, err, types.ErrFpAlreadySlashed)
+	})
+}
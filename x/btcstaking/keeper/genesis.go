@@ -24,6 +24,11 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 
 	for _, fp := range gs.FinalityProviders {
 		k.SetFinalityProvider(ctx, fp)
+		for _, rotation := range fp.KeyRotations {
+			if err := k.fpEotsPks.Set(ctx, rotation.NewEotsPk.MustMarshal(), fp.BtcPk.MustMarshal()); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, btcDel := range gs.BtcDelegations {
@@ -38,6 +43,23 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 				return types.ErrFpAlreadySlashed.Wrapf("finality key: %s", btcDel.BtcPk.MarshalHex())
 			}
 		}
+		stakingTxHash, err := btcDel.GetStakingTxHash()
+		if err != nil {
+			return err
+		}
+		if err := k.indexBTCDelegationByStaker(ctx, btcDel.BtcPk, stakingTxHash); err != nil {
+			return err
+		}
+		if err := k.indexBTCDelegationByVersion(ctx, btcDel.ParamsVersion, stakingTxHash); err != nil {
+			return err
+		}
+		// NOTE: the completion height of an early unbonding is not part of
+		// the genesis, so only delegations not unbonded early are indexed
+		if !btcDel.IsUnbondedEarly() {
+			if err := k.indexBTCDelegationExpiry(ctx, btcDel, stakingTxHash); err != nil {
+				return err
+			}
+		}
 		k.setBTCDelegation(ctx, btcDel)
 	}
 
@@ -103,7 +125,13 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		}
 	}
 
-	return nil
+	// NOTE: the covenant stats and the tracking of pending covenant
+	// signatures are not part of the genesis, so they start afresh
+
+	// NOTE: the BTC tip is not available at genesis, so the delegated
+	// satoshis include the expired delegations until they are deducted in
+	// the first BeginBlock
+	return k.InitDelegatedSats(ctx, 0)
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -2,8 +2,12 @@ package keeper
 
 import (
 	"context"
+	"encoding/hex"
+	"errors"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -34,7 +38,7 @@ func (k Keeper) FinalityProviders(c context.Context, req *types.QueryFinalityPro
 		}
 
 		isDeleted := k.IsFinalityProviderDeleted(ctx, fp.BtcPk)
-		resp := types.NewFinalityProviderResponse(&fp, currBlockHeight, isDeleted)
+		resp := types.NewFinalityProviderResponse(&fp, currBlockHeight, isDeleted, k.GetFpDelegatedSats(ctx, fp.BtcPk))
 		fpResp = append(fpResp, resp)
 		return nil
 	})
@@ -74,7 +78,7 @@ func (k Keeper) FinalityProvider(c context.Context, req *types.QueryFinalityProv
 
 	currBlockHeight := uint64(ctx.BlockHeight())
 	isDeleted := k.IsFinalityProviderDeleted(ctx, fp.BtcPk)
-	fpResp := types.NewFinalityProviderResponse(fp, currBlockHeight, isDeleted)
+	fpResp := types.NewFinalityProviderResponse(fp, currBlockHeight, isDeleted, k.GetFpDelegatedSats(ctx, fp.BtcPk))
 	return &types.QueryFinalityProviderResponse{FinalityProvider: fpResp}, nil
 }
 
@@ -178,6 +182,233 @@ func (k Keeper) FinalityProviderDelegations(ctx context.Context, req *types.Quer
 	return &types.QueryFinalityProviderDelegationsResponse{BtcDelegatorDelegations: btcDels, Pagination: pageRes}, nil
 }
 
+// DelegationsByStaker returns all the BTC delegations of the provided staker
+// BTC PK filtered by the provided status.
+func (k Keeper) DelegationsByStaker(ctx context.Context, req *types.QueryDelegationsByStakerRequest) (*types.QueryDelegationsByStakerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if len(req.StakerBtcPkHex) == 0 {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "staker BTC public key cannot be empty")
+	}
+
+	stakerPK, err := bbn.NewBIP340PubKeyFromHex(req.StakerBtcPkHex)
+	if err != nil {
+		return nil, err
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	// the response of the delegation that last matched the filter, which is
+	// returned by the transform function invoked right after
+	var matched *types.BTCDelegationResponse
+	btcDels, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.btcDelegationsByStaker,
+		req.Pagination,
+		func(key collections.Pair[[]byte, []byte], _ collections.NoValue) (bool, error) {
+			stakingTxHash, err := chainhash.NewHash(key.K2())
+			if err != nil {
+				return false, err
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				return false, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+			}
+
+			params := k.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			status, err := k.BtcDelStatus(ctx, btcDel, params.CovenantQuorum, btcTipHeight)
+			if err != nil {
+				return false, err
+			}
+			if req.Status != types.BTCDelegationStatus_ANY && status != req.Status {
+				return false, nil
+			}
+			matched = types.NewBTCDelegationResponse(btcDel, status)
+			return true, nil
+		},
+		func(_ collections.Pair[[]byte, []byte], _ collections.NoValue) (*types.BTCDelegationResponse, error) {
+			return matched, nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, []byte](stakerPK.MustMarshal()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDelegationsByStakerResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// ExpiringDelegations returns the BTC delegations whose expiry height, i.e.,
+// end height minus unbonding time, falls within the provided BTC height range
+func (k Keeper) ExpiringDelegations(ctx context.Context, req *types.QueryExpiringDelegationsRequest) (*types.QueryExpiringDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is larger than end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	btcDels, pageRes, err := k.paginateBTCDelegationsByHeight(ctx, k.btcDelegationsByExpiry, req.StartHeight, req.EndHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExpiringDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// UnbondingDelegations returns the BTC delegations whose early unbonding
+// completes within the provided BTC height range
+func (k Keeper) UnbondingDelegations(ctx context.Context, req *types.QueryUnbondingDelegationsRequest) (*types.QueryUnbondingDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is larger than end height %d", req.StartHeight, req.EndHeight)
+	}
+
+	btcDels, pageRes, err := k.paginateBTCDelegationsByHeight(ctx, k.btcDelegationsByUnbonded, req.StartHeight, req.EndHeight, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// CovenantCommittees returns the covenant committees of all the parameters
+// versions, along with their status at the current BTC tip
+func (k Keeper) CovenantCommittees(c context.Context, req *types.QueryCovenantCommitteesRequest) (*types.QueryCovenantCommitteesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+	activeParams, _, err := k.GetParamsForBtcHeight(ctx, uint64(btcTipHeight))
+	if err != nil {
+		return nil, err
+	}
+
+	store := k.paramsStore(ctx)
+	var committees []*types.CovenantCommitteeResponse
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		var sp types.StoredParams
+		if err := sp.Unmarshal(value); err != nil {
+			return err
+		}
+
+		committees = append(committees, newCovenantCommitteeResponse(&sp, activeParams, btcTipHeight))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCovenantCommitteesResponse{
+		CovenantCommittees: committees,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// CovenantCommitteeDelegations returns all the BTC delegations bound to the
+// covenant committee of the provided parameters version filtered by the
+// provided status.
+func (k Keeper) CovenantCommitteeDelegations(ctx context.Context, req *types.QueryCovenantCommitteeDelegationsRequest) (*types.QueryCovenantCommitteeDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params := k.GetParamsByVersion(ctx, req.ParamsVersion)
+	if params == nil {
+		return nil, types.ErrParamsNotFound.Wrapf("version %d does not exists", req.ParamsVersion)
+	}
+
+	btcTipHeight := k.btclcKeeper.GetTipInfo(ctx).Height
+
+	// the response of the delegation that last matched the filter, which is
+	// returned by the transform function invoked right after
+	var matched *types.BTCDelegationResponse
+	btcDels, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		k.btcDelegationsByVersion,
+		req.Pagination,
+		func(key collections.Pair[uint32, []byte], _ collections.NoValue) (bool, error) {
+			stakingTxHash, err := chainhash.NewHash(key.K2())
+			if err != nil {
+				return false, err
+			}
+			btcDel := k.getBTCDelegation(ctx, *stakingTxHash)
+			if btcDel == nil {
+				return false, types.ErrBTCDelegationNotFound.Wrapf("staking tx hash: %s", stakingTxHash.String())
+			}
+
+			status, err := k.BtcDelStatus(ctx, btcDel, params.CovenantQuorum, btcTipHeight)
+			if err != nil {
+				return false, err
+			}
+			if req.Status != types.BTCDelegationStatus_ANY && status != req.Status {
+				return false, nil
+			}
+			matched = types.NewBTCDelegationResponse(btcDel, status)
+			return true, nil
+		},
+		func(_ collections.Pair[uint32, []byte], _ collections.NoValue) (*types.BTCDelegationResponse, error) {
+			return matched, nil
+		},
+		query.WithCollectionPaginationPairPrefix[uint32, []byte](req.ParamsVersion),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCovenantCommitteeDelegationsResponse{
+		BtcDelegations: btcDels,
+		Pagination:     pageRes,
+	}, nil
+}
+
+// CovenantStats returns the signature liveness stats of the covenant members
+// that signed or missed at least one BTC delegation.
+func (k Keeper) CovenantStats(ctx context.Context, req *types.QueryCovenantStatsRequest) (*types.QueryCovenantStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	stats, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.covenantStats,
+		req.Pagination,
+		func(covPKBytes []byte, s types.CovenantStats) (*types.CovenantStatsResponse, error) {
+			covPK, err := bbn.NewBIP340PubKey(covPKBytes)
+			if err != nil {
+				return nil, err
+			}
+			return types.NewCovenantStatsResponse(covPK, &s), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCovenantStatsResponse{
+		CovenantStats: stats,
+		Pagination:    pageRes,
+	}, nil
+}
+
 // BTCDelegation returns existing btc delegation by staking tx hash
 func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegationRequest) (*types.QueryBTCDelegationResponse, error) {
 	if req == nil {
@@ -198,6 +429,65 @@ func (k Keeper) BTCDelegation(ctx context.Context, req *types.QueryBTCDelegation
 	}, nil
 }
 
+// CovenantMuSig2Session returns the state of the covenant MuSig2 signing
+// session of the unbonding tx of the given BTC delegation
+func (k Keeper) CovenantMuSig2Session(ctx context.Context, req *types.QueryCovenantMuSig2SessionRequest) (*types.QueryCovenantMuSig2SessionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	delInfo, err := k.getBTCDelWithParams(ctx, req.StakingTxHashHex)
+	if err != nil {
+		return nil, err
+	}
+	if !delInfo.Params.CovenantMusig2Unbonding {
+		return nil, types.ErrCovenantMuSig2Disabled.Wrapf("params version: %d", delInfo.Delegation.ParamsVersion)
+	}
+
+	stakingTxHash := delInfo.Delegation.MustGetStakingTxHash()
+	resp := &types.QueryCovenantMuSig2SessionResponse{}
+
+	if aggSig := delInfo.Delegation.BtcUndelegation.CovenantUnbondingAggSig; aggSig != nil {
+		resp.AggregatedSigHex = aggSig.ToHexStr()
+		return resp, nil
+	}
+
+	hasAllNonces := true
+	for _, covPK := range delInfo.Params.CovenantPks {
+		key := collections.Join(stakingTxHash[:], covPK.MustMarshal())
+		nonce, err := k.covenantMuSig2Nonces.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			hasAllNonces = false
+			continue
+		} else if err != nil {
+			return nil, err
+		}
+
+		entry := &types.CovenantMuSig2SessionEntry{
+			CovenantBtcPkHex: covPK.MarshalHex(),
+			PubNonceHex:      hex.EncodeToString(nonce),
+		}
+		partialSig, err := k.covenantMuSig2PartialSigs.Get(ctx, key)
+		if err == nil {
+			entry.PartialSigHex = hex.EncodeToString(partialSig)
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		}
+		resp.Entries = append(resp.Entries, entry)
+	}
+
+	if hasAllNonces {
+		session, err := k.covenantMuSig2Session(ctx, delInfo, stakingTxHash[:])
+		if err != nil {
+			return nil, err
+		}
+		combinedNonce := session.CombinedNonce()
+		resp.AggregatedNonceHex = hex.EncodeToString(combinedNonce[:])
+	}
+
+	return resp, nil
+}
+
 // LargestBtcReOrg implements types.QueryServer.
 func (k Keeper) LargestBtcReOrg(ctx context.Context, _ *types.QueryLargestBtcReOrgRequest) (*types.QueryLargestBtcReOrgResponse, error) {
 	largestBtcReorg, err := k.LargestBtcReorg.Get(ctx)
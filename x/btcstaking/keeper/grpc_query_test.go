@@ -3,12 +3,14 @@ package keeper_test
 import (
 	"context"
 	"errors"
+	"math"
 	"math/rand"
 	"testing"
 	"time"
 
 	sdkmath "cosmossdk.io/math"
 
+	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/chaincfg"
 	"github.com/btcsuite/btcd/txscript"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -269,6 +271,292 @@ func FuzzFinalityProviderDelegations(f *testing.F) {
 	})
 }
 
+func FuzzDelegationsByStaker(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+		endHeight := uint32(datagen.RandomInt(r, 1000)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+		stakingTime := endHeight - startHeight
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: startHeight}).AnyTimes()
+
+		// Generate a random number of BTC delegations for a few stakers, with
+		// random covenant signatures
+		numStakers := int(datagen.RandomInt(r, 3)) + 1
+		stakerSKs := make([]*btcec.PrivateKey, 0, numStakers)
+		for i := 0; i < numStakers; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			stakerSKs = append(stakerSKs, delSK)
+		}
+		expectedStakingTxHashes := make(map[string]map[string]struct{})
+		numBTCDels := datagen.RandomInt(r, 20) + 1
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK := stakerSKs[r.Intn(numStakers)]
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				stakingTime, startHeight, endHeight, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			if r.Intn(2) == 0 {
+				btcDel.CovenantSigs = nil
+			}
+			require.NoError(t, keeper.AddBTCDelegation(ctx, btcDel))
+
+			stakerPkHex := btcDel.BtcPk.MarshalHex()
+			if expectedStakingTxHashes[stakerPkHex] == nil {
+				expectedStakingTxHashes[stakerPkHex] = make(map[string]struct{})
+			}
+			expectedStakingTxHashes[stakerPkHex][btcDel.MustGetStakingTxHash().String()] = struct{}{}
+		}
+
+		// Test nil and invalid requests
+		_, err = keeper.DelegationsByStaker(ctx, nil)
+		require.Error(t, err)
+		_, err = keeper.DelegationsByStaker(ctx, &types.QueryDelegationsByStakerRequest{})
+		require.Error(t, err)
+
+		for stakerPkHex, expectedHashes := range expectedStakingTxHashes {
+			// query all the BTC delegations of the staker page by page
+			limit := datagen.RandomInt(r, len(expectedHashes)) + 1
+			req := &types.QueryDelegationsByStakerRequest{
+				StakerBtcPkHex: stakerPkHex,
+				Status:         types.BTCDelegationStatus_ANY,
+				Pagination:     constructRequestWithLimit(r, limit),
+			}
+			btcDelsFound := make(map[string]*types.BTCDelegationResponse)
+			for {
+				resp, err := keeper.DelegationsByStaker(ctx, req)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(resp.BtcDelegations), int(limit))
+				for _, btcDel := range resp.BtcDelegations {
+					require.Equal(t, stakerPkHex, btcDel.BtcPk.MarshalHex())
+					btcDelsFound[btcDel.StakingTxHex] = btcDel
+				}
+				if resp.Pagination.NextKey == nil {
+					break
+				}
+				req.Pagination = constructRequestWithKeyAndLimit(r, resp.Pagination.NextKey, limit)
+			}
+			require.Len(t, btcDelsFound, len(expectedHashes))
+			numByStatus := make(map[types.BTCDelegationStatus]int)
+			for _, btcDel := range btcDelsFound {
+				stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.StakingTxHex)
+				require.NoError(t, err)
+				require.Contains(t, expectedHashes, stakingTx.TxHash().String())
+				status, ok := types.BTCDelegationStatus_value[btcDel.StatusDesc]
+				require.True(t, ok)
+				numByStatus[types.BTCDelegationStatus(status)]++
+			}
+
+			// query the BTC delegations of the staker under each status
+			for status, num := range numByStatus {
+				resp, err := keeper.DelegationsByStaker(ctx, &types.QueryDelegationsByStakerRequest{
+					StakerBtcPkHex: stakerPkHex,
+					Status:         status,
+				})
+				require.NoError(t, err)
+				require.Len(t, resp.BtcDelegations, num)
+				for _, btcDel := range resp.BtcDelegations {
+					require.Equal(t, status.String(), btcDel.StatusDesc)
+				}
+			}
+		}
+
+		// a staker without BTC delegations has none
+		_, otherPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		resp, err := keeper.DelegationsByStaker(ctx, &types.QueryDelegationsByStakerRequest{
+			StakerBtcPkHex: bbn.NewBIP340PubKeyFromBTCPK(otherPK).MarshalHex(),
+		})
+		require.NoError(t, err)
+		require.Empty(t, resp.BtcDelegations)
+	})
+}
+
+func FuzzDelegationsByBTCHeight(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Setup keeper and context
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+		keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+		// covenant and slashing addr
+		covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+		slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+		require.NoError(t, err)
+		slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+		require.NoError(t, err)
+		slashingChangeLockTime := uint16(101)
+		slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+		// Generate a finality provider
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		AddFinalityProvider(t, ctx, *keeper, fp)
+
+		startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+		btcTipHeight := startHeight + uint32(datagen.RandomInt(r, 10))
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: btcTipHeight}).AnyTimes()
+
+		// Generate a random number of BTC delegations with random end heights,
+		// and unbond some of them early
+		expectedExpiring := make(map[string]uint32)
+		expectedUnbonding := make(map[string]uint32)
+		numBTCDels := datagen.RandomInt(r, 30) + 1
+		for j := uint64(0); j < numBTCDels; j++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			endHeight := uint32(datagen.RandomInt(r, 200)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+			btcDel, err := datagen.GenRandomBTCDelegation(
+				r,
+				t,
+				net,
+				[]bbn.BIP340PubKey{*fp.BtcPk},
+				delSK,
+				covenantSKs,
+				covenantPKs,
+				covenantQuorum,
+				slashingPkScript,
+				endHeight-startHeight, startHeight, endHeight, 10000,
+				slashingRate,
+				slashingChangeLockTime,
+			)
+			require.NoError(t, err)
+			require.NoError(t, keeper.AddBTCDelegation(ctx, btcDel))
+			stakingTxHash := btcDel.MustGetStakingTxHash().String()
+
+			switch r.Intn(3) {
+			case 0:
+				// unbonded early via the registered unbonding tx
+				keeper.BtcUndelegate(ctx, btcDel, &types.DelegatorUnbondingInfo{SpendStakeTx: []byte{}})
+				expectedUnbonding[stakingTxHash] = btcTipHeight + btcDel.UnbondingTime
+			case 1:
+				// unbonded early via another stake spending tx
+				keeper.BtcUndelegate(ctx, btcDel, &types.DelegatorUnbondingInfo{SpendStakeTx: datagen.GenRandomByteArray(r, 32)})
+				expectedUnbonding[stakingTxHash] = btcTipHeight
+			default:
+				expectedExpiring[stakingTxHash] = btcDel.EndHeight - btcDel.UnbondingTime
+			}
+		}
+
+		// Test nil and invalid requests
+		_, err = keeper.ExpiringDelegations(ctx, nil)
+		require.Error(t, err)
+		_, err = keeper.ExpiringDelegations(ctx, &types.QueryExpiringDelegationsRequest{StartHeight: 2, EndHeight: 1})
+		require.Error(t, err)
+		_, err = keeper.UnbondingDelegations(ctx, nil)
+		require.Error(t, err)
+		_, err = keeper.UnbondingDelegations(ctx, &types.QueryUnbondingDelegationsRequest{StartHeight: 2, EndHeight: 1})
+		require.Error(t, err)
+
+		// query a random BTC height range page by page
+		queryRange := func(
+			queryFn func(start, end uint32, pagination *query.PageRequest) ([]*types.BTCDelegationAtHeightResponse, *query.PageResponse),
+			expected map[string]uint32,
+		) {
+			rangeStart := uint32(datagen.RandomInt(r, 400))
+			rangeEnd := rangeStart + uint32(datagen.RandomInt(r, 200))
+			limit := datagen.RandomInt(r, 10) + 1
+
+			var lastHeight uint32
+			found := make(map[string]uint32)
+			pagination := constructRequestWithLimit(r, limit)
+			for {
+				btcDels, pageRes := queryFn(rangeStart, rangeEnd, pagination)
+				require.LessOrEqual(t, len(btcDels), int(limit))
+				for _, btcDel := range btcDels {
+					// the delegations are ordered by BTC height
+					require.GreaterOrEqual(t, btcDel.BtcHeight, lastHeight)
+					lastHeight = btcDel.BtcHeight
+					stakingTx, _, err := bbn.NewBTCTxFromHex(btcDel.BtcDelegation.StakingTxHex)
+					require.NoError(t, err)
+					found[stakingTx.TxHash().String()] = btcDel.BtcHeight
+				}
+				if pageRes.NextKey == nil {
+					break
+				}
+				pagination = constructRequestWithKeyAndLimit(r, pageRes.NextKey, limit)
+			}
+
+			expectedInRange := make(map[string]uint32)
+			for stakingTxHash, height := range expected {
+				if height >= rangeStart && height <= rangeEnd {
+					expectedInRange[stakingTxHash] = height
+				}
+			}
+			require.Equal(t, expectedInRange, found)
+		}
+
+		queryRange(func(start, end uint32, pagination *query.PageRequest) ([]*types.BTCDelegationAtHeightResponse, *query.PageResponse) {
+			resp, err := keeper.ExpiringDelegations(ctx, &types.QueryExpiringDelegationsRequest{
+				StartHeight: start,
+				EndHeight:   end,
+				Pagination:  pagination,
+			})
+			require.NoError(t, err)
+			return resp.BtcDelegations, resp.Pagination
+		}, expectedExpiring)
+
+		queryRange(func(start, end uint32, pagination *query.PageRequest) ([]*types.BTCDelegationAtHeightResponse, *query.PageResponse) {
+			resp, err := keeper.UnbondingDelegations(ctx, &types.QueryUnbondingDelegationsRequest{
+				StartHeight: start,
+				EndHeight:   end,
+				Pagination:  pagination,
+			})
+			require.NoError(t, err)
+			return resp.BtcDelegations, resp.Pagination
+		}, expectedUnbonding)
+
+		// the whole BTC height range contains all the delegations
+		resp, err := keeper.ExpiringDelegations(ctx, &types.QueryExpiringDelegationsRequest{
+			EndHeight:  math.MaxUint32,
+			Pagination: &query.PageRequest{Limit: numBTCDels},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.BtcDelegations, len(expectedExpiring))
+	})
+}
+
 func FuzzPendingBTCDelegations(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -537,6 +825,127 @@ func TestCorrectParamsVersionIsUsed(t *testing.T) {
 	require.True(t, fpView.BtcDelegatorDelegations[0].Dels[0].Active)
 }
 
+func TestCovenantCommittees(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	btccKeeper.EXPECT().GetParams(gomock.Any()).Return(btcctypes.DefaultParams()).AnyTimes()
+	keeper, ctx := testkeeper.BTCStakingKeeper(t, btclcKeeper, btccKeeper, nil)
+
+	// covenant and slashing addr
+	covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, net)
+	require.NoError(t, err)
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	require.NoError(t, err)
+	slashingChangeLockTime := uint16(101)
+	slashingRate := sdkmath.LegacyNewDecWithPrec(int64(datagen.RandomInt(r, 41)+10), 2)
+
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	AddFinalityProvider(t, ctx, *keeper, fp)
+
+	startHeight := uint32(datagen.RandomInt(r, 100)) + 1
+	btcTipHeight := startHeight
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).DoAndReturn(func(_ context.Context) *btclctypes.BTCHeaderInfo {
+		return &btclctypes.BTCHeaderInfo{Height: btcTipHeight}
+	}).AnyTimes()
+
+	endHeight := uint32(datagen.RandomInt(r, 1000)) + startHeight + btcctypes.DefaultParams().CheckpointFinalizationTimeout + 1
+	stakingTime := endHeight - startHeight
+	addBTCDelegation := func(paramsVersion uint32, withCovenantSigs bool) {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			net,
+			[]bbn.BIP340PubKey{*fp.BtcPk},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingPkScript,
+			stakingTime, startHeight, endHeight, 10000,
+			slashingRate,
+			slashingChangeLockTime,
+		)
+		require.NoError(t, err)
+		btcDel.ParamsVersion = paramsVersion
+		if !withCovenantSigs {
+			btcDel.CovenantSigs = nil
+		}
+		require.NoError(t, keeper.AddBTCDelegation(ctx, btcDel))
+	}
+
+	// a pending and an active BTC delegation bound to the initial committee
+	addBTCDelegation(0, false)
+	addBTCDelegation(0, true)
+
+	// schedule the rotation of a covenant member
+	initParams := keeper.GetParams(ctx)
+	_, newCovPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	retiringCovPk := initParams.CovenantPks[0]
+	rotatedParams := initParams
+	rotatedParams.CovenantPks = append([]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(newCovPK)}, initParams.CovenantPks[1:]...)
+	rotatedParams.BtcActivationHeight = btcTipHeight + 10
+	require.NoError(t, keeper.SetParams(ctx, rotatedParams))
+
+	resp, err := keeper.CovenantCommittees(ctx, &types.QueryCovenantCommitteesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.CovenantCommittees, 2)
+	require.Equal(t, types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_ACTIVE, resp.CovenantCommittees[0].Status)
+	require.Equal(t, initParams.CovenantPksHex(), resp.CovenantCommittees[0].CovenantPksHex)
+	require.Equal(t, types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_SCHEDULED, resp.CovenantCommittees[1].Status)
+	require.Equal(t, rotatedParams.BtcActivationHeight, resp.CovenantCommittees[1].BtcActivationHeight)
+	require.Empty(t, resp.CovenantCommittees[1].RetiringCovenantPksHex)
+
+	// once the BTC tip reaches the activation height, the initial committee
+	// is retired
+	btcTipHeight = rotatedParams.BtcActivationHeight
+	addBTCDelegation(1, false)
+	resp, err = keeper.CovenantCommittees(ctx, &types.QueryCovenantCommitteesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.CovenantCommittees, 2)
+	require.Equal(t, types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_RETIRED, resp.CovenantCommittees[0].Status)
+	require.Equal(t, []string{retiringCovPk.MarshalHex()}, resp.CovenantCommittees[0].RetiringCovenantPksHex)
+	require.Equal(t, types.CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_ACTIVE, resp.CovenantCommittees[1].Status)
+
+	// the BTC delegations bound to each committee
+	delsResp, err := keeper.CovenantCommitteeDelegations(ctx, &types.QueryCovenantCommitteeDelegationsRequest{
+		ParamsVersion: 0,
+		Status:        types.BTCDelegationStatus_ANY,
+	})
+	require.NoError(t, err)
+	require.Len(t, delsResp.BtcDelegations, 2)
+	for _, btcDel := range delsResp.BtcDelegations {
+		require.Equal(t, uint32(0), btcDel.ParamsVersion)
+	}
+	delsResp, err = keeper.CovenantCommitteeDelegations(ctx, &types.QueryCovenantCommitteeDelegationsRequest{
+		ParamsVersion: 0,
+		Status:        types.BTCDelegationStatus_PENDING,
+	})
+	require.NoError(t, err)
+	require.Len(t, delsResp.BtcDelegations, 1)
+	delsResp, err = keeper.CovenantCommitteeDelegations(ctx, &types.QueryCovenantCommitteeDelegationsRequest{
+		ParamsVersion: 1,
+		Status:        types.BTCDelegationStatus_ANY,
+	})
+	require.NoError(t, err)
+	require.Len(t, delsResp.BtcDelegations, 1)
+	require.Equal(t, uint32(1), delsResp.BtcDelegations[0].ParamsVersion)
+
+	// an unknown parameters version has no covenant committee
+	_, err = keeper.CovenantCommitteeDelegations(ctx, &types.QueryCovenantCommitteeDelegationsRequest{
+		ParamsVersion: 2,
+	})
+	require.ErrorIs(t, err, types.ErrParamsNotFound)
+}
+
 func FuzzParamsVersions(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -88,8 +88,9 @@ func (k Keeper) VerifyInclusionProofAndGetHeight(
 // 4. It is not unbonded
 // 5. Verify inclusion proof
 // 6. The BTC start height of the BTC tx inclusion is higher or equal the informed tip of the btc del
-// 7. Updates start and end height
-// 8. Emit active event
+// 7. The delegated satoshis caps of the finality provider are not exceeded
+// 8. Updates start and end height
+// 9. Emit active event
 func (k Keeper) AddBTCDelegationInclusionProof(
 	ctx sdk.Context,
 	btcDel *types.BTCDelegation,
@@ -167,12 +168,40 @@ func (k Keeper) AddBTCDelegationInclusionProof(
 		)
 	}
 
-	// 7. set start height and end height and save it to db
+	// 7. check the delegated satoshis caps again, as the delegated satoshis
+	// may have changed since the creation of the delegation, and pending
+	// delegations are not counted in them
+	var replacedSats uint64
+	if btcDel.IsStakeExpansion() {
+		prevStakingTxHash, err := btcDel.StkExp.StakeExpansionTxHash()
+		if err != nil {
+			return err
+		}
+		replacedSats = k.stakeExpansionReplacedSats(ctx, prevStakingTxHash)
+	}
+	if err := k.checkDelegatedSatsCaps(
+		ctx,
+		params,
+		&btcDel.FpBtcPkList[0],
+		btcDel.StakerAddr,
+		btcDel.TotalSat,
+		replacedSats,
+	); err != nil {
+		return err
+	}
+
+	// 8. set start height and end height and save it to db
 	btcDel.StartHeight = timeInfo.StartHeight
 	btcDel.EndHeight = timeInfo.EndHeight
 	k.setBTCDelegation(ctx, btcDel)
+	if err := k.indexBTCDelegationExpiry(ctx, btcDel, stakingTxHash); err != nil {
+		return err
+	}
+	if err := k.addDelegatedSats(ctx, btcDel); err != nil {
+		return err
+	}
 
-	// 8. emit events
+	// 9. emit events
 	newInclusionProofEvent := types.NewInclusionProofEvent(
 		stakingTxHash.String(),
 		btcDel.StartHeight,
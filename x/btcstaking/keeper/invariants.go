@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// RegisterInvariants registers all btcstaking invariants
+//
+//nolint:staticcheck
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "btc-delegation-status", BTCDelegationStatusInvariant(k))
+}
+
+// AllInvariants runs all invariants of the btcstaking module
+//
+//nolint:staticcheck
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return BTCDelegationStatusInvariant(k)(ctx)
+	}
+}
+
+// BTCDelegationStatusInvariant checks that the status of each BTC delegation
+// is consistent with the BTC height indexed at the current Babylon height:
+//   - a BTC delegation with inclusion proof that is not expired at the
+//     indexed BTC height has its expiry event scheduled at the BTC height
+//     where it expires
+//   - no expiry event is left at or below the indexed BTC height, i.e., the
+//     expiry of every expired BTC delegation was processed
+//
+//nolint:staticcheck
+func BTCDelegationStatusInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		btcHeight := k.GetCurrentBTCHeight(ctx)
+
+		// staking tx hash -> BTC height of the pending expiry event
+		expiryEvents := make(map[string]uint32)
+		k.IterateAllPowerDistUpdateEvents(ctx, func(eventHeight uint32, event *types.EventPowerDistUpdate) bool {
+			delEvent := event.GetBtcDelStateUpdate()
+			if delEvent == nil || delEvent.NewState != types.BTCDelegationStatus_EXPIRED {
+				return true
+			}
+			if eventHeight <= btcHeight {
+				broken = true
+				msg += fmt.Sprintf("\tunprocessed expiry event of BTC delegation %s at BTC height %d, indexed BTC height %d\n",
+					delEvent.StakingTxHash, eventHeight, btcHeight)
+			}
+			expiryEvents[delEvent.StakingTxHash] = eventHeight
+			return true
+		})
+
+		err := k.IterateBTCDelegations(ctx, func(btcDel *types.BTCDelegation) error {
+			if !btcDel.HasInclusionProof() {
+				return nil
+			}
+			// same condition as the one of EXPIRED status
+			if btcHeight+btcDel.UnbondingTime >= btcDel.EndHeight {
+				return nil
+			}
+
+			stakingTxHash := btcDel.MustGetStakingTxHash().String()
+			expiryHeight := btcDel.EndHeight - btcDel.UnbondingTime
+			if eventHeight, ok := expiryEvents[stakingTxHash]; !ok || eventHeight != expiryHeight {
+				broken = true
+				msg += fmt.Sprintf("\tBTC delegation %s is not expired at indexed BTC height %d but its expiry event is not scheduled at BTC height %d\n",
+					stakingTxHash, btcHeight, expiryHeight)
+			}
+			return nil
+		})
+		if err != nil {
+			broken = true
+			msg += fmt.Sprintf("\tfailed to iterate BTC delegations: %s\n", err.Error())
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "btc-delegation-status", msg), broken
+	}
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/babylonlabs-io/babylon/v4/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+func FuzzBTCDelegationStatusInvariant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		_, fpPK, _ := h.CreateFinalityProvider(r)
+
+		// no BTC delegation
+		msg, broken := keeper.BTCDelegationStatusInvariant(*h.BTCStakingKeeper)(h.Ctx)
+		require.False(t, broken, msg)
+
+		// active BTC delegations have their expiry events scheduled
+		numDels := int(datagen.RandomInt(r, 3)) + 1
+		stakingTxHashes := make([]string, 0, numDels)
+		for i := 0; i < numDels; i++ {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			stakingValue := int64(datagen.RandomInt(r, 10e8)) + 10e8
+			stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+				r, delSK, fpPK, stakingValue, 1000, 0, 0, true, false, 10, 10,
+			)
+			h.NoError(err)
+			h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+			h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+			stakingTxHashes = append(stakingTxHashes, stakingTxHash)
+		}
+
+		btcTip := &btclctypes.BTCHeaderInfo{Height: 30}
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).DoAndReturn(
+			func(_ any) *btclctypes.BTCHeaderInfo { return btcTip },
+		).AnyTimes()
+		h.SetCtxHeight(datagen.RandomInt(r, 10) + 1)
+		h.BTCStakingKeeper.IndexBTCHeight(h.Ctx)
+		msg, broken = keeper.BTCDelegationStatusInvariant(*h.BTCStakingKeeper)(h.Ctx)
+		require.False(t, broken, msg)
+
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHashes[r.Intn(len(stakingTxHashes))])
+		h.NoError(err)
+		expiryHeight := btcDel.EndHeight - btcDel.UnbondingTime
+
+		// an expiry event left unprocessed at the indexed BTC height breaks
+		// the invariant
+		btcTip = &btclctypes.BTCHeaderInfo{Height: expiryHeight}
+		h.BTCStakingKeeper.IndexBTCHeight(h.Ctx)
+		_, broken = keeper.BTCDelegationStatusInvariant(*h.BTCStakingKeeper)(h.Ctx)
+		require.True(t, broken)
+
+		// a BTC delegation that is not expired without its expiry event
+		// breaks the invariant
+		btcTip = &btclctypes.BTCHeaderInfo{Height: 30}
+		h.BTCStakingKeeper.IndexBTCHeight(h.Ctx)
+		msg, broken = keeper.BTCDelegationStatusInvariant(*h.BTCStakingKeeper)(h.Ctx)
+		require.False(t, broken, msg)
+		h.BTCStakingKeeper.ClearPowerDistUpdateEvents(h.Ctx, expiryHeight)
+		_, broken = keeper.BTCDelegationStatusInvariant(*h.BTCStakingKeeper)(h.Ctx)
+		require.True(t, broken)
+	})
+}
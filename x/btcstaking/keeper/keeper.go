@@ -35,6 +35,53 @@ type (
 		finalityProvidersDeleted collections.KeySet[[]byte]
 		// heightToVersionMap key: HeightToVersionMapKey
 		heightToVersionMap collections.Item[types.HeightToVersionMap]
+		// covenantMuSig2Nonces key: (staking tx hash, covenant BIP340PubKey bytes)
+		// value: MuSig2 public nonce
+		covenantMuSig2Nonces collections.Map[collections.Pair[[]byte, []byte], []byte]
+		// covenantMuSig2PartialSigs key: (staking tx hash, covenant BIP340PubKey bytes)
+		// value: MuSig2 partial signature
+		covenantMuSig2PartialSigs collections.Map[collections.Pair[[]byte, []byte], []byte]
+		// fpEotsPks key: EOTS BIP340PubKey bytes a finality provider rotated to
+		// value: BIP340PubKey bytes of the finality provider
+		fpEotsPks collections.Map[[]byte, []byte]
+		// btcDelegationsByStaker key: (staker BIP340PubKey bytes, staking tx hash)
+		btcDelegationsByStaker collections.Map[collections.Pair[[]byte, []byte], collections.NoValue]
+		// btcDelegationsByExpiry key: (BTC height at which the delegation
+		// expires, i.e., end height minus unbonding time, staking tx hash)
+		btcDelegationsByExpiry collections.Map[collections.Pair[uint32, []byte], collections.NoValue]
+		// btcDelegationsByUnbonded key: (BTC height at which the early
+		// unbonding of the delegation completes, staking tx hash)
+		btcDelegationsByUnbonded collections.Map[collections.Pair[uint32, []byte], collections.NoValue]
+		// fpDelegatedSats key: finality provider BIP340PubKey bytes
+		// value: satoshis of the BTC delegations to the finality provider
+		// that are included on BTC and neither unbonded nor expired
+		fpDelegatedSats collections.Map[[]byte, uint64]
+		// totalDelegatedSats is the sum of the delegated satoshis of all the
+		// finality providers that are not slashed
+		totalDelegatedSats collections.Item[uint64]
+		// delegatedSatsBtcHeight is the BTC height up to which the expired
+		// BTC delegations are deducted from the delegated satoshis
+		delegatedSatsBtcHeight collections.Item[uint32]
+		// btcDelegationsByVersion key: (version of the parameters the
+		// delegation is bound to, staking tx hash)
+		btcDelegationsByVersion collections.Map[collections.Pair[uint32, []byte], collections.NoValue]
+		// covenantSigWaiting key: staking tx hash of a BTC delegation waiting
+		// for covenant signatures
+		// value: Babylon height at which the delegation was created
+		covenantSigWaiting collections.Map[[]byte, uint64]
+		// covenantSigWaitingByHeight key: (Babylon height at which the
+		// delegation was created, staking tx hash)
+		covenantSigWaitingByHeight collections.Map[collections.Pair[uint64, []byte], collections.NoValue]
+		// covenantSigLateByHeight key: (Babylon height at which the timed out
+		// delegation was created, staking tx hash)
+		covenantSigLateByHeight collections.Map[collections.Pair[uint64, []byte], collections.NoValue]
+		// covenantStats key: covenant BIP340PubKey bytes
+		// value: signature liveness stats of the covenant member
+		covenantStats collections.Map[[]byte, types.CovenantStats]
+		// fpSelfBondedSats key: finality provider BIP340PubKey bytes
+		// value: delegated satoshis of the finality provider that are staked
+		// from its own Babylon address
+		fpSelfBondedSats collections.Map[[]byte, uint64]
 
 		btcNet *chaincfg.Params
 		// the address capable of executing a MsgUpdateParams or
@@ -95,6 +142,109 @@ func NewKeeper(
 			"height_to_version_map",
 			codec.CollValue[types.HeightToVersionMap](cdc),
 		),
+		covenantMuSig2Nonces: collections.NewMap(
+			sb,
+			types.CovenantMuSig2NonceKey,
+			"covenant_musig2_nonces",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			collections.BytesValue,
+		),
+		covenantMuSig2PartialSigs: collections.NewMap(
+			sb,
+			types.CovenantMuSig2PartialSigKey,
+			"covenant_musig2_partial_sigs",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			collections.BytesValue,
+		),
+		fpEotsPks: collections.NewMap(
+			sb,
+			types.FpEotsPkKey,
+			"fp_eots_pks",
+			collections.BytesKey,
+			collections.BytesValue,
+		),
+		btcDelegationsByStaker: collections.NewMap(
+			sb,
+			types.BTCDelegationsByStakerKey,
+			"btc_delegations_by_staker",
+			collections.PairKeyCodec(collections.BytesKey, collections.BytesKey),
+			collections.NoValue{},
+		),
+		btcDelegationsByExpiry: collections.NewMap(
+			sb,
+			types.BTCDelegationsByExpiryKey,
+			"btc_delegations_by_expiry",
+			collections.PairKeyCodec(collections.Uint32Key, collections.BytesKey),
+			collections.NoValue{},
+		),
+		btcDelegationsByUnbonded: collections.NewMap(
+			sb,
+			types.BTCDelegationsByUnbondedKey,
+			"btc_delegations_by_unbonded",
+			collections.PairKeyCodec(collections.Uint32Key, collections.BytesKey),
+			collections.NoValue{},
+		),
+		fpDelegatedSats: collections.NewMap(
+			sb,
+			types.FpDelegatedSatsKey,
+			"fp_delegated_sats",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		totalDelegatedSats: collections.NewItem(
+			sb,
+			types.TotalDelegatedSatsKey,
+			"total_delegated_sats",
+			collections.Uint64Value,
+		),
+		delegatedSatsBtcHeight: collections.NewItem(
+			sb,
+			types.DelegatedSatsBtcHeightKey,
+			"delegated_sats_btc_height",
+			collections.Uint32Value,
+		),
+		btcDelegationsByVersion: collections.NewMap(
+			sb,
+			types.BTCDelegationsByVersionKey,
+			"btc_delegations_by_version",
+			collections.PairKeyCodec(collections.Uint32Key, collections.BytesKey),
+			collections.NoValue{},
+		),
+		covenantSigWaiting: collections.NewMap(
+			sb,
+			types.CovenantSigWaitingKey,
+			"covenant_sig_waiting",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
+		covenantSigWaitingByHeight: collections.NewMap(
+			sb,
+			types.CovenantSigWaitingHeightKey,
+			"covenant_sig_waiting_by_height",
+			collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey),
+			collections.NoValue{},
+		),
+		covenantSigLateByHeight: collections.NewMap(
+			sb,
+			types.CovenantSigLateHeightKey,
+			"covenant_sig_late_by_height",
+			collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey),
+			collections.NoValue{},
+		),
+		covenantStats: collections.NewMap(
+			sb,
+			types.CovenantStatsKey,
+			"covenant_stats",
+			collections.BytesKey,
+			codec.CollValue[types.CovenantStats](cdc),
+		),
+		fpSelfBondedSats: collections.NewMap(
+			sb,
+			types.FpSelfBondedSatsKey,
+			"fp_self_bonded_sats",
+			collections.BytesKey,
+			collections.Uint64Value,
+		),
 		btcNet:    btcNet,
 		authority: authority,
 	}
@@ -118,10 +268,19 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 // the voting power distribution cache used for computing voting power table
 // and distributing rewards once the block is finalised by finality providers.
 func (k Keeper) BeginBlocker(ctx context.Context) error {
+	btcTip := k.btclcKeeper.GetTipInfo(ctx)
+
 	// index BTC height at the current height
-	k.IndexBTCHeight(ctx)
+	k.indexBTCHeight(ctx, btcTip)
+
+	// process the BTC delegations expired up to the current BTC tip
+	if err := k.processExpiredBTCDelegations(ctx, btcTip); err != nil {
+		return err
+	}
 
-	return nil
+	// record the covenant members that missed the BTC delegations waiting
+	// for covenant signatures longer than the timeout
+	return k.processCovenantSigTimeouts(ctx)
 }
 
 func (k Keeper) BtccKeeper() types.BtcCheckpointKeeper {
@@ -1,10 +1,20 @@
 package keeper
 
 import (
-	v2 "github.com/babylonlabs-io/babylon/v4/x/btcstaking/migrations/v2"
+	"context"
+
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/babylonlabs-io/babylon/v4/x/btcstaking/migrations/v2"
+	v3 "github.com/babylonlabs-io/babylon/v4/x/btcstaking/migrations/v3"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
 )
 
+// backfillBatchSize is the number of BTC delegations read from the store at
+// once when backfilling the BTC delegation indexes
+const backfillBatchSize = 1000
+
 // Migrator is a struct for handling in-place store migrations.
 type Migrator struct {
 	k Keeper
@@ -21,3 +31,80 @@ func NewMigrator(k Keeper) Migrator {
 func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	return v2.MigrateStore(ctx, m.k.cdc, m.k.storeService.OpenKVStore(ctx), m.k)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.k, m.k.btclcKeeper.GetTipInfo(ctx).Height)
+}
+
+// BackfillBTCDelegations indexes all the existing BTC delegations by staker
+// BTC PK, by parameters version and, unless unbonded early, by expiry height,
+// and initialises the delegated satoshis with the ones included on BTC and
+// not expired at the given BTC height. The store is read in a single pass, in
+// batches of backfillBatchSize delegations, so that the indexes are not
+// written while iterating the store and the memory used does not grow with
+// the number of delegations.
+func (k Keeper) BackfillBTCDelegations(ctx context.Context, btcHeight uint32) error {
+	if err := k.delegatedSatsBtcHeight.Set(ctx, btcHeight); err != nil {
+		return err
+	}
+
+	store := k.btcDelegationStore(ctx)
+	var start []byte
+	for {
+		dels, next := k.readBTCDelegationsBatch(store, start)
+		for _, btcDel := range dels {
+			if err := k.backfillBTCDelegation(ctx, btcDel); err != nil {
+				return err
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		start = next
+	}
+}
+
+// readBTCDelegationsBatch reads up to backfillBatchSize BTC delegations from
+// the given store starting at the given key, and returns the key of the next
+// BTC delegation, or nil if all of them are read
+func (k Keeper) readBTCDelegationsBatch(store storetypes.KVStore, start []byte) ([]*types.BTCDelegation, []byte) {
+	iter := store.Iterator(start, nil)
+	defer iter.Close()
+
+	dels := make([]*types.BTCDelegation, 0, backfillBatchSize)
+	for ; iter.Valid() && len(dels) < backfillBatchSize; iter.Next() {
+		var btcDel types.BTCDelegation
+		k.cdc.MustUnmarshal(iter.Value(), &btcDel)
+		dels = append(dels, &btcDel)
+	}
+	if !iter.Valid() {
+		return dels, nil
+	}
+	return dels, append([]byte{}, iter.Key()...)
+}
+
+// backfillBTCDelegation indexes the given existing BTC delegation and adds it
+// to the delegated satoshis
+func (k Keeper) backfillBTCDelegation(ctx context.Context, btcDel *types.BTCDelegation) error {
+	stakingTxHash, err := btcDel.GetStakingTxHash()
+	if err != nil {
+		return err
+	}
+	if err := k.indexBTCDelegationByStaker(ctx, btcDel.BtcPk, stakingTxHash); err != nil {
+		return err
+	}
+	if err := k.indexBTCDelegationByVersion(ctx, btcDel.ParamsVersion, stakingTxHash); err != nil {
+		return err
+	}
+	// the delegations unbonded early are not indexed by expiry height, as
+	// the inclusion height of their stake spending tx is not persisted, and
+	// are already deducted from the delegated satoshis
+	if btcDel.IsUnbondedEarly() {
+		return nil
+	}
+	if err := k.indexBTCDelegationExpiry(ctx, btcDel, stakingTxHash); err != nil {
+		return err
+	}
+	return k.addDelegatedSats(ctx, btcDel)
+}
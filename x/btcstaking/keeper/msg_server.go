@@ -53,6 +53,11 @@ func (ms msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdatePara
 				unbondingTime, ckptFinalizationTime)
 	}
 
+	// ensure a rotation of the covenant committee is scheduled safely
+	if err := ms.validateCovenantRotation(ctx, &req.Params, req.SkipCovenantOverlapCheck); err != nil {
+		return nil, err
+	}
+
 	if err := ms.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -105,6 +110,9 @@ func (ms msgServer) EditFinalityProvider(goCtx context.Context, req *types.MsgEd
 
 	// all good, update the finality provider and set back
 	fp.Description = req.Description
+	if req.MaxDelegatedSats != nil {
+		fp.MaxDelegatedSats = req.MaxDelegatedSats.Uint64()
+	}
 
 	ms.SetFinalityProvider(goCtx, fp)
 
@@ -150,6 +158,13 @@ func (ms msgServer) BtcStakeExpand(goCtx context.Context, req *types.MsgBtcStake
 		return nil, status.Errorf(codes.InvalidArgument, "previous staking transaction is not active")
 	}
 
+	// the unbonding path of the previous staking output commits to the MuSig2
+	// aggregated covenant key, which is only signed for the unbonding tx, so
+	// the stake expansion tx could never be signed by the covenant committee
+	if delInfo.Params.CovenantMusig2Unbonding {
+		return nil, status.Errorf(codes.InvalidArgument, "stake expansion of BTC delegations with covenant MuSig2 unbonding is not supported")
+	}
+
 	if !strings.EqualFold(prevBtcDel.StakerAddr, req.StakerAddr) {
 		return nil, status.Errorf(codes.InvalidArgument, "the previous BTC staking transaction staker address: %s does not match with current staker address: %s", prevBtcDel.StakerAddr, req.StakerAddr)
 	}
@@ -267,7 +282,21 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		return nil, types.ErrInvalidCovenantPK.Wrapf("covenant pk: %s", req.Pk.MarshalHex())
 	}
 
-	if btcDel.IsSignedByCovMember(req.Pk) && btcDel.BtcUndelegation.IsSignedByCovMember(req.Pk) {
+	// with covenant MuSig2 unbonding, covenant members sign the unbonding tx
+	// only through MuSig2, as their individual signatures cannot satisfy the
+	// unbonding path committing to the aggregated covenant key
+	if params.CovenantMusig2Unbonding && req.UnbondingTxSig != nil {
+		return nil, types.ErrInvalidCovenantSig.Wrap("the unbonding tx must be signed through covenant MuSig2")
+	}
+	if !params.CovenantMusig2Unbonding && req.UnbondingTxSig == nil {
+		return nil, types.ErrInvalidCovenantSig.Wrap("empty covenant signature on the unbonding tx")
+	}
+
+	signedUndelegation := btcDel.BtcUndelegation.IsSignedByCovMember(req.Pk)
+	if params.CovenantMusig2Unbonding {
+		signedUndelegation = btcDel.BtcUndelegation.IsSignedByCovMemberOnSlashing(req.Pk)
+	}
+	if btcDel.IsSignedByCovMember(req.Pk) && signedUndelegation {
 		ms.Logger(ctx).Debug("Received duplicated covenant signature", "covenant pk", req.Pk.MarshalHex())
 		// return error if the covenant signature is already submitted
 		// this is to secure the tx refunding against duplicated messages
@@ -310,7 +339,7 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		stakingInfo.StakingOutput,
 		slashingSpendInfo,
 		req.Pk,
-		btcDel.FpBtcPkList,
+		btcDel.FpScriptPks(),
 		req.SlashingTxSigs,
 	)
 	if err != nil {
@@ -332,20 +361,22 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 	if err != nil {
 		panic(fmt.Errorf("failed to parse unbonding tx from existing delegation with hash %s : %v", req.StakingTxHash, err))
 	}
-	unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
-	if err != nil {
-		// our staking info was constructed by using BuildStakingInfo constructor, so if
-		// this fails, it is a programming error
-		panic(err)
-	}
-	if err := btcstaking.VerifyTransactionSigWithOutput(
-		unbondingMsgTx,
-		stakingInfo.StakingOutput,
-		unbondingSpendInfo.GetPkScriptPath(),
-		req.Pk.MustToBTCPK(),
-		*req.UnbondingTxSig,
-	); err != nil {
-		return nil, types.ErrInvalidCovenantSig.Wrap(err.Error())
+	if req.UnbondingTxSig != nil {
+		unbondingSpendInfo, err := stakingInfo.UnbondingPathSpendInfo()
+		if err != nil {
+			// our staking info was constructed by using BuildStakingInfo constructor, so if
+			// this fails, it is a programming error
+			panic(err)
+		}
+		if err := btcstaking.VerifyTransactionSigWithOutput(
+			unbondingMsgTx,
+			stakingInfo.StakingOutput,
+			unbondingSpendInfo.GetPkScriptPath(),
+			req.Pk.MustToBTCPK(),
+			*req.UnbondingTxSig,
+		); err != nil {
+			return nil, types.ErrInvalidCovenantSig.Wrap(err.Error())
+		}
 	}
 
 	/*
@@ -366,7 +397,7 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 		unbondingOutput,
 		unbondingSlashingSpendInfo,
 		req.Pk,
-		btcDel.FpBtcPkList,
+		btcDel.FpScriptPks(),
 		req.SlashingUnbondingTxSigs,
 	)
 	if err != nil {
@@ -401,6 +432,55 @@ func (ms msgServer) AddCovenantSigs(goCtx context.Context, req *types.MsgAddCove
 	return &types.MsgAddCovenantSigsResponse{}, nil
 }
 
+// AddCovenantMuSig2Nonce adds public nonce of a covenant member for MuSig2
+// signing of the unbonding tx of a BTC delegation
+func (ms msgServer) AddCovenantMuSig2Nonce(goCtx context.Context, req *types.MsgAddCovenantMuSig2Nonce) (*types.MsgAddCovenantMuSig2NonceResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddCovenantMuSig2Nonce)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delInfo, err := ms.getCovenantMuSig2Delegation(ctx, req.StakingTxHash, req.Pk)
+	if err != nil {
+		return nil, err
+	}
+
+	reset, err := ms.addCovenantMuSig2Nonce(ctx, delInfo, req.Pk, req.PubNonce)
+	if err != nil {
+		return nil, err
+	}
+
+	// the nonce is valid and not duplicated, so the message is refundable,
+	// unless it resets the signing session
+	if !reset {
+		ms.iKeeper.IndexRefundableMsg(ctx, req)
+	}
+
+	return &types.MsgAddCovenantMuSig2NonceResponse{}, nil
+}
+
+// AddCovenantMuSig2PartialSig adds MuSig2 partial signature of a covenant
+// member on the unbonding tx of a BTC delegation. Once partial signatures of
+// all covenant members are received, they are aggregated into a single
+// signature
+func (ms msgServer) AddCovenantMuSig2PartialSig(goCtx context.Context, req *types.MsgAddCovenantMuSig2PartialSig) (*types.MsgAddCovenantMuSig2PartialSigResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddCovenantMuSig2PartialSig)
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	delInfo, err := ms.getCovenantMuSig2Delegation(ctx, req.StakingTxHash, req.Pk)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.addCovenantMuSig2PartialSig(ctx, delInfo, req.Pk, req.PartialSig); err != nil {
+		return nil, err
+	}
+
+	// the partial signature is verified and not duplicated, so the message is
+	// refundable
+	ms.iKeeper.IndexRefundableMsg(ctx, req)
+
+	return &types.MsgAddCovenantMuSig2PartialSigResponse{}, nil
+}
+
 // validateStakeExpansionSig validates the covenant signature for a stake expansion.
 //
 // It performs the following checks:
@@ -673,7 +753,7 @@ func (ms msgServer) BTCUndelegate(goCtx context.Context, req *types.MsgBTCUndele
 
 	// all good, add the signature to BTC delegation's undelegation
 	// and set back
-	ms.btcUndelegate(ctx, btcDel, delegatorUnbondingInfo)
+	ms.btcUndelegate(ctx, btcDel, delegatorUnbondingInfo, stakerSpendigTxHeader.Height)
 
 	// At this point, the unbonding signature is verified.
 	// Thus, we can safely consider this message as refundable
@@ -13,6 +13,7 @@ import (
 	"github.com/btcsuite/btcd/btcec/v2"
 	"github.com/btcsuite/btcd/txscript"
 	"github.com/btcsuite/btcd/wire"
+	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/golang/mock/gomock"
@@ -71,6 +72,256 @@ func FuzzMsgServer_UpdateParams(f *testing.F) {
 	})
 }
 
+func TestCovenantStats(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+	// set all parameters, with a covenant signature timeout of 10 blocks
+	covenantSKs, _ := h.GenAndApplyParams(r)
+	params := h.BTCStakingKeeper.GetParams(h.Ctx)
+	params.CovenantSigTimeoutBlocks = 10
+	params.BtcActivationHeight++
+	h.NoError(h.BTCStakingKeeper.SetParams(h.Ctx, params))
+
+	// generate and insert new finality provider
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+
+	createDelegation := func() *types.BTCDelegation {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, btcDel, _, _, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			int64(2*10e8),
+			1000,
+			0,
+			0,
+			false,
+			false,
+			10,
+			30,
+		)
+		h.NoError(err)
+		return btcDel
+	}
+	addCovenantSig := func(msg *types.MsgAddCovenantSigs) {
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		_, err := h.MsgServer.AddCovenantSigs(h.Ctx, msg)
+		h.NoError(err)
+	}
+	beginBlock := func() {
+		btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+		h.NoError(h.BTCStakingKeeper.BeginBlocker(h.Ctx))
+	}
+
+	// create two delegations at height 100
+	h.SetCtxHeight(100)
+	del1 := createDelegation()
+	del2 := createDelegation()
+	msgs1 := h.GenerateCovenantSignaturesMessages(r, covenantSKs, del1)
+	msgs2 := h.GenerateCovenantSignaturesMessages(r, covenantSKs, del2)
+
+	// all covenant members sign the first delegation, some of them later
+	// than the others
+	h.SetCtxHeight(103)
+	for _, msg := range msgs1[:3] {
+		addCovenantSig(msg)
+	}
+	h.SetCtxHeight(105)
+	for _, msg := range msgs1[3:] {
+		addCovenantSig(msg)
+	}
+
+	// a single covenant member signs the second delegation
+	h.SetCtxHeight(104)
+	addCovenantSig(msgs2[0])
+
+	// the second delegation does not time out until it waits for more than
+	// the timeout
+	h.SetCtxHeight(110)
+	h.Ctx = h.Ctx.WithEventManager(sdk.NewEventManager())
+	beginBlock()
+	for _, event := range h.Ctx.EventManager().Events() {
+		require.False(t, testutilevents.IsEventType(abci.Event(event), &types.EventCovenantSignatureTimeout{}))
+	}
+
+	// the covenant members that did not sign the second delegation missed it
+	h.SetCtxHeight(111)
+	beginBlock()
+	var foundTimeoutEvent bool
+	for _, event := range h.Ctx.EventManager().Events() {
+		if testutilevents.IsEventType(abci.Event(event), &types.EventCovenantSignatureTimeout{}) {
+			foundTimeoutEvent = true
+			testutilevents.RequireEventAttribute(t, event, "staking_tx_hash", fmt.Sprintf("\"%s\"", del2.MustGetStakingTxHash().String()))
+			testutilevents.RequireEventAttribute(t, event, "waited_blocks", "\"11\"")
+		}
+	}
+	require.True(t, foundTimeoutEvent)
+
+	// the signatures submitted after the timeout are recorded as late
+	h.SetCtxHeight(112)
+	addCovenantSig(msgs2[1])
+
+	expectedStats := func(msg *types.MsgAddCovenantSigs, numSigs, totalLatency, maxLatency, numMissed, numLate uint64) {
+		require.Equal(t, types.CovenantStats{
+			NumSignatures:      numSigs,
+			TotalLatencyBlocks: totalLatency,
+			MaxLatencyBlocks:   maxLatency,
+			NumMissed:          numMissed,
+			NumLate:            numLate,
+		}, h.BTCStakingKeeper.GetCovenantStats(h.Ctx, msg.Pk))
+	}
+	expectedStats(msgs1[0], 2, 3+4, 4, 0, 0)
+	expectedStats(msgs1[1], 1, 3, 3, 0, 1)
+	expectedStats(msgs1[2], 1, 3, 3, 1, 0)
+	expectedStats(msgs1[3], 1, 5, 5, 1, 0)
+	expectedStats(msgs1[4], 1, 5, 5, 1, 0)
+
+	// the signatures submitted more than another timeout after the timeout
+	// are no longer tracked
+	h.SetCtxHeight(121)
+	beginBlock()
+	addCovenantSig(msgs2[2])
+	expectedStats(msgs1[2], 1, 3, 3, 1, 0)
+
+	resp, err := h.BTCStakingKeeper.CovenantStats(h.Ctx, &types.QueryCovenantStatsRequest{})
+	h.NoError(err)
+	require.Len(t, resp.CovenantStats, len(covenantSKs))
+	for _, stats := range resp.CovenantStats {
+		covPK, err := bbn.NewBIP340PubKeyFromHex(stats.CovenantBtcPkHex)
+		h.NoError(err)
+		s := h.BTCStakingKeeper.GetCovenantStats(h.Ctx, covPK)
+		require.Equal(t, s.NumSignatures, stats.NumSignatures)
+		require.Equal(t, s.TotalLatencyBlocks, stats.TotalLatencyBlocks)
+		require.Equal(t, s.MaxLatencyBlocks, stats.MaxLatencyBlocks)
+		require.Equal(t, s.NumMissed, stats.NumMissed)
+		require.Equal(t, s.NumLate, stats.NumLate)
+	}
+}
+
+func TestMsgServer_UpdateParamsCovenantRotation(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+	// set all parameters
+	covenantSKs, covenantPKs := h.GenAndApplyParams(r)
+
+	btcTipHeight := uint32(100)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).DoAndReturn(func(_ any) *btclctypes.BTCHeaderInfo {
+		return &btclctypes.BTCHeaderInfo{Height: btcTipHeight}
+	}).AnyTimes()
+
+	// an active BTC delegation bound to the initial covenant committee
+	initialParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+	_, fpPK, _ := h.CreateFinalityProvider(r)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	btcDel, err := datagen.GenRandomBTCDelegation(
+		r,
+		t,
+		h.Net,
+		[]bbn.BIP340PubKey{*bbn.NewBIP340PubKeyFromBTCPK(fpPK)},
+		delSK,
+		covenantSKs,
+		covenantPKs,
+		initialParams.Params.CovenantQuorum,
+		initialParams.Params.SlashingPkScript,
+		1000, 10, 1010, 10000,
+		initialParams.Params.SlashingRate,
+		uint16(initialParams.Params.UnbondingTimeBlocks),
+	)
+	require.NoError(t, err)
+	btcDel.ParamsVersion = initialParams.Version
+	require.NoError(t, h.BTCStakingKeeper.AddBTCDelegation(h.Ctx, btcDel))
+
+	currParams := h.BTCStakingKeeper.GetParams(h.Ctx)
+	updateParamsWithOverlapCheck := func(covenantPks []bbn.BIP340PubKey, btcActivationHeight uint32, skipOverlapCheck bool) error {
+		params := currParams
+		params.CovenantPks = covenantPks
+		params.BtcActivationHeight = btcActivationHeight
+		_, err := h.MsgServer.UpdateParams(h.Ctx, &types.MsgUpdateParams{
+			Authority:                appparams.AccGov.String(),
+			Params:                   params,
+			SkipCovenantOverlapCheck: skipOverlapCheck,
+		})
+		return err
+	}
+	updateParams := func(covenantPks []bbn.BIP340PubKey, btcActivationHeight uint32) error {
+		return updateParamsWithOverlapCheck(covenantPks, btcActivationHeight, false)
+	}
+
+	// replacing a single covenant member keeps a quorum of the current members
+	_, newCovPK, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+	newCovBIP340PK := bbn.NewBIP340PubKeyFromBTCPK(newCovPK)
+	rotatedCovPks := append([]bbn.BIP340PubKey{*newCovBIP340PK}, currParams.CovenantPks[1:]...)
+
+	// the rotation cannot be activated at or before the current BTC tip
+	err = updateParams(rotatedCovPks, btcTipHeight)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+
+	// the rotation cannot replace the whole covenant committee at once
+	_, newCovPKs, err := datagen.GenRandomBTCKeyPairs(r, len(currParams.CovenantPks))
+	require.NoError(t, err)
+	err = updateParams(bbn.NewBIP340PKsFromBTCPKs(newCovPKs), btcTipHeight+10)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+
+	// a rotation scheduled after the current BTC tip with enough overlap succeeds
+	err = updateParams(rotatedCovPks, btcTipHeight+10)
+	require.NoError(t, err)
+	require.Equal(t, rotatedCovPks, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantPks)
+
+	// updating other parameters does not rotate the covenant committee
+	currParams = h.BTCStakingKeeper.GetParams(h.Ctx)
+	err = updateParams(currParams.CovenantPks, btcTipHeight+20)
+	require.NoError(t, err)
+
+	// a rotation keeping a quorum of the current committee but not of the
+	// initial committee, which still governs the active BTC delegation, fails
+	quorum := int(currParams.CovenantQuorum)
+	_, freshCovPKs, err := datagen.GenRandomBTCKeyPairs(r, len(currParams.CovenantPks)-quorum)
+	require.NoError(t, err)
+	secondRotationCovPks := append([]bbn.BIP340PubKey{*newCovBIP340PK}, initialParams.Params.CovenantPks[1:quorum]...)
+	secondRotationCovPks = append(secondRotationCovPks, bbn.NewBIP340PKsFromBTCPKs(freshCovPKs)...)
+	err = updateParams(secondRotationCovPks, btcTipHeight+30)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+
+	// skipping the overlap check does not skip the activation height check
+	err = updateParamsWithOverlapCheck(secondRotationCovPks, btcTipHeight, true)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+
+	// the rotation succeeds once the BTC delegation expired
+	btcTipHeight = btcDel.EndHeight - btcDel.UnbondingTime
+	err = updateParams(secondRotationCovPks, btcTipHeight+10)
+	require.NoError(t, err)
+	require.Equal(t, secondRotationCovPks, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantPks)
+
+	// the governance can replace the whole covenant committee, e.g., if it is
+	// compromised, only by skipping the overlap check
+	currParams = h.BTCStakingKeeper.GetParams(h.Ctx)
+	_, replacementCovPKs, err := datagen.GenRandomBTCKeyPairs(r, len(currParams.CovenantPks))
+	require.NoError(t, err)
+	replacementCovPks := bbn.NewBIP340PKsFromBTCPKs(replacementCovPKs)
+	err = updateParams(replacementCovPks, btcTipHeight+20)
+	require.ErrorIs(t, err, types.ErrInvalidCovenantRotation)
+	err = updateParamsWithOverlapCheck(replacementCovPks, btcTipHeight+20, true)
+	require.NoError(t, err)
+	require.Equal(t, replacementCovPks, h.BTCStakingKeeper.GetParams(h.Ctx).CovenantPks)
+}
+
 func FuzzMsgCreateFinalityProvider(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 
@@ -1481,6 +1732,299 @@ func TestAllowList(t *testing.T) {
 	require.NotNil(t, msgCreateBTCDel2)
 }
 
+func TestFpDelegatedSatsCaps(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+	// set all parameters
+	h.GenAndApplyParams(r)
+
+	// generate and insert new finality providers
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+	_, fpPK1, fp1 := h.CreateFinalityProvider(r)
+
+	stakingValue := int64(2 * 10e8)
+	createDelegation := func(fpPK *btcec.PublicKey) error {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, _, _, _, _, err = h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			stakingValue,
+			1000,
+			0,
+			0,
+			false,
+			false,
+			10,
+			30,
+		)
+		return err
+	}
+
+	// the delegation included on BTC is counted in the delegated sats
+	h.NoError(createDelegation(fpPK))
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetTotalDelegatedSats(h.Ctx))
+
+	// cap the delegated sats of the finality provider below two delegations
+	h.Ctx = h.Ctx.WithBlockTime(h.Ctx.BlockTime().Add(25 * time.Hour))
+	maxDelegatedSats := sdkmath.NewInt(stakingValue + stakingValue/2)
+	_, err := h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+		Addr:             fp.Addr,
+		BtcPk:            *fp.BtcPk,
+		Description:      fp.Description,
+		Commission:       fp.Commission,
+		MaxDelegatedSats: &maxDelegatedSats,
+	})
+	h.NoError(err)
+	require.ErrorIs(t, createDelegation(fpPK), types.ErrFpDelegationCapExceeded)
+
+	// remove the cap, and cap the ratio of the total delegated sats instead
+	h.Ctx = h.Ctx.WithBlockTime(h.Ctx.BlockTime().Add(25 * time.Hour))
+	noCap := sdkmath.ZeroInt()
+	_, err = h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+		Addr:             fp.Addr,
+		BtcPk:            *fp.BtcPk,
+		Description:      fp.Description,
+		Commission:       fp.Commission,
+		MaxDelegatedSats: &noCap,
+	})
+	h.NoError(err)
+	storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+	storedParams.Params.MaxFpStakeRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+	storedParams.Params.FpStakeRatioMinTotalSats = uint64(stakingValue)
+	h.NoError(h.BTCStakingKeeper.OverwriteParamsAtVersion(h.Ctx, storedParams.Version, storedParams.Params))
+
+	// the other finality provider can be delegated up to half of the total
+	h.NoError(createDelegation(fpPK1))
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp1.BtcPk))
+	require.Equal(t, uint64(2*stakingValue), h.BTCStakingKeeper.GetTotalDelegatedSats(h.Ctx))
+	require.ErrorIs(t, createDelegation(fpPK), types.ErrFpStakeRatioExceeded)
+
+	// the delegated sats are reported in the finality provider query
+	resp, err := h.BTCStakingKeeper.FinalityProvider(h.Ctx, &types.QueryFinalityProviderRequest{FpBtcPkHex: fp.BtcPk.MarshalHex()})
+	h.NoError(err)
+	require.Equal(t, uint64(stakingValue), resp.FinalityProvider.DelegatedSats)
+	require.Zero(t, resp.FinalityProvider.MaxDelegatedSats)
+
+	// the expired delegations are deducted from the delegated sats
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 2000}).AnyTimes()
+	h.NoError(h.BTCStakingKeeper.BeginBlocker(h.Ctx))
+	require.Zero(t, h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+	require.Zero(t, h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp1.BtcPk))
+	require.Zero(t, h.BTCStakingKeeper.GetTotalDelegatedSats(h.Ctx))
+}
+
+func TestFpStakeRatioBootstrap(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+	// set all parameters, capping the ratio of the total delegated sats from
+	// two delegations on
+	h.GenAndApplyParams(r)
+	stakingValue := int64(2 * 10e8)
+	storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+	storedParams.Params.MaxFpStakeRatio = sdkmath.LegacyNewDecWithPrec(5, 1)
+	storedParams.Params.FpStakeRatioMinTotalSats = uint64(2 * stakingValue)
+	h.NoError(storedParams.Params.Validate())
+	h.NoError(h.BTCStakingKeeper.OverwriteParamsAtVersion(h.Ctx, storedParams.Version, storedParams.Params))
+
+	// generate and insert new finality providers
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+	_, fpPK1, _ := h.CreateFinalityProvider(r)
+
+	createDelegation := func(fpPK *btcec.PublicKey) error {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, _, _, _, _, err = h.CreateDelegationWithBtcBlockHeight(
+			r,
+			delSK,
+			fpPK,
+			stakingValue,
+			1000,
+			0,
+			0,
+			false,
+			false,
+			10,
+			30,
+		)
+		return err
+	}
+
+	// the first delegations are accepted although a single finality provider
+	// is delegated all of the total delegated sats
+	h.NoError(createDelegation(fpPK))
+	h.NoError(createDelegation(fpPK))
+	require.Equal(t, uint64(2*stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+	require.Equal(t, uint64(2*stakingValue), h.BTCStakingKeeper.GetTotalDelegatedSats(h.Ctx))
+
+	// the ratio is enforced once the total delegated sats reach the minimum
+	require.ErrorIs(t, createDelegation(fpPK), types.ErrFpStakeRatioExceeded)
+	h.NoError(createDelegation(fpPK1))
+
+	// a ratio below 1 requires a positive minimum, as no delegation could be
+	// accepted otherwise
+	storedParams.Params.FpStakeRatioMinTotalSats = 0
+	require.ErrorContains(t, storedParams.Params.Validate(), "must be positive")
+}
+
+func TestFpSelfBond(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+	// set all parameters, requiring a self-bond of one delegation
+	h.GenAndApplyParams(r)
+	stakingValue := int64(2 * 10e8)
+	storedParams := h.BTCStakingKeeper.GetParamsWithVersion(h.Ctx)
+	storedParams.Params.MinFpSelfBondSats = uint64(stakingValue)
+	h.NoError(h.BTCStakingKeeper.OverwriteParamsAtVersion(h.Ctx, storedParams.Version, storedParams.Params))
+
+	// generate and insert new finality provider
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+
+	createDelegation := func(staker sdk.AccAddress) error {
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		h.NoError(err)
+		_, _, _, _, _, _, err = h.CreateDelegationFromStaker(
+			r,
+			staker,
+			delSK,
+			fpPK,
+			stakingValue,
+			1000,
+			0,
+			0,
+			false,
+			false,
+			10,
+			30,
+		)
+		return err
+	}
+
+	// other stakers cannot delegate before the finality provider self-bonds
+	otherStaker := datagen.GenRandomAddress()
+	require.ErrorIs(t, createDelegation(otherStaker), types.ErrFpSelfBondTooLow)
+
+	// the finality provider can always delegate to itself
+	h.NoError(createDelegation(fp.Address()))
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpSelfBondedSats(h.Ctx, fp.BtcPk))
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+
+	// other stakers can delegate once the finality provider self-bonded,
+	// which does not count in its self-bond
+	h.NoError(createDelegation(otherStaker))
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpSelfBondedSats(h.Ctx, fp.BtcPk))
+	require.Equal(t, uint64(2*stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+
+	// the self-bond is deducted once it expires
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 2000}).AnyTimes()
+	h.NoError(h.BTCStakingKeeper.BeginBlocker(h.Ctx))
+	require.Zero(t, h.BTCStakingKeeper.GetFpSelfBondedSats(h.Ctx, fp.BtcPk))
+}
+
+func TestFpDelegatedSatsCapsAtInclusion(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// mock BTC light client and BTC checkpoint modules
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btccKeeper := types.NewMockBtcCheckpointKeeper(ctrl)
+	h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+
+	// set all parameters
+	covenantSKs, _ := h.GenAndApplyParams(r)
+
+	// generate and insert new finality provider, capped below two delegations
+	_, fpPK, fp := h.CreateFinalityProvider(r)
+	stakingValue := int64(2 * 10e8)
+	h.Ctx = h.Ctx.WithBlockTime(h.Ctx.BlockTime().Add(25 * time.Hour))
+	maxDelegatedSats := sdkmath.NewInt(stakingValue + stakingValue/2)
+	_, err := h.MsgServer.EditFinalityProvider(h.Ctx, &types.MsgEditFinalityProvider{
+		Addr:             fp.Addr,
+		BtcPk:            *fp.BtcPk,
+		Description:      fp.Description,
+		Commission:       fp.Commission,
+		MaxDelegatedSats: &maxDelegatedSats,
+	})
+	h.NoError(err)
+
+	// the pending delegation is not counted in the delegated sats, hence both
+	// delegations are accepted upon creation
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	stakingTxHash, msgCreateBTCDel, pendingDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+		r,
+		delSK,
+		fpPK,
+		stakingValue,
+		1000,
+		0,
+		0,
+		true,
+		false,
+		10,
+		10,
+	)
+	h.NoError(err)
+	h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, pendingDel, 10)
+
+	delSK1, _, err := datagen.GenRandomBTCKeyPair(r)
+	h.NoError(err)
+	_, _, _, _, _, _, err = h.CreateDelegationWithBtcBlockHeight(
+		r,
+		delSK1,
+		fpPK,
+		stakingValue,
+		1000,
+		0,
+		0,
+		false,
+		false,
+		10,
+		30,
+	)
+	h.NoError(err)
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+
+	// the cap is enforced once the pending delegation is included on BTC
+	h.BTCLightClientKeeper.EXPECT().GetHeaderByHash(gomock.Eq(h.Ctx), gomock.Eq(btcHeaderInfo.Header.Hash())).Return(btcHeaderInfo, nil).AnyTimes()
+	h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(&btclctypes.BTCHeaderInfo{Height: 30})
+	_, err = h.MsgServer.AddBTCDelegationInclusionProof(h.Ctx, &types.MsgAddBTCDelegationInclusionProof{
+		StakingTxHash:           stakingTxHash,
+		StakingTxInclusionProof: inclusionProof,
+	})
+	require.ErrorIs(t, err, types.ErrFpDelegationCapExceeded)
+	require.Equal(t, uint64(stakingValue), h.BTCStakingKeeper.GetFpDelegatedSats(h.Ctx, fp.BtcPk))
+
+	// the pending delegation stays verified
+	pendingDel, err = h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+	h.NoError(err)
+	require.False(t, pendingDel.HasInclusionProof())
+}
+
 func createNDelegationsForFinalityProvider(
 	r *rand.Rand,
 	t *testing.T,
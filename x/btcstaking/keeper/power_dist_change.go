@@ -92,6 +92,27 @@ func (k Keeper) iteratePowerDistUpdateEvents(
 	}
 }
 
+// IterateAllPowerDistUpdateEvents uses the given handler function to handle
+// each voting power distribution update event that is not cleared yet, in the
+// ascending order of BTC heights
+func (k Keeper) IterateAllPowerDistUpdateEvents(
+	ctx context.Context,
+	handleFunc func(btcHeight uint32, event *types.EventPowerDistUpdate) bool,
+) {
+	iter := k.powerDistUpdateEventStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		// key is (BTC height || event index), both uint64 big endian
+		btcHeight := sdk.BigEndianToUint64(iter.Key()[:8])
+		var event types.EventPowerDistUpdate
+		k.cdc.MustUnmarshal(iter.Value(), &event)
+		shouldContinue := handleFunc(uint32(btcHeight), &event)
+		if !shouldContinue {
+			break
+		}
+	}
+}
+
 func (k Keeper) PowerDistUpdateEventBtcHeightStoreIterator(ctx context.Context, btcHeight uint32) storetypes.Iterator {
 	store := k.powerDistUpdateEventBtcHeightStore(ctx, btcHeight)
 	return store.Iterator(nil, nil)
@@ -0,0 +1,53 @@
+package v3
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// Keeper the expected keeper interface to perform the migration
+type Keeper interface {
+	BackfillBTCDelegations(ctx context.Context, btcHeight uint32) error
+	GetParamsWithVersion(ctx context.Context) types.StoredParams
+	OverwriteParamsAtVersion(ctx context.Context, v uint32, p types.Params) error
+}
+
+// MigrateStore performs in-place store migrations.
+// Migration backfills the indexes of the BTC delegations by staker BTC PK,
+// by expiry height and by parameters version with the existing BTC
+// delegations, initialises the delegated satoshis with the delegations
+// active at the given BTC tip, and sets the covenant signature timeout of the
+// latest parameters.
+//
+// The backfill reads every BTC delegation once and writes up to 4 keys per
+// delegation, i.e., the 3 index entries and the delegated satoshis of its
+// finality provider. TestMigrateStoreLargeFixture measures about 33k gas and
+// 0.1 ms per BTC delegation, i.e., about 3.3B gas and 10 seconds for 100k BTC
+// delegations. As the migration runs in the upgrade block with an infinite
+// gas meter, the gas only bounds the work done rather than failing the
+// upgrade.
+func MigrateStore(
+	ctx sdk.Context,
+	k Keeper,
+	btcTipHeight uint32,
+) error {
+	if err := k.BackfillBTCDelegations(ctx, btcTipHeight); err != nil {
+		return err
+	}
+	return setCovenantSigTimeout(ctx, k)
+}
+
+// setCovenantSigTimeout sets the covenant signature timeout of the latest
+// parameters to the default one, if not set. The parameters are overwritten
+// in place, as the timeout does not affect the validity of BTC delegations.
+func setCovenantSigTimeout(ctx context.Context, k Keeper) error {
+	sp := k.GetParamsWithVersion(ctx)
+	if sp.Params.CovenantSigTimeoutBlocks != 0 {
+		return nil
+	}
+	sp.Params.CovenantSigTimeoutBlocks = types.DefaultParams().CovenantSigTimeoutBlocks
+	return k.OverwriteParamsAtVersion(ctx, sp.Version, sp.Params)
+}
@@ -0,0 +1,231 @@
+package v3_test
+
+import (
+	"encoding/hex"
+	"math/rand"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/btcsuite/btcd/chaincfg"
+	"github.com/btcsuite/btcd/txscript"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	v3 "github.com/babylonlabs-io/babylon/v4/x/btcstaking/migrations/v3"
+	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+)
+
+// maxMigrationGasPerBTCDel is the maximum gas consumed by the migration per
+// BTC delegation
+const maxMigrationGasPerBTCDel = 40000
+
+func TestMigrateStore(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+	btcstakingKeeper, ctx, cdc, kvStore := keepertest.BTCStakingKeeperWithStoreService(t, btclcKeeper, nil, nil)
+	// parameters stored before the covenant signature timeout exists
+	params := types.DefaultParams()
+	params.CovenantSigTimeoutBlocks = 0
+	require.NoError(t, btcstakingKeeper.SetParams(ctx, params))
+
+	covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, &chaincfg.SimNetParams)
+	require.NoError(t, err)
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	require.NoError(t, err)
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+	btcstakingKeeper.SetFinalityProvider(ctx, fp)
+	fpPK := fp.BtcPk
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+
+	// BTC delegations stored before the index by staker BTC PK exists
+	numBTCDels := 5
+	expectedStakingTxHexes := make(map[string]struct{}, numBTCDels)
+	var (
+		stakerPkHex  string
+		expectedSats uint64
+	)
+	for i := 0; i < numBTCDels; i++ {
+		btcDel, err := datagen.GenRandomBTCDelegation(
+			r,
+			t,
+			&chaincfg.SimNetParams,
+			[]bbn.BIP340PubKey{*fpPK},
+			delSK,
+			covenantSKs,
+			covenantPKs,
+			covenantQuorum,
+			slashingPkScript,
+			1000, 10, 1010, 10000,
+			sdkmath.LegacyNewDecWithPrec(1, 1),
+			101,
+		)
+		require.NoError(t, err)
+		stakingTxHash := btcDel.MustGetStakingTxHash()
+		key := append(append([]byte{}, types.BTCDelegationKey...), stakingTxHash[:]...)
+		require.NoError(t, kvStore.Set(key, cdc.MustMarshal(btcDel)))
+		expectedStakingTxHexes[hex.EncodeToString(btcDel.StakingTx)] = struct{}{}
+		stakerPkHex = btcDel.BtcPk.MarshalHex()
+		expectedSats += btcDel.TotalSat
+	}
+
+	req := &types.QueryDelegationsByStakerRequest{
+		StakerBtcPkHex: stakerPkHex,
+		Status:         types.BTCDelegationStatus_ANY,
+	}
+	resp, err := btcstakingKeeper.DelegationsByStaker(ctx, req)
+	require.NoError(t, err)
+	require.Empty(t, resp.BtcDelegations)
+	expiringReq := &types.QueryExpiringDelegationsRequest{
+		StartHeight: 1010 - 101,
+		EndHeight:   1010 - 101,
+	}
+	expiringResp, err := btcstakingKeeper.ExpiringDelegations(ctx, expiringReq)
+	require.NoError(t, err)
+	require.Empty(t, expiringResp.BtcDelegations)
+	committeeReq := &types.QueryCovenantCommitteeDelegationsRequest{
+		ParamsVersion: 0,
+		Status:        types.BTCDelegationStatus_ANY,
+	}
+	committeeResp, err := btcstakingKeeper.CovenantCommitteeDelegations(ctx, committeeReq)
+	require.NoError(t, err)
+	require.Empty(t, committeeResp.BtcDelegations)
+
+	require.NoError(t, v3.MigrateStore(ctx, btcstakingKeeper, 1))
+
+	resp, err = btcstakingKeeper.DelegationsByStaker(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, resp.BtcDelegations, numBTCDels)
+	for _, btcDel := range resp.BtcDelegations {
+		require.Contains(t, expectedStakingTxHexes, btcDel.StakingTxHex)
+	}
+
+	// the BTC delegations expire at end height minus unbonding time
+	expiringResp, err = btcstakingKeeper.ExpiringDelegations(ctx, expiringReq)
+	require.NoError(t, err)
+	require.Len(t, expiringResp.BtcDelegations, numBTCDels)
+	for _, btcDel := range expiringResp.BtcDelegations {
+		require.Equal(t, uint32(1010-101), btcDel.BtcHeight)
+		require.Contains(t, expectedStakingTxHexes, btcDel.BtcDelegation.StakingTxHex)
+	}
+
+	// the BTC delegations are bound to the covenant committee of their
+	// parameters version
+	committeeResp, err = btcstakingKeeper.CovenantCommitteeDelegations(ctx, committeeReq)
+	require.NoError(t, err)
+	require.Len(t, committeeResp.BtcDelegations, numBTCDels)
+
+	// the BTC delegations active at the BTC tip are delegated to the finality
+	// provider
+	require.Equal(t, expectedSats, btcstakingKeeper.GetFpDelegatedSats(ctx, fpPK))
+	require.Equal(t, expectedSats, btcstakingKeeper.GetTotalDelegatedSats(ctx))
+
+	// the covenant signature timeout is set in place on the latest parameters
+	sp := btcstakingKeeper.GetParamsWithVersion(ctx)
+	require.Zero(t, sp.Version)
+	require.Equal(t, types.DefaultParams().CovenantSigTimeoutBlocks, sp.Params.CovenantSigTimeoutBlocks)
+}
+
+// TestMigrateStoreLargeFixture runs the migration against a large number of
+// BTC delegations generated at runtime, spanning many batches of the
+// backfill, and bounds the gas consumed per BTC delegation
+func TestMigrateStoreLargeFixture(t *testing.T) {
+	r := rand.New(rand.NewSource(10))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	btclcKeeper := types.NewMockBTCLightClientKeeper(ctrl)
+	btclcKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(&btclctypes.BTCHeaderInfo{Height: 1}).AnyTimes()
+	btcstakingKeeper, ctx, cdc, kvStore := keepertest.BTCStakingKeeperWithStoreService(t, btclcKeeper, nil, nil)
+	require.NoError(t, btcstakingKeeper.SetParams(ctx, types.DefaultParams()))
+
+	covenantSKs, covenantPKs, covenantQuorum := datagen.GenCovenantCommittee(r)
+	slashingAddress, err := datagen.GenRandomBTCAddress(r, &chaincfg.SimNetParams)
+	require.NoError(t, err)
+	slashingPkScript, err := txscript.PayToAddrScript(slashingAddress)
+	require.NoError(t, err)
+	delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+	require.NoError(t, err)
+
+	// BTC delegations to a few finality providers, copied from a random one
+	// with distinct staking txs so that generating them stays cheap
+	numFPs := 10
+	fpPKs := make([]*bbn.BIP340PubKey, 0, numFPs)
+	for i := 0; i < numFPs; i++ {
+		fp, err := datagen.GenRandomFinalityProvider(r)
+		require.NoError(t, err)
+		btcstakingKeeper.SetFinalityProvider(ctx, fp)
+		fpPKs = append(fpPKs, fp.BtcPk)
+	}
+	baseDel, err := datagen.GenRandomBTCDelegation(
+		r,
+		t,
+		&chaincfg.SimNetParams,
+		[]bbn.BIP340PubKey{*fpPKs[0]},
+		delSK,
+		covenantSKs,
+		covenantPKs,
+		covenantQuorum,
+		slashingPkScript,
+		1000, 10, 1010, 10000,
+		sdkmath.LegacyNewDecWithPrec(1, 1),
+		101,
+	)
+	require.NoError(t, err)
+
+	numBTCDels := 20000
+	for i := 0; i < numBTCDels; i++ {
+		btcDel := *baseDel
+		btcDel.FpBtcPkList = []bbn.BIP340PubKey{*fpPKs[i%numFPs]}
+		stakingTx := baseDel.MustGetStakingTx()
+		stakingTx.LockTime = uint32(i)
+		btcDel.StakingTx, err = bbn.SerializeBTCTx(stakingTx)
+		require.NoError(t, err)
+		stakingTxHash := stakingTx.TxHash()
+		key := append(append([]byte{}, types.BTCDelegationKey...), stakingTxHash[:]...)
+		require.NoError(t, kvStore.Set(key, cdc.MustMarshal(&btcDel)))
+	}
+
+	gasBefore := ctx.GasMeter().GasConsumed()
+	start := time.Now()
+	require.NoError(t, v3.MigrateStore(ctx, btcstakingKeeper, 1))
+	elapsed := time.Since(start)
+	gasPerDel := (ctx.GasMeter().GasConsumed() - gasBefore) / uint64(numBTCDels)
+	t.Logf("migrated %d BTC delegations in %s, %s and %d gas per BTC delegation",
+		numBTCDels, elapsed, elapsed/time.Duration(numBTCDels), gasPerDel)
+
+	// every BTC delegation is indexed and delegated to its finality provider
+	expiringResp, err := btcstakingKeeper.ExpiringDelegations(ctx, &types.QueryExpiringDelegationsRequest{
+		StartHeight: 1010 - 101,
+		EndHeight:   1010 - 101,
+		Pagination:  &query.PageRequest{CountTotal: true, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(numBTCDels), expiringResp.Pagination.Total)
+	committeeResp, err := btcstakingKeeper.CovenantCommitteeDelegations(ctx, &types.QueryCovenantCommitteeDelegationsRequest{
+		ParamsVersion: 0,
+		Status:        types.BTCDelegationStatus_ANY,
+		Pagination:    &query.PageRequest{CountTotal: true, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(numBTCDels), committeeResp.Pagination.Total)
+	for _, fpPK := range fpPKs {
+		require.Equal(t, uint64(numBTCDels/numFPs)*baseDel.TotalSat, btcstakingKeeper.GetFpDelegatedSats(ctx, fpPK))
+	}
+	require.Equal(t, uint64(numBTCDels)*baseDel.TotalSat, btcstakingKeeper.GetTotalDelegatedSats(ctx))
+
+	// the gas consumed grows linearly with the number of BTC delegations
+	require.LessOrEqual(t, gasPerDel, uint64(maxMigrationGasPerBTCDel))
+}
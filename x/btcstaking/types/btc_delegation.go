@@ -107,6 +107,16 @@ func (d *BTCDelegation) FinalityProviderKeys() []string {
 	return fpPks
 }
 
+// FpScriptPks returns the PKs of the finality providers that the staking,
+// slashing and unbonding scripts of the BTC delegation commit to, i.e., the
+// EOTS PK of the finality provider at the creation of the BTC delegation
+func (d *BTCDelegation) FpScriptPks() []bbn.BIP340PubKey {
+	if d.FpEotsPk == nil {
+		return d.FpBtcPkList
+	}
+	return []bbn.BIP340PubKey{*d.FpEotsPk}
+}
+
 // GetStatus returns the status of the BTC Delegation based on BTC height,
 // unbonding time, and covenant quorum
 // Pending: the BTC height is in the range of d's [startHeight, endHeight-unbondingTime]
@@ -251,6 +261,14 @@ func (d *BTCDelegation) ValidateBasic() error {
 	if duplicate {
 		return fmt.Errorf("list of finality provider PKs has duplication")
 	}
+	if d.FpEotsPk != nil {
+		if len(d.FpBtcPkList) != 1 {
+			return fmt.Errorf("the EOTS PK of the finality provider is set for a BTC delegation to %d finality providers", len(d.FpBtcPkList))
+		}
+		if _, err := d.FpEotsPk.ToBTCPK(); err != nil {
+			return fmt.Errorf("FpEotsPk is not correctly formatted: %w", err)
+		}
+	}
 	if d.StakingTx == nil {
 		return fmt.Errorf("empty staking tx")
 	}
@@ -344,7 +362,7 @@ func (d *BTCDelegation) AddCovenantSigs(
 // the staking info can be used for constructing witness of slashing tx
 // with access to a finality provider's SK
 func (d *BTCDelegation) GetStakingInfo(bsParams *Params, btcNet *chaincfg.Params) (*btcstaking.StakingInfo, error) {
-	fpBtcPkList, err := bbn.NewBTCPKsFromBIP340PKs(d.FpBtcPkList)
+	fpBtcPkList, err := bbn.NewBTCPKsFromBIP340PKs(d.FpScriptPks())
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert finality provider pks to BTC pks %v", err)
 	}
@@ -352,11 +370,11 @@ func (d *BTCDelegation) GetStakingInfo(bsParams *Params, btcNet *chaincfg.Params
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert covenant pks to BTC pks %v", err)
 	}
-	stakingInfo, err := btcstaking.BuildStakingInfo(
+	stakingInfo, err := buildStakingInfo(
+		bsParams,
 		d.BtcPk.MustToBTCPK(),
 		fpBtcPkList,
 		covenantBtcPkList,
-		bsParams.CovenantQuorum,
 		d.MustGetValidStakingTime(),
 		btcutil.Amount(d.TotalSat),
 		btcNet,
@@ -402,7 +420,7 @@ func (d *BTCDelegation) SignUnbondingTx(bsParams *Params, btcNet *chaincfg.Param
 // the unbonding info can be used for constructing witness of unbonding slashing
 // tx with access to a finality provider's SK
 func (d *BTCDelegation) GetUnbondingInfo(bsParams *Params, btcNet *chaincfg.Params) (*btcstaking.UnbondingInfo, error) {
-	fpBtcPkList, err := bbn.NewBTCPKsFromBIP340PKs(d.FpBtcPkList)
+	fpBtcPkList, err := bbn.NewBTCPKsFromBIP340PKs(d.FpScriptPks())
 	if err != nil {
 		return nil, fmt.Errorf("failed to convert finality provider pks to BTC pks: %v", err)
 	}
@@ -435,7 +453,7 @@ func (d *BTCDelegation) GetUnbondingInfo(bsParams *Params, btcNet *chaincfg.Para
 // findFPIdx returns the index of the given finality provider
 // among all restaked finality providers
 func (d *BTCDelegation) findFPIdx(fpBTCPK *bbn.BIP340PubKey) (int, error) {
-	for i, pk := range d.FpBtcPkList {
+	for i, pk := range d.FpScriptPks() {
 		if pk.Equals(fpBTCPK) {
 			return i, nil
 		}
@@ -477,7 +495,7 @@ func (d *BTCDelegation) BuildSlashingTxWithWitness(bsParams *Params, btcNet *cha
 	// assemble witness for slashing tx
 	slashingMsgTxWithWitness, err := d.SlashingTx.BuildSlashingTxWithWitness(
 		fpSK,
-		d.FpBtcPkList,
+		d.FpScriptPks(),
 		stakingMsgTx,
 		d.StakingOutputIdx,
 		d.DelegatorSig,
@@ -528,7 +546,7 @@ func (d *BTCDelegation) BuildUnbondingSlashingTxWithWitness(bsParams *Params, bt
 	// assemble witness for unbonding slashing tx
 	slashingMsgTxWithWitness, err := d.BtcUndelegation.SlashingTx.BuildSlashingTxWithWitness(
 		fpSK,
-		d.FpBtcPkList,
+		d.FpScriptPks(),
 		unbondingMsgTx,
 		0,
 		d.BtcUndelegation.DelegatorSlashingSig,
@@ -9,8 +9,11 @@ func (ud *BTCUndelegation) HasCovenantQuorumOnSlashing(quorum uint32) bool {
 	return len(ud.CovenantSlashingSigs) >= int(quorum)
 }
 
+// HasCovenantQuorumOnUnbonding returns whether the covenant committee signed
+// the unbonding tx, either through a quorum of signatures of covenant members
+// or through the MuSig2 aggregated signature
 func (ud *BTCUndelegation) HasCovenantQuorumOnUnbonding(quorum uint32) bool {
-	return len(ud.CovenantUnbondingSigList) >= int(quorum)
+	return ud.CovenantUnbondingAggSig != nil || len(ud.CovenantUnbondingSigList) >= int(quorum)
 }
 
 // IsSignedByCovMemberOnUnbonding checks whether the given covenant PK has signed the unbonding tx
@@ -66,7 +69,8 @@ func (ud *BTCUndelegation) GetCovSlashingAdaptorSig(
 // AddCovenantSigs adds a Schnorr signature on the unbonding tx, and
 // a list of adaptor signatures on the unbonding slashing tx, each encrypted
 // by a finality provider's PK this BTC delegation restakes to, from the given
-// covenant
+// covenant. The unbonding signature is nil if the unbonding tx is signed
+// through MuSig2
 // It is up to the caller to ensure that given adaptor signatures are valid or
 // that they were not added before
 func (ud *BTCUndelegation) addCovenantSigs(
@@ -74,8 +78,10 @@ func (ud *BTCUndelegation) addCovenantSigs(
 	unbondingSig *bbn.BIP340Signature,
 	slashingSigs []asig.AdaptorSignature,
 ) {
-	covUnbondingSigInfo := &SignatureInfo{Pk: covPk, Sig: unbondingSig}
-	ud.CovenantUnbondingSigList = append(ud.CovenantUnbondingSigList, covUnbondingSigInfo)
+	if unbondingSig != nil {
+		covUnbondingSigInfo := &SignatureInfo{Pk: covPk, Sig: unbondingSig}
+		ud.CovenantUnbondingSigList = append(ud.CovenantUnbondingSigList, covUnbondingSigInfo)
+	}
 
 	adaptorSigs := make([][]byte, 0, len(slashingSigs))
 	for _, s := range slashingSigs {
@@ -65,9 +65,58 @@ func (fp *FinalityProvider) Validate() error {
 		return err
 	}
 
+	// ensure key rotations are well-formatted, use distinct keys and are
+	// sorted by activation height
+	seenPks := map[string]struct{}{fp.BtcPk.MarshalHex(): {}}
+	for i, rotation := range fp.KeyRotations {
+		if rotation == nil || rotation.NewEotsPk == nil {
+			return fmt.Errorf("empty EOTS public key in key rotation %d", i)
+		}
+		if _, err := rotation.NewEotsPk.ToBTCPK(); err != nil {
+			return fmt.Errorf("EOTS public key in key rotation %d is not correctly formatted: %w", i, err)
+		}
+		if _, ok := seenPks[rotation.NewEotsPk.MarshalHex()]; ok {
+			return fmt.Errorf("duplicated EOTS public key in key rotation %d", i)
+		}
+		seenPks[rotation.NewEotsPk.MarshalHex()] = struct{}{}
+		if i > 0 && rotation.ActivationHeight <= fp.KeyRotations[i-1].ActivationHeight {
+			return fmt.Errorf("key rotations are not sorted by activation height")
+		}
+	}
+
 	return nil
 }
 
+// EotsPkAtHeight returns the EOTS public key of the finality provider at the
+// given height, i.e., the key of the last rotation activated at or before the
+// height, or its BTC public key if there is no such rotation
+func (fp *FinalityProvider) EotsPkAtHeight(height uint64) *bbn.BIP340PubKey {
+	eotsPk := fp.BtcPk
+	for _, rotation := range fp.KeyRotations {
+		if rotation.ActivationHeight > height {
+			break
+		}
+		eotsPk = rotation.NewEotsPk
+	}
+	return eotsPk
+}
+
+// LatestEotsPk returns the EOTS public key of the last key rotation of the
+// finality provider, including a pending one, or its BTC public key if it
+// never rotated its key
+func (fp *FinalityProvider) LatestEotsPk() *bbn.BIP340PubKey {
+	if len(fp.KeyRotations) == 0 {
+		return fp.BtcPk
+	}
+	return fp.KeyRotations[len(fp.KeyRotations)-1].NewEotsPk
+}
+
+// HasPendingKeyRotation returns whether the finality provider has a key
+// rotation that is not activated at the given height yet
+func (fp *FinalityProvider) HasPendingKeyRotation(height uint64) bool {
+	return len(fp.KeyRotations) > 0 && fp.KeyRotations[len(fp.KeyRotations)-1].ActivationHeight > height
+}
+
 func ExistsDup(btcPKs []bbn.BIP340PubKey) (bool, error) {
 	seen := make(map[string]struct{})
 
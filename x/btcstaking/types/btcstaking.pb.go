@@ -120,6 +120,15 @@ type FinalityProvider struct {
 	HighestVotedHeight uint32 `protobuf:"varint,9,opt,name=highest_voted_height,json=highestVotedHeight,proto3" json:"highest_voted_height,omitempty"`
 	// commission_info contains information details of the finality provider commission.
 	CommissionInfo *CommissionInfo `protobuf:"bytes,11,opt,name=commission_info,json=commissionInfo,proto3" json:"commission_info,omitempty"`
+	// key_rotations is the list of rotations of the EOTS key of the finality
+	// provider, in increasing order of activation height. The finality provider
+	// keeps being identified by btc_pk, which remains the key of its BTC
+	// delegations.
+	KeyRotations []*FinalityProviderKeyRotation `protobuf:"bytes,12,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	// max_delegated_sats is the maximum amount of satoshis the finality
+	// provider accepts to be delegated to it.
+	// if it's 0 then the delegations to the finality provider are not capped
+	MaxDelegatedSats uint64 `protobuf:"varint,13,opt,name=max_delegated_sats,json=maxDelegatedSats,proto3" json:"max_delegated_sats,omitempty"`
 }
 
 func (m *FinalityProvider) Reset()         { *m = FinalityProvider{} }
@@ -211,6 +220,70 @@ func (m *FinalityProvider) GetCommissionInfo() *CommissionInfo {
 	return nil
 }
 
+func (m *FinalityProvider) GetKeyRotations() []*FinalityProviderKeyRotation {
+	if m != nil {
+		return m.KeyRotations
+	}
+	return nil
+}
+
+func (m *FinalityProvider) GetMaxDelegatedSats() uint64 {
+	if m != nil {
+		return m.MaxDelegatedSats
+	}
+	return 0
+}
+
+// FinalityProviderKeyRotation defines the rotation of the EOTS key of a
+// finality provider to a successor key
+type FinalityProviderKeyRotation struct {
+	// new_eots_pk is the successor BIP-340 PK used by the finality provider to
+	// commit public randomness and cast finality votes
+	NewEotsPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,1,opt,name=new_eots_pk,json=newEotsPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"new_eots_pk,omitempty"`
+	// activation_height is the Babylon height from which new_eots_pk is used
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *FinalityProviderKeyRotation) Reset()         { *m = FinalityProviderKeyRotation{} }
+func (m *FinalityProviderKeyRotation) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderKeyRotation) ProtoMessage()    {}
+func (*FinalityProviderKeyRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+}
+func (m *FinalityProviderKeyRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderKeyRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderKeyRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalityProviderKeyRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderKeyRotation.Merge(m, src)
+}
+func (m *FinalityProviderKeyRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderKeyRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderKeyRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProviderKeyRotation proto.InternalMessageInfo
+
+func (m *FinalityProviderKeyRotation) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 // CommissionInfo defines the information related to the commission of
 // a finality provider.
 type CommissionInfo struct {
@@ -226,7 +299,7 @@ func (m *CommissionInfo) Reset()         { *m = CommissionInfo{} }
 func (m *CommissionInfo) String() string { return proto.CompactTextString(m) }
 func (*CommissionInfo) ProtoMessage()    {}
 func (*CommissionInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{1}
+	return fileDescriptor_3851ae95ccfaf7db, []int{2}
 }
 func (m *CommissionInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -291,7 +364,7 @@ func (m *FinalityProviderWithMeta) Reset()         { *m = FinalityProviderWithMe
 func (m *FinalityProviderWithMeta) String() string { return proto.CompactTextString(m) }
 func (*FinalityProviderWithMeta) ProtoMessage()    {}
 func (*FinalityProviderWithMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{2}
+	return fileDescriptor_3851ae95ccfaf7db, []int{3}
 }
 func (m *FinalityProviderWithMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,13 +491,18 @@ type BTCDelegation struct {
 	// stk_exp is contains the relevant information about the previous staking that
 	// originated this stake. If nil it is NOT a stake expansion.
 	StkExp *StakeExpansion `protobuf:"bytes,18,opt,name=stk_exp,json=stkExp,proto3" json:"stk_exp,omitempty"`
+	// fp_eots_pk is the EOTS PK of the finality provider that the staking,
+	// slashing and unbonding scripts commit to. It is only set if the finality
+	// provider rotated its EOTS key before the creation of the BTC delegation,
+	// otherwise the scripts commit to the BTC PK of the finality provider
+	FpEotsPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,19,opt,name=fp_eots_pk,json=fpEotsPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_eots_pk,omitempty"`
 }
 
 func (m *BTCDelegation) Reset()         { *m = BTCDelegation{} }
 func (m *BTCDelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegation) ProtoMessage()    {}
 func (*BTCDelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{3}
+	return fileDescriptor_3851ae95ccfaf7db, []int{4}
 }
 func (m *BTCDelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -575,7 +653,7 @@ func (m *StakeExpansion) Reset()         { *m = StakeExpansion{} }
 func (m *StakeExpansion) String() string { return proto.CompactTextString(m) }
 func (*StakeExpansion) ProtoMessage()    {}
 func (*StakeExpansion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{4}
+	return fileDescriptor_3851ae95ccfaf7db, []int{5}
 }
 func (m *StakeExpansion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +717,7 @@ func (m *DelegatorUnbondingInfo) Reset()         { *m = DelegatorUnbondingInfo{}
 func (m *DelegatorUnbondingInfo) String() string { return proto.CompactTextString(m) }
 func (*DelegatorUnbondingInfo) ProtoMessage()    {}
 func (*DelegatorUnbondingInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{5}
+	return fileDescriptor_3851ae95ccfaf7db, []int{6}
 }
 func (m *DelegatorUnbondingInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -701,13 +779,18 @@ type BTCUndelegation struct {
 	// delegator_unbonding_info is the information about transaction which spent
 	// the staking output
 	DelegatorUnbondingInfo *DelegatorUnbondingInfo `protobuf:"bytes,6,opt,name=delegator_unbonding_info,json=delegatorUnbondingInfo,proto3" json:"delegator_unbonding_info,omitempty"`
+	// covenant_unbonding_agg_sig is the MuSig2 aggregated signature of the
+	// covenant committee on the unbonding tx. It is only set for BTC delegations
+	// created under parameters with covenant MuSig2 unbonding enabled, in which
+	// case it replaces covenant_unbonding_sig_list
+	CovenantUnbondingAggSig *github_com_babylonlabs_io_babylon_v4_types.BIP340Signature `protobuf:"bytes,7,opt,name=covenant_unbonding_agg_sig,json=covenantUnbondingAggSig,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340Signature" json:"covenant_unbonding_agg_sig,omitempty"`
 }
 
 func (m *BTCUndelegation) Reset()         { *m = BTCUndelegation{} }
 func (m *BTCUndelegation) String() string { return proto.CompactTextString(m) }
 func (*BTCUndelegation) ProtoMessage()    {}
 func (*BTCUndelegation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{6}
+	return fileDescriptor_3851ae95ccfaf7db, []int{7}
 }
 func (m *BTCUndelegation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -774,7 +857,7 @@ func (m *BTCDelegatorDelegations) Reset()         { *m = BTCDelegatorDelegations
 func (m *BTCDelegatorDelegations) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegations) ProtoMessage()    {}
 func (*BTCDelegatorDelegations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{7}
+	return fileDescriptor_3851ae95ccfaf7db, []int{8}
 }
 func (m *BTCDelegatorDelegations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +903,7 @@ func (m *BTCDelegatorDelegationIndex) Reset()         { *m = BTCDelegatorDelegat
 func (m *BTCDelegatorDelegationIndex) String() string { return proto.CompactTextString(m) }
 func (*BTCDelegatorDelegationIndex) ProtoMessage()    {}
 func (*BTCDelegatorDelegationIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{8}
+	return fileDescriptor_3851ae95ccfaf7db, []int{9}
 }
 func (m *BTCDelegatorDelegationIndex) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -866,7 +949,7 @@ func (m *SignatureInfo) Reset()         { *m = SignatureInfo{} }
 func (m *SignatureInfo) String() string { return proto.CompactTextString(m) }
 func (*SignatureInfo) ProtoMessage()    {}
 func (*SignatureInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{9}
+	return fileDescriptor_3851ae95ccfaf7db, []int{10}
 }
 func (m *SignatureInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -910,7 +993,7 @@ func (m *CovenantAdaptorSignatures) Reset()         { *m = CovenantAdaptorSignat
 func (m *CovenantAdaptorSignatures) String() string { return proto.CompactTextString(m) }
 func (*CovenantAdaptorSignatures) ProtoMessage()    {}
 func (*CovenantAdaptorSignatures) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{10}
+	return fileDescriptor_3851ae95ccfaf7db, []int{11}
 }
 func (m *CovenantAdaptorSignatures) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -966,7 +1049,7 @@ func (m *SelectiveSlashingEvidence) Reset()         { *m = SelectiveSlashingEvid
 func (m *SelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*SelectiveSlashingEvidence) ProtoMessage()    {}
 func (*SelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{11}
+	return fileDescriptor_3851ae95ccfaf7db, []int{12}
 }
 func (m *SelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1017,7 +1100,7 @@ func (m *InclusionProof) Reset()         { *m = InclusionProof{} }
 func (m *InclusionProof) String() string { return proto.CompactTextString(m) }
 func (*InclusionProof) ProtoMessage()    {}
 func (*InclusionProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{12}
+	return fileDescriptor_3851ae95ccfaf7db, []int{13}
 }
 func (m *InclusionProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1075,7 +1158,7 @@ func (m *LargestBtcReOrg) Reset()         { *m = LargestBtcReOrg{} }
 func (m *LargestBtcReOrg) String() string { return proto.CompactTextString(m) }
 func (*LargestBtcReOrg) ProtoMessage()    {}
 func (*LargestBtcReOrg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3851ae95ccfaf7db, []int{13}
+	return fileDescriptor_3851ae95ccfaf7db, []int{14}
 }
 func (m *LargestBtcReOrg) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,9 +1208,99 @@ func (m *LargestBtcReOrg) GetRollbackTo() *types2.BTCHeaderInfo {
 	return nil
 }
 
+// CovenantStats records the liveness of a covenant member in signing BTC
+// delegations
+type CovenantStats struct {
+	// num_signatures is the number of BTC delegations signed by the covenant
+	// member within the covenant signature timeout
+	NumSignatures uint64 `protobuf:"varint,1,opt,name=num_signatures,json=numSignatures,proto3" json:"num_signatures,omitempty"`
+	// total_latency_blocks is the sum of the Babylon blocks elapsed between the
+	// creation of the signed BTC delegations and their signature
+	TotalLatencyBlocks uint64 `protobuf:"varint,2,opt,name=total_latency_blocks,json=totalLatencyBlocks,proto3" json:"total_latency_blocks,omitempty"`
+	// max_latency_blocks is the largest number of Babylon blocks elapsed between
+	// the creation of a signed BTC delegation and its signature
+	MaxLatencyBlocks uint64 `protobuf:"varint,3,opt,name=max_latency_blocks,json=maxLatencyBlocks,proto3" json:"max_latency_blocks,omitempty"`
+	// num_missed is the number of BTC delegations that the covenant member did
+	// not sign within the covenant signature timeout and has not signed since
+	NumMissed uint64 `protobuf:"varint,4,opt,name=num_missed,json=numMissed,proto3" json:"num_missed,omitempty"`
+	// num_late is the number of BTC delegations that the covenant member signed
+	// within another covenant signature timeout after the covenant signature
+	// timeout, which are no longer counted as missed
+	NumLate uint64 `protobuf:"varint,5,opt,name=num_late,json=numLate,proto3" json:"num_late,omitempty"`
+}
+
+func (m *CovenantStats) Reset()         { *m = CovenantStats{} }
+func (m *CovenantStats) String() string { return proto.CompactTextString(m) }
+func (*CovenantStats) ProtoMessage()    {}
+func (*CovenantStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3851ae95ccfaf7db, []int{15}
+}
+func (m *CovenantStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CovenantStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantStats.Merge(m, src)
+}
+func (m *CovenantStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CovenantStats proto.InternalMessageInfo
+
+func (m *CovenantStats) GetNumSignatures() uint64 {
+	if m != nil {
+		return m.NumSignatures
+	}
+	return 0
+}
+
+func (m *CovenantStats) GetTotalLatencyBlocks() uint64 {
+	if m != nil {
+		return m.TotalLatencyBlocks
+	}
+	return 0
+}
+
+func (m *CovenantStats) GetMaxLatencyBlocks() uint64 {
+	if m != nil {
+		return m.MaxLatencyBlocks
+	}
+	return 0
+}
+
+func (m *CovenantStats) GetNumMissed() uint64 {
+	if m != nil {
+		return m.NumMissed
+	}
+	return 0
+}
+
+func (m *CovenantStats) GetNumLate() uint64 {
+	if m != nil {
+		return m.NumLate
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.BTCDelegationStatus", BTCDelegationStatus_name, BTCDelegationStatus_value)
 	proto.RegisterType((*FinalityProvider)(nil), "babylon.btcstaking.v1.FinalityProvider")
+	proto.RegisterType((*FinalityProviderKeyRotation)(nil), "babylon.btcstaking.v1.FinalityProviderKeyRotation")
 	proto.RegisterType((*CommissionInfo)(nil), "babylon.btcstaking.v1.CommissionInfo")
 	proto.RegisterType((*FinalityProviderWithMeta)(nil), "babylon.btcstaking.v1.FinalityProviderWithMeta")
 	proto.RegisterType((*BTCDelegation)(nil), "babylon.btcstaking.v1.BTCDelegation")
@@ -1141,6 +1314,7 @@ func init() {
 	proto.RegisterType((*SelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.SelectiveSlashingEvidence")
 	proto.RegisterType((*InclusionProof)(nil), "babylon.btcstaking.v1.InclusionProof")
 	proto.RegisterType((*LargestBtcReOrg)(nil), "babylon.btcstaking.v1.LargestBtcReOrg")
+	proto.RegisterType((*CovenantStats)(nil), "babylon.btcstaking.v1.CovenantStats")
 }
 
 func init() {
@@ -1148,118 +1322,132 @@ func init() {
 }
 
 var fileDescriptor_3851ae95ccfaf7db = []byte{
-	// 1766 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4f, 0x6f, 0x1b, 0xc7,
-	0x15, 0xf7, 0x92, 0x14, 0x25, 0x3d, 0xfe, 0x11, 0x35, 0x51, 0x94, 0xb5, 0x8c, 0x4a, 0x2a, 0xeb,
-	0x04, 0x42, 0x6a, 0x91, 0xb6, 0x62, 0x34, 0xad, 0x8b, 0x1a, 0x30, 0x45, 0xaa, 0x66, 0x62, 0x4b,
-	0xf4, 0x92, 0x76, 0xda, 0x1e, 0xba, 0x19, 0xee, 0x0e, 0x97, 0x5b, 0x92, 0x3b, 0x8b, 0x9d, 0x21,
-	0x4b, 0x7d, 0x80, 0xde, 0x83, 0xa2, 0xbd, 0xf7, 0xd8, 0x53, 0xd1, 0x43, 0x4e, 0xfd, 0x04, 0x41,
-	0x4f, 0x41, 0x2e, 0x2d, 0x0c, 0x54, 0x2d, 0xec, 0x43, 0xfa, 0x31, 0x8a, 0xf9, 0xb3, 0xe4, 0x52,
-	0xb5, 0x1c, 0x39, 0xd1, 0x45, 0xe0, 0xbc, 0xff, 0xfb, 0xde, 0xef, 0xf7, 0x76, 0x56, 0xf0, 0x5e,
-	0x17, 0x77, 0x4f, 0x87, 0x34, 0xa8, 0x76, 0xb9, 0xc3, 0x38, 0x1e, 0xf8, 0x81, 0x57, 0x9d, 0xdc,
-	0x49, 0x9c, 0x2a, 0x61, 0x44, 0x39, 0x45, 0x6f, 0x6b, 0xbb, 0x4a, 0x42, 0x33, 0xb9, 0xb3, 0xb5,
-	0xe1, 0x51, 0x8f, 0x4a, 0x8b, 0xaa, 0xf8, 0xa5, 0x8c, 0xb7, 0xae, 0x3b, 0x94, 0x8d, 0x28, 0xb3,
-	0x95, 0x42, 0x1d, 0xb4, 0xea, 0xa6, 0x3a, 0x55, 0xe7, 0xb9, 0xba, 0x84, 0xe3, 0x3b, 0xd5, 0x85,
-	0x6c, 0x5b, 0x3b, 0xaf, 0xae, 0x2a, 0xa4, 0xa1, 0x36, 0xb8, 0x95, 0x30, 0x70, 0xfa, 0xc4, 0x19,
-	0x84, 0xd4, 0x0f, 0xb8, 0xae, 0x7c, 0x2e, 0xd0, 0xd6, 0x95, 0x84, 0xf5, 0xd0, 0xf7, 0xfa, 0xe2,
-	0x2f, 0x99, 0x99, 0x27, 0x24, 0xda, 0x7e, 0x1d, 0x8f, 0xfc, 0x80, 0x56, 0xe5, 0xdf, 0xb8, 0x22,
-	0x8f, 0x52, 0x6f, 0x48, 0xaa, 0xf2, 0xd4, 0x1d, 0xf7, 0xaa, 0xdc, 0x1f, 0x11, 0xc6, 0xf1, 0x48,
-	0x57, 0x54, 0xfe, 0x3a, 0x03, 0xa5, 0x23, 0x3f, 0xc0, 0x43, 0x9f, 0x9f, 0xb6, 0x22, 0x3a, 0xf1,
-	0x5d, 0x12, 0xa1, 0x5b, 0x90, 0xc1, 0xae, 0x1b, 0x99, 0xc6, 0xae, 0xb1, 0xb7, 0x5a, 0x33, 0xbf,
-	0xfa, 0x7c, 0x7f, 0x43, 0x77, 0xe3, 0x81, 0xeb, 0x46, 0x84, 0xb1, 0x36, 0x8f, 0xfc, 0xc0, 0xb3,
-	0xa4, 0x15, 0x6a, 0x40, 0xce, 0x25, 0xcc, 0x89, 0xfc, 0x90, 0xfb, 0x34, 0x30, 0x53, 0xbb, 0xc6,
-	0x5e, 0xee, 0xe0, 0x07, 0x15, 0xed, 0x31, 0xef, 0xba, 0xec, 0x58, 0xa5, 0x3e, 0x37, 0xb5, 0x92,
-	0x7e, 0xe8, 0x31, 0x80, 0x43, 0x47, 0x23, 0x9f, 0x31, 0x11, 0x25, 0x2d, 0x53, 0xef, 0x3f, 0x3f,
-	0xdb, 0xb9, 0xa1, 0x02, 0x31, 0x77, 0x50, 0xf1, 0x69, 0x75, 0x84, 0x79, 0xbf, 0xf2, 0x88, 0x78,
-	0xd8, 0x39, 0xad, 0x13, 0xe7, 0xab, 0xcf, 0xf7, 0x41, 0xe7, 0xa9, 0x13, 0xc7, 0x4a, 0x04, 0x40,
-	0x16, 0x64, 0xbb, 0xdc, 0xb1, 0xc3, 0x81, 0x99, 0xd9, 0x35, 0xf6, 0xf2, 0xb5, 0x9f, 0x3e, 0x3f,
-	0xdb, 0xf9, 0xd0, 0xf3, 0x79, 0x7f, 0xdc, 0xad, 0x38, 0x74, 0x54, 0xd5, 0xbd, 0x1d, 0xe2, 0x2e,
-	0xdb, 0xf7, 0x69, 0x7c, 0xac, 0x4e, 0xee, 0x56, 0xf9, 0x69, 0x48, 0x58, 0xa5, 0xd6, 0x6c, 0x7d,
-	0x70, 0xf7, 0x76, 0x6b, 0xdc, 0xfd, 0x98, 0x9c, 0x5a, 0x4b, 0x5d, 0xee, 0xb4, 0x06, 0xe8, 0x67,
-	0x90, 0x0e, 0x69, 0x68, 0x2e, 0xc9, 0x27, 0xfc, 0x61, 0xe5, 0x95, 0xd8, 0xaa, 0xb4, 0x22, 0x4a,
-	0x7b, 0x27, 0xbd, 0x16, 0x65, 0x8c, 0xc8, 0x52, 0x6a, 0x9d, 0x43, 0x4b, 0xf8, 0xa1, 0xbb, 0xb0,
-	0xc9, 0x86, 0x98, 0xf5, 0x89, 0x6b, 0x6b, 0x57, 0xbb, 0x4f, 0xc4, 0x14, 0xcd, 0xec, 0xae, 0xb1,
-	0x97, 0xb1, 0x36, 0xb4, 0xb6, 0xa6, 0x94, 0x0f, 0xa5, 0x0e, 0xdd, 0x02, 0x34, 0xf3, 0xe2, 0x4e,
-	0xec, 0xb1, 0xbc, 0x6b, 0xec, 0x15, 0xac, 0x52, 0xec, 0xc1, 0x1d, 0x6d, 0xbd, 0x09, 0xd9, 0xdf,
-	0x60, 0x7f, 0x48, 0x5c, 0x73, 0x65, 0xd7, 0xd8, 0x5b, 0xb1, 0xf4, 0x09, 0xdd, 0x86, 0x8d, 0xbe,
-	0xef, 0xf5, 0x09, 0xe3, 0xf6, 0x84, 0x72, 0xe2, 0xc6, 0x71, 0x56, 0x65, 0x1c, 0xa4, 0x75, 0xcf,
-	0x84, 0x4a, 0x47, 0x3a, 0x86, 0xb5, 0x79, 0x3b, 0x6d, 0x3f, 0xe8, 0x51, 0x33, 0x27, 0x1f, 0xfc,
-	0xdd, 0x0b, 0x1e, 0xfc, 0x70, 0x66, 0xdd, 0x0c, 0x7a, 0xd4, 0x2a, 0x3a, 0x0b, 0xe7, 0xf2, 0x1f,
-	0x53, 0x50, 0x5c, 0x34, 0x41, 0x4f, 0x60, 0x65, 0x84, 0xa7, 0x76, 0x84, 0x39, 0xd1, 0x58, 0xfb,
-	0xd1, 0x17, 0x67, 0x3b, 0xd7, 0xde, 0x68, 0xe8, 0x7f, 0xfe, 0xfa, 0xaf, 0xef, 0x1b, 0xd6, 0xf2,
-	0x08, 0x4f, 0x2d, 0xcc, 0x09, 0xfa, 0x35, 0xac, 0x89, 0x90, 0x4e, 0x1f, 0x07, 0x1e, 0x51, 0x91,
-	0x53, 0xdf, 0x29, 0x72, 0x61, 0x84, 0xa7, 0x87, 0x32, 0x9a, 0x8c, 0xff, 0x11, 0xe4, 0xc6, 0xa1,
-	0x8b, 0x39, 0xb1, 0x05, 0x93, 0x24, 0x4c, 0x73, 0x07, 0x5b, 0x15, 0x45, 0xb3, 0x4a, 0x4c, 0xb3,
-	0x4a, 0x27, 0xa6, 0x59, 0xad, 0x20, 0xf2, 0x7e, 0xf6, 0xef, 0x1d, 0x43, 0x85, 0x03, 0xe5, 0x2d,
-	0xf4, 0xf7, 0x32, 0xff, 0xfd, 0xd3, 0x8e, 0x51, 0xfe, 0x47, 0x0a, 0xcc, 0xf3, 0x0c, 0xfc, 0xc4,
-	0xe7, 0xfd, 0xc7, 0x84, 0xe3, 0x04, 0x8a, 0x8d, 0x2b, 0x43, 0xf1, 0x26, 0x64, 0xf5, 0xf0, 0x53,
-	0x12, 0x76, 0xfa, 0x84, 0xbe, 0x0f, 0xf9, 0x09, 0xe5, 0x7e, 0xe0, 0xd9, 0x21, 0xfd, 0x2d, 0x89,
-	0xe4, 0xb3, 0x65, 0xac, 0x9c, 0x92, 0xb5, 0x84, 0xe8, 0x35, 0x08, 0xce, 0xbc, 0x31, 0x82, 0x97,
-	0xbe, 0x11, 0xc1, 0xd9, 0x4b, 0x21, 0x78, 0xf9, 0x22, 0x04, 0x97, 0x7f, 0xb7, 0x02, 0x85, 0x5a,
-	0xe7, 0xb0, 0x4e, 0x86, 0xc4, 0xc3, 0x72, 0xc7, 0xfc, 0x04, 0x72, 0x02, 0xb0, 0x24, 0xb2, 0x2f,
-	0xb5, 0xdf, 0x40, 0x19, 0x0b, 0x61, 0x62, 0x12, 0xa9, 0xab, 0xde, 0x27, 0xe9, 0x6f, 0xb9, 0x4f,
-	0x3e, 0x85, 0x62, 0x2f, 0xb4, 0x55, 0x55, 0xf6, 0xd0, 0x67, 0x62, 0x0a, 0xe9, 0xef, 0x5a, 0x5a,
-	0xae, 0x17, 0xd6, 0x44, 0x71, 0x8f, 0x7c, 0x26, 0x21, 0xa1, 0x2b, 0x51, 0x70, 0x57, 0x33, 0xcb,
-	0x69, 0x99, 0x00, 0xb1, 0x36, 0x89, 0x78, 0x72, 0x95, 0x29, 0x93, 0x88, 0xeb, 0x89, 0x7e, 0x0f,
-	0x80, 0x04, 0xe7, 0xe6, 0xb5, 0x4a, 0x82, 0x78, 0xd1, 0xdc, 0x80, 0x55, 0x4e, 0x39, 0x1e, 0xda,
-	0x0c, 0x73, 0xb9, 0xb5, 0x32, 0xd6, 0x8a, 0x14, 0xb4, 0xb1, 0xf4, 0x9d, 0x55, 0x30, 0x95, 0xdb,
-	0x2a, 0x6f, 0xad, 0xc6, 0xf9, 0xa7, 0x12, 0x5a, 0x5a, 0x4d, 0xc7, 0x3c, 0x1c, 0x73, 0xdb, 0x77,
-	0xa7, 0x26, 0x68, 0x68, 0x29, 0xcd, 0x89, 0x54, 0x34, 0xdd, 0x29, 0x3a, 0x80, 0x9c, 0x84, 0x9b,
-	0x8e, 0x96, 0x93, 0x83, 0x5c, 0x7f, 0x7e, 0xb6, 0x23, 0x60, 0xd2, 0xd6, 0x9a, 0xce, 0xd4, 0x02,
-	0x36, 0xfb, 0x8d, 0x1c, 0x28, 0xb8, 0x0a, 0x40, 0x34, 0xb2, 0x99, 0xef, 0x99, 0x79, 0xe9, 0x75,
-	0xff, 0xf9, 0xd9, 0xce, 0xbd, 0x37, 0xee, 0x71, 0xdb, 0xf7, 0x02, 0xcc, 0xc7, 0x11, 0xb1, 0xf2,
-	0xb3, 0xa0, 0x6d, 0xdf, 0x43, 0x4f, 0xa1, 0xe0, 0xd0, 0x09, 0x09, 0x70, 0xc0, 0x45, 0x0e, 0x66,
-	0x16, 0x76, 0xd3, 0x7b, 0xb9, 0x83, 0xdb, 0x17, 0x6e, 0x5a, 0x65, 0xfb, 0xc0, 0xc5, 0xa1, 0x8a,
-	0xa0, 0xa2, 0x32, 0x2b, 0x1f, 0x87, 0x69, 0xfb, 0x1e, 0x43, 0xef, 0x42, 0x71, 0x1c, 0x74, 0x69,
-	0xe0, 0xce, 0x06, 0x58, 0x94, 0x9d, 0x29, 0xcc, 0xa4, 0x72, 0x84, 0x4f, 0xa0, 0x24, 0x40, 0x34,
-	0x0e, 0xdc, 0x19, 0x53, 0xcc, 0x35, 0x89, 0xc9, 0xf7, 0x2e, 0x28, 0xa0, 0xd6, 0x39, 0x7c, 0x9a,
-	0xb0, 0xb6, 0xd6, 0xba, 0xdc, 0x49, 0x0a, 0x44, 0xe6, 0x10, 0x47, 0x78, 0xc4, 0xec, 0x09, 0x89,
-	0xe4, 0x0b, 0xbd, 0xa4, 0x32, 0x2b, 0xe9, 0x33, 0x25, 0x44, 0x37, 0xa1, 0x28, 0x32, 0x73, 0x3f,
-	0x8c, 0xd1, 0xb1, 0x2e, 0xcd, 0xf2, 0x5d, 0xee, 0x74, 0xfc, 0x50, 0x03, 0xe4, 0x3e, 0x2c, 0x33,
-	0x3e, 0xb0, 0xc9, 0x34, 0x34, 0xd1, 0x6b, 0xdf, 0x40, 0x6d, 0x41, 0xd7, 0xc6, 0x34, 0xc4, 0x81,
-	0x88, 0x6e, 0x65, 0x19, 0x1f, 0x34, 0xa6, 0x61, 0xf9, 0x5f, 0x06, 0x14, 0x17, 0x55, 0xe8, 0x43,
-	0x30, 0xc3, 0x88, 0x4c, 0x7c, 0x3a, 0x66, 0xf6, 0x1c, 0x5f, 0x76, 0x1f, 0xb3, 0xbe, 0xda, 0xb4,
-	0xd6, 0xdb, 0xb1, 0xbe, 0x1d, 0x83, 0xed, 0x21, 0x66, 0x7d, 0x54, 0x85, 0x0d, 0xca, 0xfb, 0x24,
-	0xb2, 0x7b, 0x63, 0xdd, 0xd6, 0xa9, 0x40, 0x9e, 0x5a, 0x0a, 0xd6, 0xba, 0xd4, 0x1d, 0x29, 0x55,
-	0x67, 0x7a, 0x32, 0xe6, 0x08, 0xc3, 0x56, 0x22, 0xd3, 0xc0, 0x5e, 0x9c, 0x73, 0x5a, 0xce, 0xf9,
-	0xe6, 0x45, 0xcf, 0x13, 0x0f, 0x56, 0xbe, 0x50, 0xdf, 0x99, 0x57, 0x34, 0x38, 0x4c, 0x8c, 0xb9,
-	0x7c, 0x1f, 0x36, 0xeb, 0x31, 0x9a, 0x9e, 0xc6, 0x93, 0x95, 0x2f, 0xd8, 0x9b, 0x50, 0x64, 0xa1,
-	0xe0, 0x9e, 0x5c, 0x64, 0x02, 0xf3, 0xea, 0xe1, 0xf2, 0x52, 0x2a, 0x7b, 0xd2, 0x99, 0x96, 0xff,
-	0x90, 0x81, 0xb5, 0x73, 0x13, 0x15, 0xb4, 0x4e, 0x40, 0x27, 0xf6, 0xcb, 0xcd, 0x81, 0xf3, 0x7f,
-	0x6c, 0x4a, 0x5d, 0x86, 0x4d, 0x1c, 0x36, 0x13, 0x6c, 0x8a, 0xbd, 0x05, 0xad, 0xd2, 0x57, 0x42,
-	0xab, 0x8d, 0x39, 0xad, 0x74, 0x70, 0x41, 0xaf, 0x1e, 0x6c, 0xce, 0xdb, 0x9e, 0x48, 0xca, 0xe4,
-	0xc2, 0xfc, 0x36, 0x3c, 0xdb, 0x98, 0xf1, 0x6c, 0x9e, 0x86, 0x21, 0x07, 0x6e, 0xcc, 0xf2, 0xcc,
-	0xbb, 0xc7, 0x7c, 0x4f, 0x6d, 0xe7, 0xa5, 0x37, 0x18, 0xb6, 0x19, 0x07, 0x9a, 0x0d, 0xb4, 0xed,
-	0x7b, 0x72, 0x27, 0x7b, 0x60, 0xce, 0x5b, 0x38, 0xcf, 0x22, 0x2f, 0x68, 0x59, 0x49, 0x8f, 0xfd,
-	0x0b, 0x32, 0xbc, 0x1a, 0x24, 0xd6, 0x7c, 0x22, 0x0b, 0xf2, 0x72, 0x1b, 0xde, 0x99, 0xbf, 0x3d,
-	0x69, 0x34, 0x7f, 0x8d, 0x32, 0xf4, 0x63, 0xc8, 0xb8, 0x64, 0xc8, 0x4c, 0xe3, 0xb5, 0x4f, 0xb4,
-	0xf0, 0xee, 0xb5, 0xa4, 0x47, 0xf9, 0x18, 0x6e, 0xbc, 0x3a, 0x68, 0x33, 0x70, 0xc9, 0x54, 0xd0,
-	0xeb, 0x1c, 0x1d, 0x55, 0xeb, 0x44, 0xa2, 0xbc, 0xb5, 0xce, 0x92, 0x5c, 0x14, 0xdd, 0x28, 0xff,
-	0xc5, 0x80, 0xc2, 0x42, 0xe7, 0xd0, 0xc7, 0x90, 0xba, 0x9a, 0xeb, 0x52, 0x2a, 0x1c, 0xa0, 0x16,
-	0xa4, 0x05, 0x38, 0x53, 0x57, 0x02, 0x4e, 0x11, 0xaa, 0xfc, 0x7b, 0x03, 0xae, 0x5f, 0x88, 0x2b,
-	0x71, 0xcb, 0x70, 0xe8, 0xe4, 0xaa, 0xee, 0x7b, 0x0e, 0x9d, 0xb4, 0x06, 0x82, 0xca, 0x58, 0x25,
-	0x52, 0x98, 0x4f, 0xc9, 0x5e, 0xe6, 0xf0, 0x2c, 0x39, 0x2b, 0xff, 0xcd, 0x80, 0xeb, 0x6d, 0x32,
-	0x24, 0x0e, 0xf7, 0x27, 0x24, 0x86, 0x74, 0x43, 0x5c, 0x45, 0x03, 0x87, 0xa0, 0x4f, 0x60, 0x75,
-	0x76, 0xcf, 0xb8, 0x8a, 0xdb, 0xcf, 0xb2, 0xbe, 0x62, 0xa0, 0x7d, 0x78, 0x2b, 0x22, 0x02, 0xe8,
-	0x11, 0x71, 0x6d, 0x9d, 0x82, 0x0d, 0xd4, 0x2a, 0xb0, 0x4a, 0x33, 0xd5, 0x91, 0x30, 0x6f, 0x0f,
-	0x3e, 0xca, 0xac, 0x18, 0xa5, 0x94, 0xb5, 0x76, 0x0e, 0x20, 0xe5, 0x2e, 0x14, 0x9b, 0x81, 0x33,
-	0x1c, 0x8b, 0xc5, 0x2e, 0x2f, 0x4b, 0xe8, 0x1e, 0xa4, 0x07, 0xe4, 0x54, 0xb6, 0x30, 0x77, 0xb0,
-	0x97, 0x44, 0x67, 0xe2, 0x1b, 0x7b, 0x72, 0xa7, 0xd2, 0x89, 0x70, 0xc0, 0xb0, 0x23, 0xe0, 0x27,
-	0xea, 0x12, 0x4e, 0x68, 0x03, 0x96, 0x42, 0x11, 0x44, 0x6f, 0x74, 0x75, 0x28, 0xff, 0xdd, 0x80,
-	0xb5, 0x47, 0x38, 0xf2, 0x08, 0xe3, 0x35, 0xee, 0x58, 0xe4, 0x24, 0xf2, 0xc4, 0xd5, 0xa4, 0x3b,
-	0xa4, 0xce, 0xc0, 0x76, 0xfd, 0x5e, 0x4f, 0x26, 0x2b, 0x58, 0xab, 0x52, 0x52, 0xf7, 0x7b, 0x3d,
-	0xf4, 0x18, 0x0a, 0x11, 0x1d, 0x0e, 0xbb, 0xd8, 0x19, 0xd8, 0xbd, 0x88, 0x8e, 0xf4, 0x87, 0xf1,
-	0x42, 0x39, 0xc9, 0x6f, 0x78, 0x45, 0x98, 0x87, 0x04, 0xbb, 0x24, 0x92, 0xbc, 0xcc, 0xc7, 0xee,
-	0x47, 0x11, 0x1d, 0xa1, 0x26, 0xe4, 0x66, 0xe1, 0x38, 0xd5, 0x77, 0xc6, 0xcb, 0x07, 0x83, 0xd8,
-	0xb9, 0x43, 0xdf, 0xff, 0x14, 0xde, 0x5a, 0xa0, 0x66, 0x9b, 0x63, 0x3e, 0x66, 0x28, 0x07, 0xcb,
-	0xad, 0xc6, 0x71, 0xbd, 0x79, 0xfc, 0xf3, 0xd2, 0x35, 0x94, 0x87, 0x95, 0x67, 0x0d, 0xab, 0x79,
-	0xd4, 0x6c, 0xd4, 0x4b, 0x06, 0x02, 0xc8, 0x3e, 0x38, 0xec, 0x34, 0x9f, 0x35, 0x4a, 0x29, 0xa1,
-	0x79, 0x7a, 0x5c, 0x3b, 0x39, 0xae, 0x37, 0xea, 0xa5, 0xb4, 0x70, 0x6a, 0xfc, 0xa2, 0xd5, 0xb4,
-	0x1a, 0xf5, 0x52, 0x06, 0x2d, 0x43, 0xfa, 0xc1, 0xf1, 0x2f, 0x4b, 0x4b, 0xb5, 0x27, 0x5f, 0xbc,
-	0xd8, 0x36, 0xbe, 0x7c, 0xb1, 0x6d, 0xfc, 0xe7, 0xc5, 0xb6, 0xf1, 0xd9, 0xcb, 0xed, 0x6b, 0x5f,
-	0xbe, 0xdc, 0xbe, 0xf6, 0xcf, 0x97, 0xdb, 0xd7, 0x7e, 0x75, 0x39, 0xd0, 0x4c, 0x93, 0xff, 0x41,
-	0x91, 0x08, 0xea, 0x66, 0xe5, 0xb7, 0xd5, 0x07, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x59,
-	0xca, 0xc6, 0xfa, 0x11, 0x00, 0x00,
+	// 1996 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0xf5, 0xf7, 0x92, 0xd4, 0xaf, 0x47, 0x52, 0xa2, 0xc6, 0x8a, 0xbc, 0x96, 0x10, 0x49, 0x5f, 0x7e,
+	0x9d, 0x40, 0x48, 0x2c, 0xd2, 0x56, 0x8c, 0xa6, 0x75, 0x51, 0x03, 0xa2, 0x48, 0xd5, 0x4c, 0x6c,
+	0x89, 0x5e, 0xd2, 0x76, 0xd3, 0x02, 0xdd, 0x0c, 0x77, 0x87, 0xcb, 0x2d, 0xc9, 0x9d, 0xc5, 0xce,
+	0x90, 0xa6, 0xd0, 0x7f, 0x22, 0x2d, 0xd0, 0x7b, 0x6f, 0xed, 0xa9, 0xe8, 0x21, 0xa7, 0xfe, 0x05,
+	0x41, 0x4f, 0x46, 0x2e, 0x2d, 0x0c, 0x54, 0x0d, 0xec, 0x43, 0x7b, 0xea, 0xdf, 0x50, 0xcc, 0xcc,
+	0xee, 0x72, 0x29, 0x4b, 0xae, 0x15, 0xeb, 0x22, 0x68, 0xe6, 0xfd, 0x9a, 0x79, 0xef, 0xf3, 0x79,
+	0xf3, 0x96, 0xf0, 0x61, 0x1b, 0xb7, 0x8f, 0xfb, 0xd4, 0x2b, 0xb7, 0xb9, 0xc5, 0x38, 0xee, 0xb9,
+	0x9e, 0x53, 0x1e, 0xdd, 0x4e, 0xac, 0x4a, 0x7e, 0x40, 0x39, 0x45, 0xef, 0x85, 0x7a, 0xa5, 0x84,
+	0x64, 0x74, 0x7b, 0x6d, 0xc5, 0xa1, 0x0e, 0x95, 0x1a, 0x65, 0xf1, 0x9f, 0x52, 0x5e, 0xbb, 0x6e,
+	0x51, 0x36, 0xa0, 0xcc, 0x54, 0x02, 0xb5, 0x08, 0x45, 0x37, 0xd4, 0xaa, 0x3c, 0x89, 0xd5, 0x26,
+	0x1c, 0xdf, 0x2e, 0x4f, 0x45, 0x5b, 0xdb, 0x3c, 0xfb, 0x54, 0x3e, 0xf5, 0x43, 0x85, 0x9b, 0x09,
+	0x05, 0xab, 0x4b, 0xac, 0x9e, 0x4f, 0x5d, 0x8f, 0x87, 0x27, 0x9f, 0x6c, 0x84, 0xda, 0xa5, 0x84,
+	0x76, 0xdf, 0x75, 0xba, 0xe2, 0x2f, 0x89, 0xd5, 0x13, 0x3b, 0xa1, 0xfe, 0x32, 0x1e, 0xb8, 0x1e,
+	0x2d, 0xcb, 0xbf, 0xd1, 0x89, 0x1c, 0x4a, 0x9d, 0x3e, 0x29, 0xcb, 0x55, 0x7b, 0xd8, 0x29, 0x73,
+	0x77, 0x40, 0x18, 0xc7, 0x83, 0xf0, 0x44, 0xc5, 0xff, 0xcc, 0x40, 0xe1, 0xc0, 0xf5, 0x70, 0xdf,
+	0xe5, 0xc7, 0x8d, 0x80, 0x8e, 0x5c, 0x9b, 0x04, 0xe8, 0x26, 0x64, 0xb0, 0x6d, 0x07, 0xba, 0xb6,
+	0xa5, 0x6d, 0x2f, 0x54, 0xf4, 0x6f, 0xbf, 0xde, 0x59, 0x09, 0xb3, 0xb1, 0x67, 0xdb, 0x01, 0x61,
+	0xac, 0xc9, 0x03, 0xd7, 0x73, 0x0c, 0xa9, 0x85, 0x6a, 0x90, 0xb5, 0x09, 0xb3, 0x02, 0xd7, 0xe7,
+	0x2e, 0xf5, 0xf4, 0xd4, 0x96, 0xb6, 0x9d, 0xdd, 0xfd, 0xff, 0x52, 0x68, 0x31, 0xc9, 0xba, 0xcc,
+	0x58, 0xa9, 0x3a, 0x51, 0x35, 0x92, 0x76, 0xe8, 0x21, 0x80, 0x45, 0x07, 0x03, 0x97, 0x31, 0xe1,
+	0x25, 0x2d, 0x43, 0xef, 0xbc, 0x38, 0xd9, 0x5c, 0x57, 0x8e, 0x98, 0xdd, 0x2b, 0xb9, 0xb4, 0x3c,
+	0xc0, 0xbc, 0x5b, 0x7a, 0x40, 0x1c, 0x6c, 0x1d, 0x57, 0x89, 0xf5, 0xed, 0xd7, 0x3b, 0x10, 0xc6,
+	0xa9, 0x12, 0xcb, 0x48, 0x38, 0x40, 0x06, 0xcc, 0xb6, 0xb9, 0x65, 0xfa, 0x3d, 0x3d, 0xb3, 0xa5,
+	0x6d, 0xe7, 0x2a, 0x3f, 0x7e, 0x71, 0xb2, 0xf9, 0xa9, 0xe3, 0xf2, 0xee, 0xb0, 0x5d, 0xb2, 0xe8,
+	0xa0, 0x1c, 0xe6, 0xb6, 0x8f, 0xdb, 0x6c, 0xc7, 0xa5, 0xd1, 0xb2, 0x3c, 0xba, 0x53, 0xe6, 0xc7,
+	0x3e, 0x61, 0xa5, 0x4a, 0xbd, 0xf1, 0xc9, 0x9d, 0x5b, 0x8d, 0x61, 0xfb, 0x73, 0x72, 0x6c, 0xcc,
+	0xb4, 0xb9, 0xd5, 0xe8, 0xa1, 0x9f, 0x40, 0xda, 0xa7, 0xbe, 0x3e, 0x23, 0x6f, 0xf8, 0x71, 0xe9,
+	0x4c, 0x6c, 0x95, 0x1a, 0x01, 0xa5, 0x9d, 0xa3, 0x4e, 0x83, 0x32, 0x46, 0xe4, 0x51, 0x2a, 0xad,
+	0x7d, 0x43, 0xd8, 0xa1, 0x3b, 0xb0, 0xca, 0xfa, 0x98, 0x75, 0x89, 0x6d, 0x86, 0xa6, 0x66, 0x97,
+	0x88, 0x2a, 0xea, 0xb3, 0x5b, 0xda, 0x76, 0xc6, 0x58, 0x09, 0xa5, 0x15, 0x25, 0xbc, 0x2f, 0x65,
+	0xe8, 0x26, 0xa0, 0xd8, 0x8a, 0x5b, 0x91, 0xc5, 0xdc, 0x96, 0xb6, 0x9d, 0x37, 0x0a, 0x91, 0x05,
+	0xb7, 0x42, 0xed, 0x55, 0x98, 0xfd, 0x15, 0x76, 0xfb, 0xc4, 0xd6, 0xe7, 0xb7, 0xb4, 0xed, 0x79,
+	0x23, 0x5c, 0xa1, 0x5b, 0xb0, 0xd2, 0x75, 0x9d, 0x2e, 0x61, 0xdc, 0x1c, 0x51, 0x4e, 0xec, 0xc8,
+	0xcf, 0x82, 0xf4, 0x83, 0x42, 0xd9, 0x13, 0x21, 0x0a, 0x3d, 0x1d, 0xc2, 0xd2, 0x24, 0x9d, 0xa6,
+	0xeb, 0x75, 0xa8, 0x9e, 0x95, 0x17, 0xff, 0xe0, 0x9c, 0x8b, 0xef, 0xc7, 0xda, 0x75, 0xaf, 0x43,
+	0x8d, 0x45, 0x6b, 0x6a, 0x8d, 0x9e, 0x42, 0xbe, 0x47, 0x8e, 0xcd, 0x80, 0x72, 0x2c, 0xea, 0xcd,
+	0xf4, 0xdc, 0x56, 0x7a, 0x3b, 0xbb, 0xbb, 0x7b, 0x8e, 0xb7, 0xd3, 0xa0, 0x14, 0x65, 0x08, 0x4d,
+	0x8d, 0x5c, 0x6f, 0xb2, 0x60, 0x22, 0x41, 0x03, 0x3c, 0x36, 0x6d, 0xd2, 0x27, 0x0e, 0x16, 0x57,
+	0x63, 0x98, 0x33, 0x3d, 0x2f, 0x53, 0x5a, 0x18, 0xe0, 0x71, 0x35, 0x12, 0x34, 0x31, 0x67, 0xc5,
+	0x3f, 0x68, 0xb0, 0xfe, 0x06, 0xdf, 0xe8, 0x17, 0x90, 0xf5, 0xc8, 0x33, 0x93, 0x50, 0xce, 0x04,
+	0x78, 0xb4, 0x77, 0x07, 0xcf, 0x82, 0x47, 0x9e, 0xd5, 0x28, 0x67, 0x8d, 0x1e, 0xfa, 0x18, 0x96,
+	0xb1, 0xc5, 0xdd, 0x91, 0x0c, 0x15, 0x95, 0x20, 0xa5, 0x4e, 0x3a, 0x11, 0xa8, 0x02, 0x14, 0x7f,
+	0x97, 0x82, 0xc5, 0xe9, 0x9c, 0xa2, 0x47, 0x30, 0x2f, 0xae, 0x1a, 0x60, 0x4e, 0x42, 0x72, 0xfe,
+	0xe0, 0x9b, 0x93, 0xcd, 0x2b, 0x17, 0x62, 0xc9, 0x1f, 0xff, 0xf5, 0xe7, 0x8f, 0x34, 0x63, 0x6e,
+	0x80, 0xc7, 0x06, 0xe6, 0x04, 0xfd, 0x12, 0x96, 0x84, 0x4b, 0xab, 0x8b, 0x3d, 0x87, 0x28, 0xcf,
+	0xa9, 0x77, 0xf2, 0x9c, 0x1f, 0xe0, 0xf1, 0xbe, 0xf4, 0x26, 0xfd, 0x7f, 0x06, 0xd9, 0xa1, 0x6f,
+	0x63, 0x4e, 0x4c, 0xd1, 0x7a, 0x24, 0xaf, 0xb3, 0xbb, 0x6b, 0x25, 0xd5, 0x97, 0x4a, 0x51, 0x5f,
+	0x2a, 0xb5, 0xa2, 0xbe, 0x54, 0xc9, 0x8b, 0xb8, 0x5f, 0xfd, 0x73, 0x53, 0x53, 0xee, 0x40, 0x59,
+	0x0b, 0xf9, 0xdd, 0xcc, 0xbf, 0x7f, 0xbf, 0xa9, 0x15, 0xff, 0x96, 0x02, 0xfd, 0x74, 0x05, 0x9f,
+	0xba, 0xbc, 0xfb, 0x90, 0x70, 0x9c, 0xa0, 0xbd, 0x76, 0x69, 0xb4, 0x5f, 0x85, 0xd9, 0xa9, 0x52,
+	0x85, 0x2b, 0xf4, 0x7f, 0x90, 0x1b, 0x51, 0xee, 0x7a, 0x8e, 0xe9, 0xd3, 0x67, 0x24, 0x90, 0x77,
+	0xcb, 0x18, 0x59, 0xb5, 0xd7, 0x10, 0x5b, 0x6f, 0xa0, 0x7c, 0xe6, 0xc2, 0x94, 0x9f, 0xf9, 0x9f,
+	0x94, 0x9f, 0x7d, 0x2b, 0xca, 0xcf, 0x9d, 0x47, 0xf9, 0xe2, 0x77, 0xf3, 0x90, 0xaf, 0xb4, 0xf6,
+	0x43, 0xc2, 0x08, 0x36, 0xfc, 0x08, 0xb2, 0x82, 0x93, 0x24, 0x30, 0xdf, 0xea, 0x41, 0x00, 0xa5,
+	0x2c, 0x36, 0x13, 0x95, 0x48, 0x5d, 0x76, 0x03, 0x4e, 0x7f, 0xcf, 0x06, 0xfc, 0x25, 0x2c, 0x76,
+	0x7c, 0x53, 0x9d, 0xca, 0xec, 0xbb, 0x4c, 0x54, 0x21, 0xfd, 0xae, 0x47, 0xcb, 0x76, 0xfc, 0x8a,
+	0x38, 0xdc, 0x03, 0x97, 0x49, 0x48, 0x84, 0x27, 0x51, 0x70, 0x57, 0x35, 0xcb, 0x86, 0x7b, 0x02,
+	0xc4, 0xa1, 0x4a, 0xc0, 0x93, 0xbd, 0x5f, 0xa9, 0x04, 0x3c, 0xac, 0xe8, 0xfb, 0x00, 0xc4, 0x3b,
+	0x55, 0xaf, 0x05, 0xe2, 0x45, 0x9d, 0x79, 0x1d, 0x16, 0x38, 0xe5, 0xb8, 0x2f, 0x1a, 0x9d, 0x6c,
+	0xf3, 0x19, 0x63, 0x5e, 0x6e, 0x34, 0xb1, 0xb4, 0x8d, 0x4f, 0x30, 0x96, 0xed, 0x3d, 0x67, 0x2c,
+	0x44, 0xf1, 0xc7, 0x12, 0x5a, 0xa1, 0x98, 0x0e, 0xb9, 0x3f, 0xe4, 0xa6, 0x6b, 0x8f, 0x75, 0x08,
+	0xa1, 0xa5, 0x24, 0x47, 0x52, 0x50, 0xb7, 0xc7, 0x68, 0x17, 0xb2, 0x12, 0x6e, 0xa1, 0xb7, 0xac,
+	0x2c, 0xe4, 0xf2, 0x8b, 0x93, 0x4d, 0x01, 0x93, 0x66, 0x28, 0x69, 0x8d, 0x0d, 0x60, 0xf1, 0xff,
+	0xc8, 0x82, 0x7c, 0xd8, 0x8a, 0x69, 0x60, 0x32, 0xd7, 0xd1, 0x73, 0xd2, 0xea, 0xde, 0x8b, 0x93,
+	0xcd, 0xbb, 0x17, 0xce, 0x71, 0xd3, 0x75, 0x3c, 0xcc, 0x87, 0x01, 0x31, 0x72, 0xb1, 0xd3, 0xa6,
+	0xeb, 0xa0, 0xc7, 0x90, 0xb7, 0xe8, 0x88, 0x78, 0xd8, 0xe3, 0x22, 0x86, 0x68, 0xf7, 0xe2, 0x31,
+	0xb9, 0x75, 0xee, 0xd3, 0xa4, 0x74, 0xf7, 0x6c, 0xec, 0x2b, 0x0f, 0xca, 0x2b, 0x33, 0x72, 0x91,
+	0x9b, 0xa6, 0xeb, 0x30, 0xf4, 0x01, 0x2c, 0x0e, 0xbd, 0x36, 0xf5, 0xec, 0xb8, 0x80, 0x8b, 0x32,
+	0x33, 0xf9, 0x78, 0x57, 0x96, 0xf0, 0x11, 0x14, 0x04, 0x88, 0x86, 0x9e, 0x1d, 0x33, 0x45, 0x5f,
+	0x92, 0x98, 0xfc, 0xf0, 0x9c, 0x03, 0x54, 0x5a, 0xfb, 0x8f, 0x13, 0xda, 0xc6, 0x52, 0x9b, 0x5b,
+	0xc9, 0x0d, 0x11, 0xd9, 0xc7, 0x01, 0x1e, 0x30, 0x73, 0x44, 0x02, 0x39, 0x01, 0x15, 0x54, 0x64,
+	0xb5, 0xfb, 0x44, 0x6d, 0xa2, 0x1b, 0xb0, 0x28, 0x22, 0x73, 0xd7, 0x8f, 0xd0, 0xb1, 0x2c, 0xd5,
+	0x72, 0x6d, 0x6e, 0xb5, 0x5c, 0x3f, 0x04, 0xc8, 0x3d, 0x98, 0x63, 0xbc, 0x67, 0x92, 0xb1, 0xaf,
+	0xa3, 0x37, 0x3e, 0xd9, 0x4d, 0x41, 0xd7, 0xda, 0xd8, 0xc7, 0x9e, 0xf0, 0x6e, 0xcc, 0x32, 0xde,
+	0xab, 0x8d, 0x7d, 0xf4, 0x05, 0x40, 0xc7, 0x8f, 0x9f, 0xc0, 0xab, 0xef, 0x4e, 0xdf, 0xf9, 0x8e,
+	0xaf, 0x5e, 0xc0, 0xe2, 0x3f, 0x34, 0x58, 0x9c, 0x8e, 0x8a, 0x3e, 0x05, 0xdd, 0x0f, 0xc8, 0xc8,
+	0xa5, 0x43, 0x66, 0x4e, 0xa0, 0x6b, 0x76, 0x31, 0xeb, 0xaa, 0x26, 0x6e, 0xbc, 0x17, 0xc9, 0x9b,
+	0x11, 0x8e, 0xef, 0x63, 0xd6, 0x45, 0x65, 0x58, 0xa1, 0xbc, 0x4b, 0x02, 0xb3, 0x33, 0x0c, 0x2b,
+	0x36, 0x16, 0xa0, 0x56, 0xfd, 0xc6, 0x58, 0x96, 0xb2, 0x03, 0x25, 0x6a, 0x8d, 0x8f, 0x86, 0x1c,
+	0x61, 0x58, 0x4b, 0x44, 0xea, 0x99, 0xd3, 0x10, 0x4a, 0x4b, 0x08, 0xdd, 0x38, 0x2f, 0x55, 0x11,
+	0x66, 0xe4, 0x70, 0x73, 0x6d, 0x72, 0xa2, 0xde, 0x7e, 0x02, 0x41, 0xc5, 0x7b, 0xb0, 0x5a, 0x8d,
+	0x80, 0xfa, 0x38, 0x02, 0x8d, 0x7c, 0xbb, 0x6f, 0xc0, 0x22, 0xf3, 0x05, 0xad, 0x65, 0x8f, 0x14,
+	0x74, 0x52, 0x97, 0xcb, 0xc9, 0x5d, 0x99, 0x93, 0xd6, 0xb8, 0xf8, 0x9b, 0x19, 0x58, 0x3a, 0x05,
+	0x16, 0xd1, 0x31, 0x12, 0xa8, 0x8c, 0xec, 0xb2, 0x13, 0x4c, 0xbe, 0x46, 0xd4, 0xd4, 0xdb, 0x10,
+	0x95, 0xc3, 0x6a, 0x82, 0xa8, 0x91, 0xb5, 0x60, 0x6c, 0xfa, 0x52, 0x18, 0xbb, 0x32, 0x61, 0x6c,
+	0xe8, 0x5c, 0x30, 0xb7, 0x03, 0xab, 0x93, 0xb4, 0x27, 0x82, 0x32, 0x3d, 0xf3, 0x3d, 0x29, 0xbc,
+	0x12, 0x53, 0x78, 0x12, 0x86, 0x21, 0x0b, 0xd6, 0xe3, 0x38, 0x93, 0xec, 0x31, 0xd7, 0x51, 0x8d,
+	0x7f, 0xe6, 0x02, 0xc5, 0xd6, 0x23, 0x47, 0x71, 0x41, 0x9b, 0xae, 0x23, 0xdb, 0xbd, 0x03, 0xfa,
+	0x24, 0x85, 0x93, 0x28, 0x72, 0x58, 0x9e, 0x95, 0xcc, 0xdb, 0x39, 0x27, 0xc2, 0xd9, 0x20, 0x31,
+	0x56, 0xed, 0x33, 0xf7, 0xd1, 0xaf, 0x61, 0xed, 0x8c, 0xdb, 0x60, 0x47, 0xd5, 0x6b, 0xee, 0x52,
+	0xea, 0x75, 0xed, 0xb5, 0x6b, 0xee, 0x39, 0xe2, 0xa6, 0xc5, 0x26, 0x5c, 0x9b, 0x4c, 0x05, 0x34,
+	0x98, 0x8c, 0x07, 0x0c, 0xfd, 0x10, 0x32, 0x36, 0xe9, 0x33, 0x5d, 0x7b, 0x63, 0x3a, 0xa7, 0x66,
+	0x0a, 0x43, 0x5a, 0x14, 0x0f, 0x61, 0xfd, 0x6c, 0xa7, 0x75, 0xcf, 0x26, 0x63, 0xc1, 0xed, 0x53,
+	0xbd, 0x40, 0xd5, 0x4d, 0x04, 0xca, 0x19, 0xcb, 0x2c, 0xd9, 0x08, 0x44, 0x29, 0x8a, 0x7f, 0xd2,
+	0x20, 0x3f, 0x55, 0x36, 0xf4, 0x39, 0xa4, 0x2e, 0x67, 0x0c, 0x4c, 0xf9, 0x3d, 0xd4, 0x80, 0xb4,
+	0xc8, 0x74, 0xea, 0x52, 0x32, 0x2d, 0x5c, 0x15, 0x7f, 0xab, 0xc1, 0xf5, 0x73, 0x41, 0x2d, 0xa6,
+	0x27, 0x8b, 0x8e, 0x2e, 0x6b, 0x8e, 0xb5, 0xe8, 0xa8, 0xd1, 0x13, 0x7d, 0x04, 0xab, 0x40, 0x8a,
+	0x70, 0x29, 0x99, 0xcb, 0x2c, 0x8e, 0x83, 0xb3, 0xe2, 0x5f, 0x34, 0xb8, 0xde, 0x24, 0x7d, 0x22,
+	0xbe, 0x45, 0x48, 0xc4, 0xa7, 0x9a, 0x18, 0xb1, 0x3d, 0x8b, 0xa0, 0xa7, 0xb0, 0x10, 0xcf, 0x4f,
+	0x97, 0x31, 0xd5, 0xcd, 0x85, 0xa3, 0x13, 0xda, 0x81, 0xab, 0x01, 0x11, 0xf0, 0x0b, 0x88, 0x6d,
+	0x86, 0x21, 0x58, 0x4f, 0xf5, 0x21, 0xa3, 0x10, 0x8b, 0x0e, 0x84, 0x7a, 0xb3, 0xf7, 0x59, 0x66,
+	0x5e, 0x2b, 0xa4, 0x8c, 0xa5, 0x53, 0x00, 0x29, 0xb6, 0x61, 0xb1, 0xee, 0x59, 0xfd, 0xa1, 0x78,
+	0x55, 0xe4, 0x10, 0x88, 0xee, 0x42, 0xba, 0x47, 0x8e, 0x65, 0x0a, 0xb3, 0xbb, 0xdb, 0x49, 0x74,
+	0x26, 0x7e, 0x6c, 0x19, 0xdd, 0x2e, 0xb5, 0x02, 0xec, 0x31, 0x6c, 0x09, 0xf8, 0x89, 0x73, 0x09,
+	0x23, 0xb4, 0x02, 0x33, 0xbe, 0x70, 0x12, 0x3e, 0x27, 0x6a, 0x51, 0xfc, 0xab, 0x06, 0x4b, 0x0f,
+	0x70, 0xe0, 0x10, 0xc6, 0x2b, 0xdc, 0x32, 0xc8, 0x51, 0xe0, 0x88, 0x91, 0xab, 0xdd, 0xa7, 0x56,
+	0xcf, 0xb4, 0xdd, 0x4e, 0x47, 0x06, 0xcb, 0x1b, 0x0b, 0x72, 0xa7, 0xea, 0x76, 0x3a, 0xe8, 0x21,
+	0xe4, 0x03, 0xda, 0xef, 0xb7, 0xb1, 0xd5, 0x33, 0x3b, 0x01, 0x1d, 0xe8, 0xa9, 0xd7, 0x8f, 0x93,
+	0xfc, 0x31, 0x47, 0x11, 0xe6, 0x3e, 0xc1, 0x36, 0x09, 0x64, 0x53, 0xc8, 0x45, 0xe6, 0x07, 0x01,
+	0x1d, 0xa0, 0x3a, 0x64, 0x63, 0x77, 0x9c, 0xea, 0xe9, 0x0b, 0x3a, 0x83, 0xc8, 0xb8, 0x45, 0x8b,
+	0xcf, 0x35, 0xc8, 0xc7, 0xaf, 0x17, 0xc7, 0x5c, 0x0e, 0x40, 0xde, 0x70, 0x60, 0xb2, 0x18, 0x88,
+	0xf2, 0x3a, 0x19, 0x23, 0xef, 0x0d, 0x07, 0x09, 0x74, 0xde, 0x82, 0x15, 0x35, 0x81, 0xf6, 0x31,
+	0x27, 0x9e, 0x75, 0x6c, 0xca, 0xdb, 0xb2, 0xf0, 0xfb, 0x08, 0x49, 0xd9, 0x03, 0x25, 0xaa, 0x48,
+	0x49, 0xf4, 0x91, 0x7e, 0x4a, 0x3f, 0x1d, 0x7f, 0xa4, 0x4f, 0x6b, 0xbf, 0x0f, 0x20, 0x8e, 0x21,
+	0x3e, 0x7d, 0x89, 0x1d, 0x7e, 0x2a, 0x2d, 0x78, 0xc3, 0xc1, 0x43, 0xb9, 0x81, 0xae, 0xc3, 0xbc,
+	0x10, 0x0b, 0x67, 0x72, 0xc2, 0xce, 0x18, 0x73, 0xde, 0x70, 0x20, 0x5c, 0x7c, 0xf4, 0x25, 0x5c,
+	0x9d, 0xea, 0x36, 0xe2, 0x5a, 0x43, 0x86, 0xb2, 0x30, 0xd7, 0xa8, 0x1d, 0x56, 0xeb, 0x87, 0x3f,
+	0x2d, 0x5c, 0x41, 0x39, 0x98, 0x7f, 0x52, 0x33, 0xea, 0x07, 0xf5, 0x5a, 0xb5, 0xa0, 0x21, 0x80,
+	0xd9, 0xbd, 0xfd, 0x56, 0xfd, 0x49, 0xad, 0x90, 0x12, 0x92, 0xc7, 0x87, 0x95, 0xa3, 0xc3, 0x6a,
+	0xad, 0x5a, 0x48, 0x0b, 0xa3, 0xda, 0xcf, 0x1a, 0x75, 0xa3, 0x56, 0x2d, 0x64, 0xd0, 0x1c, 0xa4,
+	0xf7, 0x0e, 0xbf, 0x28, 0xcc, 0x54, 0x1e, 0x7d, 0xf3, 0x72, 0x43, 0x7b, 0xfe, 0x72, 0x43, 0xfb,
+	0xee, 0xe5, 0x86, 0xf6, 0xd5, 0xab, 0x8d, 0x2b, 0xcf, 0x5f, 0x6d, 0x5c, 0xf9, 0xfb, 0xab, 0x8d,
+	0x2b, 0x3f, 0x7f, 0x3b, 0x1e, 0x8c, 0x93, 0xbf, 0x0e, 0x4a, 0x52, 0xb4, 0x67, 0xe5, 0x67, 0xf0,
+	0x27, 0xff, 0x1d, 0x00, 0xf3, 0x67, 0x12, 0x36, 0xd6, 0x14, 0x00, 0x00,
 }
 
 func (this *CommissionInfo) Equal(that interface{}) bool {
@@ -1312,6 +1500,25 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegatedSats != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.MaxDelegatedSats))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if m.CommissionInfo != nil {
 		{
 			size, err := m.CommissionInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -1407,6 +1614,46 @@ func (m *FinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalityProviderKeyRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderKeyRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderKeyRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NewEotsPk != nil {
+		{
+			size := m.NewEotsPk.Size()
+			i -= size
+			if _, err := m.NewEotsPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CommissionInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1548,6 +1795,20 @@ func (m *BTCDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FpEotsPk != nil {
+		{
+			size := m.FpEotsPk.Size()
+			i -= size
+			if _, err := m.FpEotsPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if m.StkExp != nil {
 		{
 			size, err := m.StkExp.MarshalToSizedBuffer(dAtA[:i])
@@ -1812,6 +2073,18 @@ func (m *BTCUndelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.CovenantUnbondingAggSig != nil {
+		{
+			size := m.CovenantUnbondingAggSig.Size()
+			i -= size
+			if _, err := m.CovenantUnbondingAggSig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBtcstaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.DelegatorUnbondingInfo != nil {
 		{
 			size, err := m.DelegatorUnbondingInfo.MarshalToSizedBuffer(dAtA[:i])
@@ -2182,6 +2455,54 @@ func (m *LargestBtcReOrg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *CovenantStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumLate != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.NumLate))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NumMissed != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.NumMissed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxLatencyBlocks != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.MaxLatencyBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalLatencyBlocks != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.TotalLatencyBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumSignatures != 0 {
+		i = encodeVarintBtcstaking(dAtA, i, uint64(m.NumSignatures))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBtcstaking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBtcstaking(v)
 	base := offset
@@ -2235,6 +2556,31 @@ func (m *FinalityProvider) Size() (n int) {
 		l = m.CommissionInfo.Size()
 		n += 1 + l + sovBtcstaking(uint64(l))
 	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovBtcstaking(uint64(l))
+		}
+	}
+	if m.MaxDelegatedSats != 0 {
+		n += 1 + sovBtcstaking(uint64(m.MaxDelegatedSats))
+	}
+	return n
+}
+
+func (m *FinalityProviderKeyRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewEotsPk != nil {
+		l = m.NewEotsPk.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovBtcstaking(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -2358,6 +2704,10 @@ func (m *BTCDelegation) Size() (n int) {
 		l = m.StkExp.Size()
 		n += 2 + l + sovBtcstaking(uint64(l))
 	}
+	if m.FpEotsPk != nil {
+		l = m.FpEotsPk.Size()
+		n += 2 + l + sovBtcstaking(uint64(l))
+	}
 	return n
 }
 
@@ -2431,6 +2781,10 @@ func (m *BTCUndelegation) Size() (n int) {
 		l = m.DelegatorUnbondingInfo.Size()
 		n += 1 + l + sovBtcstaking(uint64(l))
 	}
+	if m.CovenantUnbondingAggSig != nil {
+		l = m.CovenantUnbondingAggSig.Size()
+		n += 1 + l + sovBtcstaking(uint64(l))
+	}
 	return n
 }
 
@@ -2554,6 +2908,30 @@ func (m *LargestBtcReOrg) Size() (n int) {
 	return n
 }
 
+func (m *CovenantStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumSignatures != 0 {
+		n += 1 + sovBtcstaking(uint64(m.NumSignatures))
+	}
+	if m.TotalLatencyBlocks != 0 {
+		n += 1 + sovBtcstaking(uint64(m.TotalLatencyBlocks))
+	}
+	if m.MaxLatencyBlocks != 0 {
+		n += 1 + sovBtcstaking(uint64(m.MaxLatencyBlocks))
+	}
+	if m.NumMissed != 0 {
+		n += 1 + sovBtcstaking(uint64(m.NumMissed))
+	}
+	if m.NumLate != 0 {
+		n += 1 + sovBtcstaking(uint64(m.NumLate))
+	}
+	return n
+}
+
 func sovBtcstaking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2877,26 +3255,183 @@ func (m *FinalityProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBtcstaking(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBtcstaking
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotations = append(m.KeyRotations, &FinalityProviderKeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedSats", wireType)
+			}
+			m.MaxDelegatedSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatedSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderKeyRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderKeyRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderKeyRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEotsPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.NewEotsPk = &v
+			if err := m.NewEotsPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *CommissionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -3778,6 +4313,41 @@ func (m *BTCDelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpEotsPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpEotsPk = &v
+			if err := m.FpEotsPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -4272,6 +4842,41 @@ func (m *BTCUndelegation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingAggSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
+			m.CovenantUnbondingAggSig = &v
+			if err := m.CovenantUnbondingAggSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBtcstaking(dAtA[iNdEx:])
@@ -5076,6 +5681,151 @@ func (m *LargestBtcReOrg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *CovenantStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBtcstaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSignatures", wireType)
+			}
+			m.NumSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSignatures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLatencyBlocks", wireType)
+			}
+			m.TotalLatencyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalLatencyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatencyBlocks", wireType)
+			}
+			m.MaxLatencyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLatencyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissed", wireType)
+			}
+			m.NumMissed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumMissed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLate", wireType)
+			}
+			m.NumLate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBtcstaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumLate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBtcstaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBtcstaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBtcstaking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5,7 +5,10 @@ import (
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
 	"github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
 )
 
@@ -99,3 +102,76 @@ func TestLargestBtcReOrg_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestFinalityProviderKeyRotations(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	fp, err := datagen.GenRandomFinalityProvider(r)
+	require.NoError(t, err)
+
+	genPk := func() *bbn.BIP340PubKey {
+		pk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		return pk
+	}
+
+	// no rotation, the BTC PK is used at every height
+	require.True(t, fp.BtcPk.Equals(fp.EotsPkAtHeight(1)))
+	require.True(t, fp.BtcPk.Equals(fp.LatestEotsPk()))
+	require.False(t, fp.HasPendingKeyRotation(1))
+
+	pk1, pk2 := genPk(), genPk()
+	fp.KeyRotations = []*types.FinalityProviderKeyRotation{
+		{NewEotsPk: pk1, ActivationHeight: 100},
+		{NewEotsPk: pk2, ActivationHeight: 200},
+	}
+	require.NoError(t, fp.Validate())
+
+	require.True(t, fp.BtcPk.Equals(fp.EotsPkAtHeight(99)))
+	require.True(t, pk1.Equals(fp.EotsPkAtHeight(100)))
+	require.True(t, pk1.Equals(fp.EotsPkAtHeight(199)))
+	require.True(t, pk2.Equals(fp.EotsPkAtHeight(200)))
+	require.True(t, pk2.Equals(fp.LatestEotsPk()))
+	require.True(t, fp.HasPendingKeyRotation(199))
+	require.False(t, fp.HasPendingKeyRotation(200))
+
+	testCases := []struct {
+		name      string
+		rotations []*types.FinalityProviderKeyRotation
+		wantErr   string
+	}{
+		{
+			name:      "nil rotation",
+			rotations: []*types.FinalityProviderKeyRotation{nil},
+			wantErr:   "empty EOTS public key",
+		},
+		{
+			name:      "rotation to the BTC PK",
+			rotations: []*types.FinalityProviderKeyRotation{{NewEotsPk: fp.BtcPk, ActivationHeight: 100}},
+			wantErr:   "duplicated EOTS public key",
+		},
+		{
+			name: "duplicated rotated key",
+			rotations: []*types.FinalityProviderKeyRotation{
+				{NewEotsPk: pk1, ActivationHeight: 100},
+				{NewEotsPk: pk1, ActivationHeight: 200},
+			},
+			wantErr: "duplicated EOTS public key",
+		},
+		{
+			name: "unsorted rotations",
+			rotations: []*types.FinalityProviderKeyRotation{
+				{NewEotsPk: pk1, ActivationHeight: 200},
+				{NewEotsPk: pk2, ActivationHeight: 200},
+			},
+			wantErr: "not sorted by activation height",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fp.KeyRotations = tc.rotations
+			err := fp.Validate()
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.wantErr)
+		})
+	}
+}
@@ -16,6 +16,8 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "btcstaking/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgAddBTCDelegationInclusionProof{}, "btcstaking/MsgAddBTCDelegationInclusionProof", nil)
 	cdc.RegisterConcrete(&MsgBtcStakeExpand{}, "btcstaking/MsgBtcStakeExpand", nil)
+	cdc.RegisterConcrete(&MsgAddCovenantMuSig2Nonce{}, "btcstaking/MsgAddCovenantMuSig2Nonce", nil)
+	cdc.RegisterConcrete(&MsgAddCovenantMuSig2PartialSig{}, "btcstaking/MsgAddCovenantMuSig2PartialSig", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -30,6 +32,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgAddBTCDelegationInclusionProof{},
 		&MsgBtcStakeExpand{},
+		&MsgAddCovenantMuSig2Nonce{},
+		&MsgAddCovenantMuSig2PartialSig{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
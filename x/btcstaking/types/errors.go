@@ -35,4 +35,13 @@ var (
 	ErrLargestBtcReorgNotFound   = errorsmod.Register(ModuleName, 1128, "there is no BTC reorg currently set")
 	ErrInvalidStakeExpansion     = errorsmod.Register(ModuleName, 1129, "invalid stake expansion")
 	ErrFinalityProviderIsDeleted = errorsmod.Register(ModuleName, 1130, "the finality provider has been deleted")
+	ErrCovenantMuSig2Disabled    = errorsmod.Register(ModuleName, 1131, "covenant MuSig2 unbonding is not enabled for the BTC delegation")
+	ErrInvalidCovenantMuSig2     = errorsmod.Register(ModuleName, 1132, "invalid covenant MuSig2 nonce or partial signature")
+	ErrDuplicatedCovenantMuSig2  = errorsmod.Register(ModuleName, 1133, "the covenant MuSig2 nonce or partial signature is already submitted")
+	ErrInvalidFpKeyRotation      = errorsmod.Register(ModuleName, 1134, "invalid finality provider key rotation")
+	ErrEotsPkInUse               = errorsmod.Register(ModuleName, 1135, "the public key is already used by a finality provider")
+	ErrFpDelegationCapExceeded   = errorsmod.Register(ModuleName, 1136, "the BTC delegation exceeds the maximum amount of satoshis accepted by the finality provider")
+	ErrFpStakeRatioExceeded      = errorsmod.Register(ModuleName, 1137, "the BTC delegation exceeds the maximum ratio of the total staked BTC delegated to a single finality provider")
+	ErrInvalidCovenantRotation   = errorsmod.Register(ModuleName, 1138, "invalid covenant committee rotation")
+	ErrFpSelfBondTooLow          = errorsmod.Register(ModuleName, 1140, "the finality provider has not self-bonded the minimum amount of satoshis")
 )
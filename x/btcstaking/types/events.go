@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bbn "github.com/babylonlabs-io/babylon/v4/types"
@@ -63,13 +64,22 @@ func NewEventFinalityProviderCreated(fp *FinalityProvider) *EventFinalityProvide
 
 func NewEventFinalityProviderEdited(fp *FinalityProvider) *EventFinalityProviderEdited {
 	return &EventFinalityProviderEdited{
-		BtcPkHex:        fp.BtcPk.MarshalHex(),
-		Commission:      fp.Commission.String(),
-		Moniker:         fp.Description.Moniker,
-		Identity:        fp.Description.Identity,
-		Website:         fp.Description.Website,
-		SecurityContact: fp.Description.SecurityContact,
-		Details:         fp.Description.Details,
+		BtcPkHex:         fp.BtcPk.MarshalHex(),
+		Commission:       fp.Commission.String(),
+		Moniker:          fp.Description.Moniker,
+		Identity:         fp.Description.Identity,
+		Website:          fp.Description.Website,
+		SecurityContact:  fp.Description.SecurityContact,
+		Details:          fp.Description.Details,
+		MaxDelegatedSats: fp.MaxDelegatedSats,
+	}
+}
+
+func NewEventFinalityProviderKeyRotated(fp *FinalityProvider, rotation *FinalityProviderKeyRotation) *EventFinalityProviderKeyRotated {
+	return &EventFinalityProviderKeyRotated{
+		BtcPkHex:         fp.BtcPk.MarshalHex(),
+		NewEotsPkHex:     rotation.NewEotsPk.MarshalHex(),
+		ActivationHeight: rotation.ActivationHeight,
 	}
 }
 
@@ -114,7 +124,11 @@ func NewCovenantSignatureReceivedEvent(
 	unbondingTxSig *bbn.BIP340Signature,
 	stakeExpansionTxSig *bbn.BIP340Signature,
 ) *EventCovenantSignatureReceived {
-	var stakeExpansionTxSigHex string
+	var unbondingTxSigHex, stakeExpansionTxSigHex string
+
+	if unbondingTxSig != nil {
+		unbondingTxSigHex = unbondingTxSig.ToHexStr()
+	}
 
 	if btcDel.IsStakeExpansion() && stakeExpansionTxSig != nil {
 		stakeExpansionTxSigHex = stakeExpansionTxSig.ToHexStr()
@@ -123,11 +137,50 @@ func NewCovenantSignatureReceivedEvent(
 	return &EventCovenantSignatureReceived{
 		StakingTxHash:                      btcDel.MustGetStakingTxHash().String(),
 		CovenantBtcPkHex:                   covPK.MarshalHex(),
-		CovenantUnbondingSignatureHex:      unbondingTxSig.ToHexStr(),
+		CovenantUnbondingSignatureHex:      unbondingTxSigHex,
 		CovenantStakeExpansionSignatureHex: stakeExpansionTxSigHex,
 	}
 }
 
+func NewCovenantMuSig2SigAggregatedEvent(
+	btcDel *BTCDelegation,
+	aggSig *bbn.BIP340Signature,
+) *EventCovenantMuSig2SigAggregated {
+	return &EventCovenantMuSig2SigAggregated{
+		StakingTxHash:                  btcDel.MustGetStakingTxHash().String(),
+		CovenantAggregatedSignatureHex: aggSig.ToHexStr(),
+	}
+}
+
+func NewCovenantMuSig2SessionResetEvent(
+	btcDel *BTCDelegation,
+	covPK *bbn.BIP340PubKey,
+) *EventCovenantMuSig2SessionReset {
+	return &EventCovenantMuSig2SessionReset{
+		StakingTxHash:    btcDel.MustGetStakingTxHash().String(),
+		CovenantBtcPkHex: covPK.MarshalHex(),
+	}
+}
+
+func NewCovenantSignatureTimeoutEvent(
+	stakingTxHash *chainhash.Hash,
+	createdHeight uint64,
+	waitedBlocks uint64,
+	missingCovPks []bbn.BIP340PubKey,
+) *EventCovenantSignatureTimeout {
+	missingCovPksHex := make([]string, 0, len(missingCovPks))
+	for _, covPk := range missingCovPks {
+		missingCovPksHex = append(missingCovPksHex, covPk.MarshalHex())
+	}
+
+	return &EventCovenantSignatureTimeout{
+		StakingTxHash:            stakingTxHash.String(),
+		CreatedHeight:            createdHeight,
+		WaitedBlocks:             waitedBlocks,
+		MissingCovenantBtcPksHex: missingCovPksHex,
+	}
+}
+
 func NewCovenantQuorumReachedEvent(
 	btcDel *BTCDelegation,
 	state BTCDelegationStatus,
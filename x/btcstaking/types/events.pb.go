@@ -188,6 +188,9 @@ type EventFinalityProviderEdited struct {
 	SecurityContact string `protobuf:"bytes,6,opt,name=security_contact,json=securityContact,proto3" json:"security_contact,omitempty"`
 	// details define other optional details.
 	Details string `protobuf:"bytes,7,opt,name=details,proto3" json:"details,omitempty"`
+	// max_delegated_sats is the maximum amount of satoshis the finality
+	// provider accepts to be delegated to it, 0 means no cap
+	MaxDelegatedSats uint64 `protobuf:"varint,8,opt,name=max_delegated_sats,json=maxDelegatedSats,proto3" json:"max_delegated_sats,omitempty"`
 }
 
 func (m *EventFinalityProviderEdited) Reset()         { *m = EventFinalityProviderEdited{} }
@@ -272,6 +275,79 @@ func (m *EventFinalityProviderEdited) GetDetails() string {
 	return ""
 }
 
+func (m *EventFinalityProviderEdited) GetMaxDelegatedSats() uint64 {
+	if m != nil {
+		return m.MaxDelegatedSats
+	}
+	return 0
+}
+
+// EventFinalityProviderKeyRotated is the event emitted when a finality
+// provider registers a successor EOTS key
+type EventFinalityProviderKeyRotated struct {
+	// btc_pk_hex is the hex string of Bitcoin secp256k1 PK of this finality provider
+	BtcPkHex string `protobuf:"bytes,1,opt,name=btc_pk_hex,json=btcPkHex,proto3" json:"btc_pk_hex,omitempty"`
+	// new_eots_pk_hex is the hex string of the successor EOTS PK
+	NewEotsPkHex string `protobuf:"bytes,2,opt,name=new_eots_pk_hex,json=newEotsPkHex,proto3" json:"new_eots_pk_hex,omitempty"`
+	// activation_height is the Babylon height from which the successor EOTS PK
+	// is used
+	ActivationHeight uint64 `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventFinalityProviderKeyRotated) Reset()         { *m = EventFinalityProviderKeyRotated{} }
+func (m *EventFinalityProviderKeyRotated) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityProviderKeyRotated) ProtoMessage()    {}
+func (*EventFinalityProviderKeyRotated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{2}
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityProviderKeyRotated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityProviderKeyRotated.Merge(m, src)
+}
+func (m *EventFinalityProviderKeyRotated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityProviderKeyRotated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityProviderKeyRotated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityProviderKeyRotated proto.InternalMessageInfo
+
+func (m *EventFinalityProviderKeyRotated) GetBtcPkHex() string {
+	if m != nil {
+		return m.BtcPkHex
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderKeyRotated) GetNewEotsPkHex() string {
+	if m != nil {
+		return m.NewEotsPkHex
+	}
+	return ""
+}
+
+func (m *EventFinalityProviderKeyRotated) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
 // EventBTCDelegationStateUpdate is the event emitted when a BTC delegation's state is
 // updated. There are the following possible state transitions:
 // - non-existing -> pending, which happens upon `MsgCreateBTCDelegation`
@@ -289,7 +365,7 @@ func (m *EventBTCDelegationStateUpdate) Reset()         { *m = EventBTCDelegatio
 func (m *EventBTCDelegationStateUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationStateUpdate) ProtoMessage()    {}
 func (*EventBTCDelegationStateUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{2}
+	return fileDescriptor_74118427820fff75, []int{3}
 }
 func (m *EventBTCDelegationStateUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,7 +420,7 @@ func (m *EventSelectiveSlashing) Reset()         { *m = EventSelectiveSlashing{}
 func (m *EventSelectiveSlashing) String() string { return proto.CompactTextString(m) }
 func (*EventSelectiveSlashing) ProtoMessage()    {}
 func (*EventSelectiveSlashing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{3}
+	return fileDescriptor_74118427820fff75, []int{4}
 }
 func (m *EventSelectiveSlashing) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -397,7 +473,7 @@ func (m *EventPowerDistUpdate) Reset()         { *m = EventPowerDistUpdate{} }
 func (m *EventPowerDistUpdate) String() string { return proto.CompactTextString(m) }
 func (*EventPowerDistUpdate) ProtoMessage()    {}
 func (*EventPowerDistUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4}
+	return fileDescriptor_74118427820fff75, []int{5}
 }
 func (m *EventPowerDistUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +586,7 @@ func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventSlashedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventSlashedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 0}
+	return fileDescriptor_74118427820fff75, []int{5, 0}
 }
 func (m *EventPowerDistUpdate_EventSlashedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -553,7 +629,7 @@ func (m *EventPowerDistUpdate_EventJailedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventJailedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 1}
+	return fileDescriptor_74118427820fff75, []int{5, 1}
 }
 func (m *EventPowerDistUpdate_EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -596,7 +672,7 @@ func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) String() string {
 }
 func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) ProtoMessage() {}
 func (*EventPowerDistUpdate_EventUnjailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{4, 2}
+	return fileDescriptor_74118427820fff75, []int{5, 2}
 }
 func (m *EventPowerDistUpdate_EventUnjailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -653,7 +729,7 @@ func (m *EventFinalityProviderStatusChange) Reset()         { *m = EventFinality
 func (m *EventFinalityProviderStatusChange) String() string { return proto.CompactTextString(m) }
 func (*EventFinalityProviderStatusChange) ProtoMessage()    {}
 func (*EventFinalityProviderStatusChange) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{5}
+	return fileDescriptor_74118427820fff75, []int{6}
 }
 func (m *EventFinalityProviderStatusChange) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,7 +807,7 @@ func (m *EventBTCDelegationCreated) Reset()         { *m = EventBTCDelegationCre
 func (m *EventBTCDelegationCreated) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationCreated) ProtoMessage()    {}
 func (*EventBTCDelegationCreated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{6}
+	return fileDescriptor_74118427820fff75, []int{7}
 }
 func (m *EventBTCDelegationCreated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -859,7 +935,7 @@ func (m *EventCovenantSignatureReceived) Reset()         { *m = EventCovenantSig
 func (m *EventCovenantSignatureReceived) String() string { return proto.CompactTextString(m) }
 func (*EventCovenantSignatureReceived) ProtoMessage()    {}
 func (*EventCovenantSignatureReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{7}
+	return fileDescriptor_74118427820fff75, []int{8}
 }
 func (m *EventCovenantSignatureReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,6 +992,124 @@ func (m *EventCovenantSignatureReceived) GetCovenantStakeExpansionSignatureHex()
 	return ""
 }
 
+// EventCovenantMuSig2SigAggregated is the event emitted when MuSig2 partial
+// signatures of all covenant members on the unbonding tx are aggregated
+type EventCovenantMuSig2SigAggregated struct {
+	// staking_tx_hash is the hash of the staking identifing the BTC delegation
+	// that this covenant signature is for
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// covenant_aggregated_signature_hex is the hex str of the aggregated BIP340
+	// Schnorr signature of the covenant committee on the unbonding tx
+	CovenantAggregatedSignatureHex string `protobuf:"bytes,2,opt,name=covenant_aggregated_signature_hex,json=covenantAggregatedSignatureHex,proto3" json:"covenant_aggregated_signature_hex,omitempty"`
+}
+
+func (m *EventCovenantMuSig2SigAggregated) Reset()         { *m = EventCovenantMuSig2SigAggregated{} }
+func (m *EventCovenantMuSig2SigAggregated) String() string { return proto.CompactTextString(m) }
+func (*EventCovenantMuSig2SigAggregated) ProtoMessage()    {}
+func (*EventCovenantMuSig2SigAggregated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{9}
+}
+func (m *EventCovenantMuSig2SigAggregated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCovenantMuSig2SigAggregated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCovenantMuSig2SigAggregated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCovenantMuSig2SigAggregated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCovenantMuSig2SigAggregated.Merge(m, src)
+}
+func (m *EventCovenantMuSig2SigAggregated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCovenantMuSig2SigAggregated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCovenantMuSig2SigAggregated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCovenantMuSig2SigAggregated proto.InternalMessageInfo
+
+func (m *EventCovenantMuSig2SigAggregated) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventCovenantMuSig2SigAggregated) GetCovenantAggregatedSignatureHex() string {
+	if m != nil {
+		return m.CovenantAggregatedSignatureHex
+	}
+	return ""
+}
+
+// EventCovenantMuSig2SessionReset is the event emitted when a covenant member
+// replaces its public nonce, which discards all nonces and partial signatures
+// of the MuSig2 signing session of the unbonding tx. Every covenant member has
+// to submit a fresh nonce afterwards
+type EventCovenantMuSig2SessionReset struct {
+	// staking_tx_hash is the hash of the staking identifing the BTC delegation
+	// whose signing session is reset
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// covenant_btc_pk_hex is the hex str of the covenant member who reset the
+	// signing session
+	CovenantBtcPkHex string `protobuf:"bytes,2,opt,name=covenant_btc_pk_hex,json=covenantBtcPkHex,proto3" json:"covenant_btc_pk_hex,omitempty"`
+}
+
+func (m *EventCovenantMuSig2SessionReset) Reset()         { *m = EventCovenantMuSig2SessionReset{} }
+func (m *EventCovenantMuSig2SessionReset) String() string { return proto.CompactTextString(m) }
+func (*EventCovenantMuSig2SessionReset) ProtoMessage()    {}
+func (*EventCovenantMuSig2SessionReset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{10}
+}
+func (m *EventCovenantMuSig2SessionReset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCovenantMuSig2SessionReset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCovenantMuSig2SessionReset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCovenantMuSig2SessionReset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCovenantMuSig2SessionReset.Merge(m, src)
+}
+func (m *EventCovenantMuSig2SessionReset) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCovenantMuSig2SessionReset) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCovenantMuSig2SessionReset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCovenantMuSig2SessionReset proto.InternalMessageInfo
+
+func (m *EventCovenantMuSig2SessionReset) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventCovenantMuSig2SessionReset) GetCovenantBtcPkHex() string {
+	if m != nil {
+		return m.CovenantBtcPkHex
+	}
+	return ""
+}
+
 // EventCovenantQuorumReached is the event emitted quorum of covenant committee
 // is reached for a BTC delegation
 type EventCovenantQuorumReached struct {
@@ -930,7 +1124,7 @@ func (m *EventCovenantQuorumReached) Reset()         { *m = EventCovenantQuorumR
 func (m *EventCovenantQuorumReached) String() string { return proto.CompactTextString(m) }
 func (*EventCovenantQuorumReached) ProtoMessage()    {}
 func (*EventCovenantQuorumReached) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{8}
+	return fileDescriptor_74118427820fff75, []int{11}
 }
 func (m *EventCovenantQuorumReached) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -995,7 +1189,7 @@ func (m *EventBTCDelegationInclusionProofReceived) Reset() {
 func (m *EventBTCDelegationInclusionProofReceived) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationInclusionProofReceived) ProtoMessage()    {}
 func (*EventBTCDelegationInclusionProofReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{9}
+	return fileDescriptor_74118427820fff75, []int{12}
 }
 func (m *EventBTCDelegationInclusionProofReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1071,7 +1265,7 @@ func (m *EventBTCDelgationUnbondedEarly) Reset()         { *m = EventBTCDelgatio
 func (m *EventBTCDelgationUnbondedEarly) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelgationUnbondedEarly) ProtoMessage()    {}
 func (*EventBTCDelgationUnbondedEarly) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{10}
+	return fileDescriptor_74118427820fff75, []int{13}
 }
 func (m *EventBTCDelgationUnbondedEarly) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1142,7 +1336,7 @@ func (m *EventBTCDelegationExpired) Reset()         { *m = EventBTCDelegationExp
 func (m *EventBTCDelegationExpired) String() string { return proto.CompactTextString(m) }
 func (*EventBTCDelegationExpired) ProtoMessage()    {}
 func (*EventBTCDelegationExpired) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{11}
+	return fileDescriptor_74118427820fff75, []int{14}
 }
 func (m *EventBTCDelegationExpired) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1203,7 +1397,7 @@ func (m *EventUnexpectedUnbondingTx) Reset()         { *m = EventUnexpectedUnbon
 func (m *EventUnexpectedUnbondingTx) String() string { return proto.CompactTextString(m) }
 func (*EventUnexpectedUnbondingTx) ProtoMessage()    {}
 func (*EventUnexpectedUnbondingTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74118427820fff75, []int{12}
+	return fileDescriptor_74118427820fff75, []int{15}
 }
 func (m *EventUnexpectedUnbondingTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,10 +1454,89 @@ func (m *EventUnexpectedUnbondingTx) GetSpendStakeTxBlockIndex() uint32 {
 	return 0
 }
 
+// EventCovenantSignatureTimeout is the event emitted when a BTC delegation
+// waits for the signatures of some covenant members longer than the covenant
+// signature timeout
+type EventCovenantSignatureTimeout struct {
+	// staking_tx_hash uniquely identifies the BTC delegation
+	StakingTxHash string `protobuf:"bytes,1,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// created_height is the Babylon height at which the BTC delegation was
+	// created
+	CreatedHeight uint64 `protobuf:"varint,2,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// waited_blocks is the number of Babylon blocks the BTC delegation has
+	// waited for covenant signatures
+	WaitedBlocks uint64 `protobuf:"varint,3,opt,name=waited_blocks,json=waitedBlocks,proto3" json:"waited_blocks,omitempty"`
+	// missing_covenant_btc_pks_hex is the list of hex str of Bitcoin secp256k1
+	// PKs of the covenant members that did not sign the BTC delegation
+	MissingCovenantBtcPksHex []string `protobuf:"bytes,4,rep,name=missing_covenant_btc_pks_hex,json=missingCovenantBtcPksHex,proto3" json:"missing_covenant_btc_pks_hex,omitempty"`
+}
+
+func (m *EventCovenantSignatureTimeout) Reset()         { *m = EventCovenantSignatureTimeout{} }
+func (m *EventCovenantSignatureTimeout) String() string { return proto.CompactTextString(m) }
+func (*EventCovenantSignatureTimeout) ProtoMessage()    {}
+func (*EventCovenantSignatureTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74118427820fff75, []int{16}
+}
+func (m *EventCovenantSignatureTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCovenantSignatureTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCovenantSignatureTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCovenantSignatureTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCovenantSignatureTimeout.Merge(m, src)
+}
+func (m *EventCovenantSignatureTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCovenantSignatureTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCovenantSignatureTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCovenantSignatureTimeout proto.InternalMessageInfo
+
+func (m *EventCovenantSignatureTimeout) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *EventCovenantSignatureTimeout) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *EventCovenantSignatureTimeout) GetWaitedBlocks() uint64 {
+	if m != nil {
+		return m.WaitedBlocks
+	}
+	return 0
+}
+
+func (m *EventCovenantSignatureTimeout) GetMissingCovenantBtcPksHex() []string {
+	if m != nil {
+		return m.MissingCovenantBtcPksHex
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("babylon.btcstaking.v1.FinalityProviderStatus", FinalityProviderStatus_name, FinalityProviderStatus_value)
 	proto.RegisterType((*EventFinalityProviderCreated)(nil), "babylon.btcstaking.v1.EventFinalityProviderCreated")
 	proto.RegisterType((*EventFinalityProviderEdited)(nil), "babylon.btcstaking.v1.EventFinalityProviderEdited")
+	proto.RegisterType((*EventFinalityProviderKeyRotated)(nil), "babylon.btcstaking.v1.EventFinalityProviderKeyRotated")
 	proto.RegisterType((*EventBTCDelegationStateUpdate)(nil), "babylon.btcstaking.v1.EventBTCDelegationStateUpdate")
 	proto.RegisterType((*EventSelectiveSlashing)(nil), "babylon.btcstaking.v1.EventSelectiveSlashing")
 	proto.RegisterType((*EventPowerDistUpdate)(nil), "babylon.btcstaking.v1.EventPowerDistUpdate")
@@ -1273,11 +1546,14 @@ func init() {
 	proto.RegisterType((*EventFinalityProviderStatusChange)(nil), "babylon.btcstaking.v1.EventFinalityProviderStatusChange")
 	proto.RegisterType((*EventBTCDelegationCreated)(nil), "babylon.btcstaking.v1.EventBTCDelegationCreated")
 	proto.RegisterType((*EventCovenantSignatureReceived)(nil), "babylon.btcstaking.v1.EventCovenantSignatureReceived")
+	proto.RegisterType((*EventCovenantMuSig2SigAggregated)(nil), "babylon.btcstaking.v1.EventCovenantMuSig2SigAggregated")
+	proto.RegisterType((*EventCovenantMuSig2SessionReset)(nil), "babylon.btcstaking.v1.EventCovenantMuSig2SessionReset")
 	proto.RegisterType((*EventCovenantQuorumReached)(nil), "babylon.btcstaking.v1.EventCovenantQuorumReached")
 	proto.RegisterType((*EventBTCDelegationInclusionProofReceived)(nil), "babylon.btcstaking.v1.EventBTCDelegationInclusionProofReceived")
 	proto.RegisterType((*EventBTCDelgationUnbondedEarly)(nil), "babylon.btcstaking.v1.EventBTCDelgationUnbondedEarly")
 	proto.RegisterType((*EventBTCDelegationExpired)(nil), "babylon.btcstaking.v1.EventBTCDelegationExpired")
 	proto.RegisterType((*EventUnexpectedUnbondingTx)(nil), "babylon.btcstaking.v1.EventUnexpectedUnbondingTx")
+	proto.RegisterType((*EventCovenantSignatureTimeout)(nil), "babylon.btcstaking.v1.EventCovenantSignatureTimeout")
 }
 
 func init() {
@@ -1285,91 +1561,104 @@ func init() {
 }
 
 var fileDescriptor_74118427820fff75 = []byte{
-	// 1334 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0x47,
-	0x14, 0x8f, 0x1d, 0xe7, 0xdf, 0x73, 0x08, 0x61, 0x9b, 0x52, 0xe3, 0x82, 0x09, 0x06, 0xa2, 0x94,
-	0x16, 0x9b, 0x3f, 0xa9, 0x38, 0x54, 0xaa, 0x64, 0x27, 0x0e, 0x36, 0x44, 0x60, 0xec, 0x04, 0x89,
-	0x5e, 0x56, 0xeb, 0xdd, 0x17, 0x7b, 0xf0, 0x7a, 0x76, 0xb5, 0x3b, 0xeb, 0xd8, 0x9f, 0xa0, 0xa7,
-	0x4a, 0x9c, 0xfb, 0x09, 0x7a, 0x2b, 0xc7, 0x7e, 0x84, 0x5e, 0x2a, 0x71, 0xe8, 0xa1, 0xea, 0xa1,
-	0xaa, 0xc8, 0xa1, 0xdf, 0xa2, 0xaa, 0x76, 0x66, 0xd6, 0xde, 0x75, 0xd6, 0x90, 0x54, 0x70, 0x89,
-	0xb2, 0x33, 0xbf, 0xf7, 0x7b, 0x6f, 0x7e, 0xf3, 0xfe, 0x8c, 0x21, 0xdf, 0xd2, 0x5a, 0x43, 0xd3,
-	0xa2, 0xc5, 0x16, 0xd3, 0x5d, 0xa6, 0x75, 0x09, 0x6d, 0x17, 0xfb, 0x77, 0x8b, 0xd8, 0x47, 0xca,
-	0xdc, 0x82, 0xed, 0x58, 0xcc, 0x52, 0x3e, 0x95, 0x98, 0xc2, 0x18, 0x53, 0xe8, 0xdf, 0xcd, 0xae,
-	0xb5, 0xad, 0xb6, 0xc5, 0x11, 0x45, 0xff, 0x3f, 0x01, 0xce, 0x6e, 0xc4, 0x13, 0x86, 0x4c, 0x05,
-	0xee, 0x82, 0xd6, 0x23, 0xd4, 0x2a, 0xf2, 0xbf, 0x62, 0x29, 0xff, 0x63, 0x12, 0x2e, 0x57, 0x7c,
-	0xc7, 0xbb, 0x84, 0x6a, 0x26, 0x61, 0xc3, 0xba, 0x63, 0xf5, 0x89, 0x81, 0xce, 0xb6, 0x83, 0x1a,
-	0x43, 0x43, 0xb9, 0x0e, 0xd0, 0x62, 0xba, 0x6a, 0x77, 0xd5, 0x0e, 0x0e, 0x32, 0x89, 0xf5, 0xc4,
-	0xe6, 0x52, 0x79, 0xee, 0xa7, 0x7f, 0x5e, 0xdf, 0x4a, 0x34, 0x16, 0x5b, 0x4c, 0xaf, 0x77, 0xab,
-	0x38, 0x50, 0x2e, 0x41, 0x4a, 0x33, 0x0c, 0x27, 0x93, 0x0c, 0x6f, 0xf3, 0x25, 0xe5, 0x26, 0x80,
-	0x6e, 0xf5, 0x7a, 0xc4, 0x75, 0x89, 0x45, 0x33, 0xb3, 0x61, 0x40, 0x68, 0x43, 0xc9, 0xc0, 0x42,
-	0xcf, 0xa2, 0xa4, 0x8b, 0x4e, 0x26, 0xe5, 0x63, 0x1a, 0xc1, 0xa7, 0x92, 0x85, 0x45, 0x62, 0x20,
-	0x65, 0x84, 0x0d, 0x33, 0x73, 0x7c, 0x6b, 0xf4, 0xed, 0x5b, 0x1d, 0x61, 0xcb, 0x25, 0x0c, 0x33,
-	0xf3, 0xc2, 0x4a, 0x7e, 0x2a, 0x5f, 0xc0, 0xaa, 0x8b, 0xba, 0xe7, 0x10, 0x36, 0x54, 0x75, 0x8b,
-	0x32, 0x4d, 0x67, 0x99, 0x05, 0x0e, 0x39, 0x1f, 0xac, 0x6f, 0x8b, 0x65, 0x9f, 0xc4, 0x40, 0xa6,
-	0x11, 0xd3, 0xcd, 0x2c, 0x0a, 0x12, 0xf9, 0x99, 0xff, 0x37, 0x01, 0x9f, 0xc7, 0x8a, 0x53, 0x31,
-	0xc8, 0xa9, 0xb5, 0x89, 0x0a, 0x90, 0x3c, 0x85, 0x00, 0xb3, 0xd3, 0x05, 0x48, 0x4d, 0x17, 0x60,
-	0xee, 0xfd, 0x02, 0xcc, 0xbf, 0x57, 0x80, 0x85, 0xa8, 0x00, 0xaf, 0x12, 0x70, 0x85, 0x0b, 0x50,
-	0xde, 0xdf, 0xde, 0x41, 0x13, 0xdb, 0x1a, 0x23, 0x16, 0x6d, 0x32, 0x8d, 0xe1, 0x81, 0x6d, 0x68,
-	0x0c, 0x95, 0x0d, 0x38, 0x2f, 0x73, 0x4c, 0x65, 0x03, 0xb5, 0xa3, 0xb9, 0x1d, 0xa1, 0x43, 0xe3,
-	0x9c, 0x5c, 0xde, 0x1f, 0x54, 0x35, 0xb7, 0xa3, 0x3c, 0x84, 0x25, 0x8a, 0x47, 0xaa, 0xeb, 0x9b,
-	0x72, 0x11, 0x56, 0xee, 0xdd, 0x2a, 0xc4, 0xe6, 0x78, 0xe1, 0x84, 0x2f, 0xcf, 0x6d, 0x2c, 0x52,
-	0x3c, 0xe2, 0x6e, 0xf3, 0x87, 0x70, 0x91, 0x47, 0xd4, 0x44, 0x13, 0x75, 0x46, 0xfa, 0xd8, 0x34,
-	0x35, 0xb7, 0x43, 0x68, 0x5b, 0xd9, 0x83, 0x45, 0xf4, 0x6f, 0x87, 0xea, 0xc8, 0x63, 0x48, 0xdf,
-	0xbb, 0x33, 0xc5, 0xc3, 0x09, 0xdb, 0x8a, 0xb4, 0x6b, 0x8c, 0x18, 0xf2, 0x3f, 0xcc, 0xc3, 0x1a,
-	0x77, 0x54, 0xb7, 0x8e, 0xd0, 0xd9, 0x21, 0x2e, 0x93, 0x27, 0x26, 0x00, 0xae, 0x6f, 0x86, 0x86,
-	0x7a, 0x68, 0x4b, 0x47, 0xd5, 0x29, 0x8e, 0xe2, 0x08, 0xc4, 0x62, 0x53, 0x50, 0x4c, 0x26, 0x56,
-	0x75, 0xa6, 0xb1, 0x24, 0xd9, 0x77, 0x6d, 0xe5, 0x10, 0x96, 0x5e, 0x6a, 0xc4, 0x14, 0x9e, 0x92,
-	0xdc, 0xd3, 0xc3, 0x33, 0x7b, 0x7a, 0xc4, 0x19, 0x62, 0x1c, 0x2d, 0x0a, 0xee, 0x5d, 0x5b, 0x31,
-	0x21, 0xed, 0xd1, 0xb1, 0xa7, 0x59, 0xee, 0xa9, 0x76, 0x66, 0x4f, 0x07, 0x92, 0x23, 0xc6, 0x17,
-	0x04, 0xfc, 0xbb, 0xb6, 0xd2, 0x86, 0x35, 0xbf, 0x6a, 0x0c, 0x34, 0x45, 0x3a, 0xa8, 0x1e, 0xe7,
-	0xe0, 0xb9, 0x9d, 0xbe, 0xb7, 0xf5, 0x2e, 0xb7, 0xd3, 0xd2, 0xb0, 0x3a, 0xd3, 0xb8, 0xd0, 0x62,
-	0xfa, 0x0e, 0x9a, 0xa1, 0xc5, 0x6c, 0x57, 0xb6, 0xb6, 0x29, 0x5a, 0x2b, 0x8f, 0x21, 0x69, 0x77,
-	0xf9, 0x0d, 0x2e, 0x97, 0xbf, 0xf9, 0xf3, 0xaf, 0xab, 0x0f, 0xda, 0x84, 0x75, 0xbc, 0x56, 0x41,
-	0xb7, 0x7a, 0x45, 0x19, 0x84, 0xa9, 0xb5, 0xdc, 0xdb, 0xc4, 0x0a, 0x3e, 0x8b, 0xfd, 0xad, 0x22,
-	0x1b, 0xda, 0xe8, 0x16, 0xca, 0xb5, 0xfa, 0xfd, 0xad, 0x3b, 0x75, 0xaf, 0xf5, 0x18, 0x87, 0x8d,
-	0xa4, 0xdd, 0xcd, 0xbe, 0x94, 0xad, 0x22, 0x5e, 0xee, 0x0f, 0xeb, 0xcb, 0x94, 0x55, 0x39, 0x4d,
-	0xf0, 0x0f, 0xea, 0xad, 0x9c, 0x82, 0x24, 0xf6, 0xf3, 0x08, 0xd7, 0x62, 0x5b, 0xa1, 0x28, 0xd0,
-	0xed, 0x8e, 0x46, 0xdb, 0xa8, 0x5c, 0x86, 0x79, 0xd1, 0x10, 0xa3, 0xcd, 0x70, 0x8e, 0x37, 0x43,
-	0x25, 0x3f, 0xd9, 0x03, 0xc6, 0xdd, 0x72, 0x54, 0xde, 0xbf, 0xa4, 0xe0, 0xd2, 0xc9, 0xab, 0x0e,
-	0x86, 0xd1, 0x97, 0xb0, 0x12, 0xee, 0x36, 0x93, 0x4d, 0x77, 0x79, 0xdc, 0x73, 0x70, 0xa0, 0x3c,
-	0x80, 0xb5, 0x00, 0x6c, 0x79, 0xcc, 0xf6, 0x98, 0x4a, 0xa8, 0x81, 0x83, 0xa8, 0x67, 0x45, 0x42,
-	0x9e, 0x72, 0x44, 0xcd, 0x07, 0x28, 0x5f, 0xc1, 0x8a, 0xad, 0x39, 0x5a, 0xcf, 0x55, 0xfb, 0xe8,
-	0x9c, 0x1c, 0x5b, 0xe7, 0xc4, 0xe6, 0x73, 0xb1, 0xa7, 0x3c, 0x84, 0x2b, 0x87, 0x52, 0x13, 0xd5,
-	0x96, 0xa2, 0xa8, 0x42, 0x05, 0x97, 0x87, 0x98, 0x5a, 0x9f, 0x1d, 0x1b, 0x5f, 0x3a, 0x9c, 0xd0,
-	0xaf, 0xec, 0x4b, 0xe3, 0xfa, 0xf1, 0xde, 0x81, 0x0b, 0x7e, 0x30, 0x23, 0x6b, 0x6e, 0x3c, 0x17,
-	0xf6, 0xbc, 0x22, 0xf6, 0xcb, 0xc1, 0x68, 0xd9, 0x84, 0xe5, 0x91, 0x1c, 0xa4, 0x27, 0x67, 0x60,
-	0x00, 0x4e, 0x07, 0x62, 0x90, 0x1e, 0xfa, 0x47, 0xf2, 0x68, 0xcb, 0xa2, 0xc6, 0x08, 0xbb, 0x10,
-	0x39, 0xd2, 0x68, 0x93, 0xa3, 0x37, 0x61, 0x39, 0x84, 0x1e, 0x88, 0xb1, 0x38, 0xe2, 0x1d, 0x63,
-	0x07, 0xd1, 0x2b, 0x5d, 0x8a, 0xbd, 0x52, 0x65, 0x03, 0xd2, 0xf2, 0x5c, 0xfc, 0x8d, 0x00, 0x91,
-	0x09, 0x28, 0x76, 0x4a, 0xfe, 0x4b, 0xe1, 0x5b, 0xb8, 0x6c, 0x3b, 0xd8, 0x27, 0x96, 0xe7, 0xaa,
-	0x13, 0x33, 0x85, 0x4b, 0x91, 0xe6, 0x73, 0x25, 0x13, 0x60, 0x9a, 0xe1, 0xf9, 0x52, 0xc5, 0x41,
-	0xfe, 0x75, 0x12, 0x72, 0x3c, 0x75, 0xb6, 0xad, 0x3e, 0x52, 0x8d, 0xb2, 0x26, 0x69, 0x53, 0x8d,
-	0x79, 0x0e, 0x36, 0x50, 0x47, 0xd2, 0x47, 0x43, 0xb9, 0x3d, 0x65, 0x5a, 0x8d, 0x74, 0x88, 0x0e,
-	0xad, 0x2d, 0xf8, 0x44, 0x97, 0x5c, 0xe1, 0x3b, 0x89, 0x24, 0xd0, 0x6a, 0x80, 0x18, 0xdd, 0xca,
-	0x13, 0x58, 0x1f, 0x59, 0x8d, 0x65, 0x74, 0x83, 0x60, 0x38, 0x45, 0x24, 0xa1, 0xae, 0x04, 0xf0,
-	0x83, 0x00, 0x3d, 0x8a, 0xdc, 0xe7, 0x7b, 0x01, 0x1b, 0x23, 0x3e, 0x2e, 0x97, 0x8a, 0x03, 0x5b,
-	0xa3, 0x7e, 0xf2, 0x4d, 0xb0, 0xa6, 0xc2, 0xac, 0xf9, 0xc0, 0xc8, 0x17, 0x0a, 0x2b, 0x81, 0x49,
-	0x98, 0x3a, 0x6f, 0x41, 0x36, 0xa2, 0xd8, 0x33, 0xcf, 0x72, 0xbc, 0x5e, 0x03, 0x35, 0xbd, 0x73,
-	0x76, 0xb5, 0x4e, 0x53, 0xde, 0xbf, 0x25, 0x60, 0xf3, 0x64, 0x79, 0xd7, 0xa8, 0x6e, 0x7a, 0x7e,
-	0x70, 0x75, 0xc7, 0xb2, 0x0e, 0xff, 0xef, 0x6d, 0x89, 0x6a, 0x70, 0x98, 0xda, 0x41, 0xd2, 0xee,
-	0xb0, 0x68, 0x08, 0x69, 0xbe, 0x55, 0xe5, 0x3b, 0xca, 0x0d, 0x00, 0xa4, 0x46, 0x80, 0x8b, 0xdc,
-	0xc5, 0x12, 0x52, 0x43, 0xa2, 0x22, 0xe7, 0x49, 0xc5, 0x9f, 0xe7, 0xf7, 0x84, 0xcc, 0x39, 0x71,
-	0x1e, 0x71, 0x1c, 0x71, 0x8d, 0x68, 0x54, 0x34, 0xc7, 0x1c, 0x7e, 0xbc, 0x53, 0x44, 0xe2, 0x9b,
-	0x8d, 0xaf, 0xbd, 0xaf, 0xe1, 0xb3, 0xc9, 0x94, 0x09, 0x82, 0x10, 0x4f, 0x49, 0xde, 0x22, 0xc7,
-	0xd9, 0x21, 0x82, 0xc8, 0xd3, 0xb8, 0x26, 0x5c, 0x19, 0xd8, 0xc4, 0xf9, 0x38, 0x69, 0xf1, 0x7d,
-	0x52, 0x26, 0xe2, 0x01, 0xc5, 0x81, 0x8d, 0x3a, 0x43, 0xe3, 0x20, 0xd4, 0x65, 0xce, 0x5e, 0xb6,
-	0xae, 0xed, 0x5f, 0xb0, 0x38, 0x7a, 0x60, 0x12, 0x2d, 0x5b, 0x8e, 0xe0, 0xa5, 0x21, 0xad, 0x4a,
-	0x90, 0x9d, 0xb4, 0x42, 0xcd, 0xef, 0xe5, 0xdc, 0x38, 0xa2, 0xef, 0xc5, 0x88, 0x31, 0x47, 0x4d,
-	0xa1, 0x68, 0x99, 0x96, 0xde, 0x95, 0x73, 0xc7, 0x17, 0xfc, 0x5c, 0x2c, 0x45, 0xd9, 0x47, 0xf1,
-	0xd9, 0x73, 0xeb, 0xe7, 0x04, 0x5c, 0x8c, 0x1f, 0xb1, 0xca, 0x4d, 0xb8, 0xb6, 0x5b, 0x7b, 0x52,
-	0xda, 0xab, 0xed, 0xbf, 0x50, 0xeb, 0x8d, 0xa7, 0xcf, 0x6b, 0x3b, 0x95, 0x86, 0xda, 0xdc, 0x2f,
-	0xed, 0x1f, 0x34, 0xd5, 0xda, 0x93, 0xd2, 0xf6, 0x7e, 0xed, 0x79, 0x65, 0x75, 0x46, 0xb9, 0x0e,
-	0x57, 0xa7, 0xc2, 0x24, 0x28, 0xf1, 0x4e, 0xd0, 0xa3, 0x52, 0x6d, 0xaf, 0xb2, 0xb3, 0x9a, 0x54,
-	0x6e, 0xc0, 0xfa, 0x54, 0x50, 0x73, 0xaf, 0xd4, 0xac, 0x56, 0x76, 0x56, 0x67, 0xcb, 0xcf, 0x7e,
-	0x7d, 0x9b, 0x4b, 0xbc, 0x79, 0x9b, 0x4b, 0xfc, 0xfd, 0x36, 0x97, 0x78, 0x75, 0x9c, 0x9b, 0x79,
-	0x73, 0x9c, 0x9b, 0xf9, 0xe3, 0x38, 0x37, 0xf3, 0xdd, 0xe9, 0x5e, 0x1d, 0x83, 0xf0, 0xaf, 0x56,
-	0xfe, 0x04, 0x69, 0xcd, 0xf3, 0xdf, 0xa6, 0xf7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x99,
-	0x45, 0x88, 0x29, 0x0f, 0x00, 0x00,
+	// 1547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xcd, 0x6f, 0x13, 0x49,
+	0x16, 0x8f, 0x1d, 0xe7, 0xeb, 0xe5, 0x83, 0xa4, 0x37, 0xcb, 0x9a, 0x2c, 0x98, 0x60, 0x20, 0xca,
+	0x66, 0x43, 0x0c, 0x21, 0x2b, 0x0e, 0x2b, 0xad, 0x64, 0x27, 0x0e, 0x36, 0x64, 0xc1, 0xd8, 0x09,
+	0x12, 0x7b, 0x69, 0x95, 0xbb, 0x2b, 0x76, 0xe1, 0x76, 0x75, 0xab, 0xbb, 0xda, 0x71, 0xfe, 0x81,
+	0xdd, 0xd3, 0x4a, 0x9c, 0xe7, 0x3a, 0x17, 0x0e, 0x23, 0x0d, 0xc7, 0xf9, 0x13, 0xe6, 0x32, 0x12,
+	0x87, 0x39, 0x8c, 0xe6, 0x30, 0x1a, 0xc1, 0x61, 0xe6, 0xcf, 0x18, 0xd5, 0x47, 0xb7, 0xbb, 0x9d,
+	0x36, 0x24, 0x23, 0x98, 0x4b, 0x94, 0xae, 0xf7, 0x7b, 0x1f, 0xf5, 0xab, 0x57, 0xef, 0xbd, 0x32,
+	0xe4, 0x9b, 0xa8, 0x79, 0x6a, 0xd9, 0xb4, 0xd0, 0x64, 0x86, 0xc7, 0x50, 0x87, 0xd0, 0x56, 0xa1,
+	0x77, 0xaf, 0x80, 0x7b, 0x98, 0x32, 0x6f, 0xcb, 0x71, 0x6d, 0x66, 0x6b, 0x7f, 0x56, 0x98, 0xad,
+	0x01, 0x66, 0xab, 0x77, 0x6f, 0x65, 0xb9, 0x65, 0xb7, 0x6c, 0x81, 0x28, 0xf0, 0xff, 0x24, 0x78,
+	0x65, 0x2d, 0xd9, 0x60, 0x44, 0x55, 0xe2, 0x96, 0x50, 0x97, 0x50, 0xbb, 0x20, 0xfe, 0xca, 0xa5,
+	0xfc, 0x17, 0x69, 0xb8, 0x5a, 0xe6, 0x8e, 0xf7, 0x09, 0x45, 0x16, 0x61, 0xa7, 0x35, 0xd7, 0xee,
+	0x11, 0x13, 0xbb, 0xbb, 0x2e, 0x46, 0x0c, 0x9b, 0xda, 0x4d, 0x80, 0x26, 0x33, 0x74, 0xa7, 0xa3,
+	0xb7, 0x71, 0x3f, 0x9b, 0x5a, 0x4d, 0xad, 0xcf, 0x94, 0x26, 0x5e, 0xff, 0xf2, 0x66, 0x23, 0x55,
+	0x9f, 0x6e, 0x32, 0xa3, 0xd6, 0xa9, 0xe0, 0xbe, 0x76, 0x05, 0x32, 0xc8, 0x34, 0xdd, 0x6c, 0x3a,
+	0x2a, 0x16, 0x4b, 0xda, 0x6d, 0x00, 0xc3, 0xee, 0x76, 0x89, 0xe7, 0x11, 0x9b, 0x66, 0xc7, 0xa3,
+	0x80, 0x88, 0x40, 0xcb, 0xc2, 0x54, 0xd7, 0xa6, 0xa4, 0x83, 0xdd, 0x6c, 0x86, 0x63, 0xea, 0xc1,
+	0xa7, 0xb6, 0x02, 0xd3, 0xc4, 0xc4, 0x94, 0x11, 0x76, 0x9a, 0x9d, 0x10, 0xa2, 0xf0, 0x9b, 0x6b,
+	0x9d, 0xe0, 0xa6, 0x47, 0x18, 0xce, 0x4e, 0x4a, 0x2d, 0xf5, 0xa9, 0xfd, 0x0d, 0x16, 0x3d, 0x6c,
+	0xf8, 0x2e, 0x61, 0xa7, 0xba, 0x61, 0x53, 0x86, 0x0c, 0x96, 0x9d, 0x12, 0x90, 0x4b, 0xc1, 0xfa,
+	0xae, 0x5c, 0xe6, 0x46, 0x4c, 0xcc, 0x10, 0xb1, 0xbc, 0xec, 0xb4, 0x34, 0xa2, 0x3e, 0xf3, 0x5f,
+	0xa5, 0xe1, 0xaf, 0x89, 0xe4, 0x94, 0x4d, 0x72, 0x6e, 0x6e, 0xe2, 0x04, 0xa4, 0xcf, 0x41, 0xc0,
+	0xf8, 0x68, 0x02, 0x32, 0xa3, 0x09, 0x98, 0xf8, 0x38, 0x01, 0x93, 0x1f, 0x25, 0x60, 0x2a, 0x46,
+	0x80, 0xb6, 0x09, 0x5a, 0x17, 0xf5, 0x75, 0x13, 0x5b, 0xb8, 0xc5, 0xb3, 0x41, 0xf7, 0x10, 0x93,
+	0x2c, 0x65, 0xea, 0x8b, 0x5d, 0xd4, 0xdf, 0x0b, 0x04, 0x0d, 0xc4, 0xbc, 0xfc, 0xeb, 0x14, 0x5c,
+	0x4f, 0xa4, 0xeb, 0x31, 0x3e, 0xad, 0xdb, 0xec, 0xfc, 0xe9, 0xb4, 0x09, 0x97, 0x28, 0x3e, 0xd1,
+	0xb1, 0xcd, 0xbc, 0x00, 0x19, 0xe3, 0x6d, 0x8e, 0xe2, 0x93, 0xb2, 0xcd, 0x3c, 0x89, 0xde, 0x86,
+	0x25, 0x64, 0x30, 0xd2, 0x43, 0x8c, 0xd8, 0x54, 0x6f, 0x63, 0xd2, 0x6a, 0x33, 0xc1, 0x61, 0x26,
+	0xc0, 0x2f, 0x0e, 0xe4, 0x15, 0x21, 0xce, 0xbf, 0x4a, 0xc1, 0x35, 0x11, 0x6a, 0xe9, 0x70, 0x57,
+	0x6d, 0x82, 0xd8, 0xb4, 0xc1, 0x63, 0x3c, 0x72, 0x4c, 0xc4, 0xb0, 0xb6, 0x06, 0x97, 0xd4, 0xe5,
+	0xd1, 0x59, 0x5f, 0x6f, 0x23, 0xaf, 0x2d, 0xa3, 0xad, 0xcf, 0xab, 0xe5, 0xc3, 0x7e, 0x05, 0x79,
+	0x6d, 0xed, 0x21, 0xcc, 0xf0, 0x58, 0x3d, 0xae, 0x2a, 0xa2, 0x5c, 0xd8, 0xde, 0xd8, 0x4a, 0xbc,
+	0xbc, 0x5b, 0x67, 0x7c, 0xf9, 0x5e, 0x7d, 0x9a, 0xe2, 0x13, 0xe1, 0x36, 0x7f, 0x0c, 0x97, 0x45,
+	0x44, 0x0d, 0x6c, 0x61, 0x1e, 0x2e, 0x6e, 0x58, 0xc8, 0x6b, 0x13, 0xda, 0xd2, 0x0e, 0x60, 0x1a,
+	0x73, 0x1e, 0xa9, 0x81, 0x45, 0x0c, 0xb3, 0xdb, 0x77, 0x47, 0x78, 0x38, 0xa3, 0x5b, 0x56, 0x7a,
+	0xf5, 0xd0, 0x42, 0xfe, 0xff, 0x93, 0xb0, 0x2c, 0x1c, 0xd5, 0xec, 0x13, 0xec, 0xee, 0x11, 0x8f,
+	0xa9, 0x1d, 0x13, 0x00, 0x8f, 0xab, 0x61, 0x53, 0x3f, 0x76, 0x94, 0xa3, 0xca, 0x08, 0x47, 0x49,
+	0x06, 0xe4, 0x62, 0x43, 0x9a, 0x18, 0x4e, 0x81, 0xca, 0x58, 0x7d, 0x46, 0x59, 0xdf, 0x77, 0xb4,
+	0x63, 0x98, 0x79, 0x89, 0x88, 0x25, 0x3d, 0xa5, 0x85, 0xa7, 0x87, 0x17, 0xf6, 0xf4, 0x48, 0x58,
+	0x48, 0x70, 0x34, 0x2d, 0x6d, 0xef, 0x3b, 0x9a, 0x05, 0xb3, 0x3e, 0x1d, 0x78, 0x1a, 0x17, 0x9e,
+	0xaa, 0x17, 0xf6, 0x74, 0xa4, 0x6c, 0x24, 0xf8, 0x82, 0xc0, 0xfe, 0xbe, 0xa3, 0xb5, 0x60, 0x99,
+	0xe7, 0xb6, 0x89, 0x2d, 0x99, 0x0e, 0xba, 0x2f, 0x6c, 0x88, 0x4b, 0x3b, 0xbb, 0xbd, 0xf3, 0x21,
+	0xb7, 0xa3, 0xd2, 0xb0, 0x32, 0x56, 0x5f, 0x6a, 0x32, 0x63, 0x0f, 0x5b, 0x91, 0xc5, 0x95, 0x8e,
+	0xaa, 0xd9, 0x23, 0xb8, 0xd6, 0x1e, 0x43, 0xda, 0xe9, 0x88, 0x13, 0x9c, 0x2b, 0xfd, 0xf3, 0xc7,
+	0x9f, 0xae, 0x3f, 0x68, 0x11, 0xd6, 0xf6, 0x9b, 0x5b, 0x86, 0xdd, 0x2d, 0xa8, 0x20, 0x2c, 0xd4,
+	0xf4, 0xee, 0x10, 0x3b, 0xf8, 0x2c, 0xf4, 0x76, 0x0a, 0xec, 0xd4, 0xc1, 0xde, 0x56, 0xa9, 0x5a,
+	0xbb, 0xbf, 0x73, 0xb7, 0xe6, 0x37, 0xf9, 0xa5, 0x4d, 0x3b, 0x9d, 0x95, 0x97, 0xaa, 0x06, 0x26,
+	0xd3, 0xfd, 0x69, 0x7d, 0x59, 0xea, 0x56, 0x8e, 0x22, 0xfc, 0x93, 0x7a, 0x2b, 0x65, 0x20, 0x8d,
+	0x7b, 0x79, 0x0c, 0x37, 0x12, 0x8b, 0x96, 0xbc, 0xa0, 0xbb, 0x6d, 0x44, 0x5b, 0x58, 0xbb, 0x0a,
+	0x93, 0xb2, 0x6c, 0xc5, 0x4b, 0xd6, 0x84, 0x28, 0x59, 0x5a, 0x7e, 0xb8, 0x06, 0x0c, 0x6a, 0x5a,
+	0x78, 0xbd, 0xbf, 0xc9, 0xc0, 0x95, 0xb3, 0x47, 0x1d, 0x74, 0xd9, 0xbf, 0xc3, 0x42, 0xb4, 0xda,
+	0x0c, 0x97, 0xc6, 0xb9, 0x41, 0xcd, 0xc1, 0x7d, 0xed, 0x01, 0x2c, 0x07, 0x60, 0xdb, 0x67, 0x8e,
+	0xcf, 0x74, 0x42, 0xcd, 0xe1, 0x1a, 0xa9, 0x29, 0xc8, 0x53, 0x81, 0xa8, 0x72, 0x80, 0xb6, 0x09,
+	0x0b, 0x0e, 0x72, 0x51, 0xd7, 0xd3, 0x7b, 0xd8, 0x3d, 0xdb, 0x8f, 0xe7, 0xa5, 0xf0, 0xb9, 0x94,
+	0x69, 0x0f, 0xe1, 0xda, 0xb1, 0xe2, 0x44, 0x77, 0x14, 0x29, 0xba, 0x64, 0xc1, 0x13, 0x21, 0x66,
+	0x56, 0xc7, 0x07, 0xca, 0x57, 0x8e, 0x87, 0xf8, 0x2b, 0x71, 0x6a, 0x3c, 0x1e, 0xef, 0x5d, 0x58,
+	0xe2, 0xc1, 0x84, 0xda, 0x42, 0x79, 0x22, 0xea, 0x79, 0x41, 0xca, 0x4b, 0x41, 0x03, 0x58, 0x87,
+	0xb9, 0x90, 0x0e, 0xd2, 0x55, 0xcd, 0x3d, 0x00, 0xcf, 0x06, 0x64, 0x90, 0x2e, 0xe6, 0x5b, 0xf2,
+	0x69, 0xd3, 0xa6, 0x66, 0x88, 0x9d, 0x8a, 0x6d, 0x29, 0x14, 0x0a, 0xf4, 0x3a, 0xcc, 0x45, 0xd0,
+	0x7d, 0xd9, 0xef, 0x43, 0xbb, 0x03, 0x6c, 0x3f, 0x7e, 0xa4, 0x33, 0x89, 0x47, 0xaa, 0xad, 0xc1,
+	0xac, 0xda, 0x97, 0x18, 0x7e, 0x20, 0xd6, 0xda, 0xa5, 0xa4, 0xc8, 0x47, 0xa0, 0x7f, 0xc1, 0x55,
+	0xc7, 0xc5, 0x3d, 0x62, 0xfb, 0x9e, 0x3e, 0xd4, 0x53, 0x04, 0x15, 0xb3, 0xa2, 0xaf, 0x64, 0x03,
+	0x4c, 0x23, 0xda, 0x5f, 0x2a, 0xb8, 0x9f, 0x7f, 0x93, 0x86, 0x9c, 0x48, 0x9d, 0x5d, 0xbb, 0x87,
+	0x29, 0xa2, 0xac, 0x41, 0x5a, 0x14, 0x31, 0xdf, 0xc5, 0x75, 0x6c, 0x60, 0xd2, 0xc3, 0xa6, 0x76,
+	0x67, 0x44, 0xb7, 0x0a, 0x79, 0x88, 0x37, 0xad, 0x1d, 0xf8, 0x93, 0xa1, 0x6c, 0x45, 0xcf, 0x24,
+	0x96, 0x40, 0x8b, 0x01, 0x22, 0x3c, 0x95, 0x27, 0xb0, 0x1a, 0x6a, 0x0d, 0x68, 0xf4, 0x82, 0x60,
+	0x84, 0x89, 0x58, 0x42, 0x5d, 0x0b, 0xe0, 0x47, 0x01, 0x3a, 0x8c, 0x9c, 0xdb, 0x7b, 0x01, 0x6b,
+	0xa1, 0x3d, 0x41, 0x97, 0x8e, 0xfb, 0x0e, 0xa2, 0x3c, 0xf9, 0x86, 0xac, 0x66, 0xa2, 0x56, 0xf3,
+	0x81, 0x12, 0x27, 0x0a, 0x97, 0x03, 0x95, 0xa8, 0xe9, 0xfc, 0x97, 0x29, 0x58, 0x8d, 0x51, 0xf6,
+	0x6f, 0xbf, 0x41, 0x5a, 0xdb, 0x0d, 0xd2, 0x2a, 0xb6, 0x5a, 0xae, 0x9c, 0x59, 0x2e, 0x4a, 0x5a,
+	0x0d, 0x6e, 0x84, 0xe1, 0xa2, 0xd0, 0xca, 0x50, 0xa4, 0x31, 0x0a, 0x73, 0x01, 0x7e, 0xe0, 0x34,
+	0x16, 0xe5, 0x7f, 0x83, 0x81, 0x69, 0x28, 0x4a, 0x2c, 0x46, 0xc2, 0x3a, 0xf6, 0x30, 0xfb, 0x43,
+	0x4e, 0x36, 0x6f, 0xc3, 0x4a, 0x2c, 0x8e, 0x67, 0xbe, 0xed, 0xfa, 0xdd, 0x3a, 0x46, 0x46, 0xfb,
+	0xe2, 0x3c, 0x9d, 0xa7, 0x1a, 0x7e, 0x97, 0x82, 0xf5, 0xb3, 0xd5, 0xb0, 0x4a, 0x0d, 0xcb, 0xe7,
+	0x5b, 0xaf, 0xb9, 0xb6, 0x7d, 0xfc, 0x7b, 0x93, 0x5b, 0x16, 0x0f, 0x97, 0x05, 0xa3, 0x60, 0x7a,
+	0xb8, 0x78, 0xb8, 0x4c, 0x4e, 0x81, 0xda, 0x2d, 0x00, 0x4c, 0xcd, 0xe8, 0xc8, 0x18, 0xe2, 0x66,
+	0x30, 0x35, 0x15, 0x2a, 0xb6, 0x9f, 0x4c, 0xf2, 0x7e, 0xbe, 0x4f, 0xa9, 0x2b, 0x2a, 0xf7, 0x23,
+	0xb7, 0x23, 0xb3, 0x1e, 0x9b, 0x65, 0xe4, 0x5a, 0xa7, 0x9f, 0x6f, 0x17, 0xb1, 0xf8, 0xc6, 0x93,
+	0x4b, 0xd5, 0x3f, 0xe0, 0x2f, 0xc3, 0x37, 0x2c, 0x08, 0x42, 0x3e, 0x29, 0x44, 0x47, 0x19, 0x5c,
+	0x26, 0x19, 0x44, 0x9e, 0x26, 0xf5, 0xac, 0x72, 0xdf, 0x21, 0xee, 0xe7, 0x49, 0x8b, 0xff, 0xa5,
+	0x55, 0x22, 0x1e, 0x51, 0xdc, 0x77, 0xb0, 0xc1, 0xb0, 0x79, 0x14, 0x29, 0xca, 0x17, 0xbf, 0x0b,
+	0x9e, 0xc3, 0x0f, 0x58, 0x6e, 0x3d, 0x50, 0x89, 0xdf, 0x05, 0x81, 0x10, 0x95, 0x44, 0x69, 0x15,
+	0x61, 0x65, 0x58, 0x0b, 0x23, 0xde, 0xfa, 0x84, 0x72, 0x8c, 0xdf, 0xcb, 0x31, 0x65, 0x81, 0x1a,
+	0x61, 0xa2, 0x69, 0xd9, 0x46, 0x47, 0xb5, 0x69, 0x4e, 0xf8, 0x7c, 0xa2, 0x89, 0x12, 0x47, 0x89,
+	0x56, 0x9d, 0xff, 0x35, 0x78, 0xa0, 0x9c, 0xa9, 0xf9, 0xbc, 0x91, 0xd9, 0xfe, 0x85, 0x0b, 0xc3,
+	0x26, 0x2c, 0x18, 0x72, 0xd8, 0x88, 0x66, 0x54, 0xf8, 0x44, 0x9a, 0x57, 0x42, 0x95, 0x53, 0x1b,
+	0x30, 0x7f, 0x82, 0xf8, 0x1b, 0x57, 0x46, 0xee, 0xc5, 0xdf, 0x53, 0x73, 0x52, 0x26, 0xc2, 0xf5,
+	0x78, 0x7b, 0x13, 0x8f, 0x58, 0xda, 0xd2, 0x87, 0x4a, 0x4f, 0x64, 0x4c, 0xa8, 0x67, 0x15, 0x66,
+	0x37, 0x5a, 0x7b, 0xf8, 0x78, 0xb0, 0xf1, 0x75, 0x0a, 0x2e, 0x27, 0x0f, 0x5f, 0xda, 0x6d, 0xb8,
+	0xb1, 0x5f, 0x7d, 0x52, 0x3c, 0xa8, 0x1e, 0xbe, 0xd0, 0x6b, 0xf5, 0xa7, 0xcf, 0xab, 0x7b, 0xe5,
+	0xba, 0xde, 0x38, 0x2c, 0x1e, 0x1e, 0x35, 0xf4, 0xea, 0x93, 0xe2, 0xee, 0x61, 0xf5, 0x79, 0x79,
+	0x71, 0x4c, 0xbb, 0x09, 0xd7, 0x47, 0xc2, 0x14, 0x28, 0xf5, 0x41, 0xd0, 0xa3, 0x62, 0xf5, 0xa0,
+	0xbc, 0xb7, 0x98, 0xd6, 0x6e, 0xc1, 0xea, 0x48, 0x50, 0xe3, 0xa0, 0xd8, 0xa8, 0x94, 0xf7, 0x16,
+	0xc7, 0x4b, 0xcf, 0xbe, 0x7d, 0x97, 0x4b, 0xbd, 0x7d, 0x97, 0x4b, 0xfd, 0xfc, 0x2e, 0x97, 0x7a,
+	0xf5, 0x3e, 0x37, 0xf6, 0xf6, 0x7d, 0x6e, 0xec, 0x87, 0xf7, 0xb9, 0xb1, 0xff, 0x9c, 0x6f, 0x1e,
+	0xed, 0x47, 0x7f, 0xa8, 0x11, 0xc3, 0x69, 0x73, 0x52, 0xfc, 0x1c, 0x73, 0xff, 0xb7, 0x00, 0x00,
+	0x00, 0xff, 0xff, 0x4c, 0x40, 0x58, 0x91, 0x1c, 0x12, 0x00, 0x00,
 }
 
 func (m *EventFinalityProviderCreated) Marshal() (dAtA []byte, err error) {
@@ -1471,6 +1760,11 @@ func (m *EventFinalityProviderEdited) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegatedSats != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.MaxDelegatedSats))
+		i--
+		dAtA[i] = 0x40
+	}
 	if len(m.Details) > 0 {
 		i -= len(m.Details)
 		copy(dAtA[i:], m.Details)
@@ -1523,6 +1817,48 @@ func (m *EventFinalityProviderEdited) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFinalityProviderKeyRotated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityProviderKeyRotated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityProviderKeyRotated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewEotsPkHex) > 0 {
+		i -= len(m.NewEotsPkHex)
+		copy(dAtA[i:], m.NewEotsPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewEotsPkHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcPkHex) > 0 {
+		i -= len(m.BtcPkHex)
+		copy(dAtA[i:], m.BtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventBTCDelegationStateUpdate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2004,7 +2340,7 @@ func (m *EventCovenantSignatureReceived) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *EventCovenantQuorumReached) Marshal() (dAtA []byte, err error) {
+func (m *EventCovenantMuSig2SigAggregated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2014,20 +2350,20 @@ func (m *EventCovenantQuorumReached) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventCovenantQuorumReached) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCovenantMuSig2SigAggregated) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventCovenantQuorumReached) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCovenantMuSig2SigAggregated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewState) > 0 {
-		i -= len(m.NewState)
-		copy(dAtA[i:], m.NewState)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
+	if len(m.CovenantAggregatedSignatureHex) > 0 {
+		i -= len(m.CovenantAggregatedSignatureHex)
+		copy(dAtA[i:], m.CovenantAggregatedSignatureHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CovenantAggregatedSignatureHex)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2041,7 +2377,7 @@ func (m *EventCovenantQuorumReached) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBTCDelegationInclusionProofReceived) Marshal() (dAtA []byte, err error) {
+func (m *EventCovenantMuSig2SessionReset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2051,34 +2387,20 @@ func (m *EventBTCDelegationInclusionProofReceived) Marshal() (dAtA []byte, err e
 	return dAtA[:n], nil
 }
 
-func (m *EventBTCDelegationInclusionProofReceived) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCovenantMuSig2SessionReset) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBTCDelegationInclusionProofReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCovenantMuSig2SessionReset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewState) > 0 {
-		i -= len(m.NewState)
-		copy(dAtA[i:], m.NewState)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EndHeight) > 0 {
-		i -= len(m.EndHeight)
-		copy(dAtA[i:], m.EndHeight)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndHeight)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StartHeight) > 0 {
-		i -= len(m.StartHeight)
-		copy(dAtA[i:], m.StartHeight)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartHeight)))
+	if len(m.CovenantBtcPkHex) > 0 {
+		i -= len(m.CovenantBtcPkHex)
+		copy(dAtA[i:], m.CovenantBtcPkHex)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CovenantBtcPkHex)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2092,7 +2414,7 @@ func (m *EventBTCDelegationInclusionProofReceived) MarshalToSizedBuffer(dAtA []b
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBTCDelgationUnbondedEarly) Marshal() (dAtA []byte, err error) {
+func (m *EventCovenantQuorumReached) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2102,23 +2424,111 @@ func (m *EventBTCDelgationUnbondedEarly) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBTCDelgationUnbondedEarly) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventCovenantQuorumReached) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBTCDelgationUnbondedEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventCovenantQuorumReached) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StakeExpansionTxHash) > 0 {
-		i -= len(m.StakeExpansionTxHash)
-		copy(dAtA[i:], m.StakeExpansionTxHash)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakeExpansionTxHash)))
-		i--
-		dAtA[i] = 0x22
-	}
+	if len(m.NewState) > 0 {
+		i -= len(m.NewState)
+		copy(dAtA[i:], m.NewState)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelegationInclusionProofReceived) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelegationInclusionProofReceived) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelegationInclusionProofReceived) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewState) > 0 {
+		i -= len(m.NewState)
+		copy(dAtA[i:], m.NewState)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EndHeight) > 0 {
+		i -= len(m.EndHeight)
+		copy(dAtA[i:], m.EndHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EndHeight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StartHeight) > 0 {
+		i -= len(m.StartHeight)
+		copy(dAtA[i:], m.StartHeight)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StartHeight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBTCDelgationUnbondedEarly) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBTCDelgationUnbondedEarly) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBTCDelgationUnbondedEarly) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakeExpansionTxHash) > 0 {
+		i -= len(m.StakeExpansionTxHash)
+		copy(dAtA[i:], m.StakeExpansionTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakeExpansionTxHash)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.NewState) > 0 {
 		i -= len(m.NewState)
 		copy(dAtA[i:], m.NewState)
@@ -2229,6 +2639,55 @@ func (m *EventUnexpectedUnbondingTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCovenantSignatureTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCovenantSignatureTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCovenantSignatureTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissingCovenantBtcPksHex) > 0 {
+		for iNdEx := len(m.MissingCovenantBtcPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MissingCovenantBtcPksHex[iNdEx])
+			copy(dAtA[i:], m.MissingCovenantBtcPksHex[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.MissingCovenantBtcPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WaitedBlocks != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WaitedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -2315,6 +2774,29 @@ func (m *EventFinalityProviderEdited) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.MaxDelegatedSats != 0 {
+		n += 1 + sovEvents(uint64(m.MaxDelegatedSats))
+	}
+	return n
+}
+
+func (m *EventFinalityProviderKeyRotated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewEotsPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ActivationHeight))
+	}
 	return n
 }
 
@@ -2543,6 +3025,40 @@ func (m *EventCovenantSignatureReceived) Size() (n int) {
 	return n
 }
 
+func (m *EventCovenantMuSig2SigAggregated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CovenantAggregatedSignatureHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCovenantMuSig2SessionReset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CovenantBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventCovenantQuorumReached) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2651,6 +3167,31 @@ func (m *EventUnexpectedUnbondingTx) Size() (n int) {
 	return n
 }
 
+func (m *EventCovenantSignatureTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovEvents(uint64(m.CreatedHeight))
+	}
+	if m.WaitedBlocks != 0 {
+		n += 1 + sovEvents(uint64(m.WaitedBlocks))
+	}
+	if len(m.MissingCovenantBtcPksHex) > 0 {
+		for _, s := range m.MissingCovenantBtcPksHex {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3216,6 +3757,25 @@ func (m *EventFinalityProviderEdited) Unmarshal(dAtA []byte) error {
 			}
 			m.Details = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedSats", wireType)
+			}
+			m.MaxDelegatedSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatedSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3237,7 +3797,7 @@ func (m *EventFinalityProviderEdited) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
+func (m *EventFinalityProviderKeyRotated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3260,15 +3820,15 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFinalityProviderKeyRotated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPkHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3296,13 +3856,45 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.BtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEotsPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewEotsPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.NewState = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3312,7 +3904,7 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				m.ActivationHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3338,7 +3930,7 @@ func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+func (m *EventBTCDelegationStateUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3361,7 +3953,108 @@ func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBTCDelegationStateUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewState", wireType)
+			}
+			m.NewState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewState |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSelectiveSlashing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSelectiveSlashing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
 			return fmt.Errorf("proto: EventSelectiveSlashing: illegal tag %d (wire type %d)", fieldNum, wire)
@@ -4563,6 +5256,234 @@ func (m *EventCovenantSignatureReceived) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCovenantMuSig2SigAggregated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCovenantMuSig2SigAggregated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCovenantMuSig2SigAggregated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantAggregatedSignatureHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantAggregatedSignatureHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCovenantMuSig2SessionReset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCovenantMuSig2SessionReset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCovenantMuSig2SessionReset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventCovenantQuorumReached) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5312,6 +6233,158 @@ func (m *EventUnexpectedUnbondingTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCovenantSignatureTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCovenantSignatureTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCovenantSignatureTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitedBlocks", wireType)
+			}
+			m.WaitedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WaitedBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingCovenantBtcPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissingCovenantBtcPksHex = append(m.MissingCovenantBtcPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -32,6 +32,21 @@ var (
 	// prefix {13} is cleaned in v3rc3 and can be reused after that upgrade is run on testnet
 	// prefix {14} reserved to FinalityProviderBsnIndexKey
 	// prefix {15} reserved to AllowedMultiStakingTxHashesKey
-	FpBbnAddrKey             = collections.NewPrefix(16) // key prefix for index fpBbnAddr
-	FinalityProvidersDeleted = collections.NewPrefix(17) // key prefix for the deleted finality provider btcPk
+	FpBbnAddrKey                = collections.NewPrefix(16) // key prefix for index fpBbnAddr
+	FinalityProvidersDeleted    = collections.NewPrefix(17) // key prefix for the deleted finality provider btcPk
+	CovenantMuSig2NonceKey      = collections.NewPrefix(18) // key prefix for covenant MuSig2 public nonces
+	CovenantMuSig2PartialSigKey = collections.NewPrefix(19) // key prefix for covenant MuSig2 partial signatures
+	FpEotsPkKey                 = collections.NewPrefix(20) // key prefix for index of the EOTS PKs rotated to by finality providers
+	BTCDelegationsByStakerKey   = collections.NewPrefix(21) // key prefix for index of the BTC delegations by staker BTC PK
+	BTCDelegationsByExpiryKey   = collections.NewPrefix(22) // key prefix for index of the BTC delegations by BTC expiry height
+	BTCDelegationsByUnbondedKey = collections.NewPrefix(23) // key prefix for index of the BTC delegations by BTC unbonding completion height
+	FpDelegatedSatsKey          = collections.NewPrefix(24) // key prefix for the delegated satoshis of each finality provider
+	TotalDelegatedSatsKey       = collections.NewPrefix(25) // key prefix for the total delegated satoshis
+	DelegatedSatsBtcHeightKey   = collections.NewPrefix(26) // key prefix for the BTC height up to which expired delegations are deducted from the delegated satoshis
+	BTCDelegationsByVersionKey  = collections.NewPrefix(27) // key prefix for index of the BTC delegations by parameters version
+	CovenantSigWaitingKey       = collections.NewPrefix(28) // key prefix for the Babylon creation height of the BTC delegations waiting for covenant signatures
+	CovenantSigWaitingHeightKey = collections.NewPrefix(29) // key prefix for index of the BTC delegations waiting for covenant signatures by Babylon creation height
+	CovenantStatsKey            = collections.NewPrefix(30) // key prefix for the signature liveness stats of covenant members
+	FpSelfBondedSatsKey         = collections.NewPrefix(31) // key prefix for the self-bonded satoshis of each finality provider
+	CovenantSigLateHeightKey    = collections.NewPrefix(32) // key prefix for index of the timed out BTC delegations whose late covenant signatures are tracked by Babylon creation height
 )
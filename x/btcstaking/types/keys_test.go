@@ -20,6 +20,21 @@ func TestNoKeyCollision(t *testing.T) {
 		"LargestBtcReorgInBlocks":     types.LargestBtcReorgInBlocks,
 		"FpBbnAddrKey":                types.FpBbnAddrKey,
 		"FinalityProvidersDeleted":    types.FinalityProvidersDeleted,
+		"CovenantMuSig2NonceKey":      types.CovenantMuSig2NonceKey,
+		"CovenantMuSig2PartialSigKey": types.CovenantMuSig2PartialSigKey,
+		"FpEotsPkKey":                 types.FpEotsPkKey,
+		"BTCDelegationsByStakerKey":   types.BTCDelegationsByStakerKey,
+		"BTCDelegationsByExpiryKey":   types.BTCDelegationsByExpiryKey,
+		"BTCDelegationsByUnbondedKey": types.BTCDelegationsByUnbondedKey,
+		"FpDelegatedSatsKey":          types.FpDelegatedSatsKey,
+		"FpSelfBondedSatsKey":         types.FpSelfBondedSatsKey,
+		"TotalDelegatedSatsKey":       types.TotalDelegatedSatsKey,
+		"DelegatedSatsBtcHeightKey":   types.DelegatedSatsBtcHeightKey,
+		"BTCDelegationsByVersionKey":  types.BTCDelegationsByVersionKey,
+		"CovenantSigWaitingKey":       types.CovenantSigWaitingKey,
+		"CovenantSigWaitingHeightKey": types.CovenantSigWaitingHeightKey,
+		"CovenantStatsKey":            types.CovenantStatsKey,
+		"CovenantSigLateHeightKey":    types.CovenantSigLateHeightKey,
 	}
 
 	store.CheckKeyCollisions(t, keys)
@@ -10,6 +10,8 @@ const (
 	MetricsKeyCreateFinalityProvider         = "create_finality_provider"
 	MetricsKeyCreateBTCDelegation            = "create_btc_delegation"
 	MetricsKeyAddCovenantSigs                = "add_covenant_sigs"
+	MetricsKeyAddCovenantMuSig2Nonce         = "add_covenant_musig2_nonce"
+	MetricsKeyAddCovenantMuSig2PartialSig    = "add_covenant_musig2_partial_sig"
 	MetricsKeyAddBTCDelegationInclusionProof = "add_btc_delegation_inclusion_proof"
 	MetricsKeyBTCUndelegate                  = "btc_undelegate"
 	MetricsKeySelectiveSlashingEvidence      = "selective_slashing_evidence"
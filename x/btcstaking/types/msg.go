@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	"github.com/babylonlabs-io/babylon/v4/btcstaking"
 	bbn "github.com/babylonlabs-io/babylon/v4/types"
 	"github.com/btcsuite/btcd/blockchain"
 	"github.com/btcsuite/btcd/btcec/v2"
@@ -23,6 +24,8 @@ var (
 	_ sdk.Msg = &MsgAddCovenantSigs{}
 	_ sdk.Msg = &MsgBTCUndelegate{}
 	_ sdk.Msg = &MsgAddBTCDelegationInclusionProof{}
+	_ sdk.Msg = &MsgAddCovenantMuSig2Nonce{}
+	_ sdk.Msg = &MsgAddCovenantMuSig2PartialSig{}
 	// Ensure msgs implement ValidateBasic
 	_ sdk.HasValidateBasic = &MsgUpdateParams{}
 	_ sdk.HasValidateBasic = &MsgCreateFinalityProvider{}
@@ -32,6 +35,8 @@ var (
 	_ sdk.HasValidateBasic = &MsgBTCUndelegate{}
 	_ sdk.HasValidateBasic = &MsgAddBTCDelegationInclusionProof{}
 	_ sdk.HasValidateBasic = &MsgSelectiveSlashingEvidence{}
+	_ sdk.HasValidateBasic = &MsgAddCovenantMuSig2Nonce{}
+	_ sdk.HasValidateBasic = &MsgAddCovenantMuSig2PartialSig{}
 )
 
 func (m MsgUpdateParams) ValidateBasic() error {
@@ -78,6 +83,14 @@ func (m *MsgEditFinalityProvider) ValidateBasic() error {
 	if _, err := m.Description.EnsureLength(); err != nil {
 		return err
 	}
+	if m.MaxDelegatedSats != nil {
+		if m.MaxDelegatedSats.IsNegative() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max delegated sats cannot be negative")
+		}
+		if !m.MaxDelegatedSats.IsUint64() {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max delegated sats overflows uint64")
+		}
+	}
 	if len(m.BtcPk) != bbn.BIP340PubKeyLen {
 		return fmt.Errorf("malformed BTC PK")
 	}
@@ -216,17 +229,51 @@ func (m *MsgAddCovenantSigs) ValidateBasic() error {
 		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
 	}
 
-	// verifications about on-demand unbonding
-	if m.UnbondingTxSig == nil {
+	// verifications about on-demand unbonding. The unbonding signature is
+	// empty if the unbonding tx is signed through MuSig2, which is checked
+	// against the parameters of the BTC delegation
+	if m.UnbondingTxSig != nil {
+		if _, err := m.UnbondingTxSig.ToBTCSig(); err != nil {
+			return fmt.Errorf("invalid covenant unbonding signature: %w", err)
+		}
+	}
+
+	if m.SlashingUnbondingTxSigs == nil {
 		return fmt.Errorf("empty covenant signature")
 	}
 
-	if _, err := m.UnbondingTxSig.ToBTCSig(); err != nil {
-		return fmt.Errorf("invalid covenant unbonding signature: %w", err)
+	return nil
+}
+
+func (m *MsgAddCovenantMuSig2Nonce) ValidateBasic() error {
+	if m.Pk == nil {
+		return fmt.Errorf("empty BTC covenant public key")
+	}
+	if _, err := m.Pk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid BTC public key: %v", err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if _, err := btcstaking.ParseMuSig2PubNonce(m.PubNonce); err != nil {
+		return fmt.Errorf("invalid covenant MuSig2 nonce: %w", err)
 	}
 
-	if m.SlashingUnbondingTxSigs == nil {
-		return fmt.Errorf("empty covenant signature")
+	return nil
+}
+
+func (m *MsgAddCovenantMuSig2PartialSig) ValidateBasic() error {
+	if m.Pk == nil {
+		return fmt.Errorf("empty BTC covenant public key")
+	}
+	if _, err := m.Pk.ToBTCPK(); err != nil {
+		return fmt.Errorf("invalid BTC public key: %v", err)
+	}
+	if len(m.StakingTxHash) != chainhash.MaxHashStringSize {
+		return fmt.Errorf("staking tx hash is not %d", chainhash.MaxHashStringSize)
+	}
+	if _, err := btcstaking.ParseMuSig2PartialSig(m.PartialSig); err != nil {
+		return fmt.Errorf("invalid covenant MuSig2 partial signature: %w", err)
 	}
 
 	return nil
@@ -193,6 +193,9 @@ func TestMsgEditFinalityProviderValidateBasic(t *testing.T) {
 		}
 		negativeDec      = math.LegacyNewDecWithPrec(-1, 2)
 		biggerThanOneDec = math.LegacyOneDec().Add(math.LegacyOneDec())
+		maxDelegatedSats = math.NewInt(10e8)
+		negativeInt      = math.NewInt(-1)
+		overflowInt      = math.NewIntFromUint64(^uint64(0)).AddRaw(1)
 		fpDesc           = &stktypes.Description{
 			Moniker: "test description",
 		}
@@ -231,6 +234,33 @@ func TestMsgEditFinalityProviderValidateBasic(t *testing.T) {
 			},
 			expected: types.ErrCommissionGTMaxRate,
 		},
+		{
+			name: "valid max delegated sats",
+			msg: &types.MsgEditFinalityProvider{
+				Description:      fpDesc,
+				BtcPk:            []byte(*validPk),
+				MaxDelegatedSats: &maxDelegatedSats,
+			},
+			expected: nil,
+		},
+		{
+			name: "max delegated sats negative value",
+			msg: &types.MsgEditFinalityProvider{
+				Description:      fpDesc,
+				BtcPk:            []byte(*validPk),
+				MaxDelegatedSats: &negativeInt,
+			},
+			expected: sdkerrors.ErrInvalidRequest.Wrap("max delegated sats cannot be negative"),
+		},
+		{
+			name: "max delegated sats overflows uint64",
+			msg: &types.MsgEditFinalityProvider{
+				Description:      fpDesc,
+				BtcPk:            []byte(*validPk),
+				MaxDelegatedSats: &overflowInt,
+			},
+			expected: sdkerrors.ErrInvalidRequest.Wrap("max delegated sats overflows uint64"),
+		},
 		{
 			name: "empty description",
 			msg: &types.MsgEditFinalityProvider{
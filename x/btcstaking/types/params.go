@@ -84,6 +84,17 @@ func DefaultParams() Params {
 		// Allow list can only be enabled by upgrade
 		AllowListExpirationHeight: 0,
 		BtcActivationHeight:       0,
+		// The default max finality provider stake ratio is 0, which disables the cap
+		MaxFpStakeRatio: sdkmath.LegacyZeroDec(),
+		// The default covenant signature timeout is 360 blocks, i.e., about an
+		// hour with 10s blocks
+		CovenantSigTimeoutBlocks: 360,
+		// The default min finality provider self-bond is 0, which disables
+		// the requirement
+		MinFpSelfBondSats: 0,
+		// The default amount of total staked satoshis from which the max
+		// finality provider stake ratio is enforced is 1000 BTC
+		FpStakeRatioMinTotalSats: 1000 * 1e8,
 	}
 }
 
@@ -114,6 +125,39 @@ func validateMinCommissionRate(rate sdkmath.LegacyDec) error {
 	return nil
 }
 
+// validateMaxFpStakeRatio checks that the max finality provider stake ratio
+// is within [0, 1]. A nil ratio is allowed for the parameters stored before
+// the ratio was introduced, and disables the cap just like a zero ratio.
+func validateMaxFpStakeRatio(ratio sdkmath.LegacyDec) error {
+	if ratio.IsNil() {
+		return nil
+	}
+
+	if ratio.IsNegative() {
+		return fmt.Errorf("max finality provider stake ratio cannot be negative")
+	}
+
+	if ratio.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("max finality provider stake ratio cannot be greater than 100%%")
+	}
+	return nil
+}
+
+// validateFpStakeRatioMinTotalSats checks that the max finality provider
+// stake ratio is enforced only from a positive amount of total staked
+// satoshis if it is below 1, as no BTC delegation could otherwise be accepted
+// while nothing is staked yet
+func validateFpStakeRatioMinTotalSats(ratio sdkmath.LegacyDec, minTotalSats uint64) error {
+	if ratio.IsNil() || !ratio.IsPositive() || ratio.Equal(sdkmath.LegacyOneDec()) {
+		return nil
+	}
+
+	if minTotalSats == 0 {
+		return fmt.Errorf("total staked sats from which the max finality provider stake ratio is enforced must be positive if the ratio is below 100%%")
+	}
+	return nil
+}
+
 // validateCovenantPks checks whether the covenants list contains any duplicates
 func validateCovenantPks(covenantPks []bbn.BIP340PubKey) error {
 	duplicate, err := ExistsDup(covenantPks)
@@ -218,6 +262,11 @@ func (p Params) Validate() error {
 	if err := validateCovenantPks(p.CovenantPks); err != nil {
 		return err
 	}
+	// MuSig2 is an n-of-n scheme, so a delegation activated by a quorum smaller
+	// than the covenant committee could not be unbonded on demand
+	if p.CovenantMusig2Unbonding && int(p.CovenantQuorum) != len(p.CovenantPks) {
+		return fmt.Errorf("covenant quorum size has to be equal to the covenant committee size when covenant MuSig2 unbonding is enabled")
+	}
 	if err := validateMinSlashingTxFeeSat(p.MinSlashingTxFeeSat); err != nil {
 		return err
 	}
@@ -243,6 +292,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMaxFpStakeRatio(p.MaxFpStakeRatio); err != nil {
+		return err
+	}
+
+	if err := validateFpStakeRatioMinTotalSats(p.MaxFpStakeRatio, p.FpStakeRatioMinTotalSats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -252,6 +309,12 @@ func (p Params) String() string {
 	return string(out)
 }
 
+// IsFpStakeRatioCapped returns whether the ratio of the total staked BTC that
+// can be delegated to a single finality provider is capped
+func (p Params) IsFpStakeRatioCapped() bool {
+	return !p.MaxFpStakeRatio.IsNil() && p.MaxFpStakeRatio.IsPositive()
+}
+
 func (p Params) HasCovenantPK(pk *bbn.BIP340PubKey) bool {
 	for _, pk2 := range p.CovenantPks {
 		if pk2.Equals(pk) {
@@ -279,6 +342,29 @@ func (p Params) MustGetCovenantPks() []*btcec.PublicKey {
 	return covenantKeys
 }
 
+// buildStakingInfo builds the staking output expected under the given
+// parameters. If covenant MuSig2 unbonding is enabled, the unbonding path
+// commits to the aggregated key of the covenant committee.
+func buildStakingInfo(
+	p *Params,
+	stakerKey *btcec.PublicKey,
+	fpKeys []*btcec.PublicKey,
+	covenantKeys []*btcec.PublicKey,
+	stakingTime uint16,
+	stakingAmount btcutil.Amount,
+	net *chaincfg.Params,
+) (*btcstaking.StakingInfo, error) {
+	if p.CovenantMusig2Unbonding {
+		return btcstaking.BuildStakingInfoWithAggregatedCovenant(
+			stakerKey, fpKeys, covenantKeys, p.CovenantQuorum, stakingTime, stakingAmount, net,
+		)
+	}
+
+	return btcstaking.BuildStakingInfo(
+		stakerKey, fpKeys, covenantKeys, p.CovenantQuorum, stakingTime, stakingAmount, net,
+	)
+}
+
 func NewHeightVersionPair(
 	startHeight uint64,
 	version uint32,
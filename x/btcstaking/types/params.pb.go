@@ -78,6 +78,36 @@ type Params struct {
 	// btc_activation_height is the btc height from which parameters are activated
 	// (inclusive)
 	BtcActivationHeight uint32 `protobuf:"varint,15,opt,name=btc_activation_height,json=btcActivationHeight,proto3" json:"btc_activation_height,omitempty"`
+	// covenant_musig2_unbonding defines whether the unbonding path of staking
+	// outputs commits to the MuSig2 aggregated key of the covenant committee
+	// instead of the covenant multisig. If enabled, all covenant members must
+	// take part in MuSig2 signing of the unbonding tx, so covenant_quorum must
+	// be equal to the number of covenant members. As a single unresponsive
+	// covenant member blocks the signing, BTC delegations can then only be
+	// created before their staking tx is included on BTC, and disabling it makes
+	// new BTC delegations fall back to the covenant multisig. Stake expansion of
+	// BTC delegations created with it enabled is not supported
+	CovenantMusig2Unbonding bool `protobuf:"varint,16,opt,name=covenant_musig2_unbonding,json=covenantMusig2Unbonding,proto3" json:"covenant_musig2_unbonding,omitempty"`
+	// max_fp_stake_ratio is the maximum ratio of the total staked BTC that can
+	// be delegated to a single finality provider. Setting it to 0 disables the
+	// cap
+	MaxFpStakeRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=max_fp_stake_ratio,json=maxFpStakeRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_fp_stake_ratio"`
+	// covenant_sig_timeout_blocks is the number of Babylon blocks after the
+	// creation of a BTC delegation within which all covenant members are
+	// expected to submit their signatures. The covenant members that did not
+	// sign by then are recorded as missing the BTC delegation. Setting it to 0
+	// disables the timeout
+	CovenantSigTimeoutBlocks uint32 `protobuf:"varint,18,opt,name=covenant_sig_timeout_blocks,json=covenantSigTimeoutBlocks,proto3" json:"covenant_sig_timeout_blocks,omitempty"`
+	// min_fp_self_bond_sats is the minimum amount of satoshis a finality
+	// provider must delegate to itself, i.e., from its own Babylon address,
+	// before accepting BTC delegations from other stakers. Setting it to 0
+	// disables the requirement
+	MinFpSelfBondSats uint64 `protobuf:"varint,19,opt,name=min_fp_self_bond_sats,json=minFpSelfBondSats,proto3" json:"min_fp_self_bond_sats,omitempty"`
+	// fp_stake_ratio_min_total_sats is the amount of total staked satoshis
+	// below which max_fp_stake_ratio is not enforced, so that the first BTC
+	// delegations can be accepted. It must be positive if max_fp_stake_ratio is
+	// below 1
+	FpStakeRatioMinTotalSats uint64 `protobuf:"varint,20,opt,name=fp_stake_ratio_min_total_sats,json=fpStakeRatioMinTotalSats,proto3" json:"fp_stake_ratio_min_total_sats,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -196,6 +226,34 @@ func (m *Params) GetBtcActivationHeight() uint32 {
 	return 0
 }
 
+func (m *Params) GetCovenantMusig2Unbonding() bool {
+	if m != nil {
+		return m.CovenantMusig2Unbonding
+	}
+	return false
+}
+
+func (m *Params) GetCovenantSigTimeoutBlocks() uint32 {
+	if m != nil {
+		return m.CovenantSigTimeoutBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinFpSelfBondSats() uint64 {
+	if m != nil {
+		return m.MinFpSelfBondSats
+	}
+	return 0
+}
+
+func (m *Params) GetFpStakeRatioMinTotalSats() uint64 {
+	if m != nil {
+		return m.FpStakeRatioMinTotalSats
+	}
+	return 0
+}
+
 // HeightVersionPair pairs a btc height with a version of the parameters
 type HeightVersionPair struct {
 	// start_height is the height from which the parameters are activated
@@ -367,53 +425,62 @@ func init() {
 }
 
 var fileDescriptor_8d1392776a3e15b9 = []byte{
-	// 733 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x5f, 0x4f, 0xd3, 0x5c,
-	0x18, 0x5f, 0x5f, 0xc6, 0x78, 0x39, 0x1b, 0xf0, 0x52, 0x20, 0x6f, 0x41, 0xdc, 0xe6, 0xbc, 0x70,
-	0x31, 0xd2, 0x3a, 0xc0, 0x98, 0x48, 0xa2, 0x71, 0x20, 0x6a, 0xc4, 0x64, 0x74, 0x0b, 0x17, 0x5e,
-	0xd8, 0x9c, 0x76, 0xc7, 0xee, 0x64, 0x6d, 0x4f, 0xed, 0x39, 0x9b, 0xdb, 0xb7, 0xf0, 0x52, 0xef,
-	0xfc, 0x10, 0x7e, 0x08, 0x2e, 0x89, 0x57, 0x86, 0x0b, 0x62, 0xe0, 0x8b, 0x98, 0x9e, 0x73, 0xda,
-	0x15, 0xc4, 0x84, 0xbb, 0x9d, 0xf3, 0xfb, 0xfb, 0xb4, 0xeb, 0x03, 0x6a, 0x36, 0xb4, 0xc7, 0x1e,
-	0x09, 0x0c, 0x9b, 0x39, 0x94, 0xc1, 0x3e, 0x0e, 0x5c, 0x63, 0xd8, 0x30, 0x42, 0x18, 0x41, 0x9f,
-	0xea, 0x61, 0x44, 0x18, 0x51, 0x57, 0x24, 0x47, 0x9f, 0x70, 0xf4, 0x61, 0x63, 0x6d, 0xd9, 0x25,
-	0x2e, 0xe1, 0x0c, 0x23, 0xfe, 0x25, 0xc8, 0x6b, 0xab, 0x0e, 0xa1, 0x3e, 0xa1, 0x96, 0x00, 0xc4,
-	0x41, 0x40, 0xb5, 0xaf, 0x33, 0xa0, 0xd0, 0xe2, 0xc6, 0xea, 0x7b, 0x50, 0x72, 0xc8, 0x10, 0x05,
-	0x30, 0x60, 0x56, 0xd8, 0xa7, 0x9a, 0x52, 0x9d, 0xaa, 0x97, 0x9a, 0x3b, 0xa7, 0x67, 0x95, 0xc7,
-	0x2e, 0x66, 0xbd, 0x81, 0xad, 0x3b, 0xc4, 0x37, 0x64, 0xae, 0x07, 0x6d, 0xba, 0x81, 0x49, 0x72,
-	0x34, 0x86, 0xdb, 0x06, 0x1b, 0x87, 0x88, 0xea, 0xcd, 0xd7, 0xad, 0xad, 0xed, 0x87, 0xad, 0x81,
-	0xfd, 0x06, 0x8d, 0xcd, 0x62, 0x62, 0xd8, 0xea, 0x53, 0xf5, 0x1e, 0x58, 0x48, 0xfd, 0x3f, 0x0e,
-	0x48, 0x34, 0xf0, 0xb5, 0x7f, 0xaa, 0x4a, 0x7d, 0xce, 0x9c, 0x4f, 0xae, 0x0f, 0xf9, 0xad, 0xda,
-	0x00, 0x2b, 0x3e, 0x0e, 0x2c, 0x39, 0x96, 0x35, 0x84, 0xde, 0x00, 0x59, 0x14, 0x32, 0x6d, 0xaa,
-	0xaa, 0xd4, 0xa7, 0x4c, 0xd5, 0xc7, 0x41, 0x5b, 0x60, 0x47, 0x31, 0xd4, 0x86, 0x8c, 0x4b, 0xe0,
-	0xe8, 0x1a, 0x49, 0x5e, 0x4a, 0xe0, 0xe8, 0xaa, 0xe4, 0x11, 0xf8, 0x3f, 0x9b, 0xc2, 0xb0, 0x8f,
-	0x2c, 0xdb, 0x23, 0x4e, 0x9f, 0x6a, 0xd3, 0xbc, 0xd6, 0xf2, 0x24, 0xa7, 0x83, 0x7d, 0xd4, 0xe4,
-	0x18, 0x97, 0x65, 0x92, 0xb2, 0xb2, 0x82, 0x94, 0xa5, 0x59, 0x19, 0xd9, 0x03, 0xa0, 0x52, 0x0f,
-	0xd2, 0x5e, 0xac, 0x09, 0xfb, 0x16, 0x75, 0x22, 0x1c, 0x32, 0x6d, 0xa6, 0xaa, 0xd4, 0x4b, 0xe6,
-	0x7f, 0x09, 0xd2, 0xea, 0xb7, 0xf9, 0xbd, 0xba, 0x2d, 0xbb, 0x25, 0x0a, 0x36, 0xb2, 0x3e, 0x20,
-	0x31, 0xd0, 0xbf, 0x7c, 0xa0, 0xa5, 0xb8, 0x9b, 0x44, 0x3b, 0xa3, 0x7d, 0xc4, 0x27, 0x3a, 0x02,
-	0x73, 0xa9, 0x22, 0x82, 0x0c, 0x69, 0xb3, 0x55, 0xa5, 0x3e, 0xdb, 0x6c, 0x1c, 0x9f, 0x55, 0x72,
-	0xa7, 0x67, 0x95, 0x5b, 0xe2, 0xc5, 0xd3, 0x6e, 0x5f, 0xc7, 0xc4, 0xf0, 0x21, 0xeb, 0xe9, 0x07,
-	0xc8, 0x85, 0xce, 0x78, 0x0f, 0x39, 0x3f, 0xbe, 0x6f, 0x00, 0xf9, 0xbf, 0xd8, 0x43, 0x8e, 0x59,
-	0x4a, 0x7c, 0x4c, 0xc8, 0x90, 0xba, 0x09, 0x56, 0x06, 0x81, 0x4d, 0x82, 0xee, 0xd5, 0x81, 0x01,
-	0x1f, 0x78, 0x29, 0x05, 0x33, 0xf3, 0xde, 0x07, 0x8b, 0x13, 0x4d, 0xd2, 0xbd, 0xc8, 0xbb, 0x2f,
-	0xa4, 0x80, 0xec, 0xdd, 0x06, 0xf1, 0x38, 0x96, 0x43, 0x7c, 0x1f, 0x53, 0x8a, 0x49, 0x20, 0xda,
-	0x97, 0x78, 0xfb, 0xbb, 0x37, 0x68, 0x6f, 0x2e, 0xfa, 0x38, 0xd8, 0x4d, 0xe5, 0xbc, 0xf4, 0x3e,
-	0xa8, 0x76, 0x91, 0x87, 0x5c, 0xc8, 0x62, 0x43, 0x27, 0x42, 0xe2, 0x87, 0x0d, 0x29, 0xb2, 0x5c,
-	0x48, 0xe3, 0x4e, 0xda, 0x5c, 0x55, 0xa9, 0xe7, 0xcd, 0xf5, 0x09, 0x6f, 0x57, 0xd2, 0x9a, 0x90,
-	0xa2, 0x97, 0x90, 0xee, 0x23, 0xa4, 0x3e, 0x03, 0xeb, 0xd0, 0xf3, 0xc8, 0x27, 0xcb, 0xc3, 0x94,
-	0x59, 0x68, 0x14, 0xe2, 0x48, 0x38, 0xf5, 0x10, 0x76, 0x7b, 0x4c, 0x9b, 0xe7, 0x1e, 0xab, 0x9c,
-	0x73, 0x80, 0x29, 0x7b, 0x91, 0x32, 0x5e, 0x71, 0x42, 0xfc, 0xf4, 0x6c, 0xe6, 0x58, 0xd0, 0x61,
-	0x78, 0x78, 0x49, 0xb9, 0x20, 0x9e, 0x9e, 0xcd, 0x9c, 0xe7, 0x29, 0x26, 0x34, 0x4f, 0xf2, 0x5f,
-	0xbe, 0x55, 0x72, 0xb5, 0x16, 0x58, 0x14, 0xe7, 0x23, 0x14, 0xc5, 0x73, 0xb5, 0x20, 0x8e, 0xd4,
-	0x3b, 0xa0, 0x44, 0x19, 0x8c, 0x58, 0xe2, 0xa2, 0xf0, 0xfc, 0x22, 0xbf, 0x93, 0x89, 0x1a, 0x98,
-	0x19, 0x0a, 0x85, 0xfc, 0xc0, 0x92, 0x63, 0xad, 0x03, 0x54, 0xc1, 0xe9, 0x10, 0xe9, 0xf9, 0x16,
-	0x86, 0xea, 0x53, 0x30, 0x1d, 0x42, 0x1c, 0x89, 0x2f, 0xbe, 0xb8, 0x59, 0xd7, 0xaf, 0xdd, 0x2d,
-	0xfa, 0x1f, 0x5d, 0x4c, 0x21, 0xab, 0x21, 0x50, 0x6a, 0x33, 0x12, 0xa1, 0xae, 0x5c, 0x24, 0x99,
-	0x7c, 0xe5, 0x52, 0xbe, 0xba, 0x03, 0x0a, 0x62, 0x8b, 0xf1, 0x62, 0xc5, 0xcd, 0xdb, 0x7f, 0x89,
-	0x12, 0x46, 0xcd, 0x7c, 0xfc, 0xee, 0x4d, 0x29, 0x69, 0x1e, 0x1e, 0x9f, 0x97, 0x95, 0x93, 0xf3,
-	0xb2, 0xf2, 0xeb, 0xbc, 0xac, 0x7c, 0xbe, 0x28, 0xe7, 0x4e, 0x2e, 0xca, 0xb9, 0x9f, 0x17, 0xe5,
-	0xdc, 0xbb, 0x9b, 0xed, 0xa7, 0x51, 0x76, 0x9f, 0xf2, 0x65, 0x65, 0x17, 0xf8, 0x12, 0xdc, 0xfa,
-	0x1d, 0x00, 0x00, 0xff, 0xff, 0x24, 0x66, 0xd0, 0xe3, 0x72, 0x05, 0x00, 0x00,
+	// 876 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xdb, 0x36,
+	0x18, 0xb6, 0x96, 0x34, 0x6d, 0x68, 0xa7, 0x69, 0x98, 0x04, 0x55, 0xfa, 0xe1, 0x78, 0xde, 0x61,
+	0xc6, 0xb0, 0xca, 0x4d, 0x9a, 0x61, 0x40, 0x8b, 0xad, 0x98, 0xda, 0x79, 0x1b, 0xd6, 0x00, 0xae,
+	0xe4, 0xe5, 0xb0, 0x43, 0x09, 0x4a, 0xa6, 0x65, 0xc2, 0x92, 0xa8, 0x89, 0x94, 0xa7, 0xfc, 0x8b,
+	0x1d, 0x77, 0xdc, 0x8f, 0xd8, 0x8f, 0xe8, 0xb1, 0xd8, 0x69, 0xe8, 0x21, 0x18, 0x92, 0xc3, 0xfe,
+	0xc6, 0xc0, 0x0f, 0x29, 0x4e, 0x9b, 0x01, 0x41, 0x6f, 0x12, 0x9f, 0x0f, 0x3e, 0x2f, 0xf9, 0xea,
+	0x15, 0xe8, 0x06, 0x38, 0x38, 0x8e, 0x59, 0xda, 0x0f, 0x44, 0xc8, 0x05, 0x9e, 0xd1, 0x34, 0xea,
+	0xcf, 0xf7, 0xfa, 0x19, 0xce, 0x71, 0xc2, 0x9d, 0x2c, 0x67, 0x82, 0xc1, 0x6d, 0xc3, 0x71, 0xce,
+	0x39, 0xce, 0x7c, 0xef, 0xce, 0x56, 0xc4, 0x22, 0xa6, 0x18, 0x7d, 0xf9, 0xa4, 0xc9, 0x77, 0x76,
+	0x42, 0xc6, 0x13, 0xc6, 0x91, 0x06, 0xf4, 0x8b, 0x86, 0xba, 0xff, 0xae, 0x82, 0x95, 0xa1, 0x32,
+	0x86, 0xaf, 0x40, 0x2b, 0x64, 0x73, 0x92, 0xe2, 0x54, 0xa0, 0x6c, 0xc6, 0x6d, 0xab, 0xb3, 0xd4,
+	0x6b, 0xb9, 0x4f, 0xde, 0x9e, 0xec, 0x7e, 0x19, 0x51, 0x31, 0x2d, 0x02, 0x27, 0x64, 0x49, 0xdf,
+	0xec, 0x1b, 0xe3, 0x80, 0x3f, 0xa0, 0xac, 0x7a, 0xed, 0xcf, 0x0f, 0xfa, 0xe2, 0x38, 0x23, 0xdc,
+	0x71, 0x7f, 0x18, 0x3e, 0x3a, 0x78, 0x38, 0x2c, 0x82, 0x1f, 0xc9, 0xb1, 0xd7, 0xac, 0x0c, 0x87,
+	0x33, 0x0e, 0x3f, 0x05, 0xeb, 0xb5, 0xff, 0x2f, 0x05, 0xcb, 0x8b, 0xc4, 0xfe, 0xa8, 0x63, 0xf5,
+	0xd6, 0xbc, 0x9b, 0xd5, 0xf2, 0x4b, 0xb5, 0x0a, 0xf7, 0xc0, 0x76, 0x42, 0x53, 0x64, 0xca, 0x42,
+	0x73, 0x1c, 0x17, 0x04, 0x71, 0x2c, 0xec, 0xa5, 0x8e, 0xd5, 0x5b, 0xf2, 0x60, 0x42, 0x53, 0x5f,
+	0x63, 0x47, 0x12, 0xf2, 0xb1, 0x50, 0x12, 0x5c, 0x5e, 0x22, 0x59, 0x36, 0x12, 0x5c, 0xbe, 0x2b,
+	0xf9, 0x02, 0xdc, 0x5e, 0xdc, 0x45, 0xd0, 0x84, 0xa0, 0x20, 0x66, 0xe1, 0x8c, 0xdb, 0xd7, 0x54,
+	0xac, 0xad, 0xf3, 0x7d, 0x46, 0x34, 0x21, 0xae, 0xc2, 0x94, 0x0c, 0x97, 0x97, 0xca, 0x56, 0x8c,
+	0x0c, 0x97, 0xef, 0xcb, 0x3e, 0x07, 0x90, 0xc7, 0x98, 0x4f, 0xa5, 0x26, 0x9b, 0x21, 0x1e, 0xe6,
+	0x34, 0x13, 0xf6, 0xf5, 0x8e, 0xd5, 0x6b, 0x79, 0xb7, 0x2a, 0x64, 0x38, 0xf3, 0xd5, 0x3a, 0x3c,
+	0x30, 0xd9, 0x2a, 0x85, 0x28, 0xd1, 0x84, 0xe8, 0x82, 0x6e, 0xa8, 0x82, 0x36, 0x65, 0x36, 0x83,
+	0x8e, 0xca, 0x01, 0x51, 0x15, 0x1d, 0x81, 0xb5, 0x5a, 0x91, 0x63, 0x41, 0xec, 0xd5, 0x8e, 0xd5,
+	0x5b, 0x75, 0xf7, 0x5e, 0x9f, 0xec, 0x36, 0xde, 0x9e, 0xec, 0xde, 0xd5, 0x17, 0xcf, 0xc7, 0x33,
+	0x87, 0xb2, 0x7e, 0x82, 0xc5, 0xd4, 0x79, 0x41, 0x22, 0x1c, 0x1e, 0x3f, 0x27, 0xe1, 0x5f, 0x7f,
+	0x3e, 0x00, 0xa6, 0x2f, 0x9e, 0x93, 0xd0, 0x6b, 0x55, 0x3e, 0x1e, 0x16, 0x04, 0xee, 0x83, 0xed,
+	0x22, 0x0d, 0x58, 0x3a, 0x7e, 0xb7, 0x60, 0xa0, 0x0a, 0xde, 0xac, 0xc1, 0x85, 0x7a, 0x3f, 0x03,
+	0x1b, 0xe7, 0x9a, 0x2a, 0x7b, 0x53, 0x65, 0x5f, 0xaf, 0x01, 0x93, 0xdb, 0x07, 0xb2, 0x1c, 0x14,
+	0xb2, 0x24, 0xa1, 0x9c, 0x53, 0x96, 0xea, 0xf4, 0x2d, 0x95, 0xfe, 0x93, 0x2b, 0xa4, 0xf7, 0x36,
+	0x12, 0x9a, 0x3e, 0xab, 0xe5, 0x2a, 0xf4, 0x00, 0x74, 0xc6, 0x24, 0x26, 0x11, 0x16, 0xd2, 0x30,
+	0xcc, 0x89, 0x7e, 0x08, 0x30, 0x27, 0x28, 0xc2, 0x5c, 0x66, 0xb2, 0xd7, 0x3a, 0x56, 0x6f, 0xd9,
+	0xbb, 0x77, 0xce, 0x7b, 0x66, 0x68, 0x2e, 0xe6, 0xe4, 0x3b, 0xcc, 0x07, 0x84, 0xc0, 0xa7, 0xe0,
+	0x1e, 0x8e, 0x63, 0xf6, 0x2b, 0x8a, 0x29, 0x17, 0x88, 0x94, 0x19, 0xcd, 0xb5, 0xd3, 0x94, 0xd0,
+	0x68, 0x2a, 0xec, 0x9b, 0xca, 0x63, 0x47, 0x71, 0x5e, 0x50, 0x2e, 0xbe, 0xad, 0x19, 0xdf, 0x2b,
+	0x82, 0x3c, 0xbd, 0x40, 0x84, 0x08, 0x87, 0x82, 0xce, 0x2f, 0x28, 0xd7, 0xf5, 0xe9, 0x05, 0x22,
+	0xfc, 0xa6, 0xc6, 0x8c, 0xe6, 0x31, 0xd8, 0xa9, 0x3f, 0x95, 0xa4, 0xe0, 0x34, 0xda, 0x47, 0xf5,
+	0xa1, 0xd9, 0xb7, 0x3a, 0x56, 0xef, 0x86, 0x77, 0xbb, 0x22, 0x1c, 0x2a, 0xfc, 0xa7, 0x0a, 0x86,
+	0xaf, 0x80, 0xec, 0x76, 0x34, 0xc9, 0x54, 0x8f, 0x12, 0xa4, 0xc2, 0xd8, 0x1b, 0x1f, 0xda, 0x0a,
+	0xeb, 0x09, 0x2e, 0x07, 0x99, 0x6c, 0x68, 0xe2, 0x49, 0x27, 0xf8, 0x15, 0xb8, 0x5b, 0x67, 0xe3,
+	0x54, 0x37, 0x04, 0x2b, 0x44, 0xd5, 0x13, 0x50, 0x55, 0x65, 0x57, 0x14, 0x9f, 0xaa, 0xae, 0x60,
+	0x85, 0x30, 0x8d, 0xf1, 0x50, 0x7f, 0xdc, 0x32, 0x1e, 0x89, 0x27, 0x48, 0xa6, 0x96, 0xbd, 0xc1,
+	0xed, 0x4d, 0x75, 0x90, 0xf2, 0x26, 0x07, 0x99, 0x4f, 0xe2, 0x89, 0xcb, 0xd2, 0xb1, 0x8f, 0x05,
+	0x87, 0x4f, 0xc1, 0xfd, 0x8b, 0xc5, 0x20, 0x69, 0x20, 0x98, 0xc0, 0xb1, 0x56, 0x6e, 0x29, 0xa5,
+	0x3d, 0x59, 0x48, 0x79, 0x48, 0xd3, 0x91, 0x24, 0x48, 0x83, 0xc7, 0xcb, 0xbf, 0xff, 0xb1, 0xdb,
+	0xe8, 0x0e, 0xc1, 0x86, 0x3e, 0xdd, 0x23, 0x92, 0xcb, 0x2e, 0x19, 0x62, 0x9a, 0xc3, 0x8f, 0x41,
+	0x8b, 0x0b, 0x9c, 0x8b, 0xea, 0x4e, 0x2c, 0x65, 0xd5, 0x54, 0x6b, 0xe6, 0x2e, 0x6c, 0x70, 0x7d,
+	0xae, 0x15, 0x66, 0x5c, 0x55, 0xaf, 0xdd, 0x11, 0x80, 0x9a, 0x33, 0x62, 0xc6, 0xf3, 0x10, 0x67,
+	0xf0, 0x6b, 0x70, 0x2d, 0xc3, 0x34, 0xd7, 0xf3, 0xb3, 0xb9, 0xdf, 0x73, 0x2e, 0x9d, 0xd4, 0xce,
+	0x7b, 0x59, 0x3c, 0x2d, 0xeb, 0x12, 0xd0, 0xf2, 0x05, 0xcb, 0xc9, 0xd8, 0x8c, 0xe5, 0x85, 0xfd,
+	0xad, 0x0b, 0xfb, 0xc3, 0x27, 0x60, 0x45, 0xff, 0x13, 0x54, 0xb0, 0xe6, 0xfe, 0xfd, 0xff, 0xd9,
+	0x4a, 0x1b, 0xb9, 0xcb, 0xf2, 0xf2, 0x3d, 0x23, 0x71, 0x5f, 0xbe, 0x3e, 0x6d, 0x5b, 0x6f, 0x4e,
+	0xdb, 0xd6, 0x3f, 0xa7, 0x6d, 0xeb, 0xb7, 0xb3, 0x76, 0xe3, 0xcd, 0x59, 0xbb, 0xf1, 0xf7, 0x59,
+	0xbb, 0xf1, 0xf3, 0xd5, 0xa6, 0x7d, 0xb9, 0xf8, 0x77, 0x52, 0xa3, 0x3f, 0x58, 0x51, 0xbf, 0x94,
+	0x47, 0xff, 0x0d, 0x00, 0x4d, 0x3b, 0xc2, 0x99, 0xc0, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -436,6 +503,51 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FpStakeRatioMinTotalSats != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FpStakeRatioMinTotalSats))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.MinFpSelfBondSats != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinFpSelfBondSats))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.CovenantSigTimeoutBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CovenantSigTimeoutBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	{
+		size := m.MaxFpStakeRatio.Size()
+		i -= size
+		if _, err := m.MaxFpStakeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.CovenantMusig2Unbonding {
+		i--
+		if m.CovenantMusig2Unbonding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
 	if m.BtcActivationHeight != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.BtcActivationHeight))
 		i--
@@ -707,6 +819,20 @@ func (m *Params) Size() (n int) {
 	if m.BtcActivationHeight != 0 {
 		n += 1 + sovParams(uint64(m.BtcActivationHeight))
 	}
+	if m.CovenantMusig2Unbonding {
+		n += 3
+	}
+	l = m.MaxFpStakeRatio.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.CovenantSigTimeoutBlocks != 0 {
+		n += 2 + sovParams(uint64(m.CovenantSigTimeoutBlocks))
+	}
+	if m.MinFpSelfBondSats != 0 {
+		n += 2 + sovParams(uint64(m.MinFpSelfBondSats))
+	}
+	if m.FpStakeRatioMinTotalSats != 0 {
+		n += 2 + sovParams(uint64(m.FpStakeRatioMinTotalSats))
+	}
 	return n
 }
 
@@ -1135,6 +1261,117 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantMusig2Unbonding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CovenantMusig2Unbonding = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFpStakeRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFpStakeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigTimeoutBlocks", wireType)
+			}
+			m.CovenantSigTimeoutBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantSigTimeoutBlocks |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFpSelfBondSats", wireType)
+			}
+			m.MinFpSelfBondSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFpSelfBondSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpStakeRatioMinTotalSats", wireType)
+			}
+			m.FpStakeRatioMinTotalSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FpStakeRatioMinTotalSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -226,6 +226,108 @@ func TestParamsValidateNoDustSlashing(t *testing.T) {
 	}
 }
 
+func TestParamsValidateMaxFpStakeRatio(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ratio         sdkmath.LegacyDec
+		expectedError string
+	}{
+		{
+			name:          "nil ratio disables the cap",
+			ratio:         sdkmath.LegacyDec{},
+			expectedError: "",
+		},
+		{
+			name:          "zero ratio disables the cap",
+			ratio:         sdkmath.LegacyZeroDec(),
+			expectedError: "",
+		},
+		{
+			name:          "valid ratio",
+			ratio:         sdkmath.LegacyNewDecWithPrec(2, 1),
+			expectedError: "",
+		},
+		{
+			name:          "negative ratio",
+			ratio:         sdkmath.LegacyNewDecWithPrec(-1, 1),
+			expectedError: "max finality provider stake ratio cannot be negative",
+		},
+		{
+			name:          "ratio greater than 1",
+			ratio:         sdkmath.LegacyNewDecWithPrec(11, 1),
+			expectedError: "max finality provider stake ratio cannot be greater than 100%",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxFpStakeRatio = tc.ratio
+
+			err := params.Validate()
+
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+				require.Equal(t, !tc.ratio.IsNil() && tc.ratio.IsPositive(), params.IsFpStakeRatioCapped())
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestParamsValidateFpStakeRatioMinTotalSats(t *testing.T) {
+	testCases := []struct {
+		name          string
+		ratio         sdkmath.LegacyDec
+		minTotalSats  uint64
+		expectedError string
+	}{
+		{
+			name:          "uncapped ratio without minimum",
+			ratio:         sdkmath.LegacyZeroDec(),
+			minTotalSats:  0,
+			expectedError: "",
+		},
+		{
+			name:          "ratio of 1 without minimum",
+			ratio:         sdkmath.LegacyOneDec(),
+			minTotalSats:  0,
+			expectedError: "",
+		},
+		{
+			name:          "ratio below 1 with minimum",
+			ratio:         sdkmath.LegacyNewDecWithPrec(2, 1),
+			minTotalSats:  1,
+			expectedError: "",
+		},
+		{
+			name:          "ratio below 1 without minimum",
+			ratio:         sdkmath.LegacyNewDecWithPrec(2, 1),
+			minTotalSats:  0,
+			expectedError: "must be positive if the ratio is below 100%",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxFpStakeRatio = tc.ratio
+			params.FpStakeRatioMinTotalSats = tc.minTotalSats
+
+			err := params.Validate()
+
+			if tc.expectedError == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expectedError)
+			}
+		})
+	}
+}
+
 func TestDefaultParamsAreValid(t *testing.T) {
 	params := types.DefaultParams()
 	require.NoError(t, params.Validate())
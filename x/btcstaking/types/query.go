@@ -2,6 +2,8 @@ package types
 
 import (
 	"encoding/hex"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
 )
 
 func delegatorUnbondingInfoToResponse(ui *DelegatorUnbondingInfo) *DelegatorUnbondingInfoResponse {
@@ -35,6 +37,7 @@ func NewBTCDelegationResponse(btcDel *BTCDelegation, status BTCDelegationStatus)
 		UnbondingTime:        btcDel.UnbondingTime,
 		UndelegationResponse: nil,
 		ParamsVersion:        btcDel.ParamsVersion,
+		FpEotsPk:             btcDel.FpEotsPk,
 	}
 
 	if btcDel.SlashingTx != nil {
@@ -69,12 +72,15 @@ func (ud *BTCUndelegation) ToResponse() (resp *BTCUndelegationResponse) {
 	if ud.DelegatorSlashingSig != nil {
 		resp.DelegatorSlashingSigHex = ud.DelegatorSlashingSig.ToHexStr()
 	}
+	if ud.CovenantUnbondingAggSig != nil {
+		resp.CovenantUnbondingAggSigHex = ud.CovenantUnbondingAggSig.ToHexStr()
+	}
 
 	return resp
 }
 
 // NewFinalityProviderResponse creates a new finality provider response based on the finality provider
-func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight uint64, softDeleted bool) *FinalityProviderResponse {
+func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight uint64, softDeleted bool, delegatedSats uint64) *FinalityProviderResponse {
 	return &FinalityProviderResponse{
 		Description:          f.Description,
 		Commission:           f.Commission,
@@ -88,5 +94,21 @@ func NewFinalityProviderResponse(f *FinalityProvider, bbnBlockHeight uint64, sof
 		HighestVotedHeight:   f.HighestVotedHeight,
 		CommissionInfo:       f.CommissionInfo,
 		SoftDeleted:          softDeleted,
+		KeyRotations:         f.KeyRotations,
+		MaxDelegatedSats:     f.MaxDelegatedSats,
+		DelegatedSats:        delegatedSats,
+	}
+}
+
+// NewCovenantStatsResponse creates a new covenant stats response based on the
+// stats of the given covenant member
+func NewCovenantStatsResponse(covPK *bbn.BIP340PubKey, s *CovenantStats) *CovenantStatsResponse {
+	return &CovenantStatsResponse{
+		CovenantBtcPkHex:   covPK.MarshalHex(),
+		NumSignatures:      s.NumSignatures,
+		TotalLatencyBlocks: s.TotalLatencyBlocks,
+		MaxLatencyBlocks:   s.MaxLatencyBlocks,
+		NumMissed:          s.NumMissed,
+		NumLate:            s.NumLate,
 	}
 }
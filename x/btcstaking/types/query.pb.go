@@ -35,6 +35,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// CovenantCommitteeStatus is the status of the covenant committee of a
+// parameters version at the current BTC tip
+type CovenantCommitteeStatus int32
+
+const (
+	// COVENANT_COMMITTEE_STATUS_SCHEDULED defines a covenant committee that is
+	// activated at a BTC height after the current BTC tip
+	CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_SCHEDULED CovenantCommitteeStatus = 0
+	// COVENANT_COMMITTEE_STATUS_ACTIVE defines the covenant committee of the
+	// parameters in effect at the current BTC tip
+	CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_ACTIVE CovenantCommitteeStatus = 1
+	// COVENANT_COMMITTEE_STATUS_RETIRED defines a covenant committee that has
+	// been replaced by the active one. The BTC delegations bound to it still
+	// collect the signatures of its members
+	CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_RETIRED CovenantCommitteeStatus = 2
+)
+
+var CovenantCommitteeStatus_name = map[int32]string{
+	0: "COVENANT_COMMITTEE_STATUS_SCHEDULED",
+	1: "COVENANT_COMMITTEE_STATUS_ACTIVE",
+	2: "COVENANT_COMMITTEE_STATUS_RETIRED",
+}
+
+var CovenantCommitteeStatus_value = map[string]int32{
+	"COVENANT_COMMITTEE_STATUS_SCHEDULED": 0,
+	"COVENANT_COMMITTEE_STATUS_ACTIVE":    1,
+	"COVENANT_COMMITTEE_STATUS_RETIRED":   2,
+}
+
+func (x CovenantCommitteeStatus) String() string {
+	return proto.EnumName(CovenantCommitteeStatus_name, int32(x))
+}
+
+func (CovenantCommitteeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -734,25 +771,30 @@ func (m *QueryFinalityProviderDelegationsResponse) GetPagination() *query.PageRe
 	return nil
 }
 
-// QueryBTCDelegationRequest is the request type to retrieve a BTC delegation by
-// staking tx hash
-type QueryBTCDelegationRequest struct {
-	// Hash of staking transaction in btc format
-	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+// QueryDelegationsByStakerRequest is the request type for the
+// Query/DelegationsByStaker RPC method.
+type QueryDelegationsByStakerRequest struct {
+	// staker_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the staker
+	// the PK follows encoding in BIP-340 spec
+	StakerBtcPkHex string `protobuf:"bytes,1,opt,name=staker_btc_pk_hex,json=stakerBtcPkHex,proto3" json:"staker_btc_pk_hex,omitempty"`
+	// status is the queried status for BTC delegations
+	Status BTCDelegationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationRequest{} }
-func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryBTCDelegationRequest) ProtoMessage()    {}
-func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryDelegationsByStakerRequest) Reset()         { *m = QueryDelegationsByStakerRequest{} }
+func (m *QueryDelegationsByStakerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByStakerRequest) ProtoMessage()    {}
+func (*QueryDelegationsByStakerRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_74d49d26f7429697, []int{14}
 }
-func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegationsByStakerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegationsByStakerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBTCDelegationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegationsByStakerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -762,44 +804,61 @@ func (m *QueryBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryBTCDelegationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBTCDelegationRequest.Merge(m, src)
+func (m *QueryDelegationsByStakerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByStakerRequest.Merge(m, src)
 }
-func (m *QueryBTCDelegationRequest) XXX_Size() int {
+func (m *QueryDelegationsByStakerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBTCDelegationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBTCDelegationRequest.DiscardUnknown(m)
+func (m *QueryDelegationsByStakerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByStakerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBTCDelegationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegationsByStakerRequest proto.InternalMessageInfo
 
-func (m *QueryBTCDelegationRequest) GetStakingTxHashHex() string {
+func (m *QueryDelegationsByStakerRequest) GetStakerBtcPkHex() string {
 	if m != nil {
-		return m.StakingTxHashHex
+		return m.StakerBtcPkHex
 	}
 	return ""
 }
 
-// QueryBTCDelegationResponse is response type matching QueryBTCDelegationRequest
-// and containing BTC delegation information
-type QueryBTCDelegationResponse struct {
-	// BTCDelegation represents the client needed information of an BTCDelegation.
-	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,1,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+func (m *QueryDelegationsByStakerRequest) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BTCDelegationStatus_PENDING
 }
 
-func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationResponse{} }
-func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryBTCDelegationResponse) ProtoMessage()    {}
-func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
+func (m *QueryDelegationsByStakerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDelegationsByStakerResponse is the response type for the
+// Query/DelegationsByStaker RPC method.
+type QueryDelegationsByStakerResponse struct {
+	// btc_delegations contains all the queried BTC delegations of the staker
+	// under the given status
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryDelegationsByStakerResponse) Reset()         { *m = QueryDelegationsByStakerResponse{} }
+func (m *QueryDelegationsByStakerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDelegationsByStakerResponse) ProtoMessage()    {}
+func (*QueryDelegationsByStakerResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_74d49d26f7429697, []int{15}
 }
-func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDelegationsByStakerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDelegationsByStakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryBTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDelegationsByStakerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -809,87 +868,55 @@ func (m *QueryBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryBTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryBTCDelegationResponse.Merge(m, src)
+func (m *QueryDelegationsByStakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDelegationsByStakerResponse.Merge(m, src)
 }
-func (m *QueryBTCDelegationResponse) XXX_Size() int {
+func (m *QueryDelegationsByStakerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryBTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryBTCDelegationResponse.DiscardUnknown(m)
+func (m *QueryDelegationsByStakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDelegationsByStakerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryBTCDelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDelegationsByStakerResponse proto.InternalMessageInfo
 
-func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
+func (m *QueryDelegationsByStakerResponse) GetBtcDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.BtcDelegation
+		return m.BtcDelegations
 	}
 	return nil
 }
 
-// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
-type BTCDelegationResponse struct {
-	// staker_addr is the address to receive rewards from BTC delegation.
-	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
-	// this BTC delegation delegates to
-	FpBtcPkList []github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
-	// staking_time is the number of blocks for which the delegation is locked on BTC chain
-	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
-	// start_height is the start BTC height of the BTC delegation
-	// it is the start BTC height of the timelock
-	StartHeight uint32 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
-	// end_height is the end height of the BTC delegation
-	// it is the end BTC height of the timelock - w
-	EndHeight uint32 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
-	// total_sat is the total amount of BTC stakes in this delegation
-	// quantified in satoshi
-	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
-	// staking_tx_hex is the hex string of staking tx
-	StakingTxHex string `protobuf:"bytes,8,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
-	// slashing_tx_hex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slash_sig_hex is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
-	// It will be a part of the witness for the staking tx output.
-	DelegatorSlashSigHex string `protobuf:"bytes,10,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
-	// covenant_sigs is a list of adaptor signatures on the slashing tx
-	// by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,11,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
-	// staking_output_idx is the index of the staking output in the staking tx
-	StakingOutputIdx uint32 `protobuf:"varint,12,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
-	// whether this delegation is active
-	Active bool `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
-	// descriptive status of current delegation.
-	StatusDesc string `protobuf:"bytes,14,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
-	// unbonding_time used in unbonding output timelock path and in slashing transactions
-	// change outputs
-	UnbondingTime uint32 `protobuf:"varint,15,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
-	// undelegation_response is the undelegation info of this delegation.
-	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,16,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
-	// params version used to validate delegation
-	ParamsVersion uint32 `protobuf:"varint,17,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
-	// stk_exp contains the stake expansion information, if nil it is NOT a stake expansion.
-	StkExp *StakeExpansionResponse `protobuf:"bytes,18,opt,name=stk_exp,json=stkExp,proto3" json:"stk_exp,omitempty"`
+func (m *QueryDelegationsByStakerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
-func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegationResponse) ProtoMessage()    {}
-func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+// QueryExpiringDelegationsRequest is the request type for the
+// Query/ExpiringDelegations RPC method.
+type QueryExpiringDelegationsRequest struct {
+	// start_height is the first BTC height of the queried range (inclusive)
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last BTC height of the queried range (inclusive)
+	EndHeight uint32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryExpiringDelegationsRequest) Reset()         { *m = QueryExpiringDelegationsRequest{} }
+func (m *QueryExpiringDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringDelegationsRequest) ProtoMessage()    {}
+func (*QueryExpiringDelegationsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_74d49d26f7429697, []int{16}
 }
-func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryExpiringDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryExpiringDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryExpiringDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -899,162 +926,240 @@ func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+func (m *QueryExpiringDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringDelegationsRequest.Merge(m, src)
 }
-func (m *BTCDelegationResponse) XXX_Size() int {
+func (m *QueryExpiringDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
-
-func (m *BTCDelegationResponse) GetStakerAddr() string {
-	if m != nil {
-		return m.StakerAddr
-	}
-	return ""
+func (m *QueryExpiringDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetStakingTime() uint32 {
-	if m != nil {
-		return m.StakingTime
-	}
-	return 0
-}
+var xxx_messageInfo_QueryExpiringDelegationsRequest proto.InternalMessageInfo
 
-func (m *BTCDelegationResponse) GetStartHeight() uint32 {
+func (m *QueryExpiringDelegationsRequest) GetStartHeight() uint32 {
 	if m != nil {
 		return m.StartHeight
 	}
 	return 0
 }
 
-func (m *BTCDelegationResponse) GetEndHeight() uint32 {
+func (m *QueryExpiringDelegationsRequest) GetEndHeight() uint32 {
 	if m != nil {
 		return m.EndHeight
 	}
 	return 0
 }
 
-func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+func (m *QueryExpiringDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.TotalSat
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetStakingTxHex() string {
-	if m != nil {
-		return m.StakingTxHex
-	}
-	return ""
+// QueryExpiringDelegationsResponse is the response type for the
+// Query/ExpiringDelegations RPC method.
+type QueryExpiringDelegationsResponse struct {
+	// btc_delegations contains the BTC delegations expiring within the queried
+	// range, ordered by their expiry height
+	BtcDelegations []*BTCDelegationAtHeightResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetSlashingTxHex() string {
-	if m != nil {
-		return m.SlashingTxHex
+func (m *QueryExpiringDelegationsResponse) Reset()         { *m = QueryExpiringDelegationsResponse{} }
+func (m *QueryExpiringDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExpiringDelegationsResponse) ProtoMessage()    {}
+func (*QueryExpiringDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{17}
+}
+func (m *QueryExpiringDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExpiringDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExpiringDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *QueryExpiringDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExpiringDelegationsResponse.Merge(m, src)
+}
+func (m *QueryExpiringDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExpiringDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExpiringDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
+var xxx_messageInfo_QueryExpiringDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryExpiringDelegationsResponse) GetBtcDelegations() []*BTCDelegationAtHeightResponse {
 	if m != nil {
-		return m.DelegatorSlashSigHex
+		return m.BtcDelegations
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
+func (m *QueryExpiringDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.CovenantSigs
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
-	if m != nil {
-		return m.StakingOutputIdx
-	}
-	return 0
+// QueryUnbondingDelegationsRequest is the request type for the
+// Query/UnbondingDelegations RPC method.
+type QueryUnbondingDelegationsRequest struct {
+	// start_height is the first BTC height of the queried range (inclusive)
+	StartHeight uint32 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the last BTC height of the queried range (inclusive)
+	EndHeight uint32 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetActive() bool {
-	if m != nil {
-		return m.Active
+func (m *QueryUnbondingDelegationsRequest) Reset()         { *m = QueryUnbondingDelegationsRequest{} }
+func (m *QueryUnbondingDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingDelegationsRequest) ProtoMessage()    {}
+func (*QueryUnbondingDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{18}
+}
+func (m *QueryUnbondingDelegationsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingDelegationsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return false
+}
+func (m *QueryUnbondingDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingDelegationsRequest.Merge(m, src)
+}
+func (m *QueryUnbondingDelegationsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingDelegationsRequest.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) GetStatusDesc() string {
+var xxx_messageInfo_QueryUnbondingDelegationsRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingDelegationsRequest) GetStartHeight() uint32 {
 	if m != nil {
-		return m.StatusDesc
+		return m.StartHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+func (m *QueryUnbondingDelegationsRequest) GetEndHeight() uint32 {
 	if m != nil {
-		return m.UnbondingTime
+		return m.EndHeight
 	}
 	return 0
 }
 
-func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+func (m *QueryUnbondingDelegationsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.UndelegationResponse
+		return m.Pagination
 	}
 	return nil
 }
 
-func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
-	if m != nil {
-		return m.ParamsVersion
-	}
-	return 0
+// QueryUnbondingDelegationsResponse is the response type for the
+// Query/UnbondingDelegations RPC method.
+type QueryUnbondingDelegationsResponse struct {
+	// btc_delegations contains the BTC delegations whose unbonding completes
+	// within the queried range, ordered by their unbonding completion height
+	BtcDelegations []*BTCDelegationAtHeightResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegationResponse) GetStkExp() *StakeExpansionResponse {
-	if m != nil {
-		return m.StkExp
+func (m *QueryUnbondingDelegationsResponse) Reset()         { *m = QueryUnbondingDelegationsResponse{} }
+func (m *QueryUnbondingDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingDelegationsResponse) ProtoMessage()    {}
+func (*QueryUnbondingDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{19}
+}
+func (m *QueryUnbondingDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *QueryUnbondingDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingDelegationsResponse.Merge(m, src)
+}
+func (m *QueryUnbondingDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingDelegationsResponse.DiscardUnknown(m)
 }
 
-// StakeExpansionResponse stores information necessary to construct the expanded BTC staking
-// transaction created from a previous BTC staking.
-type StakeExpansionResponse struct {
-	// previous_staking_tx_hash is the hex hash of the staking tx that was used as
-	// input to the stake expansion.
-	PreviousStakingTxHashHex string `protobuf:"bytes,1,opt,name=previous_staking_tx_hash_hex,json=previousStakingTxHashHex,proto3" json:"previous_staking_tx_hash_hex,omitempty"`
-	// other_funding_tx_out is the other funding output (TxOut) which was used
-	// as input to construct the BTC delegation. The stake expansion has a set of
-	// 2 inputs, the first input is the previous staking transaction and the
-	// second input (this one) is to pay for fees and optionally to add more
-	// stake to the BTC delegation.
-	OtherFundingTxOutHex string `protobuf:"bytes,2,opt,name=other_funding_tx_out_hex,json=otherFundingTxOutHex,proto3" json:"other_funding_tx_out_hex,omitempty"`
-	// previous_stk_covenant_sigs is a list of signatures on the stake expansion
-	// transaction (i.e., the transaction spending the previous staking transaction
-	// {previous_staking_tx_hash}) by each covenant member.
-	// It must be provided to allow the previous staking tx to be spent as
-	// an transaction input of another BTC staking transaction.
-	PreviousStkCovenantSigs []*SignatureInfo `protobuf:"bytes,3,rep,name=previous_stk_covenant_sigs,json=previousStkCovenantSigs,proto3" json:"previous_stk_covenant_sigs,omitempty"`
+var xxx_messageInfo_QueryUnbondingDelegationsResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingDelegationsResponse) GetBtcDelegations() []*BTCDelegationAtHeightResponse {
+	if m != nil {
+		return m.BtcDelegations
+	}
+	return nil
 }
 
-func (m *StakeExpansionResponse) Reset()         { *m = StakeExpansionResponse{} }
-func (m *StakeExpansionResponse) String() string { return proto.CompactTextString(m) }
-func (*StakeExpansionResponse) ProtoMessage()    {}
-func (*StakeExpansionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{17}
+func (m *QueryUnbondingDelegationsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *StakeExpansionResponse) XXX_Unmarshal(b []byte) error {
+
+// BTCDelegationAtHeightResponse is a BTC delegation together with the BTC
+// height at which it expires or its unbonding completes
+type BTCDelegationAtHeightResponse struct {
+	// btc_height is the BTC height at which the delegation expires or its
+	// unbonding completes
+	BtcHeight uint32 `protobuf:"varint,1,opt,name=btc_height,json=btcHeight,proto3" json:"btc_height,omitempty"`
+	// btc_delegation is the BTC delegation
+	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,2,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
+}
+
+func (m *BTCDelegationAtHeightResponse) Reset()         { *m = BTCDelegationAtHeightResponse{} }
+func (m *BTCDelegationAtHeightResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationAtHeightResponse) ProtoMessage()    {}
+func (*BTCDelegationAtHeightResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{20}
+}
+func (m *BTCDelegationAtHeightResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *StakeExpansionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BTCDelegationAtHeightResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_StakeExpansionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BTCDelegationAtHeightResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1064,59 +1169,101 @@ func (m *StakeExpansionResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *StakeExpansionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StakeExpansionResponse.Merge(m, src)
+func (m *BTCDelegationAtHeightResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationAtHeightResponse.Merge(m, src)
 }
-func (m *StakeExpansionResponse) XXX_Size() int {
+func (m *BTCDelegationAtHeightResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *StakeExpansionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StakeExpansionResponse.DiscardUnknown(m)
+func (m *BTCDelegationAtHeightResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationAtHeightResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StakeExpansionResponse proto.InternalMessageInfo
+var xxx_messageInfo_BTCDelegationAtHeightResponse proto.InternalMessageInfo
 
-func (m *StakeExpansionResponse) GetPreviousStakingTxHashHex() string {
+func (m *BTCDelegationAtHeightResponse) GetBtcHeight() uint32 {
 	if m != nil {
-		return m.PreviousStakingTxHashHex
+		return m.BtcHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *StakeExpansionResponse) GetOtherFundingTxOutHex() string {
+func (m *BTCDelegationAtHeightResponse) GetBtcDelegation() *BTCDelegationResponse {
 	if m != nil {
-		return m.OtherFundingTxOutHex
+		return m.BtcDelegation
 	}
-	return ""
+	return nil
 }
 
-func (m *StakeExpansionResponse) GetPreviousStkCovenantSigs() []*SignatureInfo {
+// QueryCovenantCommitteesRequest is the request type for the
+// Query/CovenantCommittees RPC method.
+type QueryCovenantCommitteesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCovenantCommitteesRequest) Reset()         { *m = QueryCovenantCommitteesRequest{} }
+func (m *QueryCovenantCommitteesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteesRequest) ProtoMessage()    {}
+func (*QueryCovenantCommitteesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{21}
+}
+func (m *QueryCovenantCommitteesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantCommitteesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCovenantCommitteesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteesRequest.Merge(m, src)
+}
+func (m *QueryCovenantCommitteesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantCommitteesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCovenantCommitteesRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantCommitteesRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.PreviousStkCovenantSigs
+		return m.Pagination
 	}
 	return nil
 }
 
-// DelegatorUnbondingInfoResponse provides all necessary info about transaction
-// which spent the staking output
-type DelegatorUnbondingInfoResponse struct {
-	// spend_stake_tx_hex is the transaction which spent the staking output. It is
-	// filled only if the spend_stake_tx_hex is different than the unbonding_tx_hex
-	SpendStakeTxHex string `protobuf:"bytes,1,opt,name=spend_stake_tx_hex,json=spendStakeTxHex,proto3" json:"spend_stake_tx_hex,omitempty"`
+// QueryCovenantCommitteesResponse is the response type for the
+// Query/CovenantCommittees RPC method.
+type QueryCovenantCommitteesResponse struct {
+	// covenant_committees contains the covenant committees ordered by
+	// parameters version
+	CovenantCommittees []*CovenantCommitteeResponse `protobuf:"bytes,1,rep,name=covenant_committees,json=covenantCommittees,proto3" json:"covenant_committees,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *DelegatorUnbondingInfoResponse) Reset()         { *m = DelegatorUnbondingInfoResponse{} }
-func (m *DelegatorUnbondingInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*DelegatorUnbondingInfoResponse) ProtoMessage()    {}
-func (*DelegatorUnbondingInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{18}
+func (m *QueryCovenantCommitteesResponse) Reset()         { *m = QueryCovenantCommitteesResponse{} }
+func (m *QueryCovenantCommitteesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteesResponse) ProtoMessage()    {}
+func (*QueryCovenantCommitteesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{22}
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCovenantCommitteesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCovenantCommitteesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DelegatorUnbondingInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCovenantCommitteesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1126,62 +1273,65 @@ func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelegatorUnbondingInfoResponse.Merge(m, src)
+func (m *QueryCovenantCommitteesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteesResponse.Merge(m, src)
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_Size() int {
+func (m *QueryCovenantCommitteesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *DelegatorUnbondingInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelegatorUnbondingInfoResponse.DiscardUnknown(m)
+func (m *QueryCovenantCommitteesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelegatorUnbondingInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCovenantCommitteesResponse proto.InternalMessageInfo
 
-func (m *DelegatorUnbondingInfoResponse) GetSpendStakeTxHex() string {
+func (m *QueryCovenantCommitteesResponse) GetCovenantCommittees() []*CovenantCommitteeResponse {
 	if m != nil {
-		return m.SpendStakeTxHex
+		return m.CovenantCommittees
 	}
-	return ""
+	return nil
 }
 
-// BTCUndelegationResponse provides all necessary info about the undeleagation
-type BTCUndelegationResponse struct {
-	// unbonding_tx is the transaction which will transfer the funds from staking
-	// output to unbonding output. Unbonding output will usually have lower timelock
-	// than staking output. The unbonding tx as string hex.
-	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
-	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
-	// by covenant members
-	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,2,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
-	// slashingTxHex is the hex string of slashing tx
-	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
-	// delegator_slashing_sig is the signature on the slashing tx
-	// by the delegator (i.e., SK corresponding to btc_pk).
-	// It will be a part of the witness for the unbonding tx output.
-	// The delegator slashing sig as string hex.
-	DelegatorSlashingSigHex string `protobuf:"bytes,4,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
-	// covenant_slashing_sigs is a list of adaptor signatures on the
-	// unbonding slashing tx by each covenant member
-	// It will be a part of the witness for the staking tx output.
-	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
-	// btc_undelegation_info contains all necessary info about the transaction
-	// which spent the staking output
-	DelegatorUnbondingInfoResponse *DelegatorUnbondingInfoResponse `protobuf:"bytes,6,opt,name=delegator_unbonding_info_response,json=delegatorUnbondingInfoResponse,proto3" json:"delegator_unbonding_info_response,omitempty"`
+func (m *QueryCovenantCommitteesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
-func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCUndelegationResponse) ProtoMessage()    {}
-func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{19}
+// CovenantCommitteeResponse is the covenant committee of a parameters version
+type CovenantCommitteeResponse struct {
+	// params_version is the version of the parameters defining the committee
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// btc_activation_height is the BTC height from which the committee is used
+	// for new BTC delegations (inclusive)
+	BtcActivationHeight uint32 `protobuf:"varint,2,opt,name=btc_activation_height,json=btcActivationHeight,proto3" json:"btc_activation_height,omitempty"`
+	// covenant_pks_hex is the list of hex strings of the BIP-340 PKs of the
+	// covenant members
+	CovenantPksHex []string `protobuf:"bytes,3,rep,name=covenant_pks_hex,json=covenantPksHex,proto3" json:"covenant_pks_hex,omitempty"`
+	// covenant_quorum is the minimum number of signatures needed from the
+	// covenant members
+	CovenantQuorum uint32 `protobuf:"varint,4,opt,name=covenant_quorum,json=covenantQuorum,proto3" json:"covenant_quorum,omitempty"`
+	// status is the status of the committee at the current BTC tip
+	Status CovenantCommitteeStatus `protobuf:"varint,5,opt,name=status,proto3,enum=babylon.btcstaking.v1.CovenantCommitteeStatus" json:"status,omitempty"`
+	// retiring_covenant_pks_hex is the list of hex strings of the BIP-340 PKs
+	// of the members of a retired committee that are not members of the active
+	// committee
+	RetiringCovenantPksHex []string `protobuf:"bytes,6,rep,name=retiring_covenant_pks_hex,json=retiringCovenantPksHex,proto3" json:"retiring_covenant_pks_hex,omitempty"`
+}
+
+func (m *CovenantCommitteeResponse) Reset()         { *m = CovenantCommitteeResponse{} }
+func (m *CovenantCommitteeResponse) String() string { return proto.CompactTextString(m) }
+func (*CovenantCommitteeResponse) ProtoMessage()    {}
+func (*CovenantCommitteeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{23}
 }
-func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+func (m *CovenantCommitteeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CovenantCommitteeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CovenantCommitteeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1191,77 +1341,85 @@ func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
+func (m *CovenantCommitteeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantCommitteeResponse.Merge(m, src)
 }
-func (m *BTCUndelegationResponse) XXX_Size() int {
+func (m *CovenantCommitteeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
+func (m *CovenantCommitteeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantCommitteeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
+var xxx_messageInfo_CovenantCommitteeResponse proto.InternalMessageInfo
 
-func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+func (m *CovenantCommitteeResponse) GetParamsVersion() uint32 {
 	if m != nil {
-		return m.UnbondingTxHex
+		return m.ParamsVersion
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+func (m *CovenantCommitteeResponse) GetBtcActivationHeight() uint32 {
 	if m != nil {
-		return m.CovenantUnbondingSigList
+		return m.BtcActivationHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+func (m *CovenantCommitteeResponse) GetCovenantPksHex() []string {
 	if m != nil {
-		return m.SlashingTxHex
+		return m.CovenantPksHex
 	}
-	return ""
+	return nil
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+func (m *CovenantCommitteeResponse) GetCovenantQuorum() uint32 {
 	if m != nil {
-		return m.DelegatorSlashingSigHex
+		return m.CovenantQuorum
 	}
-	return ""
+	return 0
 }
 
-func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+func (m *CovenantCommitteeResponse) GetStatus() CovenantCommitteeStatus {
 	if m != nil {
-		return m.CovenantSlashingSigs
+		return m.Status
 	}
-	return nil
+	return CovenantCommitteeStatus_COVENANT_COMMITTEE_STATUS_SCHEDULED
 }
 
-func (m *BTCUndelegationResponse) GetDelegatorUnbondingInfoResponse() *DelegatorUnbondingInfoResponse {
+func (m *CovenantCommitteeResponse) GetRetiringCovenantPksHex() []string {
 	if m != nil {
-		return m.DelegatorUnbondingInfoResponse
+		return m.RetiringCovenantPksHex
 	}
 	return nil
 }
 
-// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
-type BTCDelegatorDelegationsResponse struct {
-	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
+// QueryCovenantCommitteeDelegationsRequest is the request type for the
+// Query/CovenantCommitteeDelegations RPC method.
+type QueryCovenantCommitteeDelegationsRequest struct {
+	// params_version is the version of the parameters defining the committee
+	ParamsVersion uint32 `protobuf:"varint,1,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// status is the queried status for BTC delegations
+	Status BTCDelegationStatus `protobuf:"varint,2,opt,name=status,proto3,enum=babylon.btcstaking.v1.BTCDelegationStatus" json:"status,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
-func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
-func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
-func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{20}
+func (m *QueryCovenantCommitteeDelegationsRequest) Reset() {
+	*m = QueryCovenantCommitteeDelegationsRequest{}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCovenantCommitteeDelegationsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantCommitteeDelegationsRequest) ProtoMessage()    {}
+func (*QueryCovenantCommitteeDelegationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{24}
+}
+func (m *QueryCovenantCommitteeDelegationsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCovenantCommitteeDelegationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCovenantCommitteeDelegationsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1271,73 +1429,65 @@ func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+func (m *QueryCovenantCommitteeDelegationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeDelegationsRequest.Merge(m, src)
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+func (m *QueryCovenantCommitteeDelegationsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
+func (m *QueryCovenantCommitteeDelegationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeDelegationsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCovenantCommitteeDelegationsRequest proto.InternalMessageInfo
 
-func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+func (m *QueryCovenantCommitteeDelegationsRequest) GetParamsVersion() uint32 {
 	if m != nil {
-		return m.Dels
+		return m.ParamsVersion
 	}
-	return nil
+	return 0
 }
 
-// FinalityProviderResponse defines a finality provider with voting power information.
-type FinalityProviderResponse struct {
-	// description defines the description terms for the finality provider.
-	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
-	// commission defines the commission rate of the finality provider.
-	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
-	// addr is the address to receive commission from delegations.
-	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
-	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
-	// the PK follows encoding in BIP-340 spec
-	BtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"btc_pk,omitempty"`
-	// pop is the proof of possession of the BTC_PK by the fp addr.
-	// Essentially is the signature where the BTC SK sigs the fp addr.
-	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
-	// slashed_babylon_height indicates the Babylon height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
-	// slashed_btc_height indicates the BTC height when
-	// the finality provider is slashed.
-	// if it's 0 then the finality provider is not slashed
-	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
-	// height is the queried Babylon height
-	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
-	// jailed defines whether the finality provider is jailed
-	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
-	// highest_voted_height is the highest height for which the
-	// finality provider has voted
-	HighestVotedHeight uint32 `protobuf:"varint,10,opt,name=highest_voted_height,json=highestVotedHeight,proto3" json:"highest_voted_height,omitempty"`
-	// commission_info contains information details of the finality provider commission.
-	CommissionInfo *CommissionInfo `protobuf:"bytes,11,opt,name=commission_info,json=commissionInfo,proto3" json:"commission_info,omitempty"`
-	// soft_deleted defines if the fp is soft deleted or not. If it is soft
-	// deleted the FP can't cast votes.
-	SoftDeleted bool `protobuf:"varint,13,opt,name=soft_deleted,json=softDeleted,proto3" json:"soft_deleted,omitempty"`
+func (m *QueryCovenantCommitteeDelegationsRequest) GetStatus() BTCDelegationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return BTCDelegationStatus_PENDING
 }
 
-func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
-func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
-func (*FinalityProviderResponse) ProtoMessage()    {}
-func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{21}
-}
-func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+func (m *QueryCovenantCommitteeDelegationsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+
+// QueryCovenantCommitteeDelegationsResponse is the response type for the
+// Query/CovenantCommitteeDelegations RPC method.
+type QueryCovenantCommitteeDelegationsResponse struct {
+	// btc_delegations contains the queried BTC delegations bound to the
+	// committee under the given status
+	BtcDelegations []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=btc_delegations,json=btcDelegations,proto3" json:"btc_delegations,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryCovenantCommitteeDelegationsResponse) Reset() {
+	*m = QueryCovenantCommitteeDelegationsResponse{}
+}
+func (m *QueryCovenantCommitteeDelegationsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryCovenantCommitteeDelegationsResponse) ProtoMessage() {}
+func (*QueryCovenantCommitteeDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{25}
+}
+func (m *QueryCovenantCommitteeDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantCommitteeDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCovenantCommitteeDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1347,148 +1497,199 @@ func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+func (m *QueryCovenantCommitteeDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantCommitteeDelegationsResponse.Merge(m, src)
 }
-func (m *FinalityProviderResponse) XXX_Size() int {
+func (m *QueryCovenantCommitteeDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
+func (m *QueryCovenantCommitteeDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantCommitteeDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryCovenantCommitteeDelegationsResponse proto.InternalMessageInfo
 
-func (m *FinalityProviderResponse) GetDescription() *types.Description {
+func (m *QueryCovenantCommitteeDelegationsResponse) GetBtcDelegations() []*BTCDelegationResponse {
 	if m != nil {
-		return m.Description
+		return m.BtcDelegations
 	}
 	return nil
 }
 
-func (m *FinalityProviderResponse) GetAddr() string {
+func (m *QueryCovenantCommitteeDelegationsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.Addr
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
-	if m != nil {
-		return m.Pop
-	}
-	return nil
+// QueryCovenantStatsRequest is the request type for the
+// Query/CovenantStats RPC method.
+type QueryCovenantStatsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
-	if m != nil {
-		return m.SlashedBabylonHeight
+func (m *QueryCovenantStatsRequest) Reset()         { *m = QueryCovenantStatsRequest{} }
+func (m *QueryCovenantStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantStatsRequest) ProtoMessage()    {}
+func (*QueryCovenantStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{26}
+}
+func (m *QueryCovenantStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *QueryCovenantStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantStatsRequest.Merge(m, src)
+}
+func (m *QueryCovenantStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantStatsRequest.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint32 {
+var xxx_messageInfo_QueryCovenantStatsRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantStatsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.SlashedBtcHeight
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-func (m *FinalityProviderResponse) GetHeight() uint64 {
-	if m != nil {
-		return m.Height
+// CovenantStatsResponse is the signature liveness stats of a covenant member
+type CovenantStatsResponse struct {
+	// covenant_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the
+	// covenant member
+	CovenantBtcPkHex string `protobuf:"bytes,1,opt,name=covenant_btc_pk_hex,json=covenantBtcPkHex,proto3" json:"covenant_btc_pk_hex,omitempty"`
+	// num_signatures is the number of BTC delegations signed by the covenant
+	// member within the covenant signature timeout
+	NumSignatures uint64 `protobuf:"varint,2,opt,name=num_signatures,json=numSignatures,proto3" json:"num_signatures,omitempty"`
+	// total_latency_blocks is the sum of the Babylon blocks elapsed between the
+	// creation of the signed BTC delegations and their signature
+	TotalLatencyBlocks uint64 `protobuf:"varint,3,opt,name=total_latency_blocks,json=totalLatencyBlocks,proto3" json:"total_latency_blocks,omitempty"`
+	// max_latency_blocks is the largest number of Babylon blocks elapsed between
+	// the creation of a signed BTC delegation and its signature
+	MaxLatencyBlocks uint64 `protobuf:"varint,4,opt,name=max_latency_blocks,json=maxLatencyBlocks,proto3" json:"max_latency_blocks,omitempty"`
+	// num_missed is the number of BTC delegations that the covenant member did
+	// not sign within the covenant signature timeout and has not signed since
+	NumMissed uint64 `protobuf:"varint,5,opt,name=num_missed,json=numMissed,proto3" json:"num_missed,omitempty"`
+	// num_late is the number of BTC delegations that the covenant member signed
+	// within another covenant signature timeout after the covenant signature
+	// timeout, which are no longer counted as missed
+	NumLate uint64 `protobuf:"varint,6,opt,name=num_late,json=numLate,proto3" json:"num_late,omitempty"`
+}
+
+func (m *CovenantStatsResponse) Reset()         { *m = CovenantStatsResponse{} }
+func (m *CovenantStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*CovenantStatsResponse) ProtoMessage()    {}
+func (*CovenantStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{27}
+}
+func (m *CovenantStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *CovenantStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantStatsResponse.Merge(m, src)
+}
+func (m *CovenantStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantStatsResponse.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderResponse) GetJailed() bool {
+var xxx_messageInfo_CovenantStatsResponse proto.InternalMessageInfo
+
+func (m *CovenantStatsResponse) GetCovenantBtcPkHex() string {
 	if m != nil {
-		return m.Jailed
+		return m.CovenantBtcPkHex
 	}
-	return false
+	return ""
 }
 
-func (m *FinalityProviderResponse) GetHighestVotedHeight() uint32 {
+func (m *CovenantStatsResponse) GetNumSignatures() uint64 {
 	if m != nil {
-		return m.HighestVotedHeight
+		return m.NumSignatures
 	}
 	return 0
 }
 
-func (m *FinalityProviderResponse) GetCommissionInfo() *CommissionInfo {
+func (m *CovenantStatsResponse) GetTotalLatencyBlocks() uint64 {
 	if m != nil {
-		return m.CommissionInfo
+		return m.TotalLatencyBlocks
 	}
-	return nil
+	return 0
 }
 
-func (m *FinalityProviderResponse) GetSoftDeleted() bool {
+func (m *CovenantStatsResponse) GetMaxLatencyBlocks() uint64 {
 	if m != nil {
-		return m.SoftDeleted
+		return m.MaxLatencyBlocks
 	}
-	return false
+	return 0
 }
 
-// QueryLargestBtcReOrgRequest query request of the largest BTC reorg request
-type QueryLargestBtcReOrgRequest struct {
+func (m *CovenantStatsResponse) GetNumMissed() uint64 {
+	if m != nil {
+		return m.NumMissed
+	}
+	return 0
 }
 
-func (m *QueryLargestBtcReOrgRequest) Reset()         { *m = QueryLargestBtcReOrgRequest{} }
-func (m *QueryLargestBtcReOrgRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLargestBtcReOrgRequest) ProtoMessage()    {}
-func (*QueryLargestBtcReOrgRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{22}
-}
-func (m *QueryLargestBtcReOrgRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryLargestBtcReOrgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryLargestBtcReOrgRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *CovenantStatsResponse) GetNumLate() uint64 {
+	if m != nil {
+		return m.NumLate
 	}
-}
-func (m *QueryLargestBtcReOrgRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLargestBtcReOrgRequest.Merge(m, src)
-}
-func (m *QueryLargestBtcReOrgRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryLargestBtcReOrgRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLargestBtcReOrgRequest.DiscardUnknown(m)
+	return 0
 }
 
-var xxx_messageInfo_QueryLargestBtcReOrgRequest proto.InternalMessageInfo
-
-// QueryLargestBtcReOrgResponse stores the largest BTC reorg recorded
-type QueryLargestBtcReOrgResponse struct {
-	// BlockDiff is the difference of the block height of the BTC header Tip - the btc height
-	// which it was rolled back
-	BlockDiff uint32 `protobuf:"varint,1,opt,name=block_diff,json=blockDiff,proto3" json:"block_diff,omitempty"`
-	// RollbackFrom is the latest BTC block header prior to rollback
-	RollbackFrom *types1.BTCHeaderInfoResponse `protobuf:"bytes,2,opt,name=rollback_from,json=rollbackFrom,proto3" json:"rollback_from,omitempty"`
-	// RollbackTo is the BTC block header which we rollback to
-	RollbackTo *types1.BTCHeaderInfoResponse `protobuf:"bytes,3,opt,name=rollback_to,json=rollbackTo,proto3" json:"rollback_to,omitempty"`
+// QueryCovenantStatsResponse is the response type for the
+// Query/CovenantStats RPC method.
+type QueryCovenantStatsResponse struct {
+	// covenant_stats contains the stats of the covenant members that signed or
+	// missed at least one BTC delegation
+	CovenantStats []*CovenantStatsResponse `protobuf:"bytes,1,rep,name=covenant_stats,json=covenantStats,proto3" json:"covenant_stats,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLargestBtcReOrgResponse) Reset()         { *m = QueryLargestBtcReOrgResponse{} }
-func (m *QueryLargestBtcReOrgResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLargestBtcReOrgResponse) ProtoMessage()    {}
-func (*QueryLargestBtcReOrgResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{23}
+func (m *QueryCovenantStatsResponse) Reset()         { *m = QueryCovenantStatsResponse{} }
+func (m *QueryCovenantStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantStatsResponse) ProtoMessage()    {}
+func (*QueryCovenantStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{28}
 }
-func (m *QueryLargestBtcReOrgResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryCovenantStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLargestBtcReOrgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryCovenantStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLargestBtcReOrgResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryCovenantStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1498,58 +1699,51 @@ func (m *QueryLargestBtcReOrgResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryLargestBtcReOrgResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLargestBtcReOrgResponse.Merge(m, src)
+func (m *QueryCovenantStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantStatsResponse.Merge(m, src)
 }
-func (m *QueryLargestBtcReOrgResponse) XXX_Size() int {
+func (m *QueryCovenantStatsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLargestBtcReOrgResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLargestBtcReOrgResponse.DiscardUnknown(m)
+func (m *QueryCovenantStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLargestBtcReOrgResponse proto.InternalMessageInfo
-
-func (m *QueryLargestBtcReOrgResponse) GetBlockDiff() uint32 {
-	if m != nil {
-		return m.BlockDiff
-	}
-	return 0
-}
+var xxx_messageInfo_QueryCovenantStatsResponse proto.InternalMessageInfo
 
-func (m *QueryLargestBtcReOrgResponse) GetRollbackFrom() *types1.BTCHeaderInfoResponse {
+func (m *QueryCovenantStatsResponse) GetCovenantStats() []*CovenantStatsResponse {
 	if m != nil {
-		return m.RollbackFrom
+		return m.CovenantStats
 	}
 	return nil
 }
 
-func (m *QueryLargestBtcReOrgResponse) GetRollbackTo() *types1.BTCHeaderInfoResponse {
+func (m *QueryCovenantStatsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.RollbackTo
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryParamsVersionsRequest is the request type for the
-// Query/ParamsVersions RPC method.
-type QueryParamsVersionsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryBTCDelegationRequest is the request type to retrieve a BTC delegation by
+// staking tx hash
+type QueryBTCDelegationRequest struct {
+	// Hash of staking transaction in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
 }
 
-func (m *QueryParamsVersionsRequest) Reset()         { *m = QueryParamsVersionsRequest{} }
-func (m *QueryParamsVersionsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsVersionsRequest) ProtoMessage()    {}
-func (*QueryParamsVersionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{24}
+func (m *QueryBTCDelegationRequest) Reset()         { *m = QueryBTCDelegationRequest{} }
+func (m *QueryBTCDelegationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationRequest) ProtoMessage()    {}
+func (*QueryBTCDelegationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{29}
 }
-func (m *QueryParamsVersionsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryBTCDelegationRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBTCDelegationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsVersionsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBTCDelegationRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1559,38 +1753,44 @@ func (m *QueryParamsVersionsRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsVersionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsVersionsRequest.Merge(m, src)
+func (m *QueryBTCDelegationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationRequest.Merge(m, src)
 }
-func (m *QueryParamsVersionsRequest) XXX_Size() int {
+func (m *QueryBTCDelegationRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsVersionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsVersionsRequest.DiscardUnknown(m)
+func (m *QueryBTCDelegationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsVersionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryBTCDelegationRequest proto.InternalMessageInfo
 
-// QueryParamsVersionsResponse stores all the params with versions.
-type QueryParamsVersionsResponse struct {
-	// params holds all the params with version from this module.
-	Params []StoredParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryBTCDelegationRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
+	}
+	return ""
 }
 
-func (m *QueryParamsVersionsResponse) Reset()         { *m = QueryParamsVersionsResponse{} }
-func (m *QueryParamsVersionsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsVersionsResponse) ProtoMessage()    {}
-func (*QueryParamsVersionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74d49d26f7429697, []int{25}
+// QueryBTCDelegationResponse is response type matching QueryBTCDelegationRequest
+// and containing BTC delegation information
+type QueryBTCDelegationResponse struct {
+	// BTCDelegation represents the client needed information of an BTCDelegation.
+	BtcDelegation *BTCDelegationResponse `protobuf:"bytes,1,opt,name=btc_delegation,json=btcDelegation,proto3" json:"btc_delegation,omitempty"`
 }
-func (m *QueryParamsVersionsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryBTCDelegationResponse) Reset()         { *m = QueryBTCDelegationResponse{} }
+func (m *QueryBTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBTCDelegationResponse) ProtoMessage()    {}
+func (*QueryBTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{30}
+}
+func (m *QueryBTCDelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryBTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsVersionsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryBTCDelegationResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1600,1721 +1800,1999 @@ func (m *QueryParamsVersionsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsVersionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsVersionsResponse.Merge(m, src)
+func (m *QueryBTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBTCDelegationResponse.Merge(m, src)
 }
-func (m *QueryParamsVersionsResponse) XXX_Size() int {
+func (m *QueryBTCDelegationResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsVersionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsVersionsResponse.DiscardUnknown(m)
+func (m *QueryBTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBTCDelegationResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsVersionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryBTCDelegationResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
-	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
-	proto.RegisterType((*QueryParamsByBTCHeightRequest)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightRequest")
-	proto.RegisterType((*QueryParamsByBTCHeightResponse)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightResponse")
-	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
-	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
-	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
-	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
-	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
-	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
-	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
-	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
-	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
-	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
-	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
-	proto.RegisterType((*StakeExpansionResponse)(nil), "babylon.btcstaking.v1.StakeExpansionResponse")
-	proto.RegisterType((*DelegatorUnbondingInfoResponse)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfoResponse")
-	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
-	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
-	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
-	proto.RegisterType((*QueryLargestBtcReOrgRequest)(nil), "babylon.btcstaking.v1.QueryLargestBtcReOrgRequest")
-	proto.RegisterType((*QueryLargestBtcReOrgResponse)(nil), "babylon.btcstaking.v1.QueryLargestBtcReOrgResponse")
-	proto.RegisterType((*QueryParamsVersionsRequest)(nil), "babylon.btcstaking.v1.QueryParamsVersionsRequest")
-	proto.RegisterType((*QueryParamsVersionsResponse)(nil), "babylon.btcstaking.v1.QueryParamsVersionsResponse")
+func (m *QueryBTCDelegationResponse) GetBtcDelegation() *BTCDelegationResponse {
+	if m != nil {
+		return m.BtcDelegation
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
-
-var fileDescriptor_74d49d26f7429697 = []byte{
-	// 2164 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcf, 0x6f, 0xdb, 0xc8,
-	0xf5, 0x37, 0x6d, 0xc7, 0xb1, 0x9f, 0x6c, 0xd9, 0x99, 0x75, 0x12, 0xc6, 0x89, 0x7f, 0x84, 0xdf,
-	0xfc, 0x70, 0x7e, 0x58, 0xb4, 0x1d, 0x67, 0x83, 0x6f, 0x83, 0xa4, 0x8d, 0xec, 0x78, 0x93, 0xdd,
-	0xa4, 0x71, 0x28, 0x67, 0x0f, 0x6d, 0x51, 0x96, 0x22, 0x47, 0x14, 0x2b, 0x89, 0xc3, 0x70, 0x46,
-	0xae, 0x8c, 0xc0, 0x40, 0xd1, 0x43, 0xd1, 0x63, 0x81, 0xf6, 0x0f, 0xd8, 0x5b, 0x0b, 0xb4, 0x87,
-	0x05, 0x76, 0x2f, 0x3d, 0x14, 0x3d, 0xf4, 0x92, 0xde, 0x16, 0xe9, 0xa5, 0xd8, 0x43, 0x50, 0x24,
-	0x05, 0xb6, 0x97, 0x9e, 0x7a, 0xe9, 0xb1, 0xe0, 0x70, 0x28, 0x52, 0x32, 0x29, 0x5b, 0xae, 0x2f,
-	0x86, 0x67, 0xe6, 0xfd, 0x9e, 0xcf, 0x7b, 0xf3, 0xf8, 0x04, 0x17, 0xcb, 0x46, 0x79, 0xb7, 0x4e,
-	0x5c, 0xb5, 0xcc, 0x4c, 0xca, 0x8c, 0x9a, 0xe3, 0xda, 0xea, 0xce, 0x8a, 0xfa, 0xb2, 0x89, 0xfd,
-	0xdd, 0x82, 0xe7, 0x13, 0x46, 0xd0, 0x69, 0x41, 0x52, 0x88, 0x49, 0x0a, 0x3b, 0x2b, 0x33, 0xd3,
-	0x36, 0xb1, 0x09, 0xa7, 0x50, 0x83, 0xff, 0x42, 0xe2, 0x99, 0x0b, 0x36, 0x21, 0x76, 0x1d, 0xab,
-	0x86, 0xe7, 0xa8, 0x86, 0xeb, 0x12, 0x66, 0x30, 0x87, 0xb8, 0x54, 0x9c, 0x9e, 0x33, 0x09, 0x6d,
-	0x10, 0xaa, 0x87, 0x6c, 0xe1, 0x42, 0x1c, 0x5d, 0x0a, 0x57, 0x6a, 0x6c, 0x44, 0x19, 0x33, 0x63,
-	0x25, 0x5a, 0x0b, 0xaa, 0xeb, 0x82, 0xaa, 0x6c, 0x50, 0x1c, 0x1a, 0xd9, 0x26, 0xf4, 0x0c, 0xdb,
-	0x71, 0xb9, 0x36, 0x41, 0xab, 0xa4, 0xbb, 0xe6, 0x19, 0xbe, 0xd1, 0x88, 0xb4, 0x5e, 0x49, 0xa7,
-	0x49, 0x78, 0x1a, 0xd2, 0xcd, 0x67, 0xc8, 0x22, 0x9e, 0x20, 0xb8, 0x9c, 0x20, 0xa8, 0x3b, 0x76,
-	0x35, 0xf8, 0x8b, 0x5d, 0xd6, 0x15, 0x4b, 0x65, 0x1a, 0xd0, 0xf3, 0x60, 0xb9, 0xc5, 0x8d, 0xd0,
-	0xf0, 0xcb, 0x26, 0xa6, 0x4c, 0xd1, 0xe0, 0x83, 0x8e, 0x5d, 0xea, 0x11, 0x97, 0x62, 0x74, 0x17,
-	0x46, 0x42, 0x63, 0x65, 0x69, 0x41, 0x5a, 0xcc, 0xad, 0xce, 0x16, 0x52, 0x6f, 0xa2, 0x10, 0xb2,
-	0x15, 0x87, 0x5f, 0xbf, 0x9d, 0x1f, 0xd0, 0x04, 0x8b, 0x72, 0x07, 0xce, 0x27, 0x64, 0x16, 0x77,
-	0x3f, 0xc5, 0x3e, 0x75, 0x88, 0x2b, 0x54, 0x22, 0x19, 0x4e, 0xee, 0x84, 0x3b, 0x5c, 0xf8, 0x84,
-	0x16, 0x2d, 0x95, 0xef, 0xc3, 0x85, 0x74, 0xc6, 0xe3, 0xb0, 0xea, 0x3e, 0xcc, 0x76, 0x08, 0x2f,
-	0x6e, 0xaf, 0x3f, 0xc2, 0x41, 0xb8, 0x22, 0xbb, 0x66, 0x01, 0xca, 0xcc, 0xd4, 0xab, 0x7c, 0x53,
-	0x98, 0x36, 0x56, 0x66, 0x66, 0x48, 0xa5, 0xfc, 0x04, 0xe6, 0xb2, 0xf8, 0x8f, 0xc1, 0xbc, 0x64,
-	0x54, 0x06, 0x3b, 0xa3, 0x62, 0x0b, 0xc3, 0x37, 0x1d, 0xd7, 0xa8, 0x3b, 0x6c, 0x77, 0xcb, 0x27,
-	0x3b, 0x8e, 0x85, 0xfd, 0xe8, 0x0e, 0xd1, 0x26, 0x40, 0x8c, 0x40, 0xa1, 0xfb, 0x4a, 0x41, 0x40,
-	0x3c, 0x80, 0x6b, 0x21, 0xc4, 0x81, 0x80, 0x6b, 0x61, 0xcb, 0xb0, 0xb1, 0xe0, 0xd5, 0x12, 0x9c,
-	0xca, 0x5f, 0x24, 0xe1, 0x62, 0x8a, 0x26, 0xe1, 0xe2, 0x0f, 0x01, 0x55, 0xc4, 0x61, 0x90, 0x49,
-	0xe1, 0xa9, 0x2c, 0x2d, 0x0c, 0x2d, 0xe6, 0x56, 0xd5, 0x0c, 0x77, 0xbb, 0xa5, 0x45, 0xc2, 0xb4,
-	0x53, 0x95, 0x6e, 0x3d, 0xe8, 0xa3, 0x0e, 0x57, 0x06, 0xb9, 0x2b, 0x57, 0x0f, 0x74, 0x45, 0xc8,
-	0x4b, 0xfa, 0xf2, 0x40, 0x40, 0x69, 0xbf, 0xf2, 0x30, 0x66, 0x17, 0x61, 0xa2, 0xe2, 0xe9, 0xc1,
-	0x7d, 0x7b, 0x35, 0xbd, 0x8a, 0x5b, 0x3c, 0x6c, 0x63, 0x1a, 0x54, 0xbc, 0x22, 0x33, 0xb7, 0x6a,
-	0x8f, 0x70, 0x4b, 0xd9, 0xcb, 0x88, 0x7b, 0x3b, 0x18, 0x3f, 0x80, 0x53, 0xfb, 0x82, 0x21, 0xc2,
-	0xdf, 0x77, 0x2c, 0xa6, 0xba, 0x63, 0xa1, 0xfc, 0x56, 0x82, 0x19, 0xae, 0xbf, 0xb8, 0xbd, 0xbe,
-	0x81, 0xeb, 0xd8, 0x0e, 0xcb, 0x59, 0xe4, 0x40, 0x11, 0x46, 0x28, 0x33, 0x58, 0x33, 0x04, 0x5b,
-	0x7e, 0xf5, 0x7a, 0x86, 0xc6, 0x0e, 0xee, 0x12, 0xe7, 0xd0, 0x04, 0x67, 0x17, 0x70, 0x06, 0x8f,
-	0x0c, 0x9c, 0x3f, 0x4a, 0x22, 0xe3, 0xbb, 0x4d, 0x15, 0x81, 0x7a, 0x01, 0x93, 0x41, 0xa4, 0xad,
-	0xf8, 0x48, 0x40, 0xe6, 0xe6, 0x61, 0x8c, 0x6e, 0xc7, 0x28, 0x5f, 0x66, 0x66, 0x42, 0xfc, 0xf1,
-	0x81, 0xe5, 0xd7, 0x12, 0x5c, 0x4d, 0xbd, 0xea, 0x94, 0xb8, 0x1f, 0x0c, 0x9c, 0x63, 0x0b, 0xeb,
-	0x37, 0x12, 0x2c, 0x1e, 0x6c, 0x96, 0x88, 0xb1, 0x0f, 0xe7, 0x12, 0x31, 0x26, 0x7e, 0x4a, 0xb4,
-	0x3f, 0x3c, 0x30, 0xda, 0x24, 0x4d, 0xb4, 0x76, 0x36, 0x8e, 0x7b, 0x07, 0xc1, 0xf1, 0x5d, 0xc0,
-	0xc7, 0x70, 0x6e, 0x3f, 0x7e, 0xa2, 0x88, 0x2f, 0xc1, 0x07, 0xc2, 0x58, 0x9d, 0xb5, 0xf4, 0xaa,
-	0x41, 0xab, 0x89, 0xb8, 0x4f, 0x89, 0xa3, 0xed, 0xd6, 0x23, 0x83, 0x56, 0x83, 0xb4, 0x7d, 0x99,
-	0x96, 0x36, 0xed, 0x30, 0x95, 0x20, 0xdf, 0x09, 0x45, 0x91, 0xb0, 0xfd, 0x21, 0x71, 0xa2, 0x03,
-	0x89, 0xca, 0xeb, 0x93, 0x70, 0x3a, 0x5d, 0xdd, 0xff, 0x43, 0x2e, 0x10, 0x86, 0x7d, 0xdd, 0xb0,
-	0xac, 0xb0, 0x38, 0x8c, 0x15, 0xe5, 0x37, 0x5f, 0x2e, 0x4d, 0x8b, 0x28, 0x3d, 0xb0, 0x2c, 0x1f,
-	0x53, 0x5a, 0x62, 0xbe, 0xe3, 0xda, 0x1a, 0x84, 0xc4, 0xc1, 0x26, 0xd2, 0x60, 0x24, 0x44, 0x19,
-	0x0f, 0xec, 0x78, 0xf1, 0xee, 0xd7, 0x6f, 0xe7, 0xef, 0xd8, 0x0e, 0xab, 0x36, 0xcb, 0x05, 0x93,
-	0x34, 0x54, 0x61, 0x6f, 0xdd, 0x28, 0xd3, 0x25, 0x87, 0x44, 0x4b, 0x75, 0x67, 0x4d, 0x65, 0xbb,
-	0x1e, 0xa6, 0x85, 0xe2, 0xe3, 0xad, 0x5b, 0x6b, 0xcb, 0x5b, 0xcd, 0xf2, 0x27, 0x78, 0x57, 0x3b,
-	0x51, 0x0e, 0xc0, 0x89, 0x7e, 0x04, 0xf9, 0x18, 0xbc, 0x75, 0x87, 0x32, 0x79, 0x68, 0x61, 0xe8,
-	0x7f, 0x95, 0x9d, 0x13, 0xd0, 0x7f, 0xe2, 0xf0, 0xf4, 0x18, 0x6f, 0x5f, 0x96, 0xd3, 0xc0, 0xf2,
-	0x30, 0x7f, 0xcb, 0x72, 0xd1, 0x2d, 0x39, 0x0d, 0x2c, 0x48, 0x7c, 0x16, 0xbd, 0xb4, 0x27, 0xda,
-	0x24, 0x3e, 0x0b, 0x5f, 0xd4, 0xe0, 0x29, 0xc6, 0xae, 0x15, 0x11, 0x8c, 0x84, 0x4f, 0x31, 0x76,
-	0x2d, 0x71, 0x7c, 0x1e, 0xc6, 0x18, 0x61, 0x46, 0x5d, 0xa7, 0x06, 0x93, 0x4f, 0x2e, 0x48, 0x8b,
-	0xc3, 0xda, 0x28, 0xdf, 0x28, 0x19, 0x0c, 0x5d, 0x82, 0x7c, 0x12, 0x2e, 0xb8, 0x25, 0x8f, 0x72,
-	0xa4, 0x8c, 0xc7, 0x48, 0xc1, 0x2d, 0x74, 0x05, 0x26, 0x69, 0xdd, 0xa0, 0xd5, 0x04, 0xd9, 0x18,
-	0x27, 0x9b, 0x88, 0xb6, 0x43, 0xba, 0xdb, 0x70, 0x36, 0x4e, 0x29, 0x7e, 0xa4, 0x53, 0xc7, 0xe6,
-	0xf4, 0xc0, 0xe9, 0xa7, 0xdb, 0xc7, 0xa5, 0xe0, 0xb4, 0xe4, 0xd8, 0x01, 0xdb, 0x0b, 0x98, 0x30,
-	0xc9, 0x0e, 0x76, 0x0d, 0x97, 0x05, 0xf4, 0x54, 0xce, 0xf1, 0x0c, 0x5c, 0xce, 0x40, 0xd9, 0xba,
-	0xa0, 0x7d, 0x60, 0x19, 0x5e, 0x20, 0xc9, 0xb1, 0x5d, 0x83, 0x35, 0x7d, 0x4c, 0xb5, 0xf1, 0x48,
-	0x4c, 0xc9, 0xb1, 0x29, 0xba, 0x09, 0x28, 0xf2, 0x8d, 0x34, 0x99, 0xd7, 0x64, 0xba, 0x63, 0xb5,
-	0xe4, 0x71, 0x1e, 0x9f, 0x28, 0x13, 0x9e, 0xf1, 0x83, 0xc7, 0x56, 0x0b, 0x9d, 0x81, 0x11, 0xc3,
-	0x64, 0xce, 0x0e, 0x96, 0x27, 0x16, 0xa4, 0xc5, 0x51, 0x4d, 0xac, 0xd0, 0x3c, 0x07, 0x25, 0x6b,
-	0x52, 0xdd, 0xc2, 0xd4, 0x94, 0xf3, 0x61, 0x01, 0x0b, 0xb7, 0x36, 0x30, 0x35, 0xd1, 0x65, 0xc8,
-	0x37, 0xdd, 0x32, 0x71, 0xad, 0xf6, 0x35, 0x4e, 0x72, 0x15, 0x13, 0xed, 0x5d, 0x7e, 0x91, 0x26,
-	0x9c, 0x6e, 0xba, 0x71, 0x26, 0xe9, 0xbe, 0x40, 0xbd, 0x3c, 0xc5, 0x53, 0xaa, 0x90, 0x9d, 0x52,
-	0x2f, 0x12, 0x6c, 0xed, 0xa4, 0x9a, 0x6e, 0xa6, 0xec, 0x06, 0xb6, 0x84, 0x1d, 0x92, 0x1e, 0xb5,
-	0x47, 0xa7, 0x42, 0x5b, 0xc2, 0x5d, 0xd1, 0x22, 0xa2, 0x4d, 0x38, 0x49, 0x59, 0x4d, 0xc7, 0x2d,
-	0x4f, 0x46, 0x5c, 0xfb, 0x52, 0x86, 0xf6, 0x52, 0x90, 0x61, 0x0f, 0x5b, 0x9e, 0xe1, 0x26, 0x5b,
-	0xcb, 0xe0, 0x49, 0xac, 0x3d, 0x6c, 0x79, 0xca, 0xbf, 0x25, 0x38, 0x93, 0x4e, 0x82, 0xee, 0xc3,
-	0x05, 0xcf, 0xc7, 0x3b, 0x0e, 0x69, 0x52, 0x3d, 0xbb, 0x20, 0xc9, 0x11, 0x4d, 0xa9, 0xab, 0x30,
-	0xa1, 0x0f, 0x41, 0x26, 0xac, 0x8a, 0x7d, 0xbd, 0xd2, 0x14, 0x91, 0x6d, 0x05, 0xb7, 0xc8, 0x79,
-	0x07, 0x43, 0x2c, 0xf1, 0xf3, 0xcd, 0xf0, 0x78, 0xbb, 0xf5, 0xac, 0xc9, 0x02, 0x3e, 0x03, 0x66,
-	0x12, 0x7a, 0x6b, 0x7a, 0x27, 0xb0, 0x86, 0x38, 0xb0, 0x2e, 0x65, 0x79, 0x1b, 0x21, 0xe9, 0xb1,
-	0x5b, 0x21, 0xda, 0xd9, 0xd8, 0xb6, 0xda, 0x7a, 0x02, 0x57, 0xca, 0x53, 0x98, 0x6b, 0x17, 0xf8,
-	0x17, 0xd1, 0x1d, 0x73, 0x96, 0xc8, 0xf9, 0x1b, 0x80, 0xa8, 0x17, 0xe4, 0x24, 0xaf, 0x50, 0x51,
-	0xca, 0x84, 0x2e, 0x4f, 0xf2, 0x13, 0x1e, 0x35, 0x9e, 0x34, 0xca, 0x7f, 0x86, 0xe0, 0x6c, 0xc6,
-	0x2d, 0xa3, 0x45, 0x98, 0x4a, 0x60, 0x2b, 0x29, 0x26, 0xc6, 0x5c, 0x98, 0x7a, 0x26, 0x9c, 0x6f,
-	0xbb, 0x1a, 0xb3, 0x04, 0xd9, 0xc7, 0x2b, 0xd7, 0x60, 0x1f, 0x8e, 0xcb, 0x91, 0xa0, 0xb6, 0x73,
-	0x25, 0xc7, 0xe6, 0xf5, 0x2a, 0xa5, 0x0e, 0x0c, 0xa5, 0xd5, 0x81, 0xbb, 0x30, 0xd3, 0x55, 0x07,
-	0x22, 0x63, 0x02, 0x96, 0x61, 0xce, 0x72, 0xb6, 0xb3, 0x14, 0x84, 0x5a, 0x02, 0xe6, 0x0a, 0x9c,
-	0x89, 0x2f, 0x2d, 0xc1, 0x4b, 0xe5, 0x13, 0x47, 0x2c, 0x0b, 0xd3, 0xed, 0xb2, 0x10, 0x6b, 0xa2,
-	0xe8, 0xa7, 0x12, 0x5c, 0x8c, 0xad, 0x8c, 0x63, 0xe6, 0xb8, 0x15, 0x12, 0x67, 0xe7, 0x08, 0xcf,
-	0x8f, 0xdb, 0x19, 0x3a, 0x7b, 0xe3, 0x40, 0x9b, 0xb3, 0x7a, 0x9e, 0x2b, 0x26, 0xcc, 0x1f, 0xd0,
-	0x4e, 0xa0, 0xef, 0xc0, 0xb0, 0x85, 0xeb, 0x47, 0x6b, 0x01, 0x39, 0xa7, 0xf2, 0xf9, 0x09, 0x90,
-	0x33, 0xbb, 0xf2, 0x87, 0x90, 0x0b, 0xca, 0x9a, 0xef, 0x78, 0x89, 0xe7, 0xfd, 0xff, 0xa2, 0xae,
-	0x24, 0xd6, 0x10, 0xb6, 0x24, 0x1b, 0x31, 0xa9, 0x96, 0xe4, 0x43, 0x4f, 0x01, 0x4c, 0xd2, 0x68,
-	0x38, 0xb4, 0xfd, 0x49, 0x36, 0x56, 0x5c, 0xfa, 0xfa, 0xed, 0xfc, 0xf9, 0x50, 0x10, 0xb5, 0x6a,
-	0x05, 0x87, 0xa8, 0x0d, 0x83, 0x55, 0x0b, 0x4f, 0xb0, 0x6d, 0x98, 0xbb, 0x1b, 0xd8, 0x7c, 0xf3,
-	0xe5, 0x12, 0x08, 0x3d, 0x1b, 0xd8, 0xd4, 0x12, 0x02, 0xd0, 0x4d, 0x18, 0xe6, 0x1d, 0xc0, 0xd0,
-	0x01, 0x1d, 0x00, 0xa7, 0x4a, 0xbc, 0xfd, 0xc3, 0xc7, 0xf6, 0xf6, 0xdf, 0x83, 0x21, 0x8f, 0x78,
-	0xfc, 0xb5, 0xcd, 0xad, 0xde, 0xc8, 0xfa, 0x34, 0xf5, 0x09, 0xa9, 0x3c, 0xab, 0x6c, 0x11, 0x4a,
-	0x31, 0x37, 0xbc, 0xb8, 0xbd, 0xae, 0x05, 0x7c, 0x68, 0x0d, 0xce, 0x70, 0xe8, 0x62, 0x4b, 0x17,
-	0xac, 0xc9, 0xe7, 0x79, 0x58, 0x9b, 0x16, 0xa7, 0xc5, 0xf0, 0x50, 0xbc, 0xd4, 0xc1, 0x83, 0x15,
-	0x71, 0xc5, 0xdf, 0xd6, 0x27, 0xc5, 0x83, 0x25, 0x38, 0xa2, 0x4f, 0xec, 0xe0, 0xc1, 0x12, 0x14,
-	0xa3, 0x5c, 0xa6, 0x58, 0x05, 0xfb, 0x3f, 0x36, 0x9c, 0x3a, 0xb6, 0xf8, 0x1b, 0x3d, 0xaa, 0x89,
-	0x15, 0x5a, 0x86, 0xe9, 0xaa, 0x63, 0x57, 0x31, 0x65, 0xfa, 0x0e, 0x61, 0xb8, 0xdd, 0x30, 0x00,
-	0x97, 0x8f, 0xc4, 0xd9, 0xa7, 0xc1, 0x91, 0xd0, 0xf0, 0x5d, 0x98, 0x8c, 0x2f, 0x85, 0xe7, 0x85,
-	0x9c, 0xe3, 0x01, 0xb9, 0x9c, 0x99, 0x82, 0x11, 0x35, 0x87, 0x79, 0xde, 0xec, 0x58, 0xf3, 0x5e,
-	0x86, 0x54, 0x18, 0xef, 0x27, 0x19, 0xb6, 0xc4, 0x43, 0x9b, 0x0b, 0xf6, 0x36, 0xc2, 0xad, 0x8f,
-	0x87, 0x47, 0xc7, 0xa7, 0x26, 0x94, 0x59, 0xf1, 0x85, 0xf4, 0xc4, 0xf0, 0x6d, 0x4c, 0x59, 0x91,
-	0x99, 0x1a, 0x7e, 0xe6, 0xdb, 0xd1, 0x18, 0xe6, 0x1b, 0x49, 0x7c, 0xaf, 0xee, 0x3b, 0x17, 0xa8,
-	0x9e, 0x05, 0x28, 0xd7, 0x89, 0x59, 0xd3, 0x2d, 0xa7, 0x52, 0x69, 0x0f, 0x27, 0x82, 0x9d, 0x0d,
-	0xa7, 0x52, 0x09, 0xfa, 0x0d, 0x9f, 0xd4, 0xeb, 0x65, 0xc3, 0xac, 0xe9, 0x15, 0x9f, 0x34, 0x44,
-	0x33, 0xde, 0x51, 0x58, 0x12, 0xb3, 0x21, 0x91, 0x60, 0x8f, 0xb0, 0x61, 0x61, 0xbf, 0x23, 0xbf,
-	0xc7, 0x23, 0x31, 0x9b, 0x3e, 0x69, 0xa0, 0xe7, 0x90, 0x6b, 0x8b, 0x65, 0x84, 0x83, 0xf7, 0x28,
-	0x42, 0x21, 0x12, 0xb2, 0x4d, 0x14, 0x57, 0xb4, 0xe7, 0x5b, 0xc9, 0xe7, 0xfb, 0xb8, 0x47, 0x19,
-	0xdf, 0x1a, 0xfd, 0xc5, 0x67, 0xf3, 0x03, 0xff, 0xfc, 0x6c, 0x7e, 0x40, 0xf9, 0x42, 0xea, 0x98,
-	0x46, 0xc5, 0x0a, 0x45, 0x60, 0x1f, 0x24, 0x86, 0x36, 0x43, 0xbc, 0x52, 0x64, 0xf5, 0x0d, 0xc4,
-	0xc7, 0x56, 0xea, 0xe8, 0xe6, 0xb8, 0x3e, 0x83, 0x62, 0xab, 0x57, 0xff, 0x9c, 0x87, 0x13, 0xdc,
-	0x6a, 0xf4, 0x73, 0x09, 0x46, 0x42, 0xad, 0xe8, 0x5a, 0x86, 0x69, 0xfb, 0xc7, 0x7a, 0x33, 0xd7,
-	0x0f, 0x43, 0x2a, 0x4a, 0xf6, 0xe5, 0x9f, 0xfd, 0xf5, 0x1f, 0xbf, 0x1a, 0x9c, 0x47, 0xb3, 0x6a,
-	0xaf, 0xa9, 0x25, 0xfa, 0x8d, 0x04, 0xf9, 0xce, 0x18, 0xa2, 0x95, 0x83, 0xb5, 0x74, 0x5d, 0xf0,
-	0xcc, 0x6a, 0x3f, 0x2c, 0xc2, 0xc0, 0x02, 0x37, 0x70, 0x11, 0x5d, 0xe9, 0x69, 0x60, 0xd4, 0x1f,
-	0x52, 0xf4, 0x3b, 0x09, 0x26, 0xbb, 0x46, 0x88, 0xe8, 0x10, 0x7a, 0xbb, 0x07, 0x95, 0x33, 0xb7,
-	0xfa, 0xe2, 0x11, 0xc6, 0xaa, 0xdc, 0xd8, 0x6b, 0xe8, 0x6a, 0x4f, 0x63, 0xd5, 0x57, 0xc2, 0xda,
-	0x3d, 0xf4, 0x27, 0x09, 0x4e, 0xed, 0x9b, 0x29, 0xa2, 0xb5, 0xc3, 0xe8, 0xee, 0x1e, 0x61, 0xce,
-	0xdc, 0xee, 0x93, 0x4b, 0xd8, 0x7c, 0x8f, 0xdb, 0x7c, 0x07, 0xdd, 0xee, 0x6d, 0x73, 0x5c, 0xc1,
-	0xd5, 0x57, 0xf1, 0xff, 0x7b, 0xe8, 0x0b, 0x09, 0x4e, 0xed, 0x1b, 0x19, 0xf6, 0xf6, 0x20, 0x6b,
-	0x96, 0xd9, 0xdb, 0x83, 0xcc, 0xb9, 0xa4, 0xb2, 0xc2, 0x3d, 0xb8, 0x81, 0xae, 0x65, 0x78, 0xb0,
-	0x7f, 0x68, 0x89, 0xde, 0x48, 0x30, 0xd5, 0x2d, 0x10, 0xdd, 0xea, 0x47, 0x7d, 0x64, 0xf3, 0x5a,
-	0x7f, 0x4c, 0xc2, 0xe4, 0x12, 0x37, 0xf9, 0x29, 0xfa, 0xe4, 0xd0, 0x26, 0xab, 0xaf, 0x3a, 0x26,
-	0x4f, 0x7b, 0xfb, 0x49, 0xd0, 0xe7, 0x12, 0xe4, 0x3b, 0x87, 0x70, 0xbd, 0x93, 0x34, 0x75, 0xb6,
-	0xd8, 0x3b, 0x49, 0xd3, 0x67, 0x7c, 0xca, 0x1d, 0xee, 0xce, 0x0a, 0x52, 0xd5, 0xcc, 0xdf, 0x35,
-	0x92, 0x23, 0x29, 0xf5, 0x55, 0xf8, 0xbd, 0xb9, 0x87, 0xfe, 0x25, 0xc1, 0xf9, 0x1e, 0x03, 0x2e,
-	0x74, 0xbf, 0x9f, 0xe8, 0xa6, 0x38, 0xf3, 0xed, 0x23, 0xf3, 0x0b, 0xcf, 0x9e, 0x72, 0xcf, 0x3e,
-	0x42, 0x0f, 0x8f, 0x7e, 0x51, 0x09, 0xc7, 0xd1, 0x1f, 0x24, 0x98, 0xe8, 0x88, 0x21, 0x5a, 0x3e,
-	0x74, 0xb8, 0x23, 0x9f, 0x56, 0xfa, 0xe0, 0x10, 0x5e, 0xac, 0x73, 0x2f, 0xee, 0xa1, 0xbb, 0x87,
-	0xba, 0x1f, 0x7e, 0x3d, 0xdd, 0x5f, 0xb8, 0x7b, 0xe8, 0xf7, 0x12, 0x4c, 0x76, 0x75, 0x28, 0xbd,
-	0x2b, 0x6b, 0x7a, 0xbb, 0xd3, 0xbb, 0xb2, 0x66, 0xb4, 0x40, 0xca, 0x32, 0xf7, 0xe0, 0x3a, 0x5a,
-	0xcc, 0xf0, 0xa0, 0x1e, 0xf2, 0xf1, 0xc0, 0xfb, 0x98, 0xf8, 0x76, 0xf1, 0xf9, 0xeb, 0x77, 0x73,
-	0xd2, 0x57, 0xef, 0xe6, 0xa4, 0xbf, 0xbf, 0x9b, 0x93, 0x7e, 0xf9, 0x7e, 0x6e, 0xe0, 0xab, 0xf7,
-	0x73, 0x03, 0x7f, 0x7b, 0x3f, 0x37, 0xf0, 0xbd, 0xc3, 0x35, 0xd3, 0xad, 0xa4, 0x06, 0xde, 0x59,
-	0x97, 0x47, 0xf8, 0x8f, 0x69, 0xb7, 0xfe, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x72, 0xc0, 0xd2, 0xab,
-	0xbd, 0x1c, 0x00, 0x00,
+// BTCDelegationResponse is the client needed information from a BTCDelegation with the current status based on parameters.
+type BTCDelegationResponse struct {
+	// staker_addr is the address to receive rewards from BTC delegation.
+	StakerAddr string `protobuf:"bytes,1,opt,name=staker_addr,json=stakerAddr,proto3" json:"staker_addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this BTC delegation
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,2,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// fp_btc_pk_list is the list of BIP-340 PKs of the finality providers that
+	// this BTC delegation delegates to
+	FpBtcPkList []github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,3,rep,name=fp_btc_pk_list,json=fpBtcPkList,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_btc_pk_list,omitempty"`
+	// staking_time is the number of blocks for which the delegation is locked on BTC chain
+	StakingTime uint32 `protobuf:"varint,4,opt,name=staking_time,json=stakingTime,proto3" json:"staking_time,omitempty"`
+	// start_height is the start BTC height of the BTC delegation
+	// it is the start BTC height of the timelock
+	StartHeight uint32 `protobuf:"varint,5,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height is the end height of the BTC delegation
+	// it is the end BTC height of the timelock - w
+	EndHeight uint32 `protobuf:"varint,6,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// total_sat is the total amount of BTC stakes in this delegation
+	// quantified in satoshi
+	TotalSat uint64 `protobuf:"varint,7,opt,name=total_sat,json=totalSat,proto3" json:"total_sat,omitempty"`
+	// staking_tx_hex is the hex string of staking tx
+	StakingTxHex string `protobuf:"bytes,8,opt,name=staking_tx_hex,json=stakingTxHex,proto3" json:"staking_tx_hex,omitempty"`
+	// slashing_tx_hex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,9,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slash_sig_hex is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk) as string hex.
+	// It will be a part of the witness for the staking tx output.
+	DelegatorSlashSigHex string `protobuf:"bytes,10,opt,name=delegator_slash_sig_hex,json=delegatorSlashSigHex,proto3" json:"delegator_slash_sig_hex,omitempty"`
+	// covenant_sigs is a list of adaptor signatures on the slashing tx
+	// by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSigs []*CovenantAdaptorSignatures `protobuf:"bytes,11,rep,name=covenant_sigs,json=covenantSigs,proto3" json:"covenant_sigs,omitempty"`
+	// staking_output_idx is the index of the staking output in the staking tx
+	StakingOutputIdx uint32 `protobuf:"varint,12,opt,name=staking_output_idx,json=stakingOutputIdx,proto3" json:"staking_output_idx,omitempty"`
+	// whether this delegation is active
+	Active bool `protobuf:"varint,13,opt,name=active,proto3" json:"active,omitempty"`
+	// descriptive status of current delegation.
+	StatusDesc string `protobuf:"bytes,14,opt,name=status_desc,json=statusDesc,proto3" json:"status_desc,omitempty"`
+	// unbonding_time used in unbonding output timelock path and in slashing transactions
+	// change outputs
+	UnbondingTime uint32 `protobuf:"varint,15,opt,name=unbonding_time,json=unbondingTime,proto3" json:"unbonding_time,omitempty"`
+	// undelegation_response is the undelegation info of this delegation.
+	UndelegationResponse *BTCUndelegationResponse `protobuf:"bytes,16,opt,name=undelegation_response,json=undelegationResponse,proto3" json:"undelegation_response,omitempty"`
+	// params version used to validate delegation
+	ParamsVersion uint32 `protobuf:"varint,17,opt,name=params_version,json=paramsVersion,proto3" json:"params_version,omitempty"`
+	// stk_exp contains the stake expansion information, if nil it is NOT a stake expansion.
+	StkExp *StakeExpansionResponse `protobuf:"bytes,18,opt,name=stk_exp,json=stkExp,proto3" json:"stk_exp,omitempty"`
+	// fp_eots_pk is the EOTS PK of the finality provider that the staking,
+	// slashing and unbonding scripts commit to. It is only set if the finality
+	// provider rotated its EOTS key before the creation of the BTC delegation,
+	// otherwise the scripts commit to the BTC PK of the finality provider
+	FpEotsPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,19,opt,name=fp_eots_pk,json=fpEotsPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_eots_pk,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *BTCDelegationResponse) Reset()         { *m = BTCDelegationResponse{} }
+func (m *BTCDelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegationResponse) ProtoMessage()    {}
+func (*BTCDelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{31}
+}
+func (m *BTCDelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BTCDelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegationResponse.Merge(m, src)
+}
+func (m *BTCDelegationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegationResponse.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_BTCDelegationResponse proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ParamsVersions queries all the parameters of the module with version.
-	ParamsVersions(ctx context.Context, in *QueryParamsVersionsRequest, opts ...grpc.CallOption) (*QueryParamsVersionsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version
-	// of past params.
-	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
-	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
-	ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
-	// LargestBtcReOrg retrieves the largest BTC reorg
-	LargestBtcReOrg(ctx context.Context, in *QueryLargestBtcReOrgRequest, opts ...grpc.CallOption) (*QueryLargestBtcReOrgResponse, error)
+func (m *BTCDelegationResponse) GetStakerAddr() string {
+	if m != nil {
+		return m.StakerAddr
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *BTCDelegationResponse) GetStakingTime() uint32 {
+	if m != nil {
+		return m.StakingTime
+	}
+	return 0
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *BTCDelegationResponse) GetStartHeight() uint32 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetEndHeight() uint32 {
+	if m != nil {
+		return m.EndHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ParamsVersions(ctx context.Context, in *QueryParamsVersionsRequest, opts ...grpc.CallOption) (*QueryParamsVersionsResponse, error) {
-	out := new(QueryParamsVersionsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsVersions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetTotalSat() uint64 {
+	if m != nil {
+		return m.TotalSat
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
-	out := new(QueryParamsByVersionResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStakingTxHex() string {
+	if m != nil {
+		return m.StakingTxHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error) {
-	out := new(QueryParamsByBTCHeightResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByBTCHeight", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
-	out := new(QueryFinalityProvidersResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetDelegatorSlashSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashSigHex
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
-	out := new(QueryFinalityProviderResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetCovenantSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSigs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
-	out := new(QueryBTCDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStakingOutputIdx() uint32 {
+	if m != nil {
+		return m.StakingOutputIdx
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
-	out := new(QueryFinalityProviderDelegationsResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
-	out := new(QueryBTCDelegationResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetStatusDesc() string {
+	if m != nil {
+		return m.StatusDesc
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) LargestBtcReOrg(ctx context.Context, in *QueryLargestBtcReOrgRequest, opts ...grpc.CallOption) (*QueryLargestBtcReOrgResponse, error) {
-	out := new(QueryLargestBtcReOrgResponse)
-	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/LargestBtcReOrg", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *BTCDelegationResponse) GetUnbondingTime() uint32 {
+	if m != nil {
+		return m.UnbondingTime
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ParamsVersions queries all the parameters of the module with version.
-	ParamsVersions(context.Context, *QueryParamsVersionsRequest) (*QueryParamsVersionsResponse, error)
-	// ParamsByVersion queries the parameters of the module for a specific version
-	// of past params.
-	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
-	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
-	ParamsByBTCHeight(context.Context, *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error)
-	// FinalityProviders queries all finality providers
-	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
-	// FinalityProvider info about one finality provider
-	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
-	// BTCDelegations queries all BTC delegations under a given status
-	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
-	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
-	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
-	// BTCDelegation retrieves delegation by corresponding staking tx hash
-	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
-	// LargestBtcReOrg retrieves the largest BTC reorg
-	LargestBtcReOrg(context.Context, *QueryLargestBtcReOrgRequest) (*QueryLargestBtcReOrgResponse, error)
+func (m *BTCDelegationResponse) GetUndelegationResponse() *BTCUndelegationResponse {
+	if m != nil {
+		return m.UndelegationResponse
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *BTCDelegationResponse) GetParamsVersion() uint32 {
+	if m != nil {
+		return m.ParamsVersion
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
-}
-func (*UnimplementedQueryServer) ParamsVersions(ctx context.Context, req *QueryParamsVersionsRequest) (*QueryParamsVersionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsVersions not implemented")
-}
-func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+func (m *BTCDelegationResponse) GetStkExp() *StakeExpansionResponse {
+	if m != nil {
+		return m.StkExp
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ParamsByBTCHeight(ctx context.Context, req *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ParamsByBTCHeight not implemented")
+
+// StakeExpansionResponse stores information necessary to construct the expanded BTC staking
+// transaction created from a previous BTC staking.
+type StakeExpansionResponse struct {
+	// previous_staking_tx_hash is the hex hash of the staking tx that was used as
+	// input to the stake expansion.
+	PreviousStakingTxHashHex string `protobuf:"bytes,1,opt,name=previous_staking_tx_hash_hex,json=previousStakingTxHashHex,proto3" json:"previous_staking_tx_hash_hex,omitempty"`
+	// other_funding_tx_out is the other funding output (TxOut) which was used
+	// as input to construct the BTC delegation. The stake expansion has a set of
+	// 2 inputs, the first input is the previous staking transaction and the
+	// second input (this one) is to pay for fees and optionally to add more
+	// stake to the BTC delegation.
+	OtherFundingTxOutHex string `protobuf:"bytes,2,opt,name=other_funding_tx_out_hex,json=otherFundingTxOutHex,proto3" json:"other_funding_tx_out_hex,omitempty"`
+	// previous_stk_covenant_sigs is a list of signatures on the stake expansion
+	// transaction (i.e., the transaction spending the previous staking transaction
+	// {previous_staking_tx_hash}) by each covenant member.
+	// It must be provided to allow the previous staking tx to be spent as
+	// an transaction input of another BTC staking transaction.
+	PreviousStkCovenantSigs []*SignatureInfo `protobuf:"bytes,3,rep,name=previous_stk_covenant_sigs,json=previousStkCovenantSigs,proto3" json:"previous_stk_covenant_sigs,omitempty"`
 }
-func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+
+func (m *StakeExpansionResponse) Reset()         { *m = StakeExpansionResponse{} }
+func (m *StakeExpansionResponse) String() string { return proto.CompactTextString(m) }
+func (*StakeExpansionResponse) ProtoMessage()    {}
+func (*StakeExpansionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{32}
 }
-func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+func (m *StakeExpansionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+func (m *StakeExpansionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakeExpansionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+func (m *StakeExpansionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakeExpansionResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+func (m *StakeExpansionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) LargestBtcReOrg(ctx context.Context, req *QueryLargestBtcReOrgRequest) (*QueryLargestBtcReOrgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LargestBtcReOrg not implemented")
+func (m *StakeExpansionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakeExpansionResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_StakeExpansionResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *StakeExpansionResponse) GetPreviousStakingTxHashHex() string {
+	if m != nil {
+		return m.PreviousStakingTxHashHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsVersionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsVersions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsVersions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsVersions(ctx, req.(*QueryParamsVersionsRequest))
+func (m *StakeExpansionResponse) GetOtherFundingTxOutHex() string {
+	if m != nil {
+		return m.OtherFundingTxOutHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByVersionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByVersion(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+func (m *StakeExpansionResponse) GetPreviousStkCovenantSigs() []*SignatureInfo {
+	if m != nil {
+		return m.PreviousStkCovenantSigs
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ParamsByBTCHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsByBTCHeightRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ParamsByBTCHeight(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByBTCHeight",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ParamsByBTCHeight(ctx, req.(*QueryParamsByBTCHeightRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// DelegatorUnbondingInfoResponse provides all necessary info about transaction
+// which spent the staking output
+type DelegatorUnbondingInfoResponse struct {
+	// spend_stake_tx_hex is the transaction which spent the staking output. It is
+	// filled only if the spend_stake_tx_hex is different than the unbonding_tx_hex
+	SpendStakeTxHex string `protobuf:"bytes,1,opt,name=spend_stake_tx_hex,json=spendStakeTxHex,proto3" json:"spend_stake_tx_hex,omitempty"`
 }
 
-func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProvidersRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviders(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
+func (m *DelegatorUnbondingInfoResponse) Reset()         { *m = DelegatorUnbondingInfoResponse{} }
+func (m *DelegatorUnbondingInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*DelegatorUnbondingInfoResponse) ProtoMessage()    {}
+func (*DelegatorUnbondingInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{33}
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegatorUnbondingInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegatorUnbondingInfoResponse.Merge(m, src)
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegatorUnbondingInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegatorUnbondingInfoResponse.DiscardUnknown(m)
 }
 
-func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProvider(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
+var xxx_messageInfo_DelegatorUnbondingInfoResponse proto.InternalMessageInfo
+
+func (m *DelegatorUnbondingInfoResponse) GetSpendStakeTxHex() string {
+	if m != nil {
+		return m.SpendStakeTxHex
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// BTCUndelegationResponse provides all necessary info about the undeleagation
+type BTCUndelegationResponse struct {
+	// unbonding_tx is the transaction which will transfer the funds from staking
+	// output to unbonding output. Unbonding output will usually have lower timelock
+	// than staking output. The unbonding tx as string hex.
+	UnbondingTxHex string `protobuf:"bytes,1,opt,name=unbonding_tx_hex,json=unbondingTxHex,proto3" json:"unbonding_tx_hex,omitempty"`
+	// covenant_unbonding_sig_list is the list of signatures on the unbonding tx
+	// by covenant members
+	CovenantUnbondingSigList []*SignatureInfo `protobuf:"bytes,2,rep,name=covenant_unbonding_sig_list,json=covenantUnbondingSigList,proto3" json:"covenant_unbonding_sig_list,omitempty"`
+	// slashingTxHex is the hex string of slashing tx
+	SlashingTxHex string `protobuf:"bytes,3,opt,name=slashing_tx_hex,json=slashingTxHex,proto3" json:"slashing_tx_hex,omitempty"`
+	// delegator_slashing_sig is the signature on the slashing tx
+	// by the delegator (i.e., SK corresponding to btc_pk).
+	// It will be a part of the witness for the unbonding tx output.
+	// The delegator slashing sig as string hex.
+	DelegatorSlashingSigHex string `protobuf:"bytes,4,opt,name=delegator_slashing_sig_hex,json=delegatorSlashingSigHex,proto3" json:"delegator_slashing_sig_hex,omitempty"`
+	// covenant_slashing_sigs is a list of adaptor signatures on the
+	// unbonding slashing tx by each covenant member
+	// It will be a part of the witness for the staking tx output.
+	CovenantSlashingSigs []*CovenantAdaptorSignatures `protobuf:"bytes,5,rep,name=covenant_slashing_sigs,json=covenantSlashingSigs,proto3" json:"covenant_slashing_sigs,omitempty"`
+	// btc_undelegation_info contains all necessary info about the transaction
+	// which spent the staking output
+	DelegatorUnbondingInfoResponse *DelegatorUnbondingInfoResponse `protobuf:"bytes,6,opt,name=delegator_unbonding_info_response,json=delegatorUnbondingInfoResponse,proto3" json:"delegator_unbonding_info_response,omitempty"`
+	// covenant_unbonding_agg_sig_hex is the hex str of the MuSig2 aggregated
+	// signature of the covenant committee on the unbonding tx, set only if the
+	// unbonding path commits to the aggregated covenant key
+	CovenantUnbondingAggSigHex string `protobuf:"bytes,7,opt,name=covenant_unbonding_agg_sig_hex,json=covenantUnbondingAggSigHex,proto3" json:"covenant_unbonding_agg_sig_hex,omitempty"`
 }
 
-func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFinalityProviderDelegationsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BTCUndelegationResponse) Reset()         { *m = BTCUndelegationResponse{} }
+func (m *BTCUndelegationResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCUndelegationResponse) ProtoMessage()    {}
+func (*BTCUndelegationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{34}
 }
-
-func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBTCDelegationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BTCDelegation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *BTCUndelegationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_LargestBtcReOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLargestBtcReOrgRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LargestBtcReOrg(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/babylon.btcstaking.v1.Query/LargestBtcReOrg",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LargestBtcReOrg(ctx, req.(*QueryLargestBtcReOrgRequest))
+func (m *BTCUndelegationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCUndelegationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "babylon.btcstaking.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ParamsVersions",
-			Handler:    _Query_ParamsVersions_Handler,
-		},
-		{
-			MethodName: "ParamsByVersion",
-			Handler:    _Query_ParamsByVersion_Handler,
-		},
-		{
-			MethodName: "ParamsByBTCHeight",
-			Handler:    _Query_ParamsByBTCHeight_Handler,
-		},
-		{
-			MethodName: "FinalityProviders",
-			Handler:    _Query_FinalityProviders_Handler,
-		},
-		{
-			MethodName: "FinalityProvider",
-			Handler:    _Query_FinalityProvider_Handler,
-		},
-		{
-			MethodName: "BTCDelegations",
-			Handler:    _Query_BTCDelegations_Handler,
-		},
-		{
-			MethodName: "FinalityProviderDelegations",
-			Handler:    _Query_FinalityProviderDelegations_Handler,
-		},
-		{
-			MethodName: "BTCDelegation",
-			Handler:    _Query_BTCDelegation_Handler,
-		},
-		{
-			MethodName: "LargestBtcReOrg",
-			Handler:    _Query_LargestBtcReOrg_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "babylon/btcstaking/v1/query.proto",
+func (m *BTCUndelegationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCUndelegationResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BTCUndelegationResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCUndelegationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCUndelegationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_BTCUndelegationResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCUndelegationResponse) GetUnbondingTxHex() string {
+	if m != nil {
+		return m.UnbondingTxHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCUndelegationResponse) GetCovenantUnbondingSigList() []*SignatureInfo {
+	if m != nil {
+		return m.CovenantUnbondingSigList
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *BTCUndelegationResponse) GetSlashingTxHex() string {
+	if m != nil {
+		return m.SlashingTxHex
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCUndelegationResponse) GetDelegatorSlashingSigHex() string {
+	if m != nil {
+		return m.DelegatorSlashingSigHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BTCUndelegationResponse) GetCovenantSlashingSigs() []*CovenantAdaptorSignatures {
+	if m != nil {
+		return m.CovenantSlashingSigs
+	}
+	return nil
 }
 
-func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x8
+func (m *BTCUndelegationResponse) GetDelegatorUnbondingInfoResponse() *DelegatorUnbondingInfoResponse {
+	if m != nil {
+		return m.DelegatorUnbondingInfoResponse
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BTCUndelegationResponse) GetCovenantUnbondingAggSigHex() string {
+	if m != nil {
+		return m.CovenantUnbondingAggSigHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BTCDelegatorDelegationsResponse is a collection of BTC delegations responses from the same delegator.
+type BTCDelegatorDelegationsResponse struct {
+	Dels []*BTCDelegationResponse `protobuf:"bytes,1,rep,name=dels,proto3" json:"dels,omitempty"`
 }
 
-func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *BTCDelegatorDelegationsResponse) Reset()         { *m = BTCDelegatorDelegationsResponse{} }
+func (m *BTCDelegatorDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*BTCDelegatorDelegationsResponse) ProtoMessage()    {}
+func (*BTCDelegatorDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{35}
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BTCDelegatorDelegationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.Merge(m, src)
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BTCDelegatorDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BTCDelegatorDelegationsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByBTCHeightRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BTCDelegatorDelegationsResponse proto.InternalMessageInfo
+
+func (m *BTCDelegatorDelegationsResponse) GetDels() []*BTCDelegationResponse {
+	if m != nil {
+		return m.Dels
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsByBTCHeightRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FinalityProviderResponse defines a finality provider with voting power information.
+type FinalityProviderResponse struct {
+	// description defines the description terms for the finality provider.
+	Description *types.Description `protobuf:"bytes,1,opt,name=description,proto3" json:"description,omitempty"`
+	// commission defines the commission rate of the finality provider.
+	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+	// addr is the address to receive commission from delegations.
+	Addr string `protobuf:"bytes,3,opt,name=addr,proto3" json:"addr,omitempty"`
+	// btc_pk is the Bitcoin secp256k1 PK of this finality provider
+	// the PK follows encoding in BIP-340 spec
+	BtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,4,opt,name=btc_pk,json=btcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"btc_pk,omitempty"`
+	// pop is the proof of possession of the BTC_PK by the fp addr.
+	// Essentially is the signature where the BTC SK sigs the fp addr.
+	Pop *ProofOfPossessionBTC `protobuf:"bytes,5,opt,name=pop,proto3" json:"pop,omitempty"`
+	// slashed_babylon_height indicates the Babylon height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBabylonHeight uint64 `protobuf:"varint,6,opt,name=slashed_babylon_height,json=slashedBabylonHeight,proto3" json:"slashed_babylon_height,omitempty"`
+	// slashed_btc_height indicates the BTC height when
+	// the finality provider is slashed.
+	// if it's 0 then the finality provider is not slashed
+	SlashedBtcHeight uint32 `protobuf:"varint,7,opt,name=slashed_btc_height,json=slashedBtcHeight,proto3" json:"slashed_btc_height,omitempty"`
+	// height is the queried Babylon height
+	Height uint64 `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	// jailed defines whether the finality provider is jailed
+	Jailed bool `protobuf:"varint,9,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// highest_voted_height is the highest height for which the
+	// finality provider has voted
+	HighestVotedHeight uint32 `protobuf:"varint,10,opt,name=highest_voted_height,json=highestVotedHeight,proto3" json:"highest_voted_height,omitempty"`
+	// commission_info contains information details of the finality provider commission.
+	CommissionInfo *CommissionInfo `protobuf:"bytes,11,opt,name=commission_info,json=commissionInfo,proto3" json:"commission_info,omitempty"`
+	// soft_deleted defines if the fp is soft deleted or not. If it is soft
+	// deleted the FP can't cast votes.
+	SoftDeleted bool `protobuf:"varint,13,opt,name=soft_deleted,json=softDeleted,proto3" json:"soft_deleted,omitempty"`
+	// key_rotations is the list of rotations of the EOTS key of the finality
+	// provider, in increasing order of activation height
+	KeyRotations []*FinalityProviderKeyRotation `protobuf:"bytes,14,rep,name=key_rotations,json=keyRotations,proto3" json:"key_rotations,omitempty"`
+	// max_delegated_sats is the maximum amount of satoshis the finality
+	// provider accepts to be delegated to it, 0 means no cap
+	MaxDelegatedSats uint64 `protobuf:"varint,15,opt,name=max_delegated_sats,json=maxDelegatedSats,proto3" json:"max_delegated_sats,omitempty"`
+	// delegated_sats is the amount of satoshis of the BTC delegations to the
+	// finality provider that are included on BTC and neither unbonded nor
+	// expired
+	DelegatedSats uint64 `protobuf:"varint,16,opt,name=delegated_sats,json=delegatedSats,proto3" json:"delegated_sats,omitempty"`
 }
 
-func (m *QueryParamsByBTCHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *FinalityProviderResponse) Reset()         { *m = FinalityProviderResponse{} }
+func (m *FinalityProviderResponse) String() string { return proto.CompactTextString(m) }
+func (*FinalityProviderResponse) ProtoMessage()    {}
+func (*FinalityProviderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{36}
+}
+func (m *FinalityProviderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProviderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProviderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FinalityProviderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProviderResponse.Merge(m, src)
+}
+func (m *FinalityProviderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProviderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProviderResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsByBTCHeightResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityProviderResponse proto.InternalMessageInfo
+
+func (m *FinalityProviderResponse) GetDescription() *types.Description {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsByBTCHeightResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetAddr() string {
+	if m != nil {
+		return m.Addr
+	}
+	return ""
 }
 
-func (m *QueryParamsByBTCHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Version != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *FinalityProviderResponse) GetPop() *ProofOfPossessionBTC {
+	if m != nil {
+		return m.Pop
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSlashedBabylonHeight() uint64 {
+	if m != nil {
+		return m.SlashedBabylonHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetSlashedBtcHeight() uint32 {
+	if m != nil {
+		return m.SlashedBtcHeight
+	}
+	return 0
 }
 
-func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *FinalityProviderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetHighestVotedHeight() uint32 {
+	if m != nil {
+		return m.HighestVotedHeight
+	}
+	return 0
 }
 
-func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *FinalityProviderResponse) GetCommissionInfo() *CommissionInfo {
+	if m != nil {
+		return m.CommissionInfo
 	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetSoftDeleted() bool {
+	if m != nil {
+		return m.SoftDeleted
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProviderResponse) GetKeyRotations() []*FinalityProviderKeyRotation {
+	if m != nil {
+		return m.KeyRotations
+	}
+	return nil
 }
 
-func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *FinalityProviderResponse) GetMaxDelegatedSats() uint64 {
+	if m != nil {
+		return m.MaxDelegatedSats
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProviderResponse) GetDelegatedSats() uint64 {
+	if m != nil {
+		return m.DelegatedSats
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLargestBtcReOrgRequest query request of the largest BTC reorg request
+type QueryLargestBtcReOrgRequest struct {
 }
 
-func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		{
-			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLargestBtcReOrgRequest) Reset()         { *m = QueryLargestBtcReOrgRequest{} }
+func (m *QueryLargestBtcReOrgRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLargestBtcReOrgRequest) ProtoMessage()    {}
+func (*QueryLargestBtcReOrgRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{37}
+}
+func (m *QueryLargestBtcReOrgRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLargestBtcReOrgRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLargestBtcReOrgRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryLargestBtcReOrgRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLargestBtcReOrgRequest.Merge(m, src)
+}
+func (m *QueryLargestBtcReOrgRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLargestBtcReOrgRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLargestBtcReOrgRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLargestBtcReOrgRequest proto.InternalMessageInfo
+
+// QueryLargestBtcReOrgResponse stores the largest BTC reorg recorded
+type QueryLargestBtcReOrgResponse struct {
+	// BlockDiff is the difference of the block height of the BTC header Tip - the btc height
+	// which it was rolled back
+	BlockDiff uint32 `protobuf:"varint,1,opt,name=block_diff,json=blockDiff,proto3" json:"block_diff,omitempty"`
+	// RollbackFrom is the latest BTC block header prior to rollback
+	RollbackFrom *types1.BTCHeaderInfoResponse `protobuf:"bytes,2,opt,name=rollback_from,json=rollbackFrom,proto3" json:"rollback_from,omitempty"`
+	// RollbackTo is the BTC block header which we rollback to
+	RollbackTo *types1.BTCHeaderInfoResponse `protobuf:"bytes,3,opt,name=rollback_to,json=rollbackTo,proto3" json:"rollback_to,omitempty"`
 }
 
-func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLargestBtcReOrgResponse) Reset()         { *m = QueryLargestBtcReOrgResponse{} }
+func (m *QueryLargestBtcReOrgResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLargestBtcReOrgResponse) ProtoMessage()    {}
+func (*QueryLargestBtcReOrgResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{38}
+}
+func (m *QueryLargestBtcReOrgResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLargestBtcReOrgResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLargestBtcReOrgResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryLargestBtcReOrgResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLargestBtcReOrgResponse.Merge(m, src)
 }
-
-func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLargestBtcReOrgResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLargestBtcReOrgResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLargestBtcReOrgResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_QueryLargestBtcReOrgResponse proto.InternalMessageInfo
+
+func (m *QueryLargestBtcReOrgResponse) GetBlockDiff() uint32 {
+	if m != nil {
+		return m.BlockDiff
 	}
-	if len(m.BtcDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return 0
+}
+
+func (m *QueryLargestBtcReOrgResponse) GetRollbackFrom() *types1.BTCHeaderInfoResponse {
+	if m != nil {
+		return m.RollbackFrom
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLargestBtcReOrgResponse) GetRollbackTo() *types1.BTCHeaderInfoResponse {
+	if m != nil {
+		return m.RollbackTo
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsVersionsRequest is the request type for the
+// Query/ParamsVersions RPC method.
+type QueryParamsVersionsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FpBtcPkHex) > 0 {
-		i -= len(m.FpBtcPkHex)
-		copy(dAtA[i:], m.FpBtcPkHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryParamsVersionsRequest) Reset()         { *m = QueryParamsVersionsRequest{} }
+func (m *QueryParamsVersionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsVersionsRequest) ProtoMessage()    {}
+func (*QueryParamsVersionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{39}
 }
-
-func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsVersionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsVersionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsVersionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsVersionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsVersionsRequest.Merge(m, src)
+}
+func (m *QueryParamsVersionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsVersionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsVersionsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsVersionsRequest proto.InternalMessageInfo
+
+// QueryParamsVersionsResponse stores all the params with versions.
+type QueryParamsVersionsResponse struct {
+	// params holds all the params with version from this module.
+	Params []StoredParams `protobuf:"bytes,1,rep,name=params,proto3" json:"params"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsVersionsResponse) Reset()         { *m = QueryParamsVersionsResponse{} }
+func (m *QueryParamsVersionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsVersionsResponse) ProtoMessage()    {}
+func (*QueryParamsVersionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{40}
+}
+func (m *QueryParamsVersionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsVersionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsVersionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryParamsVersionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsVersionsResponse.Merge(m, src)
+}
+func (m *QueryParamsVersionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsVersionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsVersionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsVersionsResponse proto.InternalMessageInfo
+
+// QueryCovenantMuSig2SessionRequest is the request type for the
+// Query/CovenantMuSig2Session RPC method.
+type QueryCovenantMuSig2SessionRequest struct {
+	// Hash of staking transaction in btc format
+	StakingTxHashHex string `protobuf:"bytes,1,opt,name=staking_tx_hash_hex,json=stakingTxHashHex,proto3" json:"staking_tx_hash_hex,omitempty"`
+}
+
+func (m *QueryCovenantMuSig2SessionRequest) Reset()         { *m = QueryCovenantMuSig2SessionRequest{} }
+func (m *QueryCovenantMuSig2SessionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantMuSig2SessionRequest) ProtoMessage()    {}
+func (*QueryCovenantMuSig2SessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{41}
+}
+func (m *QueryCovenantMuSig2SessionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantMuSig2SessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantMuSig2SessionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantMuSig2SessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantMuSig2SessionRequest.Merge(m, src)
+}
+func (m *QueryCovenantMuSig2SessionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantMuSig2SessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantMuSig2SessionRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCovenantMuSig2SessionRequest proto.InternalMessageInfo
+
+func (m *QueryCovenantMuSig2SessionRequest) GetStakingTxHashHex() string {
+	if m != nil {
+		return m.StakingTxHashHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CovenantMuSig2SessionEntry is the contribution of a single covenant member
+// to the MuSig2 signing session
+type CovenantMuSig2SessionEntry struct {
+	// covenant_btc_pk_hex is the hex str of Bitcoin secp256k1 PK of the
+	// covenant member
+	CovenantBtcPkHex string `protobuf:"bytes,1,opt,name=covenant_btc_pk_hex,json=covenantBtcPkHex,proto3" json:"covenant_btc_pk_hex,omitempty"`
+	// pub_nonce_hex is the hex str of the public nonce of the covenant member
+	PubNonceHex string `protobuf:"bytes,2,opt,name=pub_nonce_hex,json=pubNonceHex,proto3" json:"pub_nonce_hex,omitempty"`
+	// partial_sig_hex is the hex str of the partial signature of the covenant
+	// member, empty if not submitted yet
+	PartialSigHex string `protobuf:"bytes,3,opt,name=partial_sig_hex,json=partialSigHex,proto3" json:"partial_sig_hex,omitempty"`
 }
 
-func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingTxHashHex) > 0 {
-		i -= len(m.StakingTxHashHex)
-		copy(dAtA[i:], m.StakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
-		i--
-		dAtA[i] = 0xa
+func (m *CovenantMuSig2SessionEntry) Reset()         { *m = CovenantMuSig2SessionEntry{} }
+func (m *CovenantMuSig2SessionEntry) String() string { return proto.CompactTextString(m) }
+func (*CovenantMuSig2SessionEntry) ProtoMessage()    {}
+func (*CovenantMuSig2SessionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{42}
+}
+func (m *CovenantMuSig2SessionEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CovenantMuSig2SessionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CovenantMuSig2SessionEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CovenantMuSig2SessionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CovenantMuSig2SessionEntry.Merge(m, src)
+}
+func (m *CovenantMuSig2SessionEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *CovenantMuSig2SessionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CovenantMuSig2SessionEntry.DiscardUnknown(m)
 }
 
-func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CovenantMuSig2SessionEntry proto.InternalMessageInfo
+
+func (m *CovenantMuSig2SessionEntry) GetCovenantBtcPkHex() string {
+	if m != nil {
+		return m.CovenantBtcPkHex
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CovenantMuSig2SessionEntry) GetPubNonceHex() string {
+	if m != nil {
+		return m.PubNonceHex
+	}
+	return ""
 }
 
-func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BtcDelegation != nil {
-		{
-			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *CovenantMuSig2SessionEntry) GetPartialSigHex() string {
+	if m != nil {
+		return m.PartialSigHex
+	}
+	return ""
+}
+
+// QueryCovenantMuSig2SessionResponse is the response type for the
+// Query/CovenantMuSig2Session RPC method.
+type QueryCovenantMuSig2SessionResponse struct {
+	// entries are the nonces and partial signatures submitted so far, in the
+	// order of covenant keys in the parameters
+	Entries []*CovenantMuSig2SessionEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// aggregated_nonce_hex is the hex str of the aggregated nonce, empty until
+	// all covenant members committed to their nonces
+	AggregatedNonceHex string `protobuf:"bytes,2,opt,name=aggregated_nonce_hex,json=aggregatedNonceHex,proto3" json:"aggregated_nonce_hex,omitempty"`
+	// aggregated_sig_hex is the hex str of the aggregated BIP-340 signature of
+	// the covenant committee on the unbonding tx, empty until all partial
+	// signatures are received
+	AggregatedSigHex string `protobuf:"bytes,3,opt,name=aggregated_sig_hex,json=aggregatedSigHex,proto3" json:"aggregated_sig_hex,omitempty"`
+}
+
+func (m *QueryCovenantMuSig2SessionResponse) Reset()         { *m = QueryCovenantMuSig2SessionResponse{} }
+func (m *QueryCovenantMuSig2SessionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCovenantMuSig2SessionResponse) ProtoMessage()    {}
+func (*QueryCovenantMuSig2SessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74d49d26f7429697, []int{43}
+}
+func (m *QueryCovenantMuSig2SessionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCovenantMuSig2SessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCovenantMuSig2SessionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCovenantMuSig2SessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCovenantMuSig2SessionResponse.Merge(m, src)
+}
+func (m *QueryCovenantMuSig2SessionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCovenantMuSig2SessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCovenantMuSig2SessionResponse.DiscardUnknown(m)
 }
 
-func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCovenantMuSig2SessionResponse proto.InternalMessageInfo
+
+func (m *QueryCovenantMuSig2SessionResponse) GetEntries() []*CovenantMuSig2SessionEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCovenantMuSig2SessionResponse) GetAggregatedNonceHex() string {
+	if m != nil {
+		return m.AggregatedNonceHex
+	}
+	return ""
 }
 
-func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.StkExp != nil {
-		{
-			size, err := m.StkExp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x92
+func (m *QueryCovenantMuSig2SessionResponse) GetAggregatedSigHex() string {
+	if m != nil {
+		return m.AggregatedSigHex
 	}
-	if m.ParamsVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.UndelegationResponse != nil {
-		{
-			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("babylon.btcstaking.v1.CovenantCommitteeStatus", CovenantCommitteeStatus_name, CovenantCommitteeStatus_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.btcstaking.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.btcstaking.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryParamsByVersionRequest)(nil), "babylon.btcstaking.v1.QueryParamsByVersionRequest")
+	proto.RegisterType((*QueryParamsByVersionResponse)(nil), "babylon.btcstaking.v1.QueryParamsByVersionResponse")
+	proto.RegisterType((*QueryParamsByBTCHeightRequest)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightRequest")
+	proto.RegisterType((*QueryParamsByBTCHeightResponse)(nil), "babylon.btcstaking.v1.QueryParamsByBTCHeightResponse")
+	proto.RegisterType((*QueryFinalityProvidersRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersRequest")
+	proto.RegisterType((*QueryFinalityProvidersResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProvidersResponse")
+	proto.RegisterType((*QueryFinalityProviderRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderRequest")
+	proto.RegisterType((*QueryFinalityProviderResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderResponse")
+	proto.RegisterType((*QueryBTCDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsRequest")
+	proto.RegisterType((*QueryBTCDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationsResponse")
+	proto.RegisterType((*QueryFinalityProviderDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsRequest")
+	proto.RegisterType((*QueryFinalityProviderDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryFinalityProviderDelegationsResponse")
+	proto.RegisterType((*QueryDelegationsByStakerRequest)(nil), "babylon.btcstaking.v1.QueryDelegationsByStakerRequest")
+	proto.RegisterType((*QueryDelegationsByStakerResponse)(nil), "babylon.btcstaking.v1.QueryDelegationsByStakerResponse")
+	proto.RegisterType((*QueryExpiringDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryExpiringDelegationsRequest")
+	proto.RegisterType((*QueryExpiringDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryExpiringDelegationsResponse")
+	proto.RegisterType((*QueryUnbondingDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryUnbondingDelegationsRequest")
+	proto.RegisterType((*QueryUnbondingDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryUnbondingDelegationsResponse")
+	proto.RegisterType((*BTCDelegationAtHeightResponse)(nil), "babylon.btcstaking.v1.BTCDelegationAtHeightResponse")
+	proto.RegisterType((*QueryCovenantCommitteesRequest)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteesRequest")
+	proto.RegisterType((*QueryCovenantCommitteesResponse)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteesResponse")
+	proto.RegisterType((*CovenantCommitteeResponse)(nil), "babylon.btcstaking.v1.CovenantCommitteeResponse")
+	proto.RegisterType((*QueryCovenantCommitteeDelegationsRequest)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeDelegationsRequest")
+	proto.RegisterType((*QueryCovenantCommitteeDelegationsResponse)(nil), "babylon.btcstaking.v1.QueryCovenantCommitteeDelegationsResponse")
+	proto.RegisterType((*QueryCovenantStatsRequest)(nil), "babylon.btcstaking.v1.QueryCovenantStatsRequest")
+	proto.RegisterType((*CovenantStatsResponse)(nil), "babylon.btcstaking.v1.CovenantStatsResponse")
+	proto.RegisterType((*QueryCovenantStatsResponse)(nil), "babylon.btcstaking.v1.QueryCovenantStatsResponse")
+	proto.RegisterType((*QueryBTCDelegationRequest)(nil), "babylon.btcstaking.v1.QueryBTCDelegationRequest")
+	proto.RegisterType((*QueryBTCDelegationResponse)(nil), "babylon.btcstaking.v1.QueryBTCDelegationResponse")
+	proto.RegisterType((*BTCDelegationResponse)(nil), "babylon.btcstaking.v1.BTCDelegationResponse")
+	proto.RegisterType((*StakeExpansionResponse)(nil), "babylon.btcstaking.v1.StakeExpansionResponse")
+	proto.RegisterType((*DelegatorUnbondingInfoResponse)(nil), "babylon.btcstaking.v1.DelegatorUnbondingInfoResponse")
+	proto.RegisterType((*BTCUndelegationResponse)(nil), "babylon.btcstaking.v1.BTCUndelegationResponse")
+	proto.RegisterType((*BTCDelegatorDelegationsResponse)(nil), "babylon.btcstaking.v1.BTCDelegatorDelegationsResponse")
+	proto.RegisterType((*FinalityProviderResponse)(nil), "babylon.btcstaking.v1.FinalityProviderResponse")
+	proto.RegisterType((*QueryLargestBtcReOrgRequest)(nil), "babylon.btcstaking.v1.QueryLargestBtcReOrgRequest")
+	proto.RegisterType((*QueryLargestBtcReOrgResponse)(nil), "babylon.btcstaking.v1.QueryLargestBtcReOrgResponse")
+	proto.RegisterType((*QueryParamsVersionsRequest)(nil), "babylon.btcstaking.v1.QueryParamsVersionsRequest")
+	proto.RegisterType((*QueryParamsVersionsResponse)(nil), "babylon.btcstaking.v1.QueryParamsVersionsResponse")
+	proto.RegisterType((*QueryCovenantMuSig2SessionRequest)(nil), "babylon.btcstaking.v1.QueryCovenantMuSig2SessionRequest")
+	proto.RegisterType((*CovenantMuSig2SessionEntry)(nil), "babylon.btcstaking.v1.CovenantMuSig2SessionEntry")
+	proto.RegisterType((*QueryCovenantMuSig2SessionResponse)(nil), "babylon.btcstaking.v1.QueryCovenantMuSig2SessionResponse")
+}
+
+func init() { proto.RegisterFile("babylon/btcstaking/v1/query.proto", fileDescriptor_74d49d26f7429697) }
+
+var fileDescriptor_74d49d26f7429697 = []byte{
+	// 3149 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xdf, 0x6f, 0x1b, 0xc7,
+	0xf1, 0xf7, 0x49, 0xb4, 0x2c, 0x8d, 0x44, 0x4a, 0x5e, 0xcb, 0x36, 0x45, 0x5b, 0x92, 0xcd, 0x58,
+	0xb6, 0xfc, 0x43, 0xa4, 0x25, 0xcb, 0x76, 0xf2, 0x35, 0xe2, 0x44, 0x94, 0xa8, 0x58, 0x89, 0x65,
+	0xc9, 0x47, 0xc9, 0x5f, 0xb4, 0x69, 0x7b, 0x3d, 0x1e, 0x97, 0xc7, 0x2b, 0xc9, 0xbb, 0xf3, 0xdd,
+	0x51, 0xa5, 0x60, 0x18, 0x28, 0xfa, 0xd0, 0xf6, 0xad, 0x05, 0x52, 0xa0, 0xaf, 0x01, 0xf2, 0xd0,
+	0x02, 0xc9, 0x43, 0x81, 0x04, 0x28, 0xfa, 0x0b, 0x05, 0xfa, 0x94, 0x36, 0x6d, 0x91, 0xa6, 0x28,
+	0x10, 0x04, 0xa8, 0x51, 0x24, 0x05, 0xd2, 0x3e, 0xf4, 0xa5, 0xfd, 0x07, 0x8a, 0xdd, 0xdb, 0xfb,
+	0x45, 0xde, 0x51, 0xa4, 0xac, 0x00, 0xc9, 0x8b, 0xc0, 0xdb, 0x9d, 0x99, 0x9d, 0xcf, 0xec, 0xcc,
+	0xec, 0xec, 0x0f, 0xc1, 0xd9, 0xa2, 0x58, 0xdc, 0xad, 0x69, 0x6a, 0xb6, 0x68, 0x49, 0xa6, 0x25,
+	0x56, 0x15, 0x55, 0xce, 0xee, 0xcc, 0x67, 0x1f, 0x36, 0xb0, 0xb1, 0x9b, 0xd1, 0x0d, 0xcd, 0xd2,
+	0xd0, 0x71, 0x46, 0x92, 0xf1, 0x48, 0x32, 0x3b, 0xf3, 0xa9, 0x71, 0x59, 0x93, 0x35, 0x4a, 0x91,
+	0x25, 0xbf, 0x6c, 0xe2, 0xd4, 0x69, 0x59, 0xd3, 0xe4, 0x1a, 0xce, 0x8a, 0xba, 0x92, 0x15, 0x55,
+	0x55, 0xb3, 0x44, 0x4b, 0xd1, 0x54, 0x93, 0xf5, 0x4e, 0x48, 0x9a, 0x59, 0xd7, 0x4c, 0xc1, 0x66,
+	0xb3, 0x3f, 0x58, 0xd7, 0x39, 0xfb, 0x2b, 0xeb, 0x29, 0x51, 0xc4, 0x96, 0x38, 0xef, 0x7c, 0x33,
+	0xaa, 0x4b, 0x8c, 0xaa, 0x28, 0x9a, 0xd8, 0x56, 0xd2, 0x25, 0xd4, 0x45, 0x59, 0x51, 0xe9, 0x68,
+	0x8c, 0x36, 0x1d, 0x0e, 0x4d, 0x17, 0x0d, 0xb1, 0xee, 0x8c, 0x7a, 0x3e, 0x9c, 0xc6, 0xfb, 0x62,
+	0x74, 0xd3, 0x11, 0xb2, 0x34, 0x9d, 0x11, 0xcc, 0xf8, 0x08, 0x6a, 0x8a, 0x5c, 0x21, 0x7f, 0xb1,
+	0x6a, 0xb5, 0xd8, 0x32, 0x3d, 0x0e, 0xe8, 0x3e, 0xf9, 0xdc, 0xa4, 0x4a, 0xf0, 0xf8, 0x61, 0x03,
+	0x9b, 0x56, 0x9a, 0x87, 0x63, 0x81, 0x56, 0x53, 0xd7, 0x54, 0x13, 0xa3, 0x5b, 0x30, 0x60, 0x2b,
+	0x9b, 0xe4, 0xce, 0x70, 0xb3, 0xc3, 0x0b, 0x93, 0x99, 0xd0, 0x99, 0xc8, 0xd8, 0x6c, 0xb9, 0xd8,
+	0xbb, 0x4f, 0xa6, 0x0f, 0xf1, 0x8c, 0x25, 0x7d, 0x13, 0x4e, 0xf9, 0x64, 0xe6, 0x76, 0x1f, 0x60,
+	0xc3, 0x54, 0x34, 0x95, 0x0d, 0x89, 0x92, 0x70, 0x64, 0xc7, 0x6e, 0xa1, 0xc2, 0xe3, 0xbc, 0xf3,
+	0x99, 0x7e, 0x15, 0x4e, 0x87, 0x33, 0x1e, 0x84, 0x56, 0xb7, 0x61, 0x32, 0x20, 0x3c, 0xb7, 0xb5,
+	0x7c, 0x07, 0x13, 0x73, 0x39, 0x7a, 0x4d, 0x02, 0x14, 0x2d, 0x49, 0xa8, 0xd0, 0x46, 0xa6, 0xda,
+	0x50, 0xd1, 0x92, 0x6c, 0xaa, 0xf4, 0x37, 0x61, 0x2a, 0x8a, 0xff, 0x00, 0xd4, 0xf3, 0x5b, 0xa5,
+	0x2f, 0x68, 0x15, 0x99, 0x29, 0xbe, 0xaa, 0xa8, 0x62, 0x4d, 0xb1, 0x76, 0x37, 0x0d, 0x6d, 0x47,
+	0x29, 0x61, 0xc3, 0x99, 0x43, 0xb4, 0x0a, 0xe0, 0x79, 0x20, 0x1b, 0xfb, 0x7c, 0x86, 0xb9, 0x38,
+	0x71, 0xd7, 0x8c, 0xed, 0x07, 0xcc, 0x5d, 0x33, 0x9b, 0xa2, 0x8c, 0x19, 0x2f, 0xef, 0xe3, 0x4c,
+	0xff, 0x8e, 0x83, 0xa9, 0xa8, 0x91, 0x18, 0xc4, 0xaf, 0x01, 0x2a, 0xb3, 0x4e, 0x41, 0x77, 0x7a,
+	0x93, 0xdc, 0x99, 0xfe, 0xd9, 0xe1, 0x85, 0x6c, 0x04, 0xdc, 0x56, 0x69, 0x8e, 0x30, 0xfe, 0x68,
+	0xb9, 0x75, 0x1c, 0xf4, 0x52, 0x00, 0x4a, 0x1f, 0x85, 0x72, 0x61, 0x4f, 0x28, 0x4c, 0x9e, 0x1f,
+	0xcb, 0x12, 0x73, 0xa5, 0xf6, 0xc1, 0x6d, 0x9b, 0x9d, 0x85, 0x78, 0x59, 0x17, 0xc8, 0x7c, 0xeb,
+	0x55, 0xa1, 0x82, 0x9b, 0xd4, 0x6c, 0x43, 0x3c, 0x94, 0xf5, 0x9c, 0x25, 0x6d, 0x56, 0xef, 0xe0,
+	0x66, 0xfa, 0x71, 0x84, 0xdd, 0x5d, 0x63, 0x7c, 0x05, 0x8e, 0xb6, 0x19, 0x83, 0x99, 0xbf, 0x67,
+	0x5b, 0x8c, 0xb5, 0xda, 0x22, 0xfd, 0x13, 0x0e, 0x52, 0x74, 0xfc, 0xdc, 0xd6, 0xf2, 0x0a, 0xae,
+	0x61, 0xd9, 0x4e, 0x67, 0x0e, 0x80, 0x1c, 0x0c, 0x98, 0x96, 0x68, 0x35, 0x6c, 0x67, 0x4b, 0x2c,
+	0x5c, 0x8a, 0x18, 0x31, 0xc0, 0x5d, 0xa0, 0x1c, 0x3c, 0xe3, 0x44, 0xab, 0x21, 0xd6, 0xde, 0x8f,
+	0xe3, 0xfc, 0x9a, 0x63, 0x11, 0xdf, 0xaa, 0x2a, 0x33, 0xd4, 0x36, 0x8c, 0x12, 0x4b, 0x97, 0xbc,
+	0x2e, 0xe6, 0x32, 0x57, 0xba, 0x51, 0xda, 0xb5, 0x51, 0xa2, 0x68, 0x49, 0x3e, 0xf1, 0x07, 0xe7,
+	0x2c, 0x3f, 0xe4, 0xe0, 0x42, 0xe8, 0x54, 0x87, 0xd8, 0x7d, 0x6f, 0xc7, 0x39, 0x30, 0xb3, 0x7e,
+	0xca, 0xc1, 0xec, 0xde, 0x6a, 0x31, 0x1b, 0x1b, 0x30, 0xe1, 0xb3, 0xb1, 0x66, 0x84, 0x58, 0xfb,
+	0xc6, 0x9e, 0xd6, 0xd6, 0xc2, 0x44, 0xf3, 0x27, 0x3d, 0xbb, 0x6b, 0xc6, 0x67, 0x32, 0x01, 0x1f,
+	0x72, 0x30, 0x4d, 0x91, 0xfa, 0xa4, 0xe7, 0x76, 0x0b, 0x96, 0x58, 0xf5, 0x22, 0xf6, 0x22, 0x1c,
+	0x35, 0x69, 0x43, 0xbb, 0xf1, 0x13, 0x76, 0x87, 0x3b, 0x01, 0x5e, 0x6c, 0xf4, 0x1d, 0x50, 0x6c,
+	0xf4, 0xef, 0x7b, 0x12, 0x7f, 0xcb, 0xc1, 0x99, 0x68, 0x68, 0x5f, 0x90, 0x00, 0x79, 0xd3, 0x99,
+	0x9f, 0x7c, 0x53, 0x57, 0x0c, 0x45, 0x95, 0x43, 0x03, 0x63, 0xc4, 0xb4, 0x44, 0xc3, 0x0a, 0x2e,
+	0xa0, 0xc3, 0xb4, 0xcd, 0x5e, 0x28, 0xc9, 0x0a, 0x8b, 0xd5, 0x92, 0x43, 0x60, 0x2f, 0x73, 0x43,
+	0x58, 0x2d, 0xb1, 0xee, 0x83, 0x32, 0xf9, 0xef, 0x1d, 0x93, 0x87, 0x6a, 0xcb, 0x4c, 0xfe, 0xd5,
+	0x28, 0x93, 0x2f, 0x76, 0x63, 0xf2, 0x25, 0x2b, 0xb8, 0xf6, 0x7f, 0x76, 0xa6, 0x7f, 0xcb, 0x01,
+	0xb3, 0xad, 0x16, 0x35, 0xb5, 0xf4, 0x79, 0xb7, 0xfd, 0x7b, 0x1c, 0x9c, 0xed, 0xa0, 0xee, 0x17,
+	0xcc, 0xf8, 0xaf, 0x71, 0x30, 0xd9, 0x71, 0xe8, 0x3d, 0x8a, 0x46, 0x54, 0x80, 0x44, 0x10, 0x28,
+	0xd3, 0xa6, 0xb7, 0xb8, 0x8e, 0x07, 0xf0, 0xa5, 0x2b, 0xac, 0x4c, 0x5b, 0xd6, 0x76, 0xb0, 0x2a,
+	0xaa, 0xd6, 0xb2, 0x56, 0xaf, 0x2b, 0x96, 0x85, 0xf1, 0x81, 0x57, 0x84, 0x7f, 0x74, 0xe2, 0x3e,
+	0x6c, 0x28, 0x66, 0x01, 0x11, 0x8e, 0x49, 0xac, 0x57, 0x90, 0xdc, 0x6e, 0x36, 0x9f, 0x57, 0x23,
+	0x70, 0xb6, 0xc9, 0x73, 0xb1, 0x22, 0xa9, 0x6d, 0xa8, 0x83, 0x9b, 0xcf, 0x3f, 0xf4, 0xc1, 0x44,
+	0xe4, 0xd0, 0x68, 0x06, 0x12, 0x76, 0x31, 0x2e, 0x04, 0xf7, 0x27, 0x71, 0xbb, 0x95, 0xed, 0x46,
+	0xd0, 0x02, 0x1c, 0x27, 0x73, 0x2a, 0x4a, 0x96, 0xb2, 0x43, 0xc5, 0x06, 0x83, 0xea, 0x58, 0xd1,
+	0x92, 0x96, 0xdc, 0x3e, 0xe6, 0x07, 0xb3, 0x30, 0xe6, 0x1a, 0x49, 0xaf, 0x9a, 0x74, 0xed, 0xea,
+	0x3f, 0xd3, 0x4f, 0xd6, 0x2e, 0xa7, 0x7d, 0xb3, 0x6a, 0x92, 0xb5, 0xeb, 0x02, 0x8c, 0xba, 0x94,
+	0x0f, 0x1b, 0x9a, 0xd1, 0xa8, 0x27, 0x63, 0x54, 0xae, 0x4b, 0x78, 0x9f, 0xb6, 0xa2, 0x55, 0x77,
+	0x91, 0x3b, 0x4c, 0x17, 0xb9, 0x4c, 0xb7, 0xa6, 0x6e, 0x59, 0xe8, 0x9e, 0x83, 0x09, 0x03, 0x5b,
+	0x34, 0x4f, 0x0a, 0x6d, 0x3a, 0x0e, 0x50, 0x1d, 0x4f, 0x38, 0x04, 0xcb, 0x01, 0x5d, 0xd3, 0x7f,
+	0x73, 0x0a, 0x94, 0xb6, 0x31, 0x42, 0x72, 0x54, 0x97, 0xd6, 0xfd, 0x3c, 0xad, 0xdd, 0xef, 0x71,
+	0x70, 0xb1, 0x0b, 0x7c, 0x5f, 0x90, 0x45, 0x5c, 0x82, 0x89, 0x00, 0x18, 0x62, 0xb4, 0x03, 0xcf,
+	0x18, 0xdf, 0xed, 0x83, 0xe3, 0x2d, 0x03, 0x30, 0xf3, 0xcc, 0xf9, 0xf2, 0x44, 0x5b, 0x05, 0xe7,
+	0x46, 0x87, 0x5b, 0xc3, 0xcd, 0x40, 0x42, 0x6d, 0xd4, 0x05, 0x53, 0x91, 0x55, 0xd1, 0x6a, 0x18,
+	0xd8, 0xf6, 0x87, 0x18, 0x1f, 0x57, 0x1b, 0xf5, 0x82, 0xdb, 0x88, 0xae, 0xc2, 0xb8, 0xa5, 0x59,
+	0x62, 0x4d, 0xa8, 0x89, 0x16, 0x56, 0xa5, 0x5d, 0xa1, 0x58, 0xd3, 0xa4, 0xaa, 0x49, 0x27, 0x3d,
+	0xc6, 0x23, 0xda, 0x77, 0xd7, 0xee, 0xca, 0xd1, 0x1e, 0x74, 0x05, 0x50, 0x5d, 0x6c, 0xb6, 0xd2,
+	0xc7, 0x28, 0xfd, 0x58, 0x5d, 0x6c, 0x06, 0xa9, 0x27, 0x01, 0x88, 0x1a, 0x75, 0xc5, 0x34, 0x71,
+	0x89, 0x46, 0x5a, 0x8c, 0x1f, 0x52, 0x1b, 0xf5, 0x75, 0xda, 0x80, 0x26, 0x60, 0x90, 0x74, 0x13,
+	0x61, 0xc9, 0x01, 0xda, 0x79, 0x44, 0x6d, 0xd4, 0x89, 0x88, 0xf4, 0x2f, 0x9c, 0xfd, 0x5b, 0xb8,
+	0x39, 0x0a, 0xe0, 0x06, 0xb4, 0x40, 0xdc, 0x76, 0x2f, 0x67, 0x09, 0x95, 0xc2, 0xc7, 0x25, 0x7f,
+	0xf3, 0xc1, 0xf9, 0xca, 0xcb, 0xcc, 0x57, 0x5a, 0x5c, 0xd4, 0xf6, 0x95, 0x39, 0x38, 0xc6, 0x14,
+	0x13, 0xac, 0xa6, 0x50, 0x11, 0xcd, 0x8a, 0x7f, 0x26, 0x59, 0xd7, 0x56, 0xf3, 0x8e, 0x68, 0x56,
+	0x48, 0x96, 0x78, 0x18, 0xb6, 0x8f, 0xf5, 0xdb, 0xa1, 0x65, 0x85, 0xe4, 0x9e, 0x7e, 0x85, 0x7c,
+	0x63, 0x10, 0x8e, 0x87, 0x0f, 0xf7, 0x1c, 0x0c, 0xb3, 0x5d, 0x84, 0x58, 0x2a, 0xd9, 0xbb, 0xf5,
+	0xa1, 0x5c, 0xf2, 0x83, 0x77, 0xe6, 0xc6, 0x99, 0x95, 0x96, 0x4a, 0x25, 0x03, 0x9b, 0x66, 0xc1,
+	0x22, 0xf9, 0x8e, 0x07, 0x9b, 0x98, 0x34, 0x22, 0x1e, 0x06, 0x6c, 0xbf, 0xa5, 0x86, 0x1d, 0xc9,
+	0xdd, 0xfa, 0xe8, 0xc9, 0xf4, 0x4d, 0x59, 0xb1, 0x2a, 0x8d, 0x62, 0x46, 0xd2, 0xea, 0x59, 0xa6,
+	0x6f, 0x4d, 0x2c, 0x9a, 0x73, 0x8a, 0xe6, 0x7c, 0x66, 0x77, 0x16, 0xb3, 0xd6, 0xae, 0x8e, 0xcd,
+	0x4c, 0x6e, 0x6d, 0xf3, 0xda, 0xe2, 0xd5, 0xcd, 0x46, 0xf1, 0x15, 0xbc, 0xcb, 0x1f, 0x2e, 0x12,
+	0x47, 0x47, 0x5f, 0x87, 0x84, 0xb7, 0x9b, 0xac, 0x29, 0xa6, 0x45, 0x57, 0x85, 0xa7, 0x94, 0x3d,
+	0xcc, 0xf6, 0xa2, 0x77, 0x15, 0xb7, 0x34, 0xb4, 0x27, 0x4b, 0xa9, 0x63, 0xb6, 0x98, 0x0c, 0x3b,
+	0xb3, 0xa4, 0xd4, 0x71, 0x5b, 0xf5, 0x78, 0x78, 0xaf, 0xea, 0x71, 0xa0, 0xb5, 0x7a, 0x3c, 0x05,
+	0x43, 0x76, 0x14, 0x9a, 0xa2, 0x95, 0x3c, 0x42, 0xe3, 0x60, 0x90, 0x36, 0x14, 0x44, 0x0b, 0x9d,
+	0x83, 0x84, 0xdf, 0x5d, 0x70, 0x33, 0x39, 0x48, 0x3d, 0x65, 0xc4, 0xf3, 0x14, 0xdc, 0x44, 0xe7,
+	0x61, 0xd4, 0xac, 0x89, 0x66, 0xc5, 0x47, 0x36, 0x44, 0xc9, 0xe2, 0x4e, 0xb3, 0x4d, 0x77, 0x1d,
+	0x4e, 0x7a, 0x7b, 0x5c, 0xda, 0x45, 0x72, 0x04, 0xa5, 0x07, 0x4a, 0x3f, 0xee, 0x76, 0x17, 0x48,
+	0x6f, 0x41, 0x91, 0x09, 0xdb, 0x36, 0xc4, 0xbd, 0x70, 0x53, 0x64, 0x33, 0x39, 0xdc, 0x55, 0x7d,
+	0xb2, 0x54, 0x12, 0x75, 0x22, 0xc9, 0x4d, 0x38, 0xfc, 0x88, 0x1b, 0x71, 0x8a, 0x4c, 0x93, 0x89,
+	0x83, 0x4d, 0x6b, 0x58, 0x7a, 0xc3, 0x12, 0x94, 0x52, 0x33, 0x39, 0x42, 0xed, 0xe3, 0x44, 0xc2,
+	0x06, 0xed, 0x58, 0x2b, 0x35, 0xd1, 0x09, 0x18, 0xa0, 0x55, 0x03, 0x4e, 0xc6, 0xcf, 0x70, 0xb3,
+	0x83, 0x3c, 0xfb, 0x42, 0xd3, 0xd4, 0x29, 0xad, 0x86, 0x29, 0x94, 0xb0, 0x29, 0x25, 0x13, 0xf6,
+	0x89, 0x82, 0xdd, 0xb4, 0x82, 0x4d, 0x89, 0x24, 0xc3, 0x86, 0x53, 0x4f, 0xdb, 0xd3, 0x38, 0x6a,
+	0xaf, 0x9d, 0x6e, 0x2b, 0x9d, 0x48, 0x09, 0x8e, 0x37, 0x54, 0x2f, 0x92, 0x04, 0x83, 0x79, 0x7d,
+	0x72, 0x8c, 0x86, 0x54, 0x26, 0x3a, 0xa4, 0xb6, 0xd5, 0x52, 0x5b, 0xac, 0xf0, 0xe3, 0x8d, 0x90,
+	0xd6, 0x90, 0x75, 0xfc, 0x68, 0xd8, 0x3a, 0xbe, 0x0a, 0x47, 0x4c, 0xab, 0x2a, 0xe0, 0xa6, 0x9e,
+	0x44, 0x74, 0xf4, 0xb9, 0x88, 0xd1, 0xe9, 0x56, 0x38, 0xdf, 0xd4, 0x45, 0xd5, 0x7f, 0xd6, 0x4b,
+	0xd6, 0xf2, 0x6a, 0xbe, 0xa9, 0xa3, 0x2f, 0x01, 0x94, 0x75, 0x01, 0x6b, 0x96, 0x49, 0x22, 0xef,
+	0xd8, 0xd3, 0x47, 0xde, 0x60, 0x59, 0xcf, 0x6b, 0x96, 0xb9, 0x59, 0x4d, 0xff, 0x97, 0x83, 0x13,
+	0xe1, 0xa3, 0xa3, 0xdb, 0x70, 0x5a, 0x37, 0xf0, 0x8e, 0xa2, 0x35, 0x4c, 0x21, 0x3a, 0xd7, 0x25,
+	0x1d, 0x9a, 0x42, 0x4b, 0xce, 0x43, 0x37, 0x20, 0xa9, 0x59, 0x15, 0x6c, 0x08, 0xe5, 0x06, 0x9b,
+	0xb4, 0x26, 0x71, 0x10, 0xca, 0xdb, 0x67, 0xbb, 0x29, 0xed, 0x5f, 0xb5, 0xbb, 0xb7, 0x9a, 0x1b,
+	0x0d, 0x8b, 0xf0, 0x89, 0x90, 0xf2, 0x8d, 0x5b, 0x15, 0x82, 0x3e, 0xdb, 0x4f, 0x7d, 0xf6, 0x5c,
+	0x94, 0x21, 0x1d, 0x27, 0x5d, 0x53, 0xcb, 0x1a, 0x7f, 0xd2, 0xd3, 0xad, 0xba, 0xec, 0x73, 0xd9,
+	0xf4, 0x3a, 0x4c, 0xb9, 0x87, 0x39, 0xee, 0x26, 0x8d, 0xb2, 0x38, 0xe0, 0x2f, 0x03, 0x32, 0x75,
+	0x12, 0xee, 0x44, 0x38, 0x76, 0xa2, 0xd1, 0x86, 0x3c, 0x4a, 0x7b, 0xa8, 0xd5, 0x68, 0x3c, 0xa6,
+	0x7f, 0x15, 0x83, 0x93, 0x11, 0x0e, 0x44, 0xaa, 0x5e, 0x9f, 0xdb, 0xfa, 0xc5, 0x78, 0xee, 0x6c,
+	0x47, 0xb5, 0x04, 0xa7, 0x5c, 0xa8, 0x1e, 0x0b, 0x09, 0x6c, 0x9a, 0x14, 0xfb, 0x7a, 0x00, 0x9e,
+	0x74, 0x04, 0xb9, 0xe0, 0x0a, 0x8a, 0x4c, 0x53, 0x61, 0x48, 0x8a, 0xe9, 0x0f, 0x4b, 0x31, 0xb7,
+	0x20, 0xd5, 0x92, 0x62, 0x1c, 0x65, 0x08, 0x4b, 0x8c, 0xb2, 0x9c, 0x0c, 0x66, 0x19, 0x7b, 0x14,
+	0xc2, 0x5c, 0x86, 0x13, 0xde, 0xa4, 0xf9, 0x78, 0x49, 0x99, 0xbe, 0xbf, 0x8c, 0x33, 0xee, 0x66,
+	0x1c, 0x6f, 0x24, 0x13, 0x7d, 0x8b, 0x83, 0xb3, 0x9e, 0x96, 0x9e, 0xcd, 0x14, 0xb5, 0xac, 0x79,
+	0x81, 0x3f, 0x40, 0x43, 0xef, 0x7a, 0xc4, 0x98, 0x9d, 0xfd, 0x80, 0x9f, 0x2a, 0x75, 0xf6, 0x93,
+	0x1c, 0x4c, 0x85, 0x4c, 0x9a, 0x28, 0x7b, 0xb6, 0x3a, 0x42, 0x6d, 0x95, 0x6a, 0x9b, 0x91, 0x25,
+	0x99, 0x99, 0x2b, 0x2d, 0xc1, 0xf4, 0x1e, 0xc7, 0x8f, 0xe8, 0x45, 0x88, 0x95, 0x70, 0x6d, 0x7f,
+	0xc5, 0x34, 0xe5, 0x4c, 0x3f, 0x19, 0x80, 0x64, 0xe4, 0x29, 0x7e, 0x1e, 0x86, 0x49, 0xd6, 0x35,
+	0x14, 0xdd, 0x57, 0x7d, 0x3c, 0xe3, 0x14, 0x4d, 0xde, 0x08, 0x76, 0xc5, 0xb4, 0xe2, 0x91, 0xf2,
+	0x7e, 0x3e, 0xb4, 0x0e, 0x40, 0x77, 0xbf, 0xa6, 0x7b, 0x85, 0x33, 0x94, 0x9b, 0xfb, 0xe8, 0xc9,
+	0xf4, 0x29, 0x5b, 0x90, 0x59, 0xaa, 0x66, 0x14, 0x2d, 0x5b, 0x17, 0xad, 0x4a, 0xe6, 0x2e, 0x96,
+	0x45, 0x69, 0x77, 0x05, 0x4b, 0x1f, 0xbc, 0x33, 0x07, 0x6c, 0x9c, 0x15, 0x2c, 0xf1, 0x3e, 0x01,
+	0xe8, 0x0a, 0xc4, 0x68, 0x81, 0xd2, 0xbf, 0x47, 0x81, 0x12, 0x13, 0x83, 0xa5, 0x49, 0xec, 0xc0,
+	0x4a, 0x93, 0xe7, 0xa1, 0x5f, 0xd7, 0x74, 0x5a, 0x0c, 0x0c, 0x2f, 0x5c, 0x8e, 0xba, 0xca, 0x32,
+	0x34, 0xad, 0xbc, 0x51, 0xde, 0xd4, 0x4c, 0x13, 0x53, 0xc5, 0x73, 0x5b, 0xcb, 0x3c, 0xe1, 0x43,
+	0x8b, 0x70, 0x82, 0xba, 0x3f, 0x2e, 0x09, 0x8c, 0xd5, 0x5f, 0x3d, 0xc4, 0xf8, 0x71, 0xd6, 0x9b,
+	0xb3, 0x3b, 0x59, 0x21, 0x41, 0xd6, 0x53, 0x87, 0xcb, 0x3b, 0x56, 0x39, 0xc2, 0xd6, 0x53, 0xc6,
+	0xe1, 0x9e, 0xae, 0x9c, 0x80, 0x01, 0x46, 0x31, 0x48, 0x65, 0x0e, 0x54, 0xdc, 0xf6, 0x6f, 0x88,
+	0x4a, 0x0d, 0x97, 0x68, 0x09, 0x31, 0xc8, 0xb3, 0x2f, 0xb2, 0x59, 0xa8, 0x28, 0x72, 0x05, 0x9b,
+	0x96, 0xb0, 0xa3, 0x59, 0xd8, 0xad, 0x67, 0x80, 0xca, 0x47, 0xac, 0xef, 0x01, 0xe9, 0x62, 0x23,
+	0xdc, 0x83, 0x51, 0x6f, 0x52, 0x68, 0x6c, 0x25, 0x87, 0xa9, 0x41, 0x66, 0x22, 0xc3, 0xd8, 0xa1,
+	0xa6, 0xa1, 0x92, 0x90, 0x02, 0xdf, 0xb4, 0xd4, 0xd2, 0xca, 0x16, 0x2d, 0x77, 0x2d, 0x5c, 0x62,
+	0x75, 0xc0, 0x30, 0x69, 0x5b, 0xb1, 0x9b, 0xd0, 0xff, 0x43, 0xbc, 0x8a, 0x77, 0x05, 0xc3, 0xb9,
+	0xbf, 0x4e, 0x26, 0xa8, 0xdf, 0x2f, 0x74, 0x79, 0xa3, 0x44, 0x66, 0x90, 0xb1, 0xf2, 0x23, 0x55,
+	0xef, 0xc3, 0xdd, 0xf8, 0xb0, 0xa0, 0xc6, 0x25, 0x52, 0xac, 0x99, 0xc9, 0x51, 0x77, 0xe3, 0xb3,
+	0xe2, 0x74, 0x14, 0xc8, 0x56, 0x62, 0x06, 0x12, 0x2d, 0x94, 0x63, 0xf6, 0xfe, 0xab, 0xe4, 0x27,
+	0x7b, 0x39, 0x36, 0x38, 0x32, 0x16, 0x4f, 0x4f, 0xb2, 0xfb, 0x9f, 0xbb, 0xa2, 0x21, 0x63, 0x93,
+	0x6c, 0xe2, 0x78, 0xbc, 0x61, 0xc8, 0xce, 0x25, 0xf3, 0xa7, 0x1c, 0x9c, 0x0e, 0xef, 0xf7, 0x9d,
+	0xa2, 0x91, 0xfd, 0x96, 0x50, 0x52, 0xca, 0x65, 0xf7, 0x14, 0x8d, 0xb4, 0xac, 0x28, 0xe5, 0x32,
+	0x29, 0xde, 0x0c, 0xad, 0x56, 0x2b, 0x8a, 0x52, 0x55, 0x28, 0x1b, 0x5a, 0x9d, 0xed, 0x6c, 0x02,
+	0xa9, 0xd4, 0x77, 0xf3, 0xcd, 0xd2, 0xc1, 0x1d, 0x2c, 0x96, 0xb0, 0x11, 0xc8, 0x68, 0x23, 0x8e,
+	0x98, 0x55, 0x43, 0xab, 0xa3, 0xfb, 0x30, 0xec, 0x8a, 0xb5, 0xb4, 0x64, 0xff, 0x3e, 0x85, 0x82,
+	0x23, 0x64, 0x4b, 0x4b, 0xab, 0x6c, 0xaf, 0xb3, 0xe9, 0xaf, 0x85, 0x0e, 0x7a, 0x93, 0xfd, 0x7f,
+	0x83, 0xdf, 0x7b, 0x7d, 0xfa, 0xd0, 0x3f, 0x5f, 0x9f, 0x3e, 0x94, 0x7e, 0x9b, 0x83, 0x53, 0xa1,
+	0x03, 0x32, 0xc3, 0x2e, 0xf9, 0xae, 0xa4, 0xfb, 0x69, 0x5e, 0x8b, 0x2a, 0xc2, 0x34, 0x03, 0x97,
+	0x42, 0x2f, 0xa6, 0x0f, 0x6a, 0x4f, 0xe9, 0xd3, 0x9a, 0x67, 0x67, 0xc4, 0xce, 0x9a, 0xb7, 0xde,
+	0x28, 0x28, 0xf2, 0x42, 0xc1, 0xce, 0x20, 0xfb, 0xdc, 0x65, 0xfe, 0x88, 0x83, 0x54, 0xa8, 0xbc,
+	0xbc, 0x6a, 0x19, 0xbb, 0xbd, 0x9e, 0x3e, 0xa4, 0x21, 0xae, 0x37, 0x8a, 0x82, 0xaa, 0xa9, 0x12,
+	0xf6, 0x15, 0x6d, 0xc3, 0x7a, 0xa3, 0x78, 0x8f, 0xb4, 0xb1, 0x1d, 0x8b, 0x2e, 0x1a, 0x96, 0x22,
+	0xd6, 0xdc, 0xf5, 0x8e, 0x95, 0x13, 0xac, 0x99, 0x2d, 0x71, 0x7f, 0xe6, 0x20, 0xdd, 0x09, 0x2e,
+	0x9b, 0xaa, 0x57, 0xe0, 0x08, 0x56, 0x2d, 0x43, 0x71, 0xcf, 0x4e, 0xe7, 0xf7, 0xa8, 0x14, 0xda,
+	0x51, 0xf2, 0x8e, 0x04, 0x92, 0xe9, 0x44, 0x59, 0x36, 0x58, 0xf8, 0xb6, 0xc2, 0x40, 0x5e, 0x9f,
+	0x8b, 0xe6, 0x0a, 0xf8, 0x5a, 0x5b, 0x00, 0x8d, 0x79, 0x3d, 0x36, 0xa6, 0x4b, 0xdf, 0xe7, 0xe0,
+	0x64, 0xc4, 0xc1, 0x22, 0xba, 0x00, 0xcf, 0x2c, 0x6f, 0x3c, 0xc8, 0xdf, 0x5b, 0xba, 0xb7, 0x25,
+	0x2c, 0x6f, 0xac, 0xaf, 0xaf, 0x6d, 0x6d, 0xe5, 0xf3, 0x42, 0x61, 0x6b, 0x69, 0x6b, 0xbb, 0x20,
+	0x14, 0x96, 0xef, 0xe4, 0x57, 0xb6, 0xef, 0xe6, 0x57, 0xc6, 0x0e, 0xa1, 0x73, 0x70, 0x26, 0x9a,
+	0x70, 0x69, 0x79, 0x6b, 0xed, 0x41, 0x7e, 0x8c, 0x43, 0x33, 0x70, 0x36, 0x9a, 0x8a, 0xcf, 0x6f,
+	0xad, 0xf1, 0xf9, 0x95, 0xb1, 0xbe, 0x85, 0xbf, 0x4e, 0xc0, 0x61, 0x6a, 0x65, 0xf4, 0x1d, 0x0e,
+	0x06, 0x6c, 0x4f, 0x46, 0x17, 0x23, 0x4c, 0xd8, 0xfe, 0x10, 0x26, 0x75, 0xa9, 0x1b, 0x52, 0x7b,
+	0xaa, 0xd2, 0x33, 0xdf, 0xfe, 0xcb, 0x3f, 0x5e, 0xeb, 0x9b, 0x46, 0x93, 0xd9, 0x4e, 0xef, 0x7c,
+	0xd0, 0x8f, 0x39, 0x48, 0x04, 0xe3, 0x12, 0xcd, 0xef, 0x3d, 0x4a, 0x4b, 0xd2, 0x48, 0x2d, 0xf4,
+	0xc2, 0xc2, 0x14, 0xcc, 0x50, 0x05, 0x67, 0xd1, 0xf9, 0x8e, 0x0a, 0x3a, 0x1b, 0x38, 0x13, 0xbd,
+	0xc9, 0xc1, 0x68, 0xcb, 0xa3, 0x1b, 0xd4, 0xc5, 0xb8, 0xad, 0x4f, 0x7b, 0x52, 0xd7, 0x7a, 0xe2,
+	0x61, 0xca, 0x66, 0xa9, 0xb2, 0x17, 0xd1, 0x85, 0x8e, 0xca, 0x66, 0x1f, 0x31, 0x6d, 0x1f, 0xa3,
+	0xdf, 0x70, 0x70, 0xb4, 0xed, 0x15, 0x0e, 0x5a, 0xec, 0x66, 0xec, 0xd6, 0x47, 0x3f, 0xa9, 0xeb,
+	0x3d, 0x72, 0x31, 0x9d, 0x9f, 0xa7, 0x3a, 0xdf, 0x44, 0xd7, 0x3b, 0xeb, 0xec, 0xd5, 0x30, 0xd9,
+	0x47, 0xde, 0xef, 0xc7, 0xe8, 0x6d, 0x0e, 0x8e, 0xb6, 0x3d, 0xb2, 0xe9, 0x8c, 0x20, 0xea, 0xf5,
+	0x4f, 0xea, 0x7a, 0x8f, 0x5c, 0x0c, 0xc1, 0x3c, 0x45, 0x70, 0x19, 0x5d, 0x8c, 0x40, 0xd0, 0xfe,
+	0xcc, 0x07, 0x7d, 0xc0, 0xc1, 0x58, 0xab, 0x40, 0x74, 0xad, 0x97, 0xe1, 0x1d, 0x9d, 0x17, 0x7b,
+	0x63, 0x62, 0x2a, 0x17, 0xa8, 0xca, 0xeb, 0xe8, 0x95, 0xae, 0x55, 0xce, 0x3e, 0x0a, 0xbc, 0xd5,
+	0x78, 0xdc, 0x4e, 0x82, 0x7e, 0xca, 0x41, 0x22, 0xf8, 0x6c, 0xa5, 0x73, 0x90, 0x86, 0xbe, 0xc6,
+	0x49, 0x2d, 0xf4, 0xc2, 0xc2, 0xe0, 0xdc, 0xa4, 0x70, 0xe6, 0x51, 0x36, 0x1b, 0xf9, 0x12, 0xd0,
+	0x7f, 0x99, 0x90, 0x7d, 0x64, 0x1f, 0x08, 0x3d, 0x46, 0xff, 0xe6, 0xe0, 0x54, 0x87, 0x27, 0x21,
+	0xe8, 0x76, 0x2f, 0xd6, 0x0d, 0x01, 0xf3, 0xc2, 0xbe, 0xf9, 0x19, 0xb2, 0x75, 0x8a, 0xec, 0x25,
+	0x94, 0xdf, 0xff, 0x44, 0xf9, 0x80, 0xa3, 0x3f, 0x71, 0x70, 0x2c, 0xe4, 0xf5, 0x04, 0xba, 0xd1,
+	0x49, 0xcf, 0xe8, 0x97, 0x24, 0xa9, 0x9b, 0x3d, 0xf3, 0x31, 0x5c, 0xab, 0x14, 0xd7, 0x8b, 0xe8,
+	0x76, 0x04, 0x2e, 0x93, 0x92, 0xdb, 0x33, 0x15, 0x7c, 0xa8, 0x12, 0x04, 0xf4, 0x4b, 0x0e, 0x8e,
+	0x85, 0xbc, 0x4d, 0xe8, 0x0c, 0x28, 0xfa, 0xe9, 0x45, 0xea, 0x66, 0xcf, 0x7c, 0x0c, 0xd0, 0x35,
+	0x0a, 0x68, 0x0e, 0x5d, 0x8e, 0x00, 0x84, 0x19, 0xaf, 0xdf, 0x0f, 0x49, 0xfa, 0x1d, 0x0f, 0xbb,
+	0xdd, 0x47, 0x1d, 0xd5, 0xe8, 0xf0, 0x7c, 0x21, 0xf5, 0x6c, 0xef, 0x8c, 0x0c, 0xc0, 0x22, 0x05,
+	0x90, 0x41, 0x57, 0x22, 0x00, 0x78, 0xc7, 0x12, 0x7e, 0x04, 0x3f, 0xe3, 0x00, 0xb5, 0xdf, 0x68,
+	0xa3, 0x8e, 0x99, 0x34, 0xf2, 0xb2, 0x3d, 0x75, 0xa3, 0x57, 0x36, 0xa6, 0xfb, 0x02, 0xd5, 0xfd,
+	0x0a, 0xba, 0x14, 0xa1, 0x7b, 0xc8, 0xad, 0x3a, 0xfa, 0x0f, 0x07, 0xa7, 0x3b, 0x5d, 0x46, 0xa2,
+	0x17, 0x7a, 0x52, 0x26, 0x64, 0x2e, 0x5e, 0xdc, 0xbf, 0x00, 0x86, 0x6b, 0x83, 0xe2, 0x5a, 0x43,
+	0x2f, 0x75, 0x8f, 0x2b, 0xfb, 0x28, 0x58, 0x91, 0x04, 0xc3, 0xe5, 0x0d, 0x0e, 0xe2, 0x81, 0xeb,
+	0x2f, 0x74, 0xb5, 0x1b, 0x25, 0xfd, 0xf7, 0x9b, 0xa9, 0xf9, 0x1e, 0x38, 0x18, 0x8e, 0x39, 0x8a,
+	0xe3, 0x02, 0x9a, 0xd9, 0x0b, 0x07, 0xbd, 0xbe, 0x43, 0x3f, 0xe7, 0x20, 0x1e, 0xc8, 0xf4, 0x9d,
+	0xb5, 0x0c, 0xbb, 0x59, 0x4b, 0xcd, 0xf7, 0xc0, 0xc1, 0xb4, 0x5c, 0xa6, 0x5a, 0x3e, 0x8f, 0x6e,
+	0x75, 0xb5, 0x8a, 0xd8, 0xa9, 0xa9, 0x65, 0x4f, 0xf5, 0x18, 0xfd, 0x8b, 0x83, 0xe3, 0xa1, 0xdb,
+	0x0a, 0xf4, 0x6c, 0x37, 0x76, 0x0b, 0xdb, 0xbf, 0xa5, 0x9e, 0xdb, 0x07, 0x27, 0xc3, 0xf4, 0x2a,
+	0xc5, 0xb4, 0x8d, 0x0a, 0x4f, 0x81, 0xc9, 0x9b, 0x9e, 0x7a, 0xc3, 0x54, 0xe4, 0x05, 0x81, 0x1d,
+	0x50, 0xa1, 0xb7, 0x38, 0x18, 0x6d, 0x39, 0x87, 0xe8, 0x5c, 0xeb, 0x86, 0x1f, 0x6a, 0xa4, 0xae,
+	0xf5, 0xc4, 0xc3, 0x90, 0x5d, 0xa5, 0xc8, 0x2e, 0xa1, 0xd9, 0x08, 0x64, 0x35, 0x9b, 0x8f, 0xae,
+	0x1c, 0x06, 0xd6, 0x0c, 0x39, 0x77, 0xff, 0xdd, 0x8f, 0xa7, 0xb8, 0xf7, 0x3f, 0x9e, 0xe2, 0xfe,
+	0xfe, 0xf1, 0x14, 0xf7, 0x83, 0x4f, 0xa6, 0x0e, 0xbd, 0xff, 0xc9, 0xd4, 0xa1, 0x0f, 0x3f, 0x99,
+	0x3a, 0xf4, 0xe5, 0xee, 0x0e, 0xf8, 0x9a, 0xfe, 0x11, 0xe8, 0x69, 0x5f, 0x71, 0x80, 0xfe, 0x43,
+	0xc0, 0xb5, 0xff, 0x0d, 0x00, 0x75, 0x83, 0x2e, 0x1a, 0x81, 0x31, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ParamsVersions queries all the parameters of the module with version.
+	ParamsVersions(ctx context.Context, in *QueryParamsVersionsRequest, opts ...grpc.CallOption) (*QueryParamsVersionsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version
+	// of past params.
+	ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error)
+	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
+	ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error)
+	// DelegationsByStaker queries all BTC delegations of the given staker BTC
+	// PK, filtered by the given status
+	DelegationsByStaker(ctx context.Context, in *QueryDelegationsByStakerRequest, opts ...grpc.CallOption) (*QueryDelegationsByStakerResponse, error)
+	// ExpiringDelegations queries the BTC delegations that expire, i.e., whose
+	// end height minus unbonding time falls, within the given BTC height range
+	ExpiringDelegations(ctx context.Context, in *QueryExpiringDelegationsRequest, opts ...grpc.CallOption) (*QueryExpiringDelegationsResponse, error)
+	// UnbondingDelegations queries the BTC delegations whose early unbonding
+	// completes within the given BTC height range
+	UnbondingDelegations(ctx context.Context, in *QueryUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryUnbondingDelegationsResponse, error)
+	// CovenantCommittees queries the covenant committees of all the parameters
+	// versions, along with their status at the current BTC tip
+	CovenantCommittees(ctx context.Context, in *QueryCovenantCommitteesRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteesResponse, error)
+	// CovenantCommitteeDelegations queries the BTC delegations bound to the
+	// covenant committee of the given parameters version, filtered by the given
+	// status
+	CovenantCommitteeDelegations(ctx context.Context, in *QueryCovenantCommitteeDelegationsRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeDelegationsResponse, error)
+	// CovenantStats queries the signature liveness stats of the covenant
+	// members
+	CovenantStats(ctx context.Context, in *QueryCovenantStatsRequest, opts ...grpc.CallOption) (*QueryCovenantStatsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error)
+	// CovenantMuSig2Session retrieves the state of the covenant MuSig2 signing
+	// session of the unbonding tx of the given BTC delegation
+	CovenantMuSig2Session(ctx context.Context, in *QueryCovenantMuSig2SessionRequest, opts ...grpc.CallOption) (*QueryCovenantMuSig2SessionResponse, error)
+	// LargestBtcReOrg retrieves the largest BTC reorg
+	LargestBtcReOrg(ctx context.Context, in *QueryLargestBtcReOrgRequest, opts ...grpc.CallOption) (*QueryLargestBtcReOrgResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.UnbondingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
-		i--
-		dAtA[i] = 0x78
+	return out, nil
+}
+
+func (c *queryClient) ParamsVersions(ctx context.Context, in *QueryParamsVersionsRequest, opts ...grpc.CallOption) (*QueryParamsVersionsResponse, error) {
+	out := new(QueryParamsVersionsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StatusDesc) > 0 {
-		i -= len(m.StatusDesc)
-		copy(dAtA[i:], m.StatusDesc)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
-		i--
-		dAtA[i] = 0x72
+	return out, nil
+}
+
+func (c *queryClient) ParamsByVersion(ctx context.Context, in *QueryParamsByVersionRequest, opts ...grpc.CallOption) (*QueryParamsByVersionResponse, error) {
+	out := new(QueryParamsByVersionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+	return out, nil
+}
+
+func (c *queryClient) ParamsByBTCHeight(ctx context.Context, in *QueryParamsByBTCHeightRequest, opts ...grpc.CallOption) (*QueryParamsByBTCHeightResponse, error) {
+	out := new(QueryParamsByBTCHeightResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ParamsByBTCHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.StakingOutputIdx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
-		i--
-		dAtA[i] = 0x60
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviders(ctx context.Context, in *QueryFinalityProvidersRequest, opts ...grpc.CallOption) (*QueryFinalityProvidersResponse, error) {
+	out := new(QueryFinalityProvidersResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.CovenantSigs) > 0 {
-		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
-		}
+	return out, nil
+}
+
+func (c *queryClient) FinalityProvider(ctx context.Context, in *QueryFinalityProviderRequest, opts ...grpc.CallOption) (*QueryFinalityProviderResponse, error) {
+	out := new(QueryFinalityProviderResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProvider", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.DelegatorSlashSigHex) > 0 {
-		i -= len(m.DelegatorSlashSigHex)
-		copy(dAtA[i:], m.DelegatorSlashSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
-		i--
-		dAtA[i] = 0x52
+	return out, nil
+}
+
+func (c *queryClient) BTCDelegations(ctx context.Context, in *QueryBTCDelegationsRequest, opts ...grpc.CallOption) (*QueryBTCDelegationsResponse, error) {
+	out := new(QueryBTCDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x4a
+	return out, nil
+}
+
+func (c *queryClient) FinalityProviderDelegations(ctx context.Context, in *QueryFinalityProviderDelegationsRequest, opts ...grpc.CallOption) (*QueryFinalityProviderDelegationsResponse, error) {
+	out := new(QueryFinalityProviderDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/FinalityProviderDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.StakingTxHex) > 0 {
-		i -= len(m.StakingTxHex)
-		copy(dAtA[i:], m.StakingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
-		i--
-		dAtA[i] = 0x42
+	return out, nil
+}
+
+func (c *queryClient) DelegationsByStaker(ctx context.Context, in *QueryDelegationsByStakerRequest, opts ...grpc.CallOption) (*QueryDelegationsByStakerResponse, error) {
+	out := new(QueryDelegationsByStakerResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/DelegationsByStaker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.TotalSat != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
-		i--
-		dAtA[i] = 0x38
+	return out, nil
+}
+
+func (c *queryClient) ExpiringDelegations(ctx context.Context, in *QueryExpiringDelegationsRequest, opts ...grpc.CallOption) (*QueryExpiringDelegationsResponse, error) {
+	out := new(QueryExpiringDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/ExpiringDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.EndHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
-		i--
-		dAtA[i] = 0x30
+	return out, nil
+}
+
+func (c *queryClient) UnbondingDelegations(ctx context.Context, in *QueryUnbondingDelegationsRequest, opts ...grpc.CallOption) (*QueryUnbondingDelegationsResponse, error) {
+	out := new(QueryUnbondingDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/UnbondingDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x28
+	return out, nil
+}
+
+func (c *queryClient) CovenantCommittees(ctx context.Context, in *QueryCovenantCommitteesRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteesResponse, error) {
+	out := new(QueryCovenantCommitteesResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantCommittees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.StakingTime != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
-		i--
-		dAtA[i] = 0x20
+	return out, nil
+}
+
+func (c *queryClient) CovenantCommitteeDelegations(ctx context.Context, in *QueryCovenantCommitteeDelegationsRequest, opts ...grpc.CallOption) (*QueryCovenantCommitteeDelegationsResponse, error) {
+	out := new(QueryCovenantCommitteeDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantCommitteeDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.FpBtcPkList) > 0 {
-		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.FpBtcPkList[iNdEx].Size()
-				i -= size
-				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.StakerAddr) > 0 {
-		i -= len(m.StakerAddr)
-		copy(dAtA[i:], m.StakerAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *StakeExpansionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) CovenantStats(ctx context.Context, in *QueryCovenantStatsRequest, opts ...grpc.CallOption) (*QueryCovenantStatsResponse, error) {
+	out := new(QueryCovenantStatsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *StakeExpansionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BTCDelegation(ctx context.Context, in *QueryBTCDelegationRequest, opts ...grpc.CallOption) (*QueryBTCDelegationResponse, error) {
+	out := new(QueryBTCDelegationResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/BTCDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *StakeExpansionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PreviousStkCovenantSigs) > 0 {
-		for iNdEx := len(m.PreviousStkCovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PreviousStkCovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.OtherFundingTxOutHex) > 0 {
-		i -= len(m.OtherFundingTxOutHex)
-		copy(dAtA[i:], m.OtherFundingTxOutHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherFundingTxOutHex)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PreviousStakingTxHashHex) > 0 {
-		i -= len(m.PreviousStakingTxHashHex)
-		copy(dAtA[i:], m.PreviousStakingTxHashHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PreviousStakingTxHashHex)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) CovenantMuSig2Session(ctx context.Context, in *QueryCovenantMuSig2SessionRequest, opts ...grpc.CallOption) (*QueryCovenantMuSig2SessionResponse, error) {
+	out := new(QueryCovenantMuSig2SessionResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/CovenantMuSig2Session", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *DelegatorUnbondingInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) LargestBtcReOrg(ctx context.Context, in *QueryLargestBtcReOrgRequest, opts ...grpc.CallOption) (*QueryLargestBtcReOrgResponse, error) {
+	out := new(QueryLargestBtcReOrgResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Query/LargestBtcReOrg", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *DelegatorUnbondingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ParamsVersions queries all the parameters of the module with version.
+	ParamsVersions(context.Context, *QueryParamsVersionsRequest) (*QueryParamsVersionsResponse, error)
+	// ParamsByVersion queries the parameters of the module for a specific version
+	// of past params.
+	ParamsByVersion(context.Context, *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error)
+	// ParamsByBTCHeight queries the parameters of the module for a specific BTC height
+	ParamsByBTCHeight(context.Context, *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error)
+	// FinalityProviders queries all finality providers
+	FinalityProviders(context.Context, *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error)
+	// FinalityProvider info about one finality provider
+	FinalityProvider(context.Context, *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error)
+	// BTCDelegations queries all BTC delegations under a given status
+	BTCDelegations(context.Context, *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error)
+	// FinalityProviderDelegations queries all BTC delegations of the given finality provider
+	FinalityProviderDelegations(context.Context, *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error)
+	// DelegationsByStaker queries all BTC delegations of the given staker BTC
+	// PK, filtered by the given status
+	DelegationsByStaker(context.Context, *QueryDelegationsByStakerRequest) (*QueryDelegationsByStakerResponse, error)
+	// ExpiringDelegations queries the BTC delegations that expire, i.e., whose
+	// end height minus unbonding time falls, within the given BTC height range
+	ExpiringDelegations(context.Context, *QueryExpiringDelegationsRequest) (*QueryExpiringDelegationsResponse, error)
+	// UnbondingDelegations queries the BTC delegations whose early unbonding
+	// completes within the given BTC height range
+	UnbondingDelegations(context.Context, *QueryUnbondingDelegationsRequest) (*QueryUnbondingDelegationsResponse, error)
+	// CovenantCommittees queries the covenant committees of all the parameters
+	// versions, along with their status at the current BTC tip
+	CovenantCommittees(context.Context, *QueryCovenantCommitteesRequest) (*QueryCovenantCommitteesResponse, error)
+	// CovenantCommitteeDelegations queries the BTC delegations bound to the
+	// covenant committee of the given parameters version, filtered by the given
+	// status
+	CovenantCommitteeDelegations(context.Context, *QueryCovenantCommitteeDelegationsRequest) (*QueryCovenantCommitteeDelegationsResponse, error)
+	// CovenantStats queries the signature liveness stats of the covenant
+	// members
+	CovenantStats(context.Context, *QueryCovenantStatsRequest) (*QueryCovenantStatsResponse, error)
+	// BTCDelegation retrieves delegation by corresponding staking tx hash
+	BTCDelegation(context.Context, *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error)
+	// CovenantMuSig2Session retrieves the state of the covenant MuSig2 signing
+	// session of the unbonding tx of the given BTC delegation
+	CovenantMuSig2Session(context.Context, *QueryCovenantMuSig2SessionRequest) (*QueryCovenantMuSig2SessionResponse, error)
+	// LargestBtcReOrg retrieves the largest BTC reorg
+	LargestBtcReOrg(context.Context, *QueryLargestBtcReOrgRequest) (*QueryLargestBtcReOrgResponse, error)
 }
 
-func (m *DelegatorUnbondingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SpendStakeTxHex) > 0 {
-		i -= len(m.SpendStakeTxHex)
-		copy(dAtA[i:], m.SpendStakeTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpendStakeTxHex)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ParamsVersions(ctx context.Context, req *QueryParamsVersionsRequest) (*QueryParamsVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsVersions not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByVersion(ctx context.Context, req *QueryParamsByVersionRequest) (*QueryParamsByVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByVersion not implemented")
+}
+func (*UnimplementedQueryServer) ParamsByBTCHeight(ctx context.Context, req *QueryParamsByBTCHeightRequest) (*QueryParamsByBTCHeightResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParamsByBTCHeight not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviders(ctx context.Context, req *QueryFinalityProvidersRequest) (*QueryFinalityProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviders not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProvider(ctx context.Context, req *QueryFinalityProviderRequest) (*QueryFinalityProviderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProvider not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegations(ctx context.Context, req *QueryBTCDelegationsRequest) (*QueryBTCDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegations not implemented")
+}
+func (*UnimplementedQueryServer) FinalityProviderDelegations(ctx context.Context, req *QueryFinalityProviderDelegationsRequest) (*QueryFinalityProviderDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalityProviderDelegations not implemented")
+}
+func (*UnimplementedQueryServer) DelegationsByStaker(ctx context.Context, req *QueryDelegationsByStakerRequest) (*QueryDelegationsByStakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelegationsByStaker not implemented")
+}
+func (*UnimplementedQueryServer) ExpiringDelegations(ctx context.Context, req *QueryExpiringDelegationsRequest) (*QueryExpiringDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExpiringDelegations not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingDelegations(ctx context.Context, req *QueryUnbondingDelegationsRequest) (*QueryUnbondingDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingDelegations not implemented")
+}
+func (*UnimplementedQueryServer) CovenantCommittees(ctx context.Context, req *QueryCovenantCommitteesRequest) (*QueryCovenantCommitteesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantCommittees not implemented")
+}
+func (*UnimplementedQueryServer) CovenantCommitteeDelegations(ctx context.Context, req *QueryCovenantCommitteeDelegationsRequest) (*QueryCovenantCommitteeDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantCommitteeDelegations not implemented")
+}
+func (*UnimplementedQueryServer) CovenantStats(ctx context.Context, req *QueryCovenantStatsRequest) (*QueryCovenantStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantStats not implemented")
+}
+func (*UnimplementedQueryServer) BTCDelegation(ctx context.Context, req *QueryBTCDelegationRequest) (*QueryBTCDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BTCDelegation not implemented")
+}
+func (*UnimplementedQueryServer) CovenantMuSig2Session(ctx context.Context, req *QueryCovenantMuSig2SessionRequest) (*QueryCovenantMuSig2SessionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CovenantMuSig2Session not implemented")
+}
+func (*UnimplementedQueryServer) LargestBtcReOrg(ctx context.Context, req *QueryLargestBtcReOrgRequest) (*QueryLargestBtcReOrgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LargestBtcReOrg not implemented")
 }
 
-func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.DelegatorUnbondingInfoResponse != nil {
-		{
-			size, err := m.DelegatorUnbondingInfoResponse.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DelegatorSlashingSigHex) > 0 {
-		i -= len(m.DelegatorSlashingSigHex)
-		copy(dAtA[i:], m.DelegatorSlashingSigHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
-		i--
-		dAtA[i] = 0x22
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if len(m.SlashingTxHex) > 0 {
-		i -= len(m.SlashingTxHex)
-		copy(dAtA[i:], m.SlashingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
-		i--
-		dAtA[i] = 0x1a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/Params",
 	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	if len(m.UnbondingTxHex) > 0 {
-		i -= len(m.UnbondingTxHex)
-		copy(dAtA[i:], m.UnbondingTxHex)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ParamsVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsVersionsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dels) > 0 {
-		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsVersions(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsVersions(ctx, req.(*QueryParamsVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ParamsByVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByVersionRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByVersion(ctx, req.(*QueryParamsByVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SoftDeleted {
-		i--
-		if m.SoftDeleted {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
+func _Query_ParamsByBTCHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsByBTCHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.CommissionInfo != nil {
-		{
-			size, err := m.CommissionInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(QueryServer).ParamsByBTCHeight(ctx, in)
 	}
-	if m.HighestVotedHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.HighestVotedHeight))
-		i--
-		dAtA[i] = 0x50
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ParamsByBTCHeight",
 	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ParamsByBTCHeight(ctx, req.(*QueryParamsByBTCHeightRequest))
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x40
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.SlashedBtcHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
-		i--
-		dAtA[i] = 0x38
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviders(ctx, in)
 	}
-	if m.SlashedBabylonHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
-		i--
-		dAtA[i] = 0x30
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviders",
 	}
-	if m.Pop != nil {
-		{
-			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviders(ctx, req.(*QueryFinalityProvidersRequest))
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FinalityProvider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProvider(ctx, in)
 	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProvider",
 	}
-	if m.Description != nil {
-		{
-			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProvider(ctx, req.(*QueryFinalityProviderRequest))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLargestBtcReOrgRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BTCDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegations(ctx, req.(*QueryBTCDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLargestBtcReOrgRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_FinalityProviderDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalityProviderDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/FinalityProviderDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalityProviderDelegations(ctx, req.(*QueryFinalityProviderDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLargestBtcReOrgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_DelegationsByStaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDelegationsByStakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DelegationsByStaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/DelegationsByStaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DelegationsByStaker(ctx, req.(*QueryDelegationsByStakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryLargestBtcReOrgResponse) Marshal() (dAtA []byte, err error) {
+func _Query_ExpiringDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExpiringDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExpiringDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/ExpiringDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExpiringDelegations(ctx, req.(*QueryExpiringDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/UnbondingDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingDelegations(ctx, req.(*QueryUnbondingDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantCommittees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantCommitteesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantCommittees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantCommittees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantCommittees(ctx, req.(*QueryCovenantCommitteesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantCommitteeDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantCommitteeDelegationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantCommitteeDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantCommitteeDelegations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantCommitteeDelegations(ctx, req.(*QueryCovenantCommitteeDelegationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantStats(ctx, req.(*QueryCovenantStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BTCDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBTCDelegationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BTCDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/BTCDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BTCDelegation(ctx, req.(*QueryBTCDelegationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CovenantMuSig2Session_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCovenantMuSig2SessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CovenantMuSig2Session(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/CovenantMuSig2Session",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CovenantMuSig2Session(ctx, req.(*QueryCovenantMuSig2SessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LargestBtcReOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLargestBtcReOrgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LargestBtcReOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Query/LargestBtcReOrg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LargestBtcReOrg(ctx, req.(*QueryLargestBtcReOrgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "babylon.btcstaking.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ParamsVersions",
+			Handler:    _Query_ParamsVersions_Handler,
+		},
+		{
+			MethodName: "ParamsByVersion",
+			Handler:    _Query_ParamsByVersion_Handler,
+		},
+		{
+			MethodName: "ParamsByBTCHeight",
+			Handler:    _Query_ParamsByBTCHeight_Handler,
+		},
+		{
+			MethodName: "FinalityProviders",
+			Handler:    _Query_FinalityProviders_Handler,
+		},
+		{
+			MethodName: "FinalityProvider",
+			Handler:    _Query_FinalityProvider_Handler,
+		},
+		{
+			MethodName: "BTCDelegations",
+			Handler:    _Query_BTCDelegations_Handler,
+		},
+		{
+			MethodName: "FinalityProviderDelegations",
+			Handler:    _Query_FinalityProviderDelegations_Handler,
+		},
+		{
+			MethodName: "DelegationsByStaker",
+			Handler:    _Query_DelegationsByStaker_Handler,
+		},
+		{
+			MethodName: "ExpiringDelegations",
+			Handler:    _Query_ExpiringDelegations_Handler,
+		},
+		{
+			MethodName: "UnbondingDelegations",
+			Handler:    _Query_UnbondingDelegations_Handler,
+		},
+		{
+			MethodName: "CovenantCommittees",
+			Handler:    _Query_CovenantCommittees_Handler,
+		},
+		{
+			MethodName: "CovenantCommitteeDelegations",
+			Handler:    _Query_CovenantCommitteeDelegations_Handler,
+		},
+		{
+			MethodName: "CovenantStats",
+			Handler:    _Query_CovenantStats_Handler,
+		},
+		{
+			MethodName: "BTCDelegation",
+			Handler:    _Query_BTCDelegation_Handler,
+		},
+		{
+			MethodName: "CovenantMuSig2Session",
+			Handler:    _Query_CovenantMuSig2Session_Handler,
+		},
+		{
+			MethodName: "LargestBtcReOrg",
+			Handler:    _Query_LargestBtcReOrg_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "babylon/btcstaking/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3324,49 +3802,20 @@ func (m *QueryLargestBtcReOrgResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryLargestBtcReOrgResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryLargestBtcReOrgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RollbackTo != nil {
-		{
-			size, err := m.RollbackTo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.RollbackFrom != nil {
-		{
-			size, err := m.RollbackFrom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.BlockDiff != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDiff))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsVersionsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3376,32 +3825,30 @@ func (m *QueryParamsVersionsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0xa
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsVersionsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsByVersionRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3411,564 +3858,5294 @@ func (m *QueryParamsVersionsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryParamsVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsByVersionRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsByVersionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Params) > 0 {
-		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsByVersionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryParamsByVersionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsByVersionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsByVersionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByVersionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryParamsByBTCHeightRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsByBTCHeightRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsByBTCHeightRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsByBTCHeightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.BtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.BtcHeight))
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsByBTCHeightResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.Version != 0 {
-		n += 1 + sovQuery(uint64(m.Version))
+func (m *QueryParamsByBTCHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProvidersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryParamsByBTCHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFinalityProvidersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryParamsByBTCHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryFinalityProvidersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryFinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FinalityProvider != nil {
-		l = m.FinalityProvider.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryFinalityProvidersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBTCDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFinalityProvidersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProvidersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProvidersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BtcDelegations) > 0 {
-		for _, e := range m.BtcDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProviderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FpBtcPkHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BtcDelegatorDelegations) > 0 {
-		for _, e := range m.BtcDelegatorDelegations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.FinalityProvider != nil {
+		{
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBTCDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBTCDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BtcDelegation != nil {
-		l = m.BtcDelegation.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProviderDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.StakerAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.FpBtcPkList) > 0 {
-		for _, e := range m.FpBtcPkList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.StakingTime != 0 {
-		n += 1 + sovQuery(uint64(m.StakingTime))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovQuery(uint64(m.StartHeight))
-	}
-	if m.EndHeight != 0 {
-		n += 1 + sovQuery(uint64(m.EndHeight))
-	}
-	if m.TotalSat != 0 {
-		n += 1 + sovQuery(uint64(m.TotalSat))
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.StakingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.CovenantSigs) > 0 {
-		for _, e := range m.CovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.StakingOutputIdx != 0 {
-		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
-	}
-	if m.Active {
-		n += 2
-	}
-	l = len(m.StatusDesc)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.UnbondingTime != 0 {
-		n += 1 + sovQuery(uint64(m.UnbondingTime))
-	}
-	if m.UndelegationResponse != nil {
-		l = m.UndelegationResponse.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	if m.ParamsVersion != 0 {
-		n += 2 + sovQuery(uint64(m.ParamsVersion))
-	}
-	if m.StkExp != nil {
-		l = m.StkExp.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *StakeExpansionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFinalityProviderDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PreviousStakingTxHashHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.OtherFundingTxOutHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.PreviousStkCovenantSigs) > 0 {
-		for _, e := range m.PreviousStkCovenantSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegatorDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegatorDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DelegatorUnbondingInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.SpendStakeTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryDelegationsByStakerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *BTCUndelegationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryDelegationsByStakerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsByStakerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.UnbondingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.CovenantUnbondingSigList) > 0 {
-		for _, e := range m.CovenantUnbondingSigList {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SlashingTxHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.DelegatorSlashingSigHex)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.CovenantSlashingSigs) > 0 {
-		for _, e := range m.CovenantSlashingSigs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.DelegatorUnbondingInfoResponse != nil {
-		l = m.DelegatorUnbondingInfoResponse.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.StakerBtcPkHex) > 0 {
+		i -= len(m.StakerBtcPkHex)
+		copy(dAtA[i:], m.StakerBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDelegationsByStakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDelegationsByStakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDelegationsByStakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Dels) > 0 {
-		for _, e := range m.Dels {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FinalityProviderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryExpiringDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Description != nil {
-		l = m.Description.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pop != nil {
-		l = m.Pop.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	if m.SlashedBabylonHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExpiringDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.SlashedBtcHeight != 0 {
-		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	return dAtA[:n], nil
+}
+
+func (m *QueryExpiringDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExpiringDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Jailed {
-		n += 2
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HighestVotedHeight != 0 {
-		n += 1 + sovQuery(uint64(m.HighestVotedHeight))
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CommissionInfo != nil {
-		l = m.CommissionInfo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.SoftDeleted {
-		n += 2
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLargestBtcReOrgRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryUnbondingDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryLargestBtcReOrgResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BTCDelegationAtHeightResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationAtHeightResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationAtHeightResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.BlockDiff != 0 {
-		n += 1 + sovQuery(uint64(m.BlockDiff))
-	}
-	if m.RollbackFrom != nil {
-		l = m.RollbackFrom.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RollbackTo != nil {
-		l = m.RollbackTo.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.BtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcHeight))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsVersionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCovenantCommitteesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsVersionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCovenantCommitteesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Params) > 0 {
-		for _, e := range m.Params {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovenantCommittees) > 0 {
+		for iNdEx := len(m.CovenantCommittees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantCommittees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantCommitteeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantCommitteeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantCommitteeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetiringCovenantPksHex) > 0 {
+		for iNdEx := len(m.RetiringCovenantPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RetiringCovenantPksHex[iNdEx])
+			copy(dAtA[i:], m.RetiringCovenantPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RetiringCovenantPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CovenantQuorum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CovenantQuorum))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CovenantPksHex) > 0 {
+		for iNdEx := len(m.CovenantPksHex) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CovenantPksHex[iNdEx])
+			copy(dAtA[i:], m.CovenantPksHex[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantPksHex[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcActivationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BtcActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantCommitteeDelegationsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteeDelegationsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteeDelegationsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantCommitteeDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantCommitteeDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantCommitteeDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BtcDelegations) > 0 {
+		for iNdEx := len(m.BtcDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BtcDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumLate != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumLate))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.NumMissed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumMissed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxLatencyBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxLatencyBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalLatencyBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalLatencyBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumSignatures != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumSignatures))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.CovenantBtcPkHex) > 0 {
+		i -= len(m.CovenantBtcPkHex)
+		copy(dAtA[i:], m.CovenantBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovenantStats) > 0 {
+		for iNdEx := len(m.CovenantStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		{
+			size, err := m.BtcDelegation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FpEotsPk != nil {
+		{
+			size := m.FpEotsPk.Size()
+			i -= size
+			if _, err := m.FpEotsPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.StkExp != nil {
+		{
+			size, err := m.StkExp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ParamsVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ParamsVersion))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.UndelegationResponse != nil {
+		{
+			size, err := m.UndelegationResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.UnbondingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UnbondingTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.StatusDesc) > 0 {
+		i -= len(m.StatusDesc)
+		copy(dAtA[i:], m.StatusDesc)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StatusDesc)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.StakingOutputIdx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingOutputIdx))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.CovenantSigs) > 0 {
+		for iNdEx := len(m.CovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.DelegatorSlashSigHex) > 0 {
+		i -= len(m.DelegatorSlashSigHex)
+		copy(dAtA[i:], m.DelegatorSlashSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashSigHex)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StakingTxHex) > 0 {
+		i -= len(m.StakingTxHex)
+		copy(dAtA[i:], m.StakingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHex)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TotalSat != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalSat))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StakingTime != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for iNdEx := len(m.FpBtcPkList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.FpBtcPkList[iNdEx].Size()
+				i -= size
+				if _, err := m.FpBtcPkList[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StakerAddr) > 0 {
+		i -= len(m.StakerAddr)
+		copy(dAtA[i:], m.StakerAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakerAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakeExpansionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakeExpansionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakeExpansionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousStkCovenantSigs) > 0 {
+		for iNdEx := len(m.PreviousStkCovenantSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreviousStkCovenantSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OtherFundingTxOutHex) > 0 {
+		i -= len(m.OtherFundingTxOutHex)
+		copy(dAtA[i:], m.OtherFundingTxOutHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OtherFundingTxOutHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousStakingTxHashHex) > 0 {
+		i -= len(m.PreviousStakingTxHashHex)
+		copy(dAtA[i:], m.PreviousStakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PreviousStakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegatorUnbondingInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegatorUnbondingInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegatorUnbondingInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendStakeTxHex) > 0 {
+		i -= len(m.SpendStakeTxHex)
+		copy(dAtA[i:], m.SpendStakeTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SpendStakeTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCUndelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCUndelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCUndelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CovenantUnbondingAggSigHex) > 0 {
+		i -= len(m.CovenantUnbondingAggSigHex)
+		copy(dAtA[i:], m.CovenantUnbondingAggSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantUnbondingAggSigHex)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DelegatorUnbondingInfoResponse != nil {
+		{
+			size, err := m.DelegatorUnbondingInfoResponse.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for iNdEx := len(m.CovenantSlashingSigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantSlashingSigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DelegatorSlashingSigHex) > 0 {
+		i -= len(m.DelegatorSlashingSigHex)
+		copy(dAtA[i:], m.DelegatorSlashingSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DelegatorSlashingSigHex)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SlashingTxHex) > 0 {
+		i -= len(m.SlashingTxHex)
+		copy(dAtA[i:], m.SlashingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SlashingTxHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for iNdEx := len(m.CovenantUnbondingSigList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CovenantUnbondingSigList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UnbondingTxHex) > 0 {
+		i -= len(m.UnbondingTxHex)
+		copy(dAtA[i:], m.UnbondingTxHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UnbondingTxHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BTCDelegatorDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for iNdEx := len(m.Dels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DelegatedSats != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DelegatedSats))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.MaxDelegatedSats != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxDelegatedSats))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.KeyRotations) > 0 {
+		for iNdEx := len(m.KeyRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.SoftDeleted {
+		i--
+		if m.SoftDeleted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CommissionInfo != nil {
+		{
+			size, err := m.CommissionInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.HighestVotedHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HighestVotedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.SlashedBtcHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBtcHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.SlashedBabylonHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashedBabylonHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Pop != nil {
+		{
+			size, err := m.Pop.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Description != nil {
+		{
+			size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLargestBtcReOrgRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLargestBtcReOrgRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLargestBtcReOrgRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLargestBtcReOrgResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLargestBtcReOrgResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLargestBtcReOrgResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RollbackTo != nil {
+		{
+			size, err := m.RollbackTo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.RollbackFrom != nil {
+		{
+			size, err := m.RollbackFrom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockDiff != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockDiff))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsVersionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsVersionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsVersionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsVersionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsVersionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsVersionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Params[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantMuSig2SessionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantMuSig2SessionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantMuSig2SessionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StakingTxHashHex) > 0 {
+		i -= len(m.StakingTxHashHex)
+		copy(dAtA[i:], m.StakingTxHashHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakingTxHashHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CovenantMuSig2SessionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CovenantMuSig2SessionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CovenantMuSig2SessionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PartialSigHex) > 0 {
+		i -= len(m.PartialSigHex)
+		copy(dAtA[i:], m.PartialSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PartialSigHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PubNonceHex) > 0 {
+		i -= len(m.PubNonceHex)
+		copy(dAtA[i:], m.PubNonceHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubNonceHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CovenantBtcPkHex) > 0 {
+		i -= len(m.CovenantBtcPkHex)
+		copy(dAtA[i:], m.CovenantBtcPkHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CovenantBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCovenantMuSig2SessionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCovenantMuSig2SessionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCovenantMuSig2SessionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AggregatedSigHex) > 0 {
+		i -= len(m.AggregatedSigHex)
+		copy(dAtA[i:], m.AggregatedSigHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregatedSigHex)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AggregatedNonceHex) > 0 {
+		i -= len(m.AggregatedNonceHex)
+		copy(dAtA[i:], m.AggregatedNonceHex)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AggregatedNonceHex)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByVersionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryParamsByVersionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsByBTCHeightRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	return n
+}
+
+func (m *QueryParamsByBTCHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProvidersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalityProviderDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegatorDelegations) > 0 {
+		for _, e := range m.BtcDelegatorDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByStakerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDelegationsByStakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExpiringDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationAtHeightResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcHeight))
+	}
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantCommitteesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantCommitteesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantCommittees) > 0 {
+		for _, e := range m.CovenantCommittees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantCommitteeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.BtcActivationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BtcActivationHeight))
+	}
+	if len(m.CovenantPksHex) > 0 {
+		for _, s := range m.CovenantPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CovenantQuorum != 0 {
+		n += 1 + sovQuery(uint64(m.CovenantQuorum))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if len(m.RetiringCovenantPksHex) > 0 {
+		for _, s := range m.RetiringCovenantPksHex {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCovenantCommitteeDelegationsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParamsVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantCommitteeDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BtcDelegations) > 0 {
+		for _, e := range m.BtcDelegations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovenantBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumSignatures != 0 {
+		n += 1 + sovQuery(uint64(m.NumSignatures))
+	}
+	if m.TotalLatencyBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.TotalLatencyBlocks))
+	}
+	if m.MaxLatencyBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.MaxLatencyBlocks))
+	}
+	if m.NumMissed != 0 {
+		n += 1 + sovQuery(uint64(m.NumMissed))
+	}
+	if m.NumLate != 0 {
+		n += 1 + sovQuery(uint64(m.NumLate))
+	}
+	return n
+}
+
+func (m *QueryCovenantStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CovenantStats) > 0 {
+		for _, e := range m.CovenantStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcDelegation != nil {
+		l = m.BtcDelegation.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakerAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FpBtcPkList) > 0 {
+		for _, e := range m.FpBtcPkList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingTime != 0 {
+		n += 1 + sovQuery(uint64(m.StakingTime))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EndHeight))
+	}
+	if m.TotalSat != 0 {
+		n += 1 + sovQuery(uint64(m.TotalSat))
+	}
+	l = len(m.StakingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSigs) > 0 {
+		for _, e := range m.CovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.StakingOutputIdx != 0 {
+		n += 1 + sovQuery(uint64(m.StakingOutputIdx))
+	}
+	if m.Active {
+		n += 2
+	}
+	l = len(m.StatusDesc)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UnbondingTime != 0 {
+		n += 1 + sovQuery(uint64(m.UnbondingTime))
+	}
+	if m.UndelegationResponse != nil {
+		l = m.UndelegationResponse.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.ParamsVersion != 0 {
+		n += 2 + sovQuery(uint64(m.ParamsVersion))
+	}
+	if m.StkExp != nil {
+		l = m.StkExp.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.FpEotsPk != nil {
+		l = m.FpEotsPk.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StakeExpansionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousStakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OtherFundingTxOutHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PreviousStkCovenantSigs) > 0 {
+		for _, e := range m.PreviousStkCovenantSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DelegatorUnbondingInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SpendStakeTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCUndelegationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.UnbondingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantUnbondingSigList) > 0 {
+		for _, e := range m.CovenantUnbondingSigList {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.SlashingTxHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DelegatorSlashingSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.CovenantSlashingSigs) > 0 {
+		for _, e := range m.CovenantSlashingSigs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.DelegatorUnbondingInfoResponse != nil {
+		l = m.DelegatorUnbondingInfoResponse.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CovenantUnbondingAggSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *BTCDelegatorDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Dels) > 0 {
+		for _, e := range m.Dels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalityProviderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Description != nil {
+		l = m.Description.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pop != nil {
+		l = m.Pop.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashedBabylonHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBabylonHeight))
+	}
+	if m.SlashedBtcHeight != 0 {
+		n += 1 + sovQuery(uint64(m.SlashedBtcHeight))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.HighestVotedHeight != 0 {
+		n += 1 + sovQuery(uint64(m.HighestVotedHeight))
+	}
+	if m.CommissionInfo != nil {
+		l = m.CommissionInfo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SoftDeleted {
+		n += 2
+	}
+	if len(m.KeyRotations) > 0 {
+		for _, e := range m.KeyRotations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.MaxDelegatedSats != 0 {
+		n += 1 + sovQuery(uint64(m.MaxDelegatedSats))
+	}
+	if m.DelegatedSats != 0 {
+		n += 2 + sovQuery(uint64(m.DelegatedSats))
+	}
+	return n
+}
+
+func (m *QueryLargestBtcReOrgRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLargestBtcReOrgResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockDiff != 0 {
+		n += 1 + sovQuery(uint64(m.BlockDiff))
+	}
+	if m.RollbackFrom != nil {
+		l = m.RollbackFrom.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RollbackTo != nil {
+		l = m.RollbackTo.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsVersionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsVersionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Params) > 0 {
+		for _, e := range m.Params {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantMuSig2SessionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakingTxHashHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CovenantMuSig2SessionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CovenantBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PubNonceHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PartialSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCovenantMuSig2SessionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.AggregatedNonceHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AggregatedSigHex)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &FinalityProviderResponse{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
+			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsByStakerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsByStakerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsByStakerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerBtcPkHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDelegationsByStakerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDelegationsByStakerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDelegationsByStakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExpiringDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExpiringDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExpiringDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationAtHeightResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingDelegationsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingDelegationsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationAtHeightResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCDelegationAtHeightResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCDelegationAtHeightResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCDelegationAtHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			}
+			m.BtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
+			}
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCovenantCommitteesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantCommitteesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantCommitteesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryCovenantCommitteesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCovenantCommitteesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCovenantCommitteesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantCommittees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantCommittees = append(m.CovenantCommittees, &CovenantCommitteeResponse{})
+			if err := m.CovenantCommittees[len(m.CovenantCommittees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *CovenantCommitteeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3978,25 +9155,165 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CovenantCommitteeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CovenantCommitteeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcActivationHeight", wireType)
+			}
+			m.BtcActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BtcActivationHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantPksHex = append(m.CovenantPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantQuorum", wireType)
+			}
+			m.CovenantQuorum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CovenantQuorum |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= CovenantCommitteeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiringCovenantPksHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiringCovenantPksHex = append(m.RetiringCovenantPksHex, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4018,7 +9335,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantCommitteeDelegationsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4041,15 +9358,53 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantCommitteeDelegationsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantCommitteeDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+			}
+			m.ParamsVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsVersion |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4076,7 +9431,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4101,7 +9459,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantCommitteeDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4124,17 +9482,17 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantCommitteeDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantCommitteeDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
 			}
-			m.Version = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4144,64 +9502,29 @@ func (m *QueryParamsByVersionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
+			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4228,7 +9551,10 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4253,7 +9579,7 @@ func (m *QueryParamsByVersionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4276,17 +9602,17 @@ func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.BtcHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4296,11 +9622,28 @@ func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BtcHeight |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4322,7 +9665,7 @@ func (m *QueryParamsByBTCHeightRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
+func (m *CovenantStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4345,17 +9688,17 @@ func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CovenantStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsByBTCHeightResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CovenantStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantBtcPkHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4365,30 +9708,86 @@ func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumSignatures", wireType)
 			}
-			m.Version = 0
+			m.NumSignatures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumSignatures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLatencyBlocks", wireType)
+			}
+			m.TotalLatencyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalLatencyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatencyBlocks", wireType)
+			}
+			m.MaxLatencyBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLatencyBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumMissed", wireType)
+			}
+			m.NumMissed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4398,66 +9797,16 @@ func (m *QueryParamsByBTCHeightResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				m.NumMissed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumLate", wireType)
 			}
-			var msglen int
+			m.NumLate = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4467,28 +9816,11 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NumLate |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4510,7 +9842,7 @@ func (m *QueryFinalityProvidersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4533,15 +9865,15 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProvidersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantStats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4568,8 +9900,8 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderResponse{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CovenantStats = append(m.CovenantStats, &CovenantStatsResponse{})
+			if err := m.CovenantStats[len(m.CovenantStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4630,7 +9962,7 @@ func (m *QueryFinalityProvidersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4653,15 +9985,15 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4689,7 +10021,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4712,7 +10044,7 @@ func (m *QueryFinalityProviderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4735,15 +10067,15 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4770,10 +10102,10 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinalityProvider == nil {
-				m.FinalityProvider = &FinalityProviderResponse{}
+			if m.BtcDelegation == nil {
+				m.BtcDelegation = &BTCDelegationResponse{}
 			}
-			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4798,7 +10130,7 @@ func (m *QueryFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4821,17 +10153,17 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4841,16 +10173,29 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= BTCDelegationStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakerAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4860,83 +10205,105 @@ func (m *QueryBTCDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPkList = append(m.FpBtcPkList, v)
+			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.StakingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegations", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4946,31 +10313,16 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EndHeight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BtcDelegations = append(m.BtcDelegations, &BTCDelegationResponse{})
-			if err := m.BtcDelegations[len(m.BtcDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
 			}
-			var msglen int
+			m.TotalSat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4980,81 +10332,14 @@ func (m *QueryBTCDelegationsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TotalSat |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5082,13 +10367,13 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
+			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5098,83 +10383,29 @@ func (m *QueryFinalityProviderDelegationsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFinalityProviderDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegatorDelegations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5184,29 +10415,27 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BtcDelegatorDelegations = append(m.BtcDelegatorDelegations, &BTCDelegatorDelegationsResponse{})
-			if err := m.BtcDelegatorDelegations[len(m.BtcDelegatorDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5233,66 +10462,53 @@ func (m *QueryFinalityProviderDelegationsResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.StakingOutputIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingOutputIdx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Active = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5320,61 +10536,30 @@ func (m *QueryBTCDelegationRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			m.StatusDesc = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UnbondingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingTime |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcDelegation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5401,68 +10586,18 @@ func (m *QueryBTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BtcDelegation == nil {
-				m.BtcDelegation = &BTCDelegationResponse{}
+			if m.UndelegationResponse == nil {
+				m.UndelegationResponse = &BTCUndelegationResponse{}
 			}
-			if err := m.BtcDelegation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakerAddr", wireType)
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
 			}
-			var stringLen uint64
+			m.ParamsVersion = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5472,29 +10607,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ParamsVersion |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StakerAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StkExp", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5504,30 +10626,31 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StkExp == nil {
+				m.StkExp = &StakeExpansionResponse{}
+			}
+			if err := m.StkExp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpEotsPk", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5555,16 +10678,66 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.FpBtcPkList = append(m.FpBtcPkList, v)
-			if err := m.FpBtcPkList[len(m.FpBtcPkList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FpEotsPk = &v
+			if err := m.FpEotsPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTime", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakeExpansionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakeExpansionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakeExpansionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHashHex", wireType)
 			}
-			m.StakingTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5574,35 +10747,29 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StakingTime |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.StartHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartHeight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EndHeight = 0
+			m.PreviousStakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherFundingTxOutHex", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5612,35 +10779,29 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndHeight |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalSat", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.TotalSat = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalSat |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 8:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OtherFundingTxOutHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStkCovenantSigs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5650,27 +10811,79 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingTxHex = string(dAtA[iNdEx:postIndex])
+			m.PreviousStkCovenantSigs = append(m.PreviousStkCovenantSigs, &SignatureInfo{})
+			if err := m.PreviousStkCovenantSigs[len(m.PreviousStkCovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5698,11 +10911,61 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			m.SpendStakeTxHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashSigHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5730,11 +10993,11 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DelegatorSlashSigHex = string(dAtA[iNdEx:postIndex])
+			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5761,53 +11024,14 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSigs = append(m.CovenantSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSigs[len(m.CovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingOutputIdx", wireType)
-			}
-			m.StakingOutputIdx = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StakingOutputIdx |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
+			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Active = bool(v != 0)
-		case 14:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusDesc", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5835,13 +11059,13 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StatusDesc = string(dAtA[iNdEx:postIndex])
+			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
 			}
-			m.UnbondingTime = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5851,14 +11075,27 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingTime |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndelegationResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5885,18 +11122,16 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.UndelegationResponse == nil {
-				m.UndelegationResponse = &BTCUndelegationResponse{}
-			}
-			if err := m.UndelegationResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
+			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ParamsVersion", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingInfoResponse", wireType)
 			}
-			m.ParamsVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5906,16 +11141,33 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ParamsVersion |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 18:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DelegatorUnbondingInfoResponse == nil {
+				m.DelegatorUnbondingInfoResponse = &DelegatorUnbondingInfoResponse{}
+			}
+			if err := m.DelegatorUnbondingInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StkExp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingAggSigHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5925,27 +11177,23 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StkExp == nil {
-				m.StkExp = &StakeExpansionResponse{}
-			}
-			if err := m.StkExp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CovenantUnbondingAggSigHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5968,7 +11216,7 @@ func (m *BTCDelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StakeExpansionResponse) Unmarshal(dAtA []byte) error {
+func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5991,79 +11239,15 @@ func (m *StakeExpansionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StakeExpansionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StakeExpansionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHashHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PreviousStakingTxHashHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OtherFundingTxOutHex", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OtherFundingTxOutHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStkCovenantSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6090,8 +11274,8 @@ func (m *StakeExpansionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousStkCovenantSigs = append(m.PreviousStkCovenantSigs, &SignatureInfo{})
-			if err := m.PreviousStkCovenantSigs[len(m.PreviousStkCovenantSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Dels = append(m.Dels, &BTCDelegationResponse{})
+			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6116,7 +11300,7 @@ func (m *StakeExpansionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6139,17 +11323,17 @@ func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DelegatorUnbondingInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendStakeTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6159,77 +11343,67 @@ func (m *DelegatorUnbondingInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendStakeTxHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			if m.Description == nil {
+				m.Description = &types.Description{}
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCUndelegationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6257,13 +11431,13 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UnbondingTxHex = string(dAtA[iNdEx:postIndex])
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantUnbondingSigList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6273,31 +11447,32 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantUnbondingSigList = append(m.CovenantUnbondingSigList, &SignatureInfo{})
-			if err := m.CovenantUnbondingSigList[len(m.CovenantUnbondingSigList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6307,29 +11482,33 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashingTxHex = string(dAtA[iNdEx:postIndex])
+			if m.Pop == nil {
+				m.Pop = &ProofOfPossessionBTC{}
+			}
+			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorSlashingSigHex", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
 			}
-			var stringLen uint64
+			m.SlashedBabylonHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6339,27 +11518,91 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.SlashedBtcHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashedBtcHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.DelegatorSlashingSigHex = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestVotedHeight", wireType)
+			}
+			m.HighestVotedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HighestVotedHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CovenantSlashingSigs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6386,14 +11629,36 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CovenantSlashingSigs = append(m.CovenantSlashingSigs, &CovenantAdaptorSignatures{})
-			if err := m.CovenantSlashingSigs[len(m.CovenantSlashingSigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CommissionInfo == nil {
+				m.CommissionInfo = &CommissionInfo{}
+			}
+			if err := m.CommissionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftDeleted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SoftDeleted = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingInfoResponse", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6420,13 +11685,49 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DelegatorUnbondingInfoResponse == nil {
-				m.DelegatorUnbondingInfoResponse = &DelegatorUnbondingInfoResponse{}
-			}
-			if err := m.DelegatorUnbondingInfoResponse.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KeyRotations = append(m.KeyRotations, &FinalityProviderKeyRotation{})
+			if err := m.KeyRotations[len(m.KeyRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedSats", wireType)
+			}
+			m.MaxDelegatedSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDelegatedSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedSats", wireType)
+			}
+			m.DelegatedSats = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DelegatedSats |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6448,7 +11749,7 @@ func (m *BTCUndelegationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLargestBtcReOrgRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6467,50 +11768,16 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BTCDelegatorDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dels", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Dels = append(m.Dels, &BTCDelegationResponse{})
-			if err := m.Dels[len(m.Dels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLargestBtcReOrgRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLargestBtcReOrgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6532,7 +11799,7 @@ func (m *BTCDelegatorDelegationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6555,17 +11822,17 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLargestBtcReOrgResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLargestBtcReOrgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockDiff", wireType)
 			}
-			var msglen int
+			m.BlockDiff = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6575,33 +11842,16 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.BlockDiff |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Description == nil {
-				m.Description = &types.Description{}
-			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackFrom", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6611,33 +11861,33 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.RollbackFrom == nil {
+				m.RollbackFrom = &types1.BTCHeaderInfoResponse{}
+			}
+			if err := m.RollbackFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RollbackTo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6647,62 +11897,81 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			if m.RollbackTo == nil {
+				m.RollbackTo = &types1.BTCHeaderInfoResponse{}
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.RollbackTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsVersionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
-		case 5:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsVersionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6729,112 +11998,66 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pop == nil {
-				m.Pop = &ProofOfPossessionBTC{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.Pop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBabylonHeight", wireType)
-			}
-			m.SlashedBabylonHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBabylonHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashedBtcHeight", wireType)
-			}
-			m.SlashedBtcHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SlashedBtcHeight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsVersionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Jailed = bool(v != 0)
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestVotedHeight", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HighestVotedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HighestVotedHeight |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 11:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsVersionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommissionInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6861,18 +12084,16 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CommissionInfo == nil {
-				m.CommissionInfo = &CommissionInfo{}
-			}
-			if err := m.CommissionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Params = append(m.Params, StoredParams{})
+			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoftDeleted", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6882,12 +12103,28 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.SoftDeleted = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6909,7 +12146,7 @@ func (m *FinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLargestBtcReOrgRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantMuSig2SessionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6932,12 +12169,44 @@ func (m *QueryLargestBtcReOrgRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLargestBtcReOrgRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantMuSig2SessionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLargestBtcReOrgRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantMuSig2SessionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHashHex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHashHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6959,7 +12228,7 @@ func (m *QueryLargestBtcReOrgRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
+func (m *CovenantMuSig2SessionEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6982,17 +12251,17 @@ func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLargestBtcReOrgResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CovenantMuSig2SessionEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLargestBtcReOrgResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CovenantMuSig2SessionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockDiff", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CovenantBtcPkHex", wireType)
 			}
-			m.BlockDiff = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7002,16 +12271,29 @@ func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockDiff |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CovenantBtcPkHex = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollbackFrom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubNonceHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7021,33 +12303,29 @@ func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollbackFrom == nil {
-				m.RollbackFrom = &types1.BTCHeaderInfoResponse{}
-			}
-			if err := m.RollbackFrom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PubNonceHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollbackTo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSigHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7057,27 +12335,23 @@ func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.RollbackTo == nil {
-				m.RollbackTo = &types1.BTCHeaderInfoResponse{}
-			}
-			if err := m.RollbackTo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PartialSigHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7100,7 +12374,7 @@ func (m *QueryLargestBtcReOrgResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsVersionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCovenantMuSig2SessionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7123,15 +12397,15 @@ func (m *QueryParamsVersionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsVersionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCovenantMuSig2SessionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsVersionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCovenantMuSig2SessionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7158,68 +12432,16 @@ func (m *QueryParamsVersionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, &CovenantMuSig2SessionEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsVersionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsVersionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsVersionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedNonceHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7229,31 +12451,29 @@ func (m *QueryParamsVersionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Params = append(m.Params, StoredParams{})
-			if err := m.Params[len(m.Params)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AggregatedNonceHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatedSigHex", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7263,27 +12483,23 @@ func (m *QueryParamsVersionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AggregatedSigHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
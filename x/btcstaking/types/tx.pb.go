@@ -207,6 +207,10 @@ type MsgEditFinalityProvider struct {
 	Description *types.Description `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
 	// commission defines the updated commission rate of the finality provider
 	Commission *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=commission,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission,omitempty"`
+	// max_delegated_sats defines the updated maximum amount of satoshis the
+	// finality provider accepts to be delegated to it. It is left unchanged
+	// if not set, and setting it to 0 removes the cap
+	MaxDelegatedSats *cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_delegated_sats,json=maxDelegatedSats,proto3,customtype=cosmossdk.io/math.Int" json:"max_delegated_sats,omitempty"`
 }
 
 func (m *MsgEditFinalityProvider) Reset()         { *m = MsgEditFinalityProvider{} }
@@ -806,7 +810,10 @@ type MsgAddCovenantSigs struct {
 	// of the corresponding delegation
 	SlashingTxSigs [][]byte `protobuf:"bytes,4,rep,name=slashing_tx_sigs,json=slashingTxSigs,proto3" json:"slashing_tx_sigs,omitempty"`
 	// unbonding_tx_sig is the signature of the covenant on the unbonding tx
-	// submitted to babylon the signature follows encoding in BIP-340 spec
+	// submitted to babylon the signature follows encoding in BIP-340 spec.
+	// It must be empty if the BTC delegation was created under parameters with
+	// covenant_musig2_unbonding enabled, as the unbonding tx is then signed
+	// through MsgAddCovenantMuSig2Nonce and MsgAddCovenantMuSig2PartialSig
 	UnbondingTxSig *github_com_babylonlabs_io_babylon_v4_types.BIP340Signature `protobuf:"bytes,5,opt,name=unbonding_tx_sig,json=unbondingTxSig,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340Signature" json:"unbonding_tx_sig,omitempty"`
 	// slashing_unbonding_tx_sigs is a list of adaptor signatures of the covenant
 	// on slashing tx corresponding to unbonding tx submitted to babylon
@@ -917,6 +924,225 @@ func (m *MsgAddCovenantSigsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddCovenantSigsResponse proto.InternalMessageInfo
 
+// MsgAddCovenantMuSig2Nonce is the message for committing to the public nonce
+// of a covenant member in the first round of MuSig2 signing of the unbonding
+// tx. It is only accepted if the BTC delegation was created under parameters
+// with covenant_musig2_unbonding enabled. Submitting a nonce different from
+// the one already committed to resets the signing session
+type MsgAddCovenantMuSig2Nonce struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// pk is the BTC public key of the covenant member
+	Pk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,2,opt,name=pk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// pub_nonce is the 66 bytes MuSig2 public nonce of the covenant member
+	PubNonce []byte `protobuf:"bytes,4,opt,name=pub_nonce,json=pubNonce,proto3" json:"pub_nonce,omitempty"`
+}
+
+func (m *MsgAddCovenantMuSig2Nonce) Reset()         { *m = MsgAddCovenantMuSig2Nonce{} }
+func (m *MsgAddCovenantMuSig2Nonce) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantMuSig2Nonce) ProtoMessage()    {}
+func (*MsgAddCovenantMuSig2Nonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{13}
+}
+func (m *MsgAddCovenantMuSig2Nonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantMuSig2Nonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantMuSig2Nonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantMuSig2Nonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantMuSig2Nonce.Merge(m, src)
+}
+func (m *MsgAddCovenantMuSig2Nonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantMuSig2Nonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantMuSig2Nonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantMuSig2Nonce proto.InternalMessageInfo
+
+func (m *MsgAddCovenantMuSig2Nonce) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantMuSig2Nonce) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantMuSig2Nonce) GetPubNonce() []byte {
+	if m != nil {
+		return m.PubNonce
+	}
+	return nil
+}
+
+// MsgAddCovenantMuSig2NonceResponse is the response for
+// MsgAddCovenantMuSig2Nonce
+type MsgAddCovenantMuSig2NonceResponse struct {
+}
+
+func (m *MsgAddCovenantMuSig2NonceResponse) Reset()         { *m = MsgAddCovenantMuSig2NonceResponse{} }
+func (m *MsgAddCovenantMuSig2NonceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantMuSig2NonceResponse) ProtoMessage()    {}
+func (*MsgAddCovenantMuSig2NonceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{14}
+}
+func (m *MsgAddCovenantMuSig2NonceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantMuSig2NonceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantMuSig2NonceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantMuSig2NonceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantMuSig2NonceResponse.Merge(m, src)
+}
+func (m *MsgAddCovenantMuSig2NonceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantMuSig2NonceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantMuSig2NonceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantMuSig2NonceResponse proto.InternalMessageInfo
+
+// MsgAddCovenantMuSig2PartialSig is the message for submitting MuSig2 partial
+// signature of a covenant member on the unbonding tx. Partial signatures can
+// be submitted only after all covenant members committed to their nonces.
+// Once partial signatures of all covenant members are received, they are
+// aggregated into a single BIP-340 signature
+type MsgAddCovenantMuSig2PartialSig struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// pk is the BTC public key of the covenant member
+	Pk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,2,opt,name=pk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"pk,omitempty"`
+	// staking_tx_hash is the hash of the staking tx.
+	// It uniquely identifies a BTC delegation
+	StakingTxHash string `protobuf:"bytes,3,opt,name=staking_tx_hash,json=stakingTxHash,proto3" json:"staking_tx_hash,omitempty"`
+	// partial_sig is the 32 bytes MuSig2 partial signature of the covenant
+	// member
+	PartialSig []byte `protobuf:"bytes,4,opt,name=partial_sig,json=partialSig,proto3" json:"partial_sig,omitempty"`
+}
+
+func (m *MsgAddCovenantMuSig2PartialSig) Reset()         { *m = MsgAddCovenantMuSig2PartialSig{} }
+func (m *MsgAddCovenantMuSig2PartialSig) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantMuSig2PartialSig) ProtoMessage()    {}
+func (*MsgAddCovenantMuSig2PartialSig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{15}
+}
+func (m *MsgAddCovenantMuSig2PartialSig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantMuSig2PartialSig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantMuSig2PartialSig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantMuSig2PartialSig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantMuSig2PartialSig.Merge(m, src)
+}
+func (m *MsgAddCovenantMuSig2PartialSig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantMuSig2PartialSig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantMuSig2PartialSig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantMuSig2PartialSig proto.InternalMessageInfo
+
+func (m *MsgAddCovenantMuSig2PartialSig) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantMuSig2PartialSig) GetStakingTxHash() string {
+	if m != nil {
+		return m.StakingTxHash
+	}
+	return ""
+}
+
+func (m *MsgAddCovenantMuSig2PartialSig) GetPartialSig() []byte {
+	if m != nil {
+		return m.PartialSig
+	}
+	return nil
+}
+
+// MsgAddCovenantMuSig2PartialSigResponse is the response for
+// MsgAddCovenantMuSig2PartialSig
+type MsgAddCovenantMuSig2PartialSigResponse struct {
+}
+
+func (m *MsgAddCovenantMuSig2PartialSigResponse) Reset() {
+	*m = MsgAddCovenantMuSig2PartialSigResponse{}
+}
+func (m *MsgAddCovenantMuSig2PartialSigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCovenantMuSig2PartialSigResponse) ProtoMessage()    {}
+func (*MsgAddCovenantMuSig2PartialSigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4baddb53e97f38f2, []int{16}
+}
+func (m *MsgAddCovenantMuSig2PartialSigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddCovenantMuSig2PartialSigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCovenantMuSig2PartialSigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddCovenantMuSig2PartialSigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCovenantMuSig2PartialSigResponse.Merge(m, src)
+}
+func (m *MsgAddCovenantMuSig2PartialSigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddCovenantMuSig2PartialSigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCovenantMuSig2PartialSigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddCovenantMuSig2PartialSigResponse proto.InternalMessageInfo
+
 // MsgBTCUndelegate is the message for handling signature on unbonding tx
 // from its delegator. This signature effectively proves that the delegator
 // wants to unbond this BTC delegation
@@ -940,7 +1166,7 @@ func (m *MsgBTCUndelegate) Reset()         { *m = MsgBTCUndelegate{} }
 func (m *MsgBTCUndelegate) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegate) ProtoMessage()    {}
 func (*MsgBTCUndelegate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{13}
+	return fileDescriptor_4baddb53e97f38f2, []int{17}
 }
 func (m *MsgBTCUndelegate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1012,7 +1238,7 @@ func (m *MsgBTCUndelegateResponse) Reset()         { *m = MsgBTCUndelegateRespon
 func (m *MsgBTCUndelegateResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBTCUndelegateResponse) ProtoMessage()    {}
 func (*MsgBTCUndelegateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{14}
+	return fileDescriptor_4baddb53e97f38f2, []int{18}
 }
 func (m *MsgBTCUndelegateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1056,7 +1282,7 @@ func (m *MsgSelectiveSlashingEvidence) Reset()         { *m = MsgSelectiveSlashi
 func (m *MsgSelectiveSlashingEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidence) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{15}
+	return fileDescriptor_4baddb53e97f38f2, []int{19}
 }
 func (m *MsgSelectiveSlashingEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1334,7 @@ func (m *MsgSelectiveSlashingEvidenceResponse) Reset()         { *m = MsgSelecti
 func (m *MsgSelectiveSlashingEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSelectiveSlashingEvidenceResponse) ProtoMessage()    {}
 func (*MsgSelectiveSlashingEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{16}
+	return fileDescriptor_4baddb53e97f38f2, []int{20}
 }
 func (m *MsgSelectiveSlashingEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1148,13 +1374,19 @@ type MsgUpdateParams struct {
 	//
 	// NOTE: All parameters must be supplied.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// skip_covenant_overlap_check skips the requirement that a new covenant
+	// committee keeps a quorum of members of every covenant committee that
+	// still governs BTC delegations, e.g., to replace a compromised committee.
+	// The pending BTC delegations bound to a committee replaced this way can
+	// no longer collect a quorum of covenant signatures.
+	SkipCovenantOverlapCheck bool `protobuf:"varint,3,opt,name=skip_covenant_overlap_check,json=skipCovenantOverlapCheck,proto3" json:"skip_covenant_overlap_check,omitempty"`
 }
 
 func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{17}
+	return fileDescriptor_4baddb53e97f38f2, []int{21}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1197,6 +1429,13 @@ func (m *MsgUpdateParams) GetParams() Params {
 	return Params{}
 }
 
+func (m *MsgUpdateParams) GetSkipCovenantOverlapCheck() bool {
+	if m != nil {
+		return m.SkipCovenantOverlapCheck
+	}
+	return false
+}
+
 // MsgUpdateParamsResponse is the response to the MsgUpdateParams message.
 type MsgUpdateParamsResponse struct {
 }
@@ -1205,7 +1444,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4baddb53e97f38f2, []int{18}
+	return fileDescriptor_4baddb53e97f38f2, []int{22}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,6 +1487,10 @@ func init() {
 	proto.RegisterType((*MsgAddBTCDelegationInclusionProofResponse)(nil), "babylon.btcstaking.v1.MsgAddBTCDelegationInclusionProofResponse")
 	proto.RegisterType((*MsgAddCovenantSigs)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigs")
 	proto.RegisterType((*MsgAddCovenantSigsResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantSigsResponse")
+	proto.RegisterType((*MsgAddCovenantMuSig2Nonce)(nil), "babylon.btcstaking.v1.MsgAddCovenantMuSig2Nonce")
+	proto.RegisterType((*MsgAddCovenantMuSig2NonceResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantMuSig2NonceResponse")
+	proto.RegisterType((*MsgAddCovenantMuSig2PartialSig)(nil), "babylon.btcstaking.v1.MsgAddCovenantMuSig2PartialSig")
+	proto.RegisterType((*MsgAddCovenantMuSig2PartialSigResponse)(nil), "babylon.btcstaking.v1.MsgAddCovenantMuSig2PartialSigResponse")
 	proto.RegisterType((*MsgBTCUndelegate)(nil), "babylon.btcstaking.v1.MsgBTCUndelegate")
 	proto.RegisterType((*MsgBTCUndelegateResponse)(nil), "babylon.btcstaking.v1.MsgBTCUndelegateResponse")
 	proto.RegisterType((*MsgSelectiveSlashingEvidence)(nil), "babylon.btcstaking.v1.MsgSelectiveSlashingEvidence")
@@ -1259,110 +1502,122 @@ func init() {
 func init() { proto.RegisterFile("babylon/btcstaking/v1/tx.proto", fileDescriptor_4baddb53e97f38f2) }
 
 var fileDescriptor_4baddb53e97f38f2 = []byte{
-	// 1644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x58, 0xcd, 0x6f, 0xdb, 0x46,
-	0x16, 0x37, 0x25, 0xd9, 0x91, 0x9e, 0x3e, 0x2c, 0xd3, 0x5f, 0x32, 0x13, 0x4b, 0xb6, 0x92, 0x38,
-	0x8e, 0xb3, 0x96, 0xe2, 0x24, 0x9b, 0xec, 0xda, 0xd8, 0xc5, 0x46, 0xb2, 0x83, 0xcd, 0x87, 0x51,
-	0x87, 0x92, 0x7b, 0xe8, 0xa1, 0x2a, 0x45, 0x8d, 0x29, 0xc2, 0x12, 0x49, 0x70, 0x28, 0x41, 0x46,
-	0x81, 0xa2, 0x08, 0x0a, 0x04, 0x3d, 0x14, 0x28, 0x50, 0xa0, 0xa7, 0x1e, 0x7a, 0xec, 0xad, 0x39,
-	0xe4, 0x8f, 0xc8, 0x31, 0x08, 0x7a, 0x28, 0x7c, 0x30, 0x8a, 0xe4, 0x90, 0x5e, 0x0b, 0xf4, 0xde,
-	0x82, 0x43, 0x72, 0x48, 0x29, 0xa2, 0x2d, 0x7f, 0x20, 0xe8, 0xa1, 0x17, 0xc3, 0x9a, 0xf9, 0xbd,
-	0xdf, 0x7b, 0xf3, 0x9b, 0xf7, 0xde, 0xcc, 0x10, 0xd2, 0x55, 0xa1, 0xba, 0xd7, 0x50, 0x95, 0x7c,
-	0xd5, 0x10, 0xb1, 0x21, 0xec, 0xca, 0x8a, 0x94, 0x6f, 0xaf, 0xe4, 0x8d, 0x4e, 0x4e, 0xd3, 0x55,
-	0x43, 0x65, 0x27, 0xed, 0xf9, 0x9c, 0x3b, 0x9f, 0x6b, 0xaf, 0x70, 0x13, 0x92, 0x2a, 0xa9, 0x04,
-	0x91, 0x37, 0xff, 0xb3, 0xc0, 0xdc, 0x8c, 0xa8, 0xe2, 0xa6, 0x8a, 0x2b, 0xd6, 0x84, 0xf5, 0xc3,
-	0x9e, 0x9a, 0xb6, 0x7e, 0xe5, 0x9b, 0x98, 0xf0, 0x37, 0xb1, 0x64, 0x4f, 0x64, 0xfb, 0x07, 0xa0,
-	0x09, 0xba, 0xd0, 0x74, 0x8c, 0x2f, 0xd9, 0xc6, 0xee, 0x7c, 0x15, 0x19, 0xc2, 0x8a, 0xf3, 0xdb,
-	0x46, 0x65, 0x7c, 0x98, 0x54, 0xcd, 0x06, 0x2c, 0xf4, 0x07, 0x78, 0x56, 0x66, 0xe1, 0xc6, 0x84,
-	0xa6, 0xac, 0xa8, 0x79, 0xf2, 0xd7, 0x1a, 0xca, 0x3e, 0x0d, 0xc2, 0xcc, 0x26, 0x96, 0x8a, 0x3a,
-	0x12, 0x0c, 0x74, 0x4f, 0x56, 0x84, 0x86, 0x6c, 0xec, 0x6d, 0xe9, 0x6a, 0x5b, 0xae, 0x21, 0x9d,
-	0xfd, 0x07, 0x84, 0x84, 0x5a, 0x4d, 0x4f, 0x31, 0x73, 0xcc, 0x62, 0xa4, 0x90, 0x7a, 0xf5, 0x7c,
-	0x79, 0xc2, 0x5e, 0xfc, 0xdd, 0x5a, 0x4d, 0x47, 0x18, 0x97, 0x0c, 0x5d, 0x56, 0x24, 0x9e, 0xa0,
-	0xd8, 0x0d, 0x88, 0xd6, 0x10, 0x16, 0x75, 0x59, 0x33, 0x64, 0x55, 0x49, 0x05, 0xe6, 0x98, 0xc5,
-	0xe8, 0x8d, 0x8b, 0x39, 0xdb, 0xc2, 0x15, 0x99, 0xac, 0x31, 0xb7, 0xee, 0x42, 0x79, 0xaf, 0x1d,
-	0xcb, 0xc3, 0x48, 0xd5, 0x10, 0x2b, 0xda, 0x6e, 0x2a, 0x34, 0xc7, 0x2c, 0xc6, 0x0a, 0x6b, 0xfb,
-	0x07, 0x99, 0x3b, 0x92, 0x6c, 0xd4, 0x5b, 0xd5, 0x9c, 0xa8, 0x36, 0xf3, 0xf6, 0x62, 0x1b, 0x42,
-	0x15, 0x2f, 0xcb, 0xaa, 0xf3, 0x33, 0xdf, 0xbe, 0x95, 0x37, 0xf6, 0x34, 0x84, 0x73, 0x85, 0xfb,
-	0x5b, 0x37, 0x6f, 0x5d, 0xdf, 0x6a, 0x55, 0x1f, 0xa2, 0x3d, 0x7e, 0xb8, 0x6a, 0x88, 0x5b, 0xbb,
-	0xec, 0x7f, 0x20, 0xa8, 0xa9, 0x5a, 0x6a, 0x98, 0x84, 0x74, 0x2d, 0xd7, 0x77, 0xef, 0x73, 0x5b,
-	0xba, 0xaa, 0xee, 0x7c, 0xb0, 0xb3, 0xa5, 0x62, 0x8c, 0x30, 0x96, 0x55, 0xa5, 0x50, 0x2e, 0xf2,
-	0xa6, 0x1d, 0xfb, 0x18, 0x40, 0x54, 0x9b, 0x4d, 0x99, 0x8c, 0xa6, 0xce, 0x11, 0x96, 0x05, 0x1f,
-	0x96, 0x22, 0x05, 0xf2, 0x82, 0x81, 0x70, 0x21, 0xf2, 0xe2, 0x20, 0x33, 0xf4, 0xc3, 0xdb, 0x67,
-	0x4b, 0x0c, 0xef, 0x21, 0x59, 0x8d, 0x3c, 0x79, 0xfb, 0x6c, 0x89, 0xe8, 0xf6, 0x20, 0x14, 0x0e,
-	0x26, 0x43, 0xd9, 0x8b, 0x30, 0xef, 0xbb, 0x11, 0x3c, 0xc2, 0x9a, 0xaa, 0x60, 0x94, 0xfd, 0x36,
-	0x00, 0xa3, 0x3d, 0x0e, 0xd8, 0x07, 0x10, 0xd2, 0x05, 0x03, 0xd9, 0x9b, 0x74, 0xdb, 0x74, 0xb7,
-	0x7f, 0x90, 0x39, 0x6f, 0xc9, 0x8e, 0x6b, 0xbb, 0x39, 0x59, 0xcd, 0x37, 0x05, 0xa3, 0x9e, 0x7b,
-	0x84, 0x24, 0x41, 0xdc, 0x5b, 0x47, 0xe2, 0xab, 0xe7, 0xcb, 0x60, 0xef, 0xca, 0x3a, 0x12, 0xad,
-	0xd8, 0x08, 0x07, 0xfb, 0x18, 0xc2, 0x4d, 0xa1, 0x53, 0x21, 0x7c, 0x81, 0x53, 0xf1, 0x9d, 0x6b,
-	0x0a, 0x1d, 0x33, 0x3e, 0xf6, 0x63, 0x18, 0x35, 0x29, 0xc5, 0xba, 0xa0, 0x48, 0xc8, 0x62, 0x0e,
-	0x9e, 0x8a, 0x39, 0xde, 0x14, 0x3a, 0x45, 0xc2, 0x66, 0xf2, 0xaf, 0x86, 0x7e, 0xfd, 0x3e, 0xc3,
-	0x64, 0xff, 0x60, 0x60, 0x7a, 0x13, 0x4b, 0x1b, 0x35, 0xd9, 0x38, 0x65, 0x16, 0x4f, 0xd2, 0xf4,
-	0x33, 0x05, 0x88, 0x39, 0x19, 0xd4, 0x93, 0xdc, 0xc1, 0x13, 0x26, 0xf7, 0x66, 0x57, 0x26, 0x85,
-	0x48, 0x44, 0xcb, 0xc7, 0x12, 0xc1, 0x27, 0x8b, 0xb2, 0xf3, 0x90, 0xf1, 0x11, 0x80, 0x66, 0xcf,
-	0x37, 0x61, 0x98, 0xa2, 0x39, 0x56, 0x28, 0x17, 0xd7, 0x51, 0x03, 0x49, 0x02, 0x89, 0xeb, 0xdf,
-	0x10, 0x35, 0xd7, 0x80, 0xf4, 0xca, 0x40, 0x52, 0x81, 0x05, 0x36, 0x07, 0x9d, 0xda, 0x0a, 0x9c,
-	0xb0, 0xb6, 0xdc, 0x72, 0x0f, 0x9e, 0x59, 0xb9, 0x7f, 0x02, 0x89, 0x1d, 0xad, 0x62, 0xd1, 0x56,
-	0x1a, 0x32, 0x36, 0x52, 0xa1, 0xb9, 0xe0, 0x69, 0xb9, 0xa3, 0x3b, 0x5a, 0xc1, 0x64, 0x7f, 0x24,
-	0x63, 0x83, 0x9d, 0x87, 0x98, 0xbd, 0xba, 0x8a, 0x21, 0x37, 0x11, 0xe9, 0x2c, 0x71, 0x3e, 0x6a,
-	0x8f, 0x95, 0xe5, 0x26, 0x62, 0x2f, 0x42, 0xdc, 0x81, 0xb4, 0x85, 0x46, 0x0b, 0xa5, 0x46, 0xe6,
-	0x98, 0xc5, 0x20, 0xef, 0xd8, 0x7d, 0x68, 0x8e, 0xb1, 0xb3, 0x00, 0x94, 0xa7, 0x43, 0x3a, 0x4b,
-	0x8c, 0x8f, 0x38, 0x2c, 0x1d, 0xb6, 0x0a, 0x9c, 0x3b, 0x5d, 0x91, 0x15, 0xb1, 0xd1, 0x32, 0xc5,
-	0x33, 0x0f, 0x22, 0x75, 0x27, 0x15, 0x26, 0x92, 0x5f, 0xf6, 0x91, 0xfc, 0xbe, 0x83, 0x26, 0xda,
-	0xf3, 0xd3, 0x94, 0xb5, 0x7b, 0x82, 0xbd, 0x01, 0x51, 0xdc, 0x10, 0x70, 0xdd, 0x8e, 0x21, 0x42,
-	0x76, 0x61, 0x6c, 0xff, 0x20, 0x13, 0x2f, 0x94, 0x8b, 0x25, 0x7b, 0xa6, 0xdc, 0xe1, 0x01, 0xd3,
-	0xff, 0x59, 0x03, 0xa6, 0x6a, 0x56, 0xf2, 0xa8, 0x7a, 0x85, 0x5a, 0x63, 0x59, 0x4a, 0x01, 0x31,
-	0xff, 0xef, 0xfe, 0x41, 0x66, 0xf5, 0xd8, 0x42, 0x97, 0x64, 0x49, 0x11, 0x8c, 0x96, 0x8e, 0xf8,
-	0x09, 0xca, 0xee, 0x04, 0x50, 0x92, 0x25, 0xf6, 0x32, 0x24, 0x5a, 0x4a, 0x55, 0x55, 0x6a, 0x54,
-	0xf6, 0x28, 0x91, 0x3d, 0x4e, 0x47, 0x89, 0xf0, 0xf3, 0x10, 0xf3, 0xc0, 0x3a, 0xa9, 0x18, 0x51,
-	0x35, 0xea, 0x82, 0x3a, 0xec, 0x15, 0x18, 0x75, 0x21, 0xd6, 0xee, 0xc4, 0xc9, 0xee, 0xb8, 0x0e,
-	0xac, 0xfd, 0xd9, 0x80, 0x49, 0x17, 0xe8, 0x95, 0x29, 0xe1, 0x27, 0xd3, 0x38, 0xc5, 0xbb, 0x83,
-	0xec, 0x53, 0x06, 0xe6, 0x5c, 0xc1, 0xfa, 0x30, 0x9a, 0xd2, 0x8d, 0x9e, 0x89, 0x74, 0xb3, 0xd4,
-	0xcf, 0x76, 0x6f, 0x20, 0x25, 0x59, 0x5a, 0x4d, 0x9a, 0x1d, 0xc3, 0x5b, 0xeb, 0xd9, 0x39, 0x48,
-	0xf7, 0x6f, 0x0a, 0xb4, 0x6f, 0x3c, 0x09, 0xc3, 0xd8, 0x26, 0x96, 0x0a, 0x86, 0x58, 0x32, 0xed,
-	0x36, 0x3a, 0x9a, 0xa0, 0xd4, 0xfe, 0x6e, 0x19, 0x7f, 0xcd, 0x96, 0xd1, 0x53, 0xce, 0xe1, 0xd3,
-	0x95, 0x73, 0xe4, 0xbd, 0x96, 0x33, 0x0c, 0x52, 0xce, 0xd1, 0x81, 0xca, 0x39, 0x76, 0xbc, 0x72,
-	0x8e, 0x9f, 0x7d, 0x39, 0x27, 0xde, 0x43, 0x39, 0xb3, 0x77, 0x20, 0xa5, 0xe9, 0xa8, 0x2d, 0xab,
-	0x2d, 0x5c, 0xf1, 0x9c, 0x14, 0x75, 0x01, 0xd7, 0x49, 0x3f, 0x89, 0xf0, 0x93, 0xce, 0x7c, 0xc9,
-	0x49, 0x91, 0xff, 0x0b, 0xb8, 0x6e, 0x66, 0xd1, 0x4e, 0x8b, 0x6a, 0x9a, 0xb4, 0xb2, 0xc8, 0x1e,
-	0x29, 0x77, 0xfa, 0xb4, 0x89, 0xf3, 0xe4, 0xa1, 0xd0, 0xdd, 0x03, 0x68, 0x87, 0xf8, 0x89, 0x21,
-	0xb7, 0xd7, 0xbb, 0xb5, 0x5a, 0x57, 0x07, 0xe9, 0x39, 0x69, 0xa6, 0x60, 0x04, 0xcb, 0x92, 0x82,
-	0xec, 0x66, 0xc1, 0xdb, 0xbf, 0xd8, 0x05, 0x18, 0xed, 0x8d, 0x9d, 0x5c, 0x3e, 0xf9, 0x38, 0xee,
-	0x8a, 0xf9, 0xf0, 0xd3, 0x30, 0x78, 0x16, 0xa7, 0xe1, 0x6a, 0xd4, 0x5c, 0xb8, 0x1d, 0x58, 0xf6,
-	0x1a, 0x5c, 0x3d, 0x72, 0x55, 0x54, 0x83, 0xdf, 0x83, 0xc0, 0x5a, 0xe8, 0xa2, 0xda, 0x46, 0x8a,
-	0xa0, 0x18, 0x25, 0x59, 0xc2, 0xbe, 0x8b, 0x7e, 0x08, 0x01, 0xe7, 0x8e, 0x79, 0xba, 0x26, 0x13,
-	0xd0, 0x76, 0xfb, 0x29, 0x18, 0xec, 0xa7, 0xe0, 0x22, 0x24, 0x3d, 0x59, 0x6f, 0xa6, 0x29, 0xb6,
-	0xfa, 0x1c, 0x9f, 0x70, 0xdb, 0x01, 0x09, 0xbb, 0x0e, 0x49, 0x6f, 0xd5, 0x91, 0x8c, 0x1e, 0x3e,
-	0x93, 0x8c, 0x4e, 0x78, 0x2a, 0xd7, 0x4c, 0xe1, 0x35, 0xe0, 0x68, 0x4c, 0xbd, 0x2e, 0x71, 0x6a,
-	0x84, 0x44, 0x37, 0xed, 0x20, 0xb6, 0xbb, 0x6c, 0x31, 0x8b, 0x61, 0x8a, 0x24, 0x69, 0x05, 0x99,
-	0x09, 0x49, 0xb2, 0xc1, 0x0e, 0xf6, 0xdc, 0x99, 0x04, 0x3b, 0x8e, 0x69, 0xb6, 0x9b, 0xe4, 0xc4,
-	0x6b, 0x77, 0x8e, 0x5c, 0x00, 0xee, 0xdd, 0x5d, 0xa7, 0x49, 0xf1, 0x63, 0x00, 0x92, 0x66, 0xd9,
-	0x94, 0x8b, 0xdb, 0x8a, 0x5d, 0xc9, 0xe8, 0xd4, 0x75, 0xb0, 0x04, 0x63, 0xd6, 0xa2, 0xb1, 0x86,
-	0x68, 0x09, 0x93, 0xa3, 0x90, 0x27, 0x04, 0xa8, 0x64, 0x8f, 0x97, 0x3b, 0xac, 0x0a, 0xf3, 0xef,
-	0x60, 0xdf, 0x29, 0x9d, 0xd0, 0x71, 0x4a, 0x67, 0xb6, 0xc7, 0x45, 0x4f, 0x91, 0xaf, 0xc0, 0x04,
-	0x6d, 0x2c, 0xba, 0xa0, 0x60, 0x41, 0x34, 0x6b, 0x06, 0xa7, 0x86, 0xc9, 0x46, 0x8e, 0x3b, 0x2d,
-	0xc6, 0x33, 0xd5, 0xad, 0x27, 0x07, 0xa9, 0x5e, 0xc1, 0xa8, 0x9a, 0x5f, 0x32, 0x70, 0x61, 0x13,
-	0x4b, 0x25, 0xd4, 0x40, 0xa2, 0x21, 0xb7, 0x91, 0xd3, 0x09, 0x37, 0xcc, 0x77, 0x8e, 0x22, 0xfa,
-	0x2b, 0xbb, 0x0c, 0xe3, 0x3a, 0x12, 0xd5, 0x36, 0xd2, 0x51, 0xad, 0x62, 0x9f, 0xf3, 0xd8, 0xbe,
-	0x3e, 0xf0, 0x49, 0x3a, 0x75, 0xcf, 0x3c, 0xae, 0x4b, 0xbb, 0x5d, 0x01, 0x3d, 0x08, 0x85, 0x03,
-	0xc9, 0x20, 0xdf, 0xbb, 0x33, 0xd9, 0x05, 0xb8, 0x74, 0x58, 0x28, 0xee, 0x93, 0x9d, 0x81, 0xd1,
-	0x4d, 0x2c, 0x6d, 0x6b, 0x35, 0xc1, 0x40, 0x5b, 0xe4, 0xeb, 0x0f, 0x7b, 0x1b, 0x22, 0x42, 0xcb,
-	0xa8, 0xab, 0xba, 0x6c, 0xec, 0x1d, 0x79, 0x71, 0x72, 0xa1, 0xec, 0x1a, 0x8c, 0x58, 0xdf, 0x8f,
-	0xec, 0xab, 0xd3, 0xac, 0xdf, 0xd5, 0x89, 0x80, 0x0a, 0x21, 0xf3, 0x85, 0xcd, 0xdb, 0x26, 0xab,
-	0x09, 0x73, 0x51, 0x2e, 0x59, 0x76, 0x86, 0xbc, 0x98, 0xbd, 0x71, 0x39, 0x31, 0xdf, 0xf8, 0x2d,
-	0x0c, 0xc1, 0x4d, 0x2c, 0xb1, 0x5f, 0x30, 0x30, 0xe5, 0xf3, 0x69, 0xe8, 0xba, 0x8f, 0x6b, 0xdf,
-	0x6f, 0x18, 0xdc, 0xbf, 0x8e, 0x6b, 0xe1, 0x84, 0xc3, 0x7e, 0x06, 0x13, 0x7d, 0x1f, 0xf6, 0x39,
-	0x7f, 0xc6, 0x7e, 0x78, 0xee, 0xf6, 0xf1, 0xf0, 0xd4, 0xff, 0xa7, 0x30, 0xde, 0xef, 0xcd, 0xbc,
-	0x7c, 0xd4, 0x82, 0xba, 0xe0, 0xdc, 0x3f, 0x8f, 0x05, 0xa7, 0xce, 0xbf, 0x63, 0x20, 0x7d, 0xc4,
-	0xb9, 0x7a, 0x88, 0xb2, 0x87, 0x5b, 0x72, 0xff, 0x3b, 0xa9, 0x25, 0x0d, 0x4f, 0x85, 0xd1, 0xde,
-	0x13, 0xef, 0xea, 0xa1, 0xa4, 0x5e, 0x28, 0xb7, 0x32, 0x30, 0x94, 0x3a, 0x94, 0x21, 0xde, 0xdd,
-	0x4d, 0xaf, 0xf8, 0x73, 0x74, 0x01, 0xb9, 0xfc, 0x80, 0x40, 0xea, 0xea, 0x2b, 0x06, 0x66, 0xfc,
-	0x7b, 0xcd, 0x4d, 0x7f, 0x3a, 0x5f, 0x23, 0x6e, 0xed, 0x04, 0x46, 0x34, 0x9e, 0x1d, 0x88, 0x75,
-	0xb5, 0x91, 0x05, 0x7f, 0x32, 0x2f, 0x8e, 0xcb, 0x0d, 0x86, 0xa3, 0x7e, 0x1a, 0x90, 0xe8, 0x79,
-	0xeb, 0x2d, 0x1e, 0x22, 0x5d, 0x17, 0x92, 0xbb, 0x3e, 0x28, 0xd2, 0xf1, 0xc6, 0x0d, 0x7f, 0xfe,
-	0xf6, 0xd9, 0x12, 0x53, 0x78, 0xfc, 0xe2, 0x75, 0x9a, 0x79, 0xf9, 0x3a, 0xcd, 0xfc, 0xf2, 0x3a,
-	0xcd, 0x7c, 0xfd, 0x26, 0x3d, 0xf4, 0xf2, 0x4d, 0x7a, 0xe8, 0xe7, 0x37, 0xe9, 0xa1, 0x8f, 0x06,
-	0xbb, 0x19, 0x75, 0xbc, 0x5f, 0xbf, 0xc9, 0x61, 0x5e, 0x1d, 0x21, 0xdf, 0xb8, 0x6f, 0xfe, 0x19,
-	0x00, 0x00, 0xff, 0xff, 0xa8, 0x1b, 0xbe, 0x4e, 0x0c, 0x18, 0x00, 0x00,
+	// 1836 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf6, 0x92, 0xb4, 0x4c, 0x3e, 0x8a, 0x12, 0xbd, 0xfa, 0x45, 0xad, 0x62, 0x52, 0xa2, 0x13,
+	0x59, 0x71, 0x2a, 0xd2, 0xb2, 0x13, 0x27, 0x95, 0xe1, 0xa2, 0x21, 0xad, 0xa0, 0x76, 0xa2, 0x46,
+	0x5e, 0x4a, 0x3d, 0xf4, 0x50, 0x76, 0xb8, 0x1c, 0x2d, 0x17, 0x22, 0x77, 0x17, 0x3b, 0x43, 0x82,
+	0x42, 0x81, 0xa2, 0x08, 0x0a, 0x04, 0x3d, 0xb4, 0x28, 0x10, 0xa0, 0xa7, 0x1e, 0x7a, 0xec, 0xad,
+	0x39, 0xe4, 0x8f, 0xc8, 0x31, 0x30, 0xda, 0xa2, 0x50, 0x01, 0xa1, 0xb0, 0x0f, 0xe9, 0x1f, 0xd0,
+	0x3f, 0xa0, 0x98, 0xd9, 0xdd, 0x21, 0x97, 0xe2, 0x52, 0xa4, 0x24, 0x04, 0x3e, 0xe4, 0x62, 0x78,
+	0x67, 0xbe, 0xf7, 0xcd, 0x9b, 0x6f, 0xbe, 0x79, 0x33, 0x1c, 0x41, 0xb6, 0x86, 0x6a, 0xc7, 0x4d,
+	0xcb, 0x2c, 0xd6, 0xa8, 0x46, 0x28, 0x3a, 0x32, 0x4c, 0xbd, 0xd8, 0xd9, 0x2a, 0xd2, 0x6e, 0xc1,
+	0x76, 0x2c, 0x6a, 0xc9, 0x0b, 0x5e, 0x7f, 0xa1, 0xd7, 0x5f, 0xe8, 0x6c, 0x29, 0xf3, 0xba, 0xa5,
+	0x5b, 0x1c, 0x51, 0x64, 0xff, 0x73, 0xc1, 0xca, 0xb2, 0x66, 0x91, 0x96, 0x45, 0xaa, 0x6e, 0x87,
+	0xfb, 0xe1, 0x75, 0x2d, 0xb9, 0x5f, 0xc5, 0x16, 0xe1, 0xfc, 0x2d, 0xa2, 0x7b, 0x1d, 0xf9, 0xe1,
+	0x09, 0xd8, 0xc8, 0x41, 0x2d, 0x3f, 0xf8, 0x4d, 0x2f, 0xb8, 0xd7, 0x5f, 0xc3, 0x14, 0x6d, 0xf9,
+	0xdf, 0x1e, 0x2a, 0x17, 0xc2, 0x64, 0xd9, 0x1e, 0x60, 0x7d, 0x38, 0xa0, 0xf7, 0xe5, 0xe1, 0x6e,
+	0xa2, 0x96, 0x61, 0x5a, 0x45, 0xfe, 0xaf, 0xdb, 0x94, 0xff, 0x3c, 0x0a, 0xcb, 0xbb, 0x44, 0x2f,
+	0x3b, 0x18, 0x51, 0xfc, 0x91, 0x61, 0xa2, 0xa6, 0x41, 0x8f, 0xf7, 0x1c, 0xab, 0x63, 0xd4, 0xb1,
+	0x23, 0xff, 0x00, 0x62, 0xa8, 0x5e, 0x77, 0x32, 0xd2, 0xaa, 0xb4, 0x91, 0x28, 0x65, 0x5e, 0x7c,
+	0xb5, 0x39, 0xef, 0x4d, 0xfe, 0xc3, 0x7a, 0xdd, 0xc1, 0x84, 0x54, 0xa8, 0x63, 0x98, 0xba, 0xca,
+	0x51, 0xf2, 0x0e, 0x24, 0xeb, 0x98, 0x68, 0x8e, 0x61, 0x53, 0xc3, 0x32, 0x33, 0x91, 0x55, 0x69,
+	0x23, 0x79, 0xff, 0x76, 0xc1, 0x8b, 0xe8, 0x89, 0xcc, 0xe7, 0x58, 0x78, 0xd2, 0x83, 0xaa, 0xfd,
+	0x71, 0xb2, 0x0a, 0x53, 0x35, 0xaa, 0x55, 0xed, 0xa3, 0x4c, 0x6c, 0x55, 0xda, 0x98, 0x2e, 0x3d,
+	0x3a, 0x39, 0xcd, 0xbd, 0xaf, 0x1b, 0xb4, 0xd1, 0xae, 0x15, 0x34, 0xab, 0x55, 0xf4, 0x26, 0xdb,
+	0x44, 0x35, 0xb2, 0x69, 0x58, 0xfe, 0x67, 0xb1, 0xf3, 0x6e, 0x91, 0x1e, 0xdb, 0x98, 0x14, 0x4a,
+	0x4f, 0xf7, 0x1e, 0xbc, 0x7b, 0x6f, 0xaf, 0x5d, 0xfb, 0x18, 0x1f, 0xab, 0xd7, 0x6b, 0x54, 0xdb,
+	0x3b, 0x92, 0x1f, 0x43, 0xd4, 0xb6, 0xec, 0xcc, 0x75, 0x9e, 0xd2, 0x3b, 0x85, 0xa1, 0x6b, 0x5f,
+	0xd8, 0x73, 0x2c, 0xeb, 0xf0, 0xd3, 0xc3, 0x3d, 0x8b, 0x10, 0x4c, 0x88, 0x61, 0x99, 0xa5, 0xfd,
+	0xb2, 0xca, 0xe2, 0xe4, 0xe7, 0x00, 0x9a, 0xd5, 0x6a, 0x19, 0xbc, 0x35, 0x73, 0x83, 0xb3, 0xac,
+	0x87, 0xb0, 0x94, 0x05, 0x50, 0x45, 0x14, 0x93, 0x52, 0xe2, 0xeb, 0xd3, 0xdc, 0xb5, 0xbf, 0x7e,
+	0xfb, 0xe5, 0x5d, 0x49, 0xed, 0x23, 0xd9, 0x4e, 0x7c, 0xf6, 0xed, 0x97, 0x77, 0xb9, 0x6e, 0xcf,
+	0x62, 0xf1, 0x68, 0x3a, 0x96, 0xbf, 0x0d, 0x6b, 0xa1, 0x0b, 0xa1, 0x62, 0x62, 0x5b, 0x26, 0xc1,
+	0xf9, 0x3f, 0x45, 0x60, 0x76, 0x60, 0x00, 0xf9, 0x19, 0xc4, 0x1c, 0x44, 0xb1, 0xb7, 0x48, 0x0f,
+	0xd9, 0x70, 0x27, 0xa7, 0xb9, 0x15, 0x57, 0x76, 0x52, 0x3f, 0x2a, 0x18, 0x56, 0xb1, 0x85, 0x68,
+	0xa3, 0xf0, 0x09, 0xd6, 0x91, 0x76, 0xfc, 0x04, 0x6b, 0x2f, 0xbe, 0xda, 0x04, 0x6f, 0x55, 0x9e,
+	0x60, 0xcd, 0xcd, 0x8d, 0x73, 0xc8, 0xcf, 0x21, 0xde, 0x42, 0xdd, 0x2a, 0xe7, 0x8b, 0x5c, 0x8a,
+	0xef, 0x46, 0x0b, 0x75, 0x59, 0x7e, 0xf2, 0x2f, 0x60, 0x96, 0x51, 0x6a, 0x0d, 0x64, 0xea, 0xd8,
+	0x65, 0x8e, 0x5e, 0x8a, 0x39, 0xd5, 0x42, 0xdd, 0x32, 0x67, 0x63, 0xfc, 0xdb, 0xb1, 0xff, 0xfe,
+	0x25, 0x27, 0xe5, 0xff, 0x19, 0x81, 0xa5, 0x5d, 0xa2, 0xef, 0xd4, 0x0d, 0x7a, 0x49, 0x17, 0x2f,
+	0x08, 0xfb, 0x31, 0x01, 0xa6, 0x7d, 0x07, 0x0d, 0x98, 0x3b, 0x7a, 0x41, 0x73, 0xef, 0x06, 0x9c,
+	0x14, 0xe3, 0x19, 0x6d, 0x4e, 0x24, 0x42, 0xbf, 0x8b, 0xe4, 0x03, 0x90, 0x99, 0xb8, 0x75, 0xdc,
+	0xc4, 0x3a, 0xa2, 0xb8, 0x5e, 0x25, 0x88, 0x12, 0x6e, 0xf3, 0x44, 0xe9, 0xce, 0xc9, 0x69, 0x6e,
+	0xe1, 0x2c, 0xed, 0x53, 0x93, 0xf6, 0x11, 0x3e, 0x35, 0xa9, 0x9a, 0x6e, 0xa1, 0xee, 0x13, 0x9f,
+	0xa1, 0x82, 0x28, 0xe9, 0x33, 0x67, 0x7e, 0x0d, 0x72, 0x21, 0xba, 0x0a, 0x53, 0x7e, 0x11, 0x87,
+	0x45, 0x61, 0xdd, 0xd2, 0x7e, 0xd9, 0xe3, 0x62, 0xf9, 0xfd, 0x10, 0x92, 0x4c, 0x1a, 0xec, 0x54,
+	0xc7, 0x5a, 0x01, 0x70, 0xc1, 0xac, 0xd1, 0xdf, 0xb2, 0x91, 0x0b, 0x6e, 0xd9, 0x5e, 0x15, 0x89,
+	0x5e, 0x59, 0x15, 0xf9, 0x25, 0xcc, 0x1c, 0xda, 0x55, 0x97, 0xb6, 0xda, 0x34, 0x08, 0xcd, 0xc4,
+	0x56, 0xa3, 0x97, 0xe5, 0x4e, 0x1e, 0xda, 0x25, 0xc6, 0xfe, 0x89, 0x41, 0xa8, 0xbc, 0x06, 0xd3,
+	0xde, 0xec, 0xaa, 0xd4, 0x68, 0x61, 0xbe, 0x92, 0x29, 0x35, 0xe9, 0xb5, 0xed, 0x1b, 0x2d, 0x2c,
+	0xdf, 0x86, 0x94, 0x0f, 0xe9, 0xa0, 0x66, 0x1b, 0x67, 0xa6, 0x56, 0xa5, 0x8d, 0xa8, 0xea, 0xc7,
+	0xfd, 0x8c, 0xb5, 0xc9, 0xb7, 0x00, 0x04, 0x4f, 0x97, 0x17, 0xac, 0x69, 0x35, 0xe1, 0xb3, 0x74,
+	0xe5, 0x1a, 0x28, 0xbd, 0xee, 0xaa, 0x61, 0x6a, 0xcd, 0x36, 0x13, 0x8f, 0x9d, 0x6f, 0xd6, 0x61,
+	0x26, 0xce, 0x25, 0x7f, 0x2b, 0x44, 0xf2, 0xa7, 0x3e, 0x9a, 0x6b, 0xaf, 0x2e, 0x09, 0xd6, 0x60,
+	0x87, 0x7c, 0x1f, 0x92, 0xa4, 0x89, 0x48, 0xc3, 0xcb, 0x21, 0xc1, 0x57, 0xe1, 0xe6, 0xc9, 0x69,
+	0x2e, 0x55, 0xda, 0x2f, 0x57, 0xbc, 0x9e, 0xfd, 0xae, 0x0a, 0x44, 0xfc, 0x5f, 0xa6, 0xb0, 0xe8,
+	0x59, 0xd9, 0x72, 0xaa, 0x22, 0x9a, 0x18, 0x7a, 0x06, 0x78, 0xf8, 0x8f, 0x4e, 0x4e, 0x73, 0xdb,
+	0x13, 0x0b, 0x5d, 0x31, 0x74, 0x13, 0xd1, 0xb6, 0x83, 0xd5, 0x79, 0xc1, 0xee, 0x27, 0x50, 0x31,
+	0x74, 0xf9, 0x2d, 0x98, 0x69, 0x9b, 0x35, 0xcb, 0xac, 0x0b, 0xd9, 0x93, 0x5c, 0xf6, 0x94, 0x68,
+	0xe5, 0xc2, 0xaf, 0xc1, 0x74, 0x1f, 0xac, 0x9b, 0x99, 0xe6, 0xaa, 0x26, 0x7b, 0xa0, 0xae, 0x7c,
+	0x07, 0x66, 0x7b, 0x10, 0x77, 0x75, 0x52, 0x7c, 0x75, 0x7a, 0x03, 0xb8, 0xeb, 0xb3, 0x03, 0x0b,
+	0x3d, 0x60, 0xbf, 0x4c, 0x33, 0x61, 0x32, 0xcd, 0x09, 0x7c, 0xaf, 0x51, 0xfe, 0x5c, 0x82, 0xd5,
+	0x9e, 0x60, 0x43, 0x18, 0x99, 0x74, 0xb3, 0x57, 0x22, 0xdd, 0x2d, 0x31, 0xce, 0xc1, 0x60, 0x22,
+	0x15, 0x43, 0xdf, 0x4e, 0xb3, 0x8a, 0xd1, 0xbf, 0xd7, 0xf3, 0xab, 0x90, 0x1d, 0x5e, 0x14, 0x44,
+	0xdd, 0xf8, 0x2c, 0x0e, 0x37, 0x77, 0x89, 0x5e, 0xa2, 0x5a, 0x85, 0xc5, 0xed, 0x74, 0x6d, 0x64,
+	0xd6, 0xbf, 0x2f, 0x19, 0xaf, 0x67, 0xc9, 0x18, 0xd8, 0xce, 0xf1, 0xcb, 0x6d, 0xe7, 0xc4, 0x77,
+	0xba, 0x9d, 0x61, 0x9c, 0xed, 0x9c, 0x1c, 0x6b, 0x3b, 0x4f, 0x4f, 0xb6, 0x9d, 0x53, 0x57, 0xbf,
+	0x9d, 0x67, 0xbe, 0x83, 0xed, 0x2c, 0xbf, 0x0f, 0x19, 0xdb, 0xc1, 0x1d, 0xc3, 0x6a, 0x93, 0x6a,
+	0xdf, 0x49, 0xd1, 0x40, 0xa4, 0xc1, 0xeb, 0x49, 0x42, 0x5d, 0xf0, 0xfb, 0x2b, 0xbe, 0x45, 0x7e,
+	0x82, 0x48, 0x83, 0xb9, 0xe8, 0xb0, 0x2d, 0x34, 0x4d, 0xbb, 0x2e, 0xf2, 0x5a, 0xf6, 0xbb, 0x43,
+	0xca, 0xc4, 0x0a, 0x2c, 0x9f, 0xa9, 0x01, 0xa2, 0x42, 0xfc, 0x5d, 0xe2, 0x97, 0xe2, 0x0f, 0xeb,
+	0xf5, 0x40, 0x05, 0x19, 0x38, 0x69, 0x16, 0x61, 0x8a, 0x18, 0xba, 0x89, 0xbd, 0x62, 0xa1, 0x7a,
+	0x5f, 0xf2, 0x3a, 0xcc, 0x0e, 0xe6, 0xce, 0xef, 0xb4, 0x6a, 0x8a, 0x04, 0x72, 0x1e, 0x7d, 0x1a,
+	0x46, 0xaf, 0xe2, 0x34, 0xdc, 0x4e, 0xb2, 0x89, 0x7b, 0x89, 0xe5, 0xdf, 0x81, 0xb7, 0xcf, 0x9d,
+	0x95, 0xd0, 0xe0, 0x7f, 0x51, 0x90, 0x5d, 0x74, 0xd9, 0xea, 0x60, 0x13, 0x99, 0xb4, 0x62, 0xe8,
+	0x24, 0x74, 0xd2, 0x1f, 0x43, 0xc4, 0xbf, 0xba, 0x5e, 0xae, 0xc8, 0x44, 0xec, 0xa3, 0x61, 0x0a,
+	0x46, 0x87, 0x29, 0xb8, 0x01, 0xe9, 0x3e, 0xd7, 0x33, 0x9b, 0x12, 0xb7, 0xce, 0xa9, 0x33, 0xbd,
+	0x72, 0xc0, 0xd3, 0x6e, 0x40, 0xba, 0x7f, 0xd7, 0x71, 0x47, 0x5f, 0xbf, 0x12, 0x47, 0xcf, 0xf4,
+	0xed, 0x5c, 0x66, 0xe1, 0x47, 0xa0, 0x88, 0x9c, 0x06, 0x87, 0x24, 0x99, 0x29, 0x9e, 0xdd, 0x92,
+	0x8f, 0x38, 0x08, 0xc4, 0x12, 0x99, 0xc0, 0x22, 0x37, 0x69, 0x15, 0x33, 0x43, 0x72, 0x37, 0x78,
+	0xc9, 0xde, 0xb8, 0x92, 0x64, 0xe7, 0x88, 0x70, 0x3b, 0x23, 0xe7, 0xa3, 0x06, 0x3d, 0xf2, 0x06,
+	0x28, 0x67, 0x57, 0x5d, 0x98, 0xe2, 0x85, 0x04, 0xcb, 0xc1, 0xee, 0xdd, 0x76, 0xc5, 0xd0, 0xef,
+	0xff, 0xd4, 0x32, 0x35, 0xfc, 0x7a, 0x79, 0x63, 0x05, 0x12, 0x76, 0xbb, 0x56, 0x35, 0x59, 0x66,
+	0xee, 0x2f, 0x7a, 0x35, 0x6e, 0xb7, 0x6b, 0x3c, 0xd3, 0xe0, 0x94, 0x6f, 0xc3, 0x5a, 0xe8, 0x9c,
+	0xc4, 0xcc, 0xff, 0x2d, 0x41, 0x76, 0x18, 0x6a, 0x0f, 0x39, 0xd4, 0x40, 0x4d, 0xb6, 0xf2, 0xaf,
+	0xd5, 0xf4, 0x73, 0x90, 0xb4, 0xdd, 0xd4, 0xb8, 0x7d, 0x5c, 0x01, 0xc0, 0x16, 0xd9, 0x06, 0x25,
+	0xd8, 0x80, 0xf5, 0xd1, 0x93, 0x13, 0x3a, 0xfc, 0x2d, 0x02, 0x69, 0x56, 0x38, 0xf7, 0xcb, 0x07,
+	0xa6, 0xff, 0xf3, 0xef, 0xd2, 0x95, 0xf0, 0x2e, 0xdc, 0x74, 0x6d, 0x4f, 0x6c, 0x2c, 0x8a, 0x38,
+	0xbf, 0x0c, 0xa9, 0x9c, 0x00, 0x57, 0xbc, 0xf6, 0xfd, 0xae, 0x6c, 0xc1, 0xda, 0x19, 0xec, 0x99,
+	0xe2, 0x19, 0x9b, 0xa4, 0x78, 0xde, 0x1a, 0x18, 0x22, 0xd8, 0x2d, 0x6f, 0xc1, 0xbc, 0x38, 0x5a,
+	0x1c, 0x64, 0x12, 0xa4, 0x51, 0xc3, 0x32, 0xd9, 0xaf, 0x5d, 0xb6, 0x95, 0xe7, 0xfc, 0x43, 0xa6,
+	0xaf, 0x2b, 0xa8, 0xad, 0x02, 0x99, 0x41, 0xc1, 0x84, 0x9a, 0xbf, 0x93, 0xe0, 0x8d, 0x5d, 0xa2,
+	0x57, 0x70, 0x13, 0x6b, 0xd4, 0xe8, 0x60, 0xff, 0x2c, 0xdc, 0x61, 0xbf, 0x74, 0x47, 0x6d, 0xa9,
+	0x4d, 0x98, 0x73, 0xb0, 0x66, 0x75, 0xb0, 0x83, 0xeb, 0x55, 0xef, 0xa6, 0x47, 0xbc, 0x0b, 0xa4,
+	0x9a, 0x16, 0x5d, 0x1f, 0xb1, 0x0b, 0x5b, 0xe5, 0x28, 0x90, 0xd0, 0xb3, 0x58, 0x3c, 0x92, 0x8e,
+	0xaa, 0x83, 0x2b, 0x93, 0x5f, 0x87, 0x37, 0x47, 0xa5, 0x22, 0x72, 0xfe, 0x87, 0x04, 0xb3, 0xbb,
+	0x44, 0x3f, 0xb0, 0xeb, 0x88, 0xe2, 0x3d, 0xfe, 0xac, 0x28, 0x3f, 0x84, 0x04, 0x6a, 0xd3, 0x86,
+	0xe5, 0x18, 0xf4, 0xf8, 0xdc, 0xab, 0x73, 0x0f, 0x2a, 0x3f, 0x82, 0x29, 0xf7, 0x61, 0xd2, 0xbb,
+	0x3c, 0xdf, 0x0a, 0xbb, 0x3c, 0x73, 0x50, 0x29, 0xc6, 0x9e, 0x6e, 0x54, 0x2f, 0x44, 0x7e, 0x0c,
+	0x2b, 0xe4, 0xc8, 0xb0, 0xab, 0x9a, 0xe7, 0xd9, 0x2a, 0x9b, 0x73, 0x13, 0xd9, 0x55, 0xad, 0x81,
+	0x35, 0x57, 0x8b, 0xb8, 0x9a, 0x61, 0x10, 0xdf, 0xd5, 0x9f, 0xba, 0x80, 0x32, 0xeb, 0xdf, 0x9e,
+	0x61, 0x9a, 0xf4, 0x72, 0xc9, 0x2f, 0xc3, 0xd2, 0xc0, 0xb4, 0xfc, 0x29, 0xdf, 0xff, 0x43, 0x12,
+	0xa2, 0xbb, 0x44, 0x97, 0x7f, 0x2b, 0xc1, 0x62, 0xc8, 0x93, 0xe5, 0xbd, 0x90, 0xcc, 0x43, 0xdf,
+	0xd6, 0x94, 0x0f, 0x26, 0x8d, 0xf0, 0xd3, 0x91, 0x7f, 0x0d, 0xf3, 0x43, 0x1f, 0x9c, 0x0a, 0xe1,
+	0x8c, 0xc3, 0xf0, 0xca, 0xc3, 0xc9, 0xf0, 0x62, 0xfc, 0x5f, 0xc1, 0xdc, 0xb0, 0x47, 0x97, 0xcd,
+	0xf3, 0x26, 0x14, 0x80, 0x2b, 0xef, 0x4d, 0x04, 0x17, 0x83, 0xff, 0x59, 0x82, 0xec, 0x39, 0x17,
+	0xb3, 0x11, 0xca, 0x8e, 0x8e, 0x54, 0x7e, 0x7c, 0xd1, 0x48, 0x91, 0x9e, 0x05, 0xb3, 0x83, 0x57,
+	0xa6, 0xb7, 0x47, 0x92, 0xf6, 0x43, 0x95, 0xad, 0xb1, 0xa1, 0x62, 0x40, 0xe6, 0xc9, 0x90, 0xf3,
+	0xf8, 0xde, 0x58, 0x6c, 0x7d, 0x11, 0xca, 0x07, 0x93, 0x46, 0x88, 0x34, 0xbe, 0x90, 0x60, 0x65,
+	0xd4, 0xe1, 0xf8, 0xde, 0x04, 0xcc, 0xbd, 0x30, 0xe5, 0xf1, 0x85, 0xc2, 0x44, 0x56, 0x06, 0xa4,
+	0x82, 0x27, 0xd5, 0x9d, 0x70, 0xbe, 0x00, 0x50, 0x29, 0x8e, 0x09, 0x14, 0x43, 0xfd, 0x5e, 0x82,
+	0xe5, 0xf0, 0x3a, 0xfe, 0x20, 0x9c, 0x2e, 0x34, 0x48, 0x79, 0x74, 0x81, 0x20, 0x91, 0xcf, 0x21,
+	0x4c, 0x07, 0x4a, 0xf4, 0x7a, 0x38, 0x59, 0x3f, 0x4e, 0x29, 0x8c, 0x87, 0x13, 0xe3, 0x34, 0x61,
+	0x66, 0xe0, 0x25, 0x65, 0x63, 0x84, 0x74, 0x01, 0xa4, 0x72, 0x6f, 0x5c, 0xa4, 0x3f, 0x9a, 0x72,
+	0xfd, 0x37, 0xec, 0x2d, 0xbe, 0xf4, 0xfc, 0xeb, 0x97, 0x59, 0xe9, 0x9b, 0x97, 0x59, 0xe9, 0x3f,
+	0x2f, 0xb3, 0xd2, 0x1f, 0x5f, 0x65, 0xaf, 0x7d, 0xf3, 0x2a, 0x7b, 0xed, 0x5f, 0xaf, 0xb2, 0xd7,
+	0x7e, 0x3e, 0xde, 0xe5, 0xaa, 0xdb, 0xff, 0x27, 0x2b, 0x7e, 0xd3, 0xaa, 0x4d, 0xf1, 0x3f, 0x4c,
+	0x3d, 0xf8, 0xff, 0x00, 0xf5, 0xab, 0xb1, 0xe1, 0xc1, 0x1b, 0x00, 0x00,
 }
 
 func (this *CommissionRates) Equal(that interface{}) bool {
@@ -1419,6 +1674,12 @@ type MsgClient interface {
 	AddBTCDelegationInclusionProof(ctx context.Context, in *MsgAddBTCDelegationInclusionProof, opts ...grpc.CallOption) (*MsgAddBTCDelegationInclusionProofResponse, error)
 	// AddCovenantSigs handles signatures from a covenant member
 	AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs, opts ...grpc.CallOption) (*MsgAddCovenantSigsResponse, error)
+	// AddCovenantMuSig2Nonce handles public nonce of a covenant member for
+	// MuSig2 signing of the unbonding tx
+	AddCovenantMuSig2Nonce(ctx context.Context, in *MsgAddCovenantMuSig2Nonce, opts ...grpc.CallOption) (*MsgAddCovenantMuSig2NonceResponse, error)
+	// AddCovenantMuSig2PartialSig handles MuSig2 partial signature of a covenant
+	// member on the unbonding tx
+	AddCovenantMuSig2PartialSig(ctx context.Context, in *MsgAddCovenantMuSig2PartialSig, opts ...grpc.CallOption) (*MsgAddCovenantMuSig2PartialSigResponse, error)
 	// BTCUndelegate handles a signature on unbonding tx from its delegator
 	BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error)
 	// SelectiveSlashingEvidence handles the evidence of selective slashing
@@ -1483,6 +1744,24 @@ func (c *msgClient) AddCovenantSigs(ctx context.Context, in *MsgAddCovenantSigs,
 	return out, nil
 }
 
+func (c *msgClient) AddCovenantMuSig2Nonce(ctx context.Context, in *MsgAddCovenantMuSig2Nonce, opts ...grpc.CallOption) (*MsgAddCovenantMuSig2NonceResponse, error) {
+	out := new(MsgAddCovenantMuSig2NonceResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantMuSig2Nonce", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddCovenantMuSig2PartialSig(ctx context.Context, in *MsgAddCovenantMuSig2PartialSig, opts ...grpc.CallOption) (*MsgAddCovenantMuSig2PartialSigResponse, error) {
+	out := new(MsgAddCovenantMuSig2PartialSigResponse)
+	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/AddCovenantMuSig2PartialSig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) BTCUndelegate(ctx context.Context, in *MsgBTCUndelegate, opts ...grpc.CallOption) (*MsgBTCUndelegateResponse, error) {
 	out := new(MsgBTCUndelegateResponse)
 	err := c.cc.Invoke(ctx, "/babylon.btcstaking.v1.Msg/BTCUndelegate", in, out, opts...)
@@ -1532,6 +1811,12 @@ type MsgServer interface {
 	AddBTCDelegationInclusionProof(context.Context, *MsgAddBTCDelegationInclusionProof) (*MsgAddBTCDelegationInclusionProofResponse, error)
 	// AddCovenantSigs handles signatures from a covenant member
 	AddCovenantSigs(context.Context, *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error)
+	// AddCovenantMuSig2Nonce handles public nonce of a covenant member for
+	// MuSig2 signing of the unbonding tx
+	AddCovenantMuSig2Nonce(context.Context, *MsgAddCovenantMuSig2Nonce) (*MsgAddCovenantMuSig2NonceResponse, error)
+	// AddCovenantMuSig2PartialSig handles MuSig2 partial signature of a covenant
+	// member on the unbonding tx
+	AddCovenantMuSig2PartialSig(context.Context, *MsgAddCovenantMuSig2PartialSig) (*MsgAddCovenantMuSig2PartialSigResponse, error)
 	// BTCUndelegate handles a signature on unbonding tx from its delegator
 	BTCUndelegate(context.Context, *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error)
 	// SelectiveSlashingEvidence handles the evidence of selective slashing
@@ -1562,6 +1847,12 @@ func (*UnimplementedMsgServer) AddBTCDelegationInclusionProof(ctx context.Contex
 func (*UnimplementedMsgServer) AddCovenantSigs(ctx context.Context, req *MsgAddCovenantSigs) (*MsgAddCovenantSigsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantSigs not implemented")
 }
+func (*UnimplementedMsgServer) AddCovenantMuSig2Nonce(ctx context.Context, req *MsgAddCovenantMuSig2Nonce) (*MsgAddCovenantMuSig2NonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantMuSig2Nonce not implemented")
+}
+func (*UnimplementedMsgServer) AddCovenantMuSig2PartialSig(ctx context.Context, req *MsgAddCovenantMuSig2PartialSig) (*MsgAddCovenantMuSig2PartialSigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCovenantMuSig2PartialSig not implemented")
+}
 func (*UnimplementedMsgServer) BTCUndelegate(ctx context.Context, req *MsgBTCUndelegate) (*MsgBTCUndelegateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BTCUndelegate not implemented")
 }
@@ -1669,6 +1960,42 @@ func _Msg_AddCovenantSigs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddCovenantMuSig2Nonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCovenantMuSig2Nonce)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCovenantMuSig2Nonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantMuSig2Nonce",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCovenantMuSig2Nonce(ctx, req.(*MsgAddCovenantMuSig2Nonce))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddCovenantMuSig2PartialSig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCovenantMuSig2PartialSig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddCovenantMuSig2PartialSig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.btcstaking.v1.Msg/AddCovenantMuSig2PartialSig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCovenantMuSig2PartialSig(ctx, req.(*MsgAddCovenantMuSig2PartialSig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_BTCUndelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBTCUndelegate)
 	if err := dec(in); err != nil {
@@ -1765,6 +2092,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddCovenantSigs",
 			Handler:    _Msg_AddCovenantSigs_Handler,
 		},
+		{
+			MethodName: "AddCovenantMuSig2Nonce",
+			Handler:    _Msg_AddCovenantMuSig2Nonce_Handler,
+		},
+		{
+			MethodName: "AddCovenantMuSig2PartialSig",
+			Handler:    _Msg_AddCovenantMuSig2PartialSig_Handler,
+		},
 		{
 			MethodName: "BTCUndelegate",
 			Handler:    _Msg_BTCUndelegate_Handler,
@@ -1958,6 +2293,18 @@ func (m *MsgEditFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if m.MaxDelegatedSats != nil {
+		{
+			size := m.MaxDelegatedSats.Size()
+			i -= size
+			if _, err := m.MaxDelegatedSats.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.Commission != nil {
 		{
 			size := m.Commission.Size()
@@ -2582,7 +2929,7 @@ func (m *MsgAddCovenantSigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantMuSig2Nonce) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2592,49 +2939,40 @@ func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantMuSig2Nonce) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantMuSig2Nonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.FundingTransactions) > 0 {
-		for iNdEx := len(m.FundingTransactions) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FundingTransactions[iNdEx])
-			copy(dAtA[i:], m.FundingTransactions[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.FundingTransactions[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if m.StakeSpendingTxInclusionProof != nil {
-		{
-			size, err := m.StakeSpendingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
+	if len(m.PubNonce) > 0 {
+		i -= len(m.PubNonce)
+		copy(dAtA[i:], m.PubNonce)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PubNonce)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.StakeSpendingTx) > 0 {
-		i -= len(m.StakeSpendingTx)
-		copy(dAtA[i:], m.StakeSpendingTx)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakeSpendingTx)))
-		i--
-		dAtA[i] = 0x1a
-	}
 	if len(m.StakingTxHash) > 0 {
 		i -= len(m.StakingTxHash)
 		copy(dAtA[i:], m.StakingTxHash)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
 		dAtA[i] = 0x12
 	}
 	if len(m.Signer) > 0 {
@@ -2647,7 +2985,7 @@ func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantMuSig2NonceResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2657,12 +2995,12 @@ func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantMuSig2NonceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantMuSig2NonceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2670,7 +3008,7 @@ func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddCovenantMuSig2PartialSig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2680,27 +3018,194 @@ func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantMuSig2PartialSig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddCovenantMuSig2PartialSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RecoveredFpBtcSk) > 0 {
-		i -= len(m.RecoveredFpBtcSk)
-		copy(dAtA[i:], m.RecoveredFpBtcSk)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+	if len(m.PartialSig) > 0 {
+		i -= len(m.PartialSig)
+		copy(dAtA[i:], m.PartialSig)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PartialSig)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x22
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Pk != nil {
+		{
+			size := m.Pk.Size()
+			i -= size
+			if _, err := m.Pk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCovenantMuSig2PartialSigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCovenantMuSig2PartialSigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCovenantMuSig2PartialSigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCUndelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FundingTransactions) > 0 {
+		for iNdEx := len(m.FundingTransactions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FundingTransactions[iNdEx])
+			copy(dAtA[i:], m.FundingTransactions[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.FundingTransactions[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.StakeSpendingTxInclusionProof != nil {
+		{
+			size, err := m.StakeSpendingTxInclusionProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.StakeSpendingTx) > 0 {
+		i -= len(m.StakeSpendingTx)
+		copy(dAtA[i:], m.StakeSpendingTx)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakeSpendingTx)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StakingTxHash) > 0 {
+		i -= len(m.StakingTxHash)
+		copy(dAtA[i:], m.StakingTxHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingTxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBTCUndelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBTCUndelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSelectiveSlashingEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecoveredFpBtcSk) > 0 {
+		i -= len(m.RecoveredFpBtcSk)
+		copy(dAtA[i:], m.RecoveredFpBtcSk)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecoveredFpBtcSk)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -2750,6 +3255,16 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.SkipCovenantOverlapCheck {
+		i--
+		if m.SkipCovenantOverlapCheck {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2877,6 +3392,10 @@ func (m *MsgEditFinalityProvider) Size() (n int) {
 		l = m.Commission.Size()
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.MaxDelegatedSats != nil {
+		l = m.MaxDelegatedSats.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -3125,6 +3644,74 @@ func (m *MsgAddCovenantSigsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgAddCovenantMuSig2Nonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PubNonce)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCovenantMuSig2NonceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddCovenantMuSig2PartialSig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Pk != nil {
+		l = m.Pk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StakingTxHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PartialSig)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCovenantMuSig2PartialSigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MsgBTCUndelegate) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3203,6 +3790,9 @@ func (m *MsgUpdateParams) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if m.SkipCovenantOverlapCheck {
+		n += 2
+	}
 	return n
 }
 
@@ -3812,23 +4402,59 @@ func (m *MsgEditFinalityProvider) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDelegatedSats", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxDelegatedSats = &v
+			if err := m.MaxDelegatedSats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
@@ -4807,7 +5433,529 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingValue", wireType)
 			}
-			m.UnbondingValue = 0
+			m.UnbondingValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v BTCSlashingTx
+			m.UnbondingSlashingTx = &v
+			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
+			m.DelegatorUnbondingSlashingSig = &v
+			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousStakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundingTx", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundingTx = append(m.FundingTx[:0], dAtA[iNdEx:postIndex]...)
+			if m.FundingTx == nil {
+				m.FundingTx = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBtcStakeExpandResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBtcStakeExpandResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBtcStakeExpandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StakingTxInclusionProof == nil {
+				m.StakingTxInclusionProof = &InclusionProof{}
+			}
+			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4817,14 +5965,27 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UnbondingValue |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingSlashingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4851,15 +6012,12 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v BTCSlashingTx
-			m.UnbondingSlashingTx = &v
-			if err := m.UnbondingSlashingTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorUnbondingSlashingSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4887,16 +6045,16 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
-			m.DelegatorUnbondingSlashingSig = &v
-			if err := m.DelegatorUnbondingSlashingSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UnbondingTxSig = &v
+			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousStakingTxHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4906,27 +6064,27 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousStakingTxHash = string(dAtA[iNdEx:postIndex])
+			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
+			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 16:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundingTx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeExpansionTxSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4953,9 +6111,10 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundingTx = append(m.FundingTx[:0], dAtA[iNdEx:postIndex]...)
-			if m.FundingTx == nil {
-				m.FundingTx = []byte{}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
+			m.StakeExpansionTxSig = &v
+			if err := m.StakeExpansionTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4979,7 +6138,7 @@ func (m *MsgBtcStakeExpand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgBtcStakeExpandResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5002,10 +6161,10 @@ func (m *MsgBtcStakeExpandResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBtcStakeExpandResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBtcStakeExpandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5029,7 +6188,7 @@ func (m *MsgBtcStakeExpandResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantMuSig2Nonce) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5052,10 +6211,10 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2Nonce: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProof: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2Nonce: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5091,6 +6250,41 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.Pk = &v
+			if err := m.Pk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxHash", wireType)
 			}
@@ -5122,11 +6316,11 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 			}
 			m.StakingTxHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingTxInclusionProof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubNonce", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5136,26 +6330,24 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StakingTxInclusionProof == nil {
-				m.StakingTxInclusionProof = &InclusionProof{}
-			}
-			if err := m.StakingTxInclusionProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PubNonce = append(m.PubNonce[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubNonce == nil {
+				m.PubNonce = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5179,7 +6371,7 @@ func (m *MsgAddBTCDelegationInclusionProof) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantMuSig2NonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5202,10 +6394,10 @@ func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2NonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddBTCDelegationInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2NonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5229,7 +6421,7 @@ func (m *MsgAddBTCDelegationInclusionProofResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantMuSig2PartialSig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5252,10 +6444,10 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2PartialSig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2PartialSig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5359,106 +6551,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingTxSigs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingTxSigs = append(m.SlashingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingTxSigs[len(m.SlashingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTxSig", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
-			m.UnbondingTxSig = &v
-			if err := m.UnbondingTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashingUnbondingTxSigs", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SlashingUnbondingTxSigs = append(m.SlashingUnbondingTxSigs, make([]byte, postIndex-iNdEx))
-			copy(m.SlashingUnbondingTxSigs[len(m.SlashingUnbondingTxSigs)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakeExpansionTxSig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartialSig", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5485,10 +6578,9 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
-			m.StakeExpansionTxSig = &v
-			if err := m.StakeExpansionTxSig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.PartialSig = append(m.PartialSig[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartialSig == nil {
+				m.PartialSig = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -5512,7 +6604,7 @@ func (m *MsgAddCovenantSigs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCovenantMuSig2PartialSigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5535,10 +6627,10 @@ func (m *MsgAddCovenantSigsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2PartialSigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCovenantSigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCovenantMuSig2PartialSigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6088,6 +7180,26 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipCovenantOverlapCheck", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipCovenantOverlapCheck = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -36,11 +36,11 @@ func ValidateParsedMessageAgainstTheParams(
 	// - that staking time and value are correct
 	// - slashing tx is relevant to staking tx
 	// - slashing tx signature is valid
-	stakingInfo, err := btcstaking.BuildStakingInfo(
+	stakingInfo, err := buildStakingInfo(
+		parameters,
 		pm.StakerPK.PublicKey,
 		pm.FinalityProviderKeys.PublicKeys,
 		covenantPks,
-		parameters.CovenantQuorum,
 		pm.StakingTime,
 		pm.StakingValue,
 		net,
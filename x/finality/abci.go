@@ -27,6 +27,11 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 		// tally all non-finalised blocks
 		k.TallyBlocks(ctx, types.MaxFinalizedRewardedBlocksPerEndBlock)
 
+		// detect whether finality is halted since the first non-finalized block
+		if err := k.HandleFinalityHalt(ctx); err != nil {
+			return nil, err
+		}
+
 		// detect sluggish finality providers if there are any
 		// heightToExamine is determined by the current height - params.FinalitySigTimeout
 		// which indicates that finality providers have up to `params.FinalitySigTimeout` blocks
@@ -40,6 +45,10 @@ func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, e
 
 			k.HandleRewarding(ctx, heightToExamine, types.MaxFinalizedRewardedBlocksPerEndBlock)
 		}
+
+		// prune the state of finalized and rewarded heights that are out of
+		// the retention window
+		k.HandlePruning(ctx, types.MaxPrunedBlocksPerEndBlock, types.MaxPrunedCommitIndexesPerEndBlock)
 	}
 
 	return []abci.ValidatorUpdate{}, nil
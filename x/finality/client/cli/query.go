@@ -15,6 +15,7 @@ import (
 const (
 	flagQueriedBlockStatus = "queried-block-status"
 	flagStartHeight        = "start-height"
+	flagNumEpochs          = "num-epochs"
 )
 
 // GetQueryCmd returns the cli query commands for this module
@@ -36,12 +37,19 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 		CmdListPublicRandomness(),
 		CmdListPubRandCommit(),
 		CmdBlock(),
+		CmdFinalityProof(),
+		CmdFinalityHalt(),
+		CmdLatestFinalizedHeight(),
+		CmdFinalityStatusRange(),
+		CmdEpochVoteParticipation(),
 		CmdListBlocks(),
 		CmdVotesAtHeight(),
 		CmdVotingPowerDistribution(),
 		CmdListEvidences(),
 		CmdSigningInfo(),
 		CmdAllSigningInfo(),
+		CmdJailHistory(),
+		CmdFinalityProviderLiveness(),
 	)
 
 	return cmd
@@ -337,6 +345,152 @@ func CmdBlock() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalityProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-proof [height]",
+		Short: "show the finality proof of the block at a given height, for verification by light clients",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			queriedBlockHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProof(cmd.Context(), &types.QueryFinalityProofRequest{
+				Height: queriedBlockHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityHalt() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-halt",
+		Short: "show the first non-finalized block and the finality providers that have not voted for it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FinalityHalt(cmd.Context(), &types.QueryFinalityHaltRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLatestFinalizedHeight() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "latest-finalized-height",
+		Short: "show the highest finalized height",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.LatestFinalizedHeight(cmd.Context(), &types.QueryLatestFinalizedHeightRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFinalityStatusRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finality-status [start-height] [end-height]",
+		Short: "show the finality status of the blocks in a given range of heights",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startHeight, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			endHeight, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityStatusRange(cmd.Context(), &types.QueryFinalityStatusRangeRequest{
+				StartHeight: startHeight,
+				EndHeight:   endHeight,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdEpochVoteParticipation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-participation [epoch-number]",
+		Short: "show the finality vote participation of each active finality provider in a given epoch",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			epochNum, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.EpochVoteParticipation(cmd.Context(), &types.QueryEpochVoteParticipationRequest{
+				EpochNum: epochNum,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func CmdListEvidences() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-evidences",
@@ -438,3 +592,69 @@ func CmdAllSigningInfo() *cobra.Command {
 
 	return cmd
 }
+
+func CmdJailHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "jail-history [fp-pk-hex]",
+		Short: "Show the records of a given finality provider being jailed due to downtime",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.JailHistory(
+				cmd.Context(),
+				&types.QueryJailHistoryRequest{FpBtcPkHex: args[0], Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "jail-history")
+
+	return cmd
+}
+
+func CmdFinalityProviderLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness [fp-pk-hex]",
+		Short: "Show the missed heights within the signed blocks window, the per-epoch uptime and the jail history of a given finality provider",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			numEpochs, err := cmd.Flags().GetUint64(flagNumEpochs)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FinalityProviderLiveness(
+				cmd.Context(),
+				&types.QueryFinalityProviderLivenessRequest{FpBtcPkHex: args[0], NumEpochs: numEpochs},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagNumEpochs, types.DefaultLivenessQueryEpochs, "number of the most recent epochs to compute the uptime for")
+
+	return cmd
+}
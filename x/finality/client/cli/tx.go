@@ -2,7 +2,10 @@ package cli
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -10,6 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	"github.com/spf13/cobra"
 
 	bbn "github.com/babylonlabs-io/babylon/v4/types"
@@ -29,7 +37,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCommitPubRandListCmd(),
 		NewAddFinalitySigCmd(),
+		NewAddFinalitySigsCmd(),
 		NewUnjailFinalityProviderCmd(),
+		NewRotateFinalityProviderKeyCmd(),
+		NewSubmitResumeFinalityProposalCmd(),
 	)
 
 	return cmd
@@ -201,3 +212,266 @@ func NewUnjailFinalityProviderCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewRotateFinalityProviderKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-fp-key [fp_btc_pk] [new_eots_pk] [activation_height] [current_key_sig] [new_key_sig]",
+		Args:  cobra.ExactArgs(5),
+		Short: "Rotate the EOTS key of a finality provider",
+		Long: strings.TrimSpace(
+			`Rotate the EOTS key of a finality provider from the given activation height.
+The activation height has to be after the highest height with committed public randomness.
+Both signatures are BIP-340 signatures over the hash of the chain ID and the message, by the
+current EOTS key and the new EOTS key respectively.`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get new EOTS PK
+			newEotsPK, err := bbn.NewBIP340PubKeyFromHex(args[1])
+			if err != nil {
+				return err
+			}
+
+			// get activation height
+			activationHeight, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			// get signatures
+			currentKeySig, err := bbn.NewBIP340SignatureFromHex(args[3])
+			if err != nil {
+				return err
+			}
+			newKeySig, err := bbn.NewBIP340SignatureFromHex(args[4])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRotateFinalityProviderKey{
+				Signer:           clientCtx.FromAddress.String(),
+				FpBtcPk:          fpBTCPK,
+				NewEotsPk:        newEotsPK,
+				ActivationHeight: activationHeight,
+				CurrentKeySig:    currentKeySig,
+				NewKeySig:        newKeySig,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewAddFinalitySigsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-finality-sigs [fp_btc_pk] [sigs_file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Add a batch of finality signatures",
+		Long: strings.TrimSpace(
+			`Add a batch of finality signatures of a finality provider to a list of blocks.
+The file contains a JSON list of finality signatures in strictly increasing order of block height,
+where each field is encoded in the same way as in add-finality-sig. Example:
+
+[
+  {
+    "block_height": 100,
+    "pub_rand": "<hex>",
+    "proof": "<hex>",
+    "block_app_hash": "<hex>",
+    "finality_sig": "<hex>"
+  }
+]`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// get finality provider BTC PK
+			fpBTCPK, err := bbn.NewBIP340PubKeyFromHex(args[0])
+			if err != nil {
+				return err
+			}
+
+			// get finality signatures
+			sigs, err := parseFinalitySigEntriesJSON(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAddFinalitySigs{
+				Signer:  clientCtx.FromAddress.String(),
+				FpBtcPk: fpBTCPK,
+				Sigs:    sigs,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseFinalitySigEntriesJSON parses the list of finality signatures in the
+// given JSON file
+func parseFinalitySigEntriesJSON(clientCtx client.Context, path string) ([]*types.FinalitySigEntry, error) {
+	type internalEntry struct {
+		BlockHeight  uint64 `json:"block_height"`
+		PubRand      string `json:"pub_rand"`
+		Proof        string `json:"proof"`
+		BlockAppHash string `json:"block_app_hash"`
+		FinalitySig  string `json:"finality_sig"`
+	}
+
+	contents, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []internalEntry
+	if err := json.Unmarshal(contents, &entries); err != nil {
+		return nil, err
+	}
+
+	sigs := make([]*types.FinalitySigEntry, 0, len(entries))
+	for i, e := range entries {
+		pubRand, err := bbn.NewSchnorrPubRandFromHex(e.PubRand)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public randomness at index %d: %w", i, err)
+		}
+
+		proofBytes, err := hex.DecodeString(e.Proof)
+		if err != nil {
+			return nil, fmt.Errorf("invalid proof at index %d: %w", i, err)
+		}
+		var proof cmtcrypto.Proof
+		if err := clientCtx.Codec.Unmarshal(proofBytes, &proof); err != nil {
+			return nil, fmt.Errorf("invalid proof at index %d: %w", i, err)
+		}
+
+		appHash, err := hex.DecodeString(e.BlockAppHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid block app hash at index %d: %w", i, err)
+		}
+
+		finalitySig, err := bbn.NewSchnorrEOTSSigFromHex(e.FinalitySig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid finality signature at index %d: %w", i, err)
+		}
+
+		sigs = append(sigs, &types.FinalitySigEntry{
+			BlockHeight:  e.BlockHeight,
+			PubRand:      pubRand,
+			Proof:        &proof,
+			BlockAppHash: appHash,
+			FinalitySig:  finalitySig,
+		})
+	}
+
+	return sigs, nil
+}
+
+func NewSubmitResumeFinalityProposalCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-resume-finality-proposal [deposit]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a governance proposal resuming the halted finality",
+		Long: strings.TrimSpace(
+			`Submit a governance proposal with a MsgResumeFinalityProposal jailing the finality providers
+that have not voted for the first non-finalized block. The halting height and the finality
+providers to jail are queried from the node, and finality has to be halted. Use --generate-only
+to review the proposal before submitting it.`,
+		),
+		Example: strings.TrimSpace(
+			`babylond tx finality submit-resume-finality-proposal 50000000ubbn --from=mykey`,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FinalityHalt(cmd.Context(), &types.QueryFinalityHaltRequest{})
+			if err != nil {
+				return err
+			}
+			if !res.Halted {
+				return fmt.Errorf("finality is not halted")
+			}
+			if len(res.FpPksHex) == 0 {
+				return fmt.Errorf("all finality providers have voted for the block at the halting height %d", res.HaltingHeight)
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+			if title == "" {
+				title = fmt.Sprintf("Resume finality halted at height %d", res.HaltingHeight)
+			}
+			summary, err := cmd.Flags().GetString(govcli.FlagSummary)
+			if err != nil {
+				return err
+			}
+			if summary == "" {
+				summary = fmt.Sprintf("Jail the %d finality providers that have not voted for the block at height %d, "+
+					"holding %d of the total voting power %d, to resume finality.",
+					len(res.FpPksHex), res.HaltingHeight,
+					res.Halt.TotalVotingPower-res.Halt.VotedVotingPower, res.Halt.TotalVotingPower)
+			}
+			metadata, err := cmd.Flags().GetString(govcli.FlagMetadata)
+			if err != nil {
+				return err
+			}
+			expedited, err := cmd.Flags().GetBool(govcli.FlagExpedited)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgResumeFinalityProposal{
+				Authority:     authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+				FpPksHex:      res.FpPksHex,
+				HaltingHeight: res.HaltingHeight,
+			}
+			proposal, err := govv1.NewMsgSubmitProposal(
+				[]sdk.Msg{msg}, deposit, clientCtx.GetFromAddress().String(), metadata, title, summary, expedited,
+			)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "The title of the proposal, derived from the halting height if empty")
+	cmd.Flags().String(govcli.FlagSummary, "", "The summary of the proposal, derived from the finality halt if empty")
+	cmd.Flags().String(govcli.FlagMetadata, "", "The metadata of the proposal")
+	cmd.Flags().Bool(govcli.FlagExpedited, false, "Whether to submit the proposal as an expedited one")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// GetFinalityHalt returns the first non-finalized block that has a finality
+// provider set, together with the finality providers that have not voted for
+// it. It returns nil if the BTC staking protocol is not activated, or if there
+// is no such block.
+func (k Keeper) GetFinalityHalt(ctx context.Context) *types.FinalityHalt {
+	activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx)
+	if err != nil {
+		return nil
+	}
+
+	// tallying stops at the first non-finalized block with a finality
+	// provider set, see TallyBlocks
+	haltingHeight := max(k.getNextHeightToFinalize(ctx), activatedHeight)
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if haltingHeight > currentHeight {
+		return nil
+	}
+	fpSet := k.GetVotingPowerTable(ctx, haltingHeight)
+	if fpSet == nil {
+		return nil
+	}
+	voters := k.GetVoters(ctx, haltingHeight)
+
+	halt := &types.FinalityHalt{
+		HaltingHeight:   haltingHeight,
+		NumHaltedBlocks: currentHeight - haltingHeight,
+	}
+	for fpBTCPKHex, power := range fpSet {
+		halt.TotalVotingPower += power
+		if _, voted := voters[fpBTCPKHex]; voted {
+			halt.VotedVotingPower += power
+			continue
+		}
+		halt.NonVotingFps = append(halt.NonVotingFps, &types.NonVotingFinalityProvider{
+			FpBtcPkHex:  fpBTCPKHex,
+			VotingPower: power,
+		})
+	}
+	sort.Slice(halt.NonVotingFps, func(i, j int) bool {
+		return halt.NonVotingFps[i].FpBtcPkHex < halt.NonVotingFps[j].FpBtcPkHex
+	})
+
+	return halt
+}
+
+// IsFinalityHalted returns whether the given finality halt lasts for at least
+// the finality halt threshold
+func (k Keeper) IsFinalityHalted(ctx context.Context, halt *types.FinalityHalt) bool {
+	threshold := k.GetParams(ctx).FinalityHaltThreshold
+	return halt != nil && threshold > 0 && halt.NumHaltedBlocks >= threshold
+}
+
+// HandleFinalityHalt detects whether finality is halted, i.e., whether at
+// least FinalityHaltThreshold blocks are produced since the first
+// non-finalized block, and emits an EventFinalityHaltDetected with the
+// finality providers that have not voted for the block. The event is emitted
+// once per halting height.
+func (k Keeper) HandleFinalityHalt(ctx context.Context) error {
+	halt := k.GetFinalityHalt(ctx)
+	if !k.IsFinalityHalted(ctx, halt) {
+		return nil
+	}
+	if k.getLastFinalityHaltHeight(ctx) == halt.HaltingHeight {
+		// the finality halt at this height is already reported
+		return nil
+	}
+	k.setLastFinalityHaltHeight(ctx, halt.HaltingHeight)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	k.Logger(sdkCtx).Error(
+		"finality halt detected",
+		"halting_height", halt.HaltingHeight,
+		"num_halted_blocks", halt.NumHaltedBlocks,
+		"voted_voting_power", halt.VotedVotingPower,
+		"total_voting_power", halt.TotalVotingPower,
+		"num_non_voting_fps", len(halt.NonVotingFps),
+	)
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(types.NewEventFinalityHaltDetected(halt)); err != nil {
+		return fmt.Errorf("failed to emit finality halt detected event: %w", err)
+	}
+
+	return nil
+}
+
+// setLastFinalityHaltHeight sets the halting height of the last detected
+// finality halt
+func (k Keeper) setLastFinalityHaltHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	heightBytes := sdk.Uint64ToBigEndian(height)
+	if err := store.Set(types.LastFinalityHaltHeightKey, heightBytes); err != nil {
+		panic(err)
+	}
+}
+
+// getLastFinalityHaltHeight gets the halting height of the last detected
+// finality halt
+func (k Keeper) getLastFinalityHaltHeight(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.LastFinalityHaltHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
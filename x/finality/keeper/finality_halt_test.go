@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+func FuzzHandleFinalityHalt(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		fKeeper, ctx := keepertest.FinalityKeeper(t, nil, nil, nil, nil)
+
+		params := fKeeper.GetParams(ctx)
+		params.FinalityHaltThreshold = datagen.RandomInt(r, 10) + 5
+		require.NoError(t, fKeeper.SetParams(ctx, params))
+
+		// no finality halt before the activation of BTC staking
+		require.Nil(t, fKeeper.GetFinalityHalt(ctx))
+
+		// finality providers with the same voting power since the activation
+		activatedHeight := datagen.RandomInt(r, 10) + 1
+		numFinalized := datagen.RandomInt(r, 10)
+		haltingHeight := activatedHeight + numFinalized
+		currentHeight := haltingHeight + datagen.RandomInt(r, int(2*params.FinalityHaltThreshold))
+		numFps := int(datagen.RandomInt(r, 5)) + 3
+		fpBtcPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			fpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpBtcPks = append(fpBtcPks, fpBtcPk)
+		}
+		for height := activatedHeight; height <= currentHeight; height++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:  height,
+				AppHash: datagen.GenRandomByteArray(r, 32),
+			})
+			for _, fpBtcPk := range fpBtcPks {
+				fKeeper.SetVotingPower(ctx, fpBtcPk.MustMarshal(), height, 100)
+			}
+		}
+
+		// all finality providers vote for the blocks before the halting height,
+		// and at most 2/3 of them vote for the block at the halting height
+		for height := activatedHeight; height < haltingHeight; height++ {
+			for _, fpBtcPk := range fpBtcPks {
+				sig, err := datagen.GenRandomFinalitySig(r)
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpBtcPk, sig)
+			}
+		}
+		numVoters := int(datagen.RandomInt(r, numFps*2/3+1))
+		expNonVoters := make([]string, 0, numFps-numVoters)
+		for i, fpBtcPk := range fpBtcPks {
+			if i < numVoters {
+				sig, err := datagen.GenRandomFinalitySig(r)
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, haltingHeight, fpBtcPk, sig)
+				continue
+			}
+			expNonVoters = append(expNonVoters, fpBtcPk.MarshalHex())
+		}
+		sort.Strings(expNonVoters)
+
+		ctx = datagen.WithCtxHeight(ctx, currentHeight)
+		fKeeper.TallyBlocks(ctx, types.MaxFinalizedRewardedBlocksPerEndBlock)
+
+		halt := fKeeper.GetFinalityHalt(ctx)
+		require.NotNil(t, halt)
+		require.Equal(t, haltingHeight, halt.HaltingHeight)
+		require.Equal(t, currentHeight-haltingHeight, halt.NumHaltedBlocks)
+		require.Equal(t, uint64(numFps*100), halt.TotalVotingPower)
+		require.Equal(t, uint64(numVoters*100), halt.VotedVotingPower)
+		require.Len(t, halt.NonVotingFps, len(expNonVoters))
+		for i, fp := range halt.NonVotingFps {
+			require.Equal(t, expNonVoters[i], fp.FpBtcPkHex)
+			require.Equal(t, uint64(100), fp.VotingPower)
+		}
+
+		// the query proposes the parameters of a MsgResumeFinalityProposal
+		expHalted := halt.NumHaltedBlocks >= params.FinalityHaltThreshold
+		res, err := fKeeper.FinalityHalt(ctx, &types.QueryFinalityHaltRequest{})
+		require.NoError(t, err)
+		require.Equal(t, expHalted, res.Halted)
+		require.Equal(t, halt, res.Halt)
+		require.Equal(t, uint32(haltingHeight), res.HaltingHeight)
+		require.Equal(t, expNonVoters, res.FpPksHex)
+
+		// the event is emitted once per halting height
+		numHaltEvents := func(ctx sdk.Context) int {
+			n := 0
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == "babylon.finality.v1.EventFinalityHaltDetected" {
+					n++
+				}
+			}
+			return n
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, fKeeper.HandleFinalityHalt(ctx))
+		if expHalted {
+			require.Equal(t, 1, numHaltEvents(ctx))
+		} else {
+			require.Zero(t, numHaltEvents(ctx))
+		}
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		require.NoError(t, fKeeper.HandleFinalityHalt(ctx))
+		require.Zero(t, numHaltEvents(ctx))
+
+		// no finality halt is reported once the blocks are finalized
+		for height := haltingHeight; height <= currentHeight; height++ {
+			for _, fpBtcPk := range fpBtcPks {
+				sig, err := datagen.GenRandomFinalitySig(r)
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpBtcPk, sig)
+			}
+		}
+		fKeeper.TallyBlocks(ctx, types.MaxFinalizedRewardedBlocksPerEndBlock)
+		require.Nil(t, fKeeper.GetFinalityHalt(ctx))
+		res, err = fKeeper.FinalityHalt(ctx, &types.QueryFinalityHaltRequest{})
+		require.NoError(t, err)
+		require.False(t, res.Halted)
+		require.Nil(t, res.Halt)
+	})
+}
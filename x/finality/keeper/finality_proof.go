@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	bstypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// FinalityProof returns the proof that the Babylon block at the given height
+// has received finality votes. The store entries in the proof are proven
+// against the AppHash committing to the state the query is executed on.
+func (k Keeper) FinalityProof(ctx context.Context, req *types.QueryFinalityProofRequest) (*types.QueryFinalityProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if k.storeQuerier == nil {
+		return nil, status.Error(codes.Unimplemented, "the node does not support proving store entries")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
+	if !k.HasBlock(sdkCtx, req.Height) {
+		return nil, types.ErrBlockNotFound.Wrapf("height: %d", req.Height)
+	}
+	if !k.HasVotingPowerTable(sdkCtx, req.Height) {
+		return nil, types.ErrVotingPowerTableNotFound.Wrapf("height: %d", req.Height)
+	}
+
+	// the query is executed on the committed state at the context height
+	stateHeight := sdkCtx.BlockHeight()
+	proof := &types.FinalityProof{StateHeight: uint64(stateHeight)}
+
+	var err error
+	proof.Block, err = k.proveStoreEntry(types.StoreKey, types.BlockStoreKey(req.Height), stateHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	proof.VotingPowerTableProofs, err = k.proveVotingPowerTable(sdkCtx, req.Height, stateHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// sort the votes by the BTC PK of the finality providers for determinism
+	sigSet := k.GetSigSet(sdkCtx, req.Height)
+	fpBtcPkHexes := make([]string, 0, len(sigSet))
+	for fpBtcPkHex := range sigSet {
+		fpBtcPkHexes = append(fpBtcPkHexes, fpBtcPkHex)
+	}
+	sort.Strings(fpBtcPkHexes)
+
+	proof.Votes = make([]*types.FinalityProofVote, 0, len(fpBtcPkHexes))
+	for _, fpBtcPkHex := range fpBtcPkHexes {
+		fpBtcPk, err := bbn.NewBIP340PubKeyFromHex(fpBtcPkHex)
+		if err != nil {
+			return nil, err
+		}
+		vote, err := k.proveVote(sdkCtx, fpBtcPk, req.Height, stateHeight)
+		if err != nil {
+			return nil, err
+		}
+		proof.Votes = append(proof.Votes, vote)
+	}
+
+	return &types.QueryFinalityProofResponse{Proof: proof}, nil
+}
+
+// proveVote returns the finality vote of the given finality provider at the
+// given height, together with the proofs of its EOTS signature, public
+// randomness, inclusion proof of the public randomness and public randomness
+// commitment
+func (k Keeper) proveVote(ctx sdk.Context, fpBtcPk *bbn.BIP340PubKey, height uint64, stateHeight int64) (*types.FinalityProofVote, error) {
+	fpBtcPkBytes := fpBtcPk.MustMarshal()
+	vote := &types.FinalityProofVote{FpBtcPk: fpBtcPk}
+
+	var err error
+	vote.Sig, err = k.proveStoreEntry(types.StoreKey, types.VoteStoreKey(height, fpBtcPkBytes), stateHeight)
+	if err != nil {
+		return nil, err
+	}
+	vote.PubRand, err = k.proveStoreEntry(types.StoreKey, types.PubRandStoreKey(fpBtcPkBytes, height), stateHeight)
+	if err != nil {
+		return nil, err
+	}
+	// the inclusion proof is only stored for votes cast since it is stored
+	if !k.HasPubRandProof(ctx, fpBtcPk, height) {
+		return nil, status.Errorf(codes.NotFound, "the inclusion proof of the public randomness of finality provider %s at height %d is not found", fpBtcPk.MarshalHex(), height)
+	}
+	vote.PubRandProof, err = k.proveStoreEntry(types.StoreKey, types.PubRandProofStoreKey(fpBtcPkBytes, height), stateHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	prCommit, err := k.GetPubRandCommitForHeight(ctx, fpBtcPk, height)
+	if err != nil {
+		return nil, err
+	}
+	vote.PubRandCommit, err = k.proveStoreEntry(types.StoreKey, types.PubRandCommitStoreKey(fpBtcPkBytes, prCommit.StartHeight), stateHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// the finality provider is needed for determining the EOTS PK at this
+	// height only if it has rotated its EOTS key
+	fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBtcPkBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(fp.KeyRotations) > 0 {
+		fpKey := append(append([]byte{}, bstypes.FinalityProviderKey...), fpBtcPkBytes...)
+		vote.FinalityProvider, err = k.proveStoreEntry(bstypes.StoreKey, fpKey, stateHeight)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return vote, nil
+}
+
+// proveVotingPowerTable returns the non-membership proofs of the keys
+// delimiting the entries of the voting power table at the given height. The
+// proofs contain their neighbours, i.e., the entries of the table, so that
+// they altogether prove the complete table.
+func (k Keeper) proveVotingPowerTable(ctx sdk.Context, height uint64, stateHeight int64) ([]*cmtcrypto.ProofOps, error) {
+	tablePrefix := types.VotingPowerTableStorePrefix(height)
+
+	// the first proof is for the prefix of the table, and the next one for
+	// each entry is for the key of the entry followed by a zero byte
+	keys := [][]byte{tablePrefix}
+	store := k.votingPowerBbnBlockHeightStore(ctx, height)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		key := append(append([]byte{}, tablePrefix...), iter.Key()...)
+		keys = append(keys, append(key, 0))
+	}
+
+	proofs := make([]*cmtcrypto.ProofOps, 0, len(keys))
+	for _, key := range keys {
+		res, err := k.queryStore(types.StoreKey, key, stateHeight)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Value) != 0 {
+			return nil, fmt.Errorf("unexpected entry in the voting power table at key %X", key)
+		}
+		proofs = append(proofs, res.ProofOps)
+	}
+
+	return proofs, nil
+}
+
+// proveStoreEntry returns the entry with the given key in the given module
+// store, together with its proof against the AppHash at the given height
+func (k Keeper) proveStoreEntry(storeName string, key []byte, stateHeight int64) (*types.ProvenStoreEntry, error) {
+	res, err := k.queryStore(storeName, key, stateHeight)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Value) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no entry with key %X in store %s at height %d", key, storeName, stateHeight)
+	}
+
+	return &types.ProvenStoreEntry{
+		Key:   key,
+		Value: res.Value,
+		Proof: res.ProofOps,
+	}, nil
+}
+
+// queryStore queries the given key in the given module store of the
+// committed multistore with a proof
+func (k Keeper) queryStore(storeName string, key []byte, stateHeight int64) (*storetypes.ResponseQuery, error) {
+	res, err := k.storeQuerier.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", storeName),
+		Data:   key,
+		Height: stateHeight,
+		Prove:  true,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to query key %X in store %s at height %d: %v", key, storeName, stateHeight, err)
+	}
+	return res, nil
+}
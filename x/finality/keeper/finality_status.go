@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// GetLatestFinalizedHeight returns the highest finalized height and the
+// indexed block at this height, which is nil if the block is pruned. It
+// returns false if there is no finalized block.
+// Blocks are finalized in ascending order of height, thus the latest finalized
+// block is the highest finalized block below the next height to finalize.
+func (k Keeper) GetLatestFinalizedHeight(ctx context.Context) (uint64, *types.IndexedBlock, bool) {
+	iter := k.blockStore(ctx).ReverseIterator(nil, sdk.Uint64ToBigEndian(k.getNextHeightToFinalize(ctx)))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var ib types.IndexedBlock
+		k.cdc.MustUnmarshal(iter.Value(), &ib)
+		if ib.Finalized {
+			return ib.Height, &ib, true
+		}
+	}
+
+	// all the finalized blocks are pruned, and the pruned heights are
+	// finalized
+	if nextHeightToPrune := k.GetNextHeightToPrune(ctx); nextHeightToPrune > 0 {
+		return nextHeightToPrune - 1, nil, true
+	}
+
+	return 0, nil, false
+}
+
+// GetBlockFinalityStatus returns the compact finality status of the block at
+// the given height, or nil if the block is not indexed
+func (k Keeper) GetBlockFinalityStatus(ctx context.Context, height uint64) *types.BlockFinalityStatus {
+	if k.IsHeightPruned(ctx, height) {
+		// the votes are pruned, while the voting power table is kept
+		var totalPower uint64
+		for _, power := range k.GetVotingPowerTable(ctx, height) {
+			totalPower += power
+		}
+		if totalPower == 0 {
+			return nil
+		}
+		return &types.BlockFinalityStatus{
+			Height:           height,
+			Finalized:        true,
+			Pruned:           true,
+			TotalVotingPower: totalPower,
+			VotedRatio:       sdkmath.LegacyZeroDec(),
+		}
+	}
+
+	ib, err := k.GetBlock(ctx, height)
+	if err != nil {
+		return nil
+	}
+
+	status := &types.BlockFinalityStatus{
+		Height:     height,
+		Finalized:  ib.Finalized,
+		VotedRatio: sdkmath.LegacyZeroDec(),
+	}
+	if tally := k.GetVoteTally(ctx, height); tally != nil {
+		// the vote tally is kept until the block is finalized
+		status.TotalVotingPower = tally.TotalVotingPower
+		status.VotedVotingPower = tally.VotedVotingPower
+	} else {
+		voters := k.GetVoters(ctx, height)
+		for fpBTCPKHex, power := range k.GetVotingPowerTable(ctx, height) {
+			status.TotalVotingPower += power
+			if _, ok := voters[fpBTCPKHex]; ok {
+				status.VotedVotingPower += power
+			}
+		}
+	}
+	if status.TotalVotingPower > 0 {
+		status.VotedRatio = sdkmath.LegacyNewDec(int64(status.VotedVotingPower)).QuoInt64(int64(status.TotalVotingPower))
+	}
+
+	return status
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	btctest "github.com/babylonlabs-io/babylon/v4/testutil/bitcoin"
+	testutil "github.com/babylonlabs-io/babylon/v4/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	btcstktypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	epochingtypes "github.com/babylonlabs-io/babylon/v4/x/epoching/types"
+	ftypes "github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+func FuzzRotateFinalityProviderKeyMsg(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 5)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := btcstktypes.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := btcstktypes.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+		fHooks := h.FinalityHooks.(*ftypes.MockFinalityHooks)
+		fHooks.EXPECT().AfterBtcDelegationActivated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		fHooks.EXPECT().AfterBbnFpEntersActiveSet(gomock.Any(), gomock.Any()).AnyTimes()
+		fHooks.EXPECT().AfterBbnFpRemovedFromActiveSet(gomock.Any(), gomock.Any()).AnyTimes()
+		h.CheckpointingKeeperForFinality.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).Return(uint64(1)).AnyTimes()
+
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+		prevRandListInfo := h.CommitPubRandList(r, fpSK, fp, 1, 100, true)
+
+		// a BTC delegation to the finality provider
+		delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		stakingValue := int64(datagen.RandomInt(r, 10e8)) + 10e8
+		stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+			r, delSK, fpPK, stakingValue, 1000, 0, 0, true, false, 10, 10,
+		)
+		require.NoError(t, err)
+		h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+		h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+
+		// process activation of the BTC delegation
+		btcTip := &btclctypes.BTCHeaderInfo{Height: 30}
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Any()).Return(btcTip).AnyTimes()
+		h.SetCtxHeight(10)
+		h.BeginBlocker()
+		require.Positive(t, h.FinalityKeeper.GetVotingPower(h.Ctx, fp.BtcPk.MustMarshal(), 10))
+
+		newSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		newEotsPk := bbn.NewBIP340PubKeyFromBTCPK(newSK.PubKey())
+
+		// the activation height has to be after the committed public randomness
+		msg, err := datagen.NewMsgRotateFinalityProviderKey(h.Ctx.ChainID(), fp.Addr, fp.BtcPk, fpSK, newSK, 100)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, ftypes.ErrInvalidKeyRotation)
+
+		activationHeight := uint64(201)
+
+		// the signer has to be the finality provider
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(h.Ctx.ChainID(), datagen.GenRandomAccount().Address, fp.BtcPk, fpSK, newSK, activationHeight)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.Error(t, err)
+
+		// the rotation has to be authorized by the current key
+		otherSK, _, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(h.Ctx.ChainID(), fp.Addr, fp.BtcPk, otherSK, newSK, activationHeight)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, ftypes.ErrInvalidKeyRotation)
+
+		// the possession of the new key has to be proven
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(h.Ctx.ChainID(), fp.Addr, fp.BtcPk, fpSK, newSK, activationHeight)
+		require.NoError(t, err)
+		msg.NewKeySig = msg.CurrentKeySig
+		_, err = h.FMsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, ftypes.ErrInvalidKeyRotation)
+
+		// the signatures cannot be replayed from another chain
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(h.Ctx.ChainID()+"-other", fp.Addr, fp.BtcPk, fpSK, newSK, activationHeight)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.ErrorIs(t, err, ftypes.ErrInvalidKeyRotation)
+
+		// successful rotation, while the active BTC delegation commits to the
+		// BTC PK of the finality provider
+		msg, err = datagen.NewMsgRotateFinalityProviderKey(h.Ctx.ChainID(), fp.Addr, fp.BtcPk, fpSK, newSK, activationHeight)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.RotateFinalityProviderKey(h.Ctx, msg)
+		require.NoError(t, err)
+		btcDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, stakingTxHash)
+		require.NoError(t, err)
+		require.Nil(t, btcDel.FpEotsPk)
+
+		// public randomness cannot span the key rotation
+		_, prMsg, err := datagen.GenRandomMsgCommitPubRandList(r, fpSK, 101, 200)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.CommitPubRandList(h.Ctx, prMsg)
+		require.ErrorIs(t, err, ftypes.ErrInvalidPubRand)
+
+		// public randomness before the activation height is committed by the
+		// current key
+		h.CommitPubRandList(r, fpSK, fp, 101, 100, true)
+
+		// public randomness after the activation height has to be committed
+		// by the new key
+		_, prMsg, err = datagen.GenRandomMsgCommitPubRandList(r, fpSK, activationHeight, 100)
+		require.NoError(t, err)
+		_, err = h.FMsgServer.CommitPubRandList(h.Ctx, prMsg)
+		require.ErrorIs(t, err, ftypes.ErrInvalidPubRand)
+
+		_, prMsg, err = datagen.GenRandomMsgCommitPubRandList(r, newSK, activationHeight, 100)
+		require.NoError(t, err)
+		prMsg.FpBtcPk = fp.BtcPk
+		h.CheckpointingKeeperForFinality.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).Times(1)
+		_, err = h.FMsgServer.CommitPubRandList(h.Ctx, prMsg)
+		require.NoError(t, err)
+
+		// the previous key remains slashable for the heights it voted on, and
+		// the secret key revealed by an equivocation after the rotation signs
+		// the slashing tx of the BTC delegation
+		block := datagen.GenRandomBlockWithHeight(r, 10)
+		h.FinalityKeeper.SetBlock(h.Ctx, block)
+		newVote := func(appHash []byte) *ftypes.MsgAddFinalitySig {
+			vote, err := datagen.NewMsgAddFinalitySig(fp.Addr, fpSK, 1, 10, prevRandListInfo, appHash)
+			require.NoError(t, err)
+			return vote
+		}
+		_, err = h.FMsgServer.AddFinalitySig(h.Ctx, newVote(block.AppHash))
+		require.NoError(t, err)
+		_, err = h.FMsgServer.AddFinalitySig(h.Ctx, newVote(datagen.GenRandomByteArray(r, 32)))
+		require.NoError(t, err)
+		evidence, err := h.FinalityKeeper.GetEvidence(h.Ctx, fp.BtcPk, 10)
+		require.NoError(t, err)
+		require.Nil(t, evidence.EotsPk)
+		extractedSK, err := evidence.ExtractBTCSK()
+		require.NoError(t, err)
+
+		params := h.BTCStakingKeeper.GetParamsByVersion(h.Ctx, btcDel.ParamsVersion)
+		stakingInfo, err := btcDel.GetStakingInfo(params, h.Net)
+		require.NoError(t, err)
+		slashingTx, err := btcDel.BuildSlashingTxWithWitness(params, h.Net, extractedSK)
+		require.NoError(t, err)
+		btctest.AssertSlashingTxExecution(t, stakingInfo.StakingOutput, slashingTx)
+
+		actualFp, err := h.BTCStakingKeeper.GetFinalityProvider(h.Ctx, fp.BtcPk.MustMarshal())
+		require.NoError(t, err)
+		require.True(t, actualFp.IsSlashed())
+		require.True(t, newEotsPk.Equals(actualFp.LatestEotsPk()))
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	btcstk "github.com/babylonlabs-io/babylon/v4/btcstaking"
@@ -21,6 +22,10 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		k.SetEvidence(ctx, evidence)
 	}
 
+	// the vote tallies of the non-finalized blocks are built upon setting
+	// the votes and voting power tables
+	k.setNextHeightToFinalize(ctx, gs.NextHeightToFinalize)
+
 	for _, voteSig := range gs.VoteSigs {
 		k.SetSig(ctx, voteSig.BlockHeight, voteSig.FpBtcPk, voteSig.FinalitySig)
 	}
@@ -52,16 +57,27 @@ func (k Keeper) InitGenesis(ctx context.Context, gs types.GenesisState) error {
 		}
 	}
 
+	for _, record := range gs.JailRecords {
+		if err := k.JailRecords.Set(ctx, collections.Join(record.FpBtcPk.MustMarshal(), record.JailCount), *record); err != nil {
+			return err
+		}
+	}
+
 	for _, fpVP := range gs.VotingPowers {
-		k.SetVotingPower(ctx, *fpVP.FpBtcPk, fpVP.BlockHeight, fpVP.VotingPower)
+		k.setVotingPower(ctx, *fpVP.FpBtcPk, fpVP.BlockHeight, fpVP.VotingPower)
+	}
+	// build the vote tallies of the non-finalized blocks once their voting
+	// power tables are set
+	if err := k.buildVoteTallies(ctx); err != nil {
+		return err
 	}
 
 	for _, vpCache := range gs.VpDstCache {
 		k.SetVotingPowerDistCache(ctx, vpCache.BlockHeight, vpCache.VpDistribution)
 	}
 
-	k.setNextHeightToFinalize(ctx, gs.NextHeightToFinalize)
 	k.SetNextHeightToReward(ctx, gs.NextHeightToReward)
+	k.SetNextHeightToPrune(ctx, gs.NextHeightToPrune)
 
 	return k.SetParams(ctx, gs.Params)
 }
@@ -98,6 +114,11 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	jailRecords, err := k.jailRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	vpFps, err := k.fpVotingPowers(ctx)
 	if err != nil {
 		return nil, err
@@ -127,6 +148,8 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		NextHeightToFinalize: k.getNextHeightToFinalize(ctx),
 		NextHeightToReward:   k.GetNextHeightToReward(ctx),
 		PubRandCommitIndexes: idxs,
+		NextHeightToPrune:    k.GetNextHeightToPrune(ctx),
+		JailRecords:          jailRecords,
 	}, nil
 }
 
@@ -332,6 +355,20 @@ func (k Keeper) votingPowersDistCacheBlkHeight(ctx context.Context) ([]*types.Vo
 	return vps, nil
 }
 
+// jailRecords loads the jail records of all finality providers.
+func (k Keeper) jailRecords(ctx context.Context) ([]*types.JailRecord, error) {
+	records := make([]*types.JailRecord, 0)
+	err := k.JailRecords.Walk(ctx, nil, func(_ collections.Pair[[]byte, uint64], record types.JailRecord) (stop bool, err error) {
+		records = append(records, &record)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
 func (k Keeper) pubRandCommitIdxs(ctx context.Context) ([]*types.PubRandCommitIdx, error) {
 	idxs := make([]*types.PubRandCommitIdx, 0)
 	err := k.pubRandCommitIndex.Walk(ctx, nil, func(fpPkBytes []byte, idx types.PubRandCommitIndexValue) (stop bool, err error) {
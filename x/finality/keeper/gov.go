@@ -23,7 +23,6 @@ func (k Keeper) HandleResumeFinalityProposal(ctx sdk.Context, fpPksHex []string,
 	params := k.GetParams(ctx)
 	header := ctx.HeaderInfo()
 	currentHeight := header.Height
-	currentTime := header.Time
 
 	if uint64(haltingHeight) < params.FinalityActivationHeight {
 		return fmt.Errorf("finality halting height %d cannot be lower than finality activation height %d",
@@ -77,21 +76,16 @@ func (k Keeper) HandleResumeFinalityProposal(ctx sdk.Context, fpPksHex []string,
 			"public_key", fpPkHex,
 		)
 
-		err = k.jailSluggishFinalityProvider(ctx, fpPk)
-		if err != nil {
-			return fmt.Errorf("failed to jail the finality provider %s: %w", fpPkHex, err)
-		}
-
-		// update signing info
 		signInfo, err := k.FinalityProviderSigningTracker.Get(ctx, fpBtcPk)
 		if err != nil {
 			return fmt.Errorf("the signing info of finality provider %s is not created: %w", fpPkHex, err)
 		}
-		signInfo.JailedUntil = currentTime.Add(params.JailDuration)
-		signInfo.MissedBlocksCounter = 0
 
-		if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
-			return fmt.Errorf("failed to remove the missed block bit map for finality provider %s: %w", fpPkHex, err)
+		// jail the finality provider and update signing info, without
+		// counting the jailing towards the jail count
+		err = k.jailByGovernance(ctx, fpPk, &signInfo)
+		if err != nil {
+			return fmt.Errorf("failed to jail the finality provider %s: %w", fpPkHex, err)
 		}
 
 		err = k.FinalityProviderSigningTracker.Set(ctx, fpBtcPk, signInfo)
@@ -219,6 +219,71 @@ func TestHandleResumeFinalityProposalWithJailedAndSlashedFp(t *testing.T) {
 	}
 }
 
+func TestHandleResumeFinalityProposalNotCountedInJailCount(t *testing.T) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+	iKeeper := types.NewMockIncentiveKeeper(ctrl)
+	cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper, types.NewMultiFinalityHooks())
+
+	haltingHeight := uint64(100)
+	currentHeight := uint64(110)
+	ctx = datagen.WithCtxHeight(ctx, currentHeight)
+	params := fKeeper.GetParams(ctx)
+
+	// the finality provider to be jailed was jailed for downtime up to one
+	// time less than the tombstoning threshold
+	fpPks := generateNFpPks(t, r, 2)
+	setupActiveFps(t, fpPks, haltingHeight, fKeeper, ctx)
+	fpPk := fpPks[1]
+	prevJailCount := uint64(params.TombstoneJailCount) - 1
+	signingInfo, err := fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk)
+	require.NoError(t, err)
+	signingInfo.JailCount = prevJailCount
+	signingInfo.MissedBlocksCounter = 5
+	require.NoError(t, fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk, signingInfo))
+
+	for h := haltingHeight; h <= currentHeight; h++ {
+		dc := types.NewVotingPowerDistCache()
+		for i := range fpPks {
+			fKeeper.SetVotingPower(ctx, fpPks[i].MustMarshal(), h, 1)
+			dc.AddFinalityProviderDistInfo(&types.FinalityProviderDistInfo{
+				BtcPk:          &fpPks[i],
+				Addr:           datagen.GenRandomAddress(),
+				TotalBondedSat: 1,
+				IsTimestamped:  true,
+			})
+		}
+		dc.ApplyActiveFinalityProviders(uint32(len(fpPks)))
+		fKeeper.SetVotingPowerDistCache(ctx, h, dc)
+	}
+
+	bsKeeper.EXPECT().JailFinalityProvider(ctx, fpPk.MustMarshal()).Return(nil).Times(1)
+	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{
+		Addr:   datagen.GenRandomAddress().String(),
+		BtcPk:  &fpPk,
+		Jailed: false,
+	}, nil).Times(1)
+	err = fKeeper.HandleResumeFinalityProposal(ctx, []string{fpPk.MarshalHex()}, uint32(haltingHeight))
+	require.NoError(t, err)
+
+	// the finality provider is jailed for the base jail duration, and the
+	// jailing is neither counted nor recorded, so that it is not tombstoned
+	signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk)
+	require.NoError(t, err)
+	require.Equal(t, prevJailCount, signingInfo.JailCount)
+	require.False(t, signingInfo.Tombstoned)
+	require.Zero(t, signingInfo.MissedBlocksCounter)
+	require.Equal(t, ctx.HeaderInfo().Time.Add(params.JailDuration), signingInfo.JailedUntil)
+
+	res, err := fKeeper.JailHistory(ctx, &types.QueryJailHistoryRequest{FpBtcPkHex: fpPk.MarshalHex()})
+	require.NoError(t, err)
+	require.Empty(t, res.JailRecords)
+}
+
 func TestHandleResumeFinalityWithBadHaltingHeight(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/runtime"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -191,6 +192,9 @@ func (k Keeper) Block(ctx context.Context, req *types.QueryBlockRequest) (*types
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 	b, err := k.GetBlock(sdkCtx, req.Height)
 	if err != nil {
 		return nil, err
@@ -241,6 +245,9 @@ func (k Keeper) VotesAtHeight(ctx context.Context, req *types.QueryVotesAtHeight
 	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if k.IsHeightPruned(sdkCtx, req.Height) {
+		return nil, types.ErrHeightPruned.Wrapf("height: %d", req.Height)
+	}
 
 	// get the sig set of babylon block at given height
 	btcPks := []bbn.BIP340PubKey{}
@@ -373,12 +380,116 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	return &types.QuerySigningInfosResponse{SigningInfos: convertToSigningInfosResponse(signInfos), Pagination: pageRes}, nil
 }
 
+// JailHistory returns the records of a finality provider being jailed due to
+// liveness downtime, in the order of the jail count.
+func (k Keeper) JailHistory(ctx context.Context, req *types.QueryJailHistoryRequest) (*types.QueryJailHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FpBtcPkHex == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty finality provider public key")
+	}
+
+	fpPk, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider public key")
+	}
+
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		k.JailRecords,
+		req.Pagination,
+		func(_ collections.Pair[[]byte, uint64], record types.JailRecord) (types.JailRecordResponse, error) {
+			return convertToJailRecordResponse(record), nil
+		},
+		query.WithCollectionPaginationPairPrefix[[]byte, uint64](fpPk.MustMarshal()),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryJailHistoryResponse{JailRecords: records, Pagination: pageRes}, nil
+}
+
+// FinalityProviderLiveness returns the liveness history of a finality
+// provider, i.e., the heights it missed within the signed blocks window, its
+// uptime in the recent epochs and the heights at which it was jailed and
+// unjailed.
+func (k Keeper) FinalityProviderLiveness(ctx context.Context, req *types.QueryFinalityProviderLivenessRequest) (*types.QueryFinalityProviderLivenessResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.FpBtcPkHex == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "empty finality provider public key")
+	}
+
+	fpPk, err := bbn.NewBIP340PubKeyFromHex(req.FpBtcPkHex)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid finality provider public key")
+	}
+
+	numEpochs := req.NumEpochs
+	if numEpochs == 0 {
+		numEpochs = types.DefaultLivenessQueryEpochs
+	}
+	if numEpochs > types.MaxLivenessQueryEpochs {
+		return nil, status.Errorf(codes.InvalidArgument, "number of epochs %d exceeds the maximum %d", numEpochs, types.MaxLivenessQueryEpochs)
+	}
+
+	signInfo, err := k.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for the finality provider %s", req.FpBtcPkHex)
+	}
+
+	missedHeights, err := k.GetMissedHeights(ctx, &signInfo)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	windowStart, windowEnd := k.GetSignedBlocksWindow(ctx, &signInfo)
+
+	jailRecords := make([]types.JailRecordResponse, 0, signInfo.JailCount)
+	rng := collections.NewPrefixedPairRange[[]byte, uint64](fpPk.MustMarshal())
+	err = k.JailRecords.Walk(ctx, rng, func(_ collections.Pair[[]byte, uint64], record types.JailRecord) (stop bool, err error) {
+		jailRecords = append(jailRecords, convertToJailRecordResponse(record))
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryFinalityProviderLivenessResponse{
+		SigningInfo:       convertToSigningInfoResponse(signInfo),
+		WindowStartHeight: windowStart,
+		WindowEndHeight:   windowEnd,
+		MissedHeights:     missedHeights,
+		EpochUptimes:      k.GetEpochUptimes(ctx, fpPk, numEpochs),
+		JailRecords:       jailRecords,
+	}, nil
+}
+
 func convertToSigningInfoResponse(info types.FinalityProviderSigningInfo) types.SigningInfoResponse {
 	return types.SigningInfoResponse{
 		FpBtcPkHex:          info.FpBtcPk.MarshalHex(),
 		StartHeight:         info.StartHeight,
 		MissedBlocksCounter: info.MissedBlocksCounter,
 		JailedUntil:         info.JailedUntil,
+		JailCount:           info.JailCount,
+		Tombstoned:          info.Tombstoned,
+	}
+}
+
+func convertToJailRecordResponse(record types.JailRecord) types.JailRecordResponse {
+	return types.JailRecordResponse{
+		FpBtcPkHex:     record.FpBtcPk.MarshalHex(),
+		JailCount:      record.JailCount,
+		Height:         record.Height,
+		JailedAt:       record.JailedAt,
+		JailedUntil:    record.JailedUntil,
+		Tombstoned:     record.Tombstoned,
+		UnjailedHeight: record.UnjailedHeight,
+		UnjailedAt:     record.UnjailedAt,
 	}
 }
 
@@ -458,3 +569,99 @@ func (k Keeper) VotingPowerDistribution(ctx context.Context, req *types.QueryVot
 		NumActiveFps:      uint64(dc.NumActiveFps),
 	}, nil
 }
+
+// FinalityHalt returns the first non-finalized block and the finality providers
+// that have not voted for it, together with the parameters of a
+// MsgResumeFinalityProposal jailing them
+func (k Keeper) FinalityHalt(ctx context.Context, req *types.QueryFinalityHaltRequest) (*types.QueryFinalityHaltResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	halt := k.GetFinalityHalt(sdkCtx)
+	if halt == nil {
+		return &types.QueryFinalityHaltResponse{}, nil
+	}
+
+	fpPksHex := make([]string, 0, len(halt.NonVotingFps))
+	for _, fp := range halt.NonVotingFps {
+		fpPksHex = append(fpPksHex, fp.FpBtcPkHex)
+	}
+
+	return &types.QueryFinalityHaltResponse{
+		Halted:        k.IsFinalityHalted(sdkCtx, halt),
+		Halt:          halt,
+		FpPksHex:      fpPksHex,
+		HaltingHeight: uint32(halt.HaltingHeight),
+	}, nil
+}
+
+// LatestFinalizedHeight returns the highest finalized height
+func (k Keeper) LatestFinalizedHeight(ctx context.Context, req *types.QueryLatestFinalizedHeightRequest) (*types.QueryLatestFinalizedHeightResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height, ib, found := k.GetLatestFinalizedHeight(sdkCtx)
+	if !found {
+		return nil, status.Error(codes.NotFound, "no finalized block yet")
+	}
+
+	resp := &types.QueryLatestFinalizedHeightResponse{Height: height}
+	if ib != nil {
+		resp.AppHash = ib.AppHash
+	}
+	return resp, nil
+}
+
+// FinalityStatusRange returns the compact finality status of each indexed
+// block in the given range of heights
+func (k Keeper) FinalityStatusRange(ctx context.Context, req *types.QueryFinalityStatusRangeRequest) (*types.QueryFinalityStatusRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.StartHeight > req.EndHeight {
+		return nil, status.Errorf(codes.InvalidArgument, "start height %d is higher than end height %d", req.StartHeight, req.EndHeight)
+	}
+	if req.EndHeight-req.StartHeight >= types.MaxFinalityStatusRange {
+		return nil, status.Errorf(codes.InvalidArgument, "the range can span at most %d heights", types.MaxFinalityStatusRange)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	statuses := make([]*types.BlockFinalityStatus, 0)
+	for height := req.StartHeight; height <= req.EndHeight; height++ {
+		if s := k.GetBlockFinalityStatus(sdkCtx, height); s != nil {
+			statuses = append(statuses, s)
+		}
+	}
+	latestFinalizedHeight, _, _ := k.GetLatestFinalizedHeight(sdkCtx)
+
+	return &types.QueryFinalityStatusRangeResponse{
+		Statuses:              statuses,
+		LatestFinalizedHeight: latestFinalizedHeight,
+	}, nil
+}
+
+// EpochVoteParticipation returns the finality vote participation of each
+// active finality provider in the given epoch, up to the current height
+func (k Keeper) EpochVoteParticipation(ctx context.Context, req *types.QueryEpochVoteParticipationRequest) (*types.QueryEpochVoteParticipationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	start, end, found := k.getEpochHeightRange(sdkCtx, req.EpochNum, uint64(sdkCtx.HeaderInfo().Height))
+	if !found {
+		return nil, status.Errorf(codes.InvalidArgument, "epoch %d has not started yet", req.EpochNum)
+	}
+	participations, numPrunedHeights := k.GetVoteParticipation(sdkCtx, start, end)
+
+	return &types.QueryEpochVoteParticipationResponse{
+		StartHeight:      start,
+		EndHeight:        end,
+		NumPrunedHeights: numPrunedHeights,
+		Participations:   participations,
+	}, nil
+}
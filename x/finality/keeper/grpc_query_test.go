@@ -5,6 +5,7 @@ import (
 	"math/rand"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/btcsuite/btcd/btcec/v2/schnorr"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -343,6 +344,107 @@ func FuzzListBlocks(f *testing.F) {
 	})
 }
 
+func FuzzFinalityStatusRange(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		// Setup keeper and context
+		keeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, nil, nil)
+
+		// no block is finalized yet
+		_, err := keeper.LatestFinalizedHeight(ctx, &types.QueryLatestFinalizedHeightRequest{})
+		require.Equal(t, codes.NotFound, status.Code(err))
+
+		// index a random list of blocks with a voting power table, and let
+		// finality providers vote for them at random
+		startHeight := datagen.RandomInt(r, 100) + 1
+		numBlocks := datagen.RandomInt(r, 50) + 1
+		endHeight := startHeight + numBlocks - 1
+		numFps := int(datagen.RandomInt(r, 5)) + 1
+		fpBtcPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			fpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpBtcPks = append(fpBtcPks, fpBtcPk)
+		}
+		for height := startHeight; height <= endHeight; height++ {
+			keeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:  height,
+				AppHash: datagen.GenRandomByteArray(r, 32),
+			})
+			for _, fpBtcPk := range fpBtcPks {
+				keeper.SetVotingPower(ctx, fpBtcPk.MustMarshal(), height, datagen.RandomInt(r, 100)+1)
+				if r.Intn(5) != 0 {
+					sig, err := datagen.GenRandomFinalitySig(r)
+					require.NoError(t, err)
+					keeper.SetSig(ctx, height, fpBtcPk, sig)
+				}
+			}
+		}
+
+		// the expected status of each block, where the blocks are finalized
+		// in order until the first block without quorum
+		expStatuses := make([]*types.BlockFinalityStatus, 0, numBlocks)
+		expLatestFinalizedHeight := startHeight - 1
+		for height := startHeight; height <= endHeight; height++ {
+			s := &types.BlockFinalityStatus{Height: height}
+			voters := keeper.GetVoters(ctx, height)
+			for fpBtcPkHex, power := range keeper.GetVotingPowerTable(ctx, height) {
+				s.TotalVotingPower += power
+				if _, ok := voters[fpBtcPkHex]; ok {
+					s.VotedVotingPower += power
+				}
+			}
+			s.VotedRatio = sdkmath.LegacyNewDec(int64(s.VotedVotingPower)).QuoInt64(int64(s.TotalVotingPower))
+			if expLatestFinalizedHeight == height-1 && s.VotedVotingPower*3 > s.TotalVotingPower*2 {
+				s.Finalized = true
+				expLatestFinalizedHeight = height
+			}
+			expStatuses = append(expStatuses, s)
+		}
+		ctx = datagen.WithCtxHeight(ctx, endHeight)
+		keeper.TallyBlocks(ctx, types.MaxFinalizedRewardedBlocksPerEndBlock)
+
+		// the latest finalized height is consistent
+		latestResp, err := keeper.LatestFinalizedHeight(ctx, &types.QueryLatestFinalizedHeightRequest{})
+		if expLatestFinalizedHeight == startHeight-1 {
+			require.Equal(t, codes.NotFound, status.Code(err))
+			expLatestFinalizedHeight = 0
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, expLatestFinalizedHeight, latestResp.Height)
+			ib, err := keeper.GetBlock(ctx, expLatestFinalizedHeight)
+			require.NoError(t, err)
+			require.Equal(t, ib.AppHash, latestResp.AppHash)
+		}
+
+		// the statuses of a random range around the indexed blocks are
+		// consistent, and heights without an indexed block are omitted
+		queryStart := startHeight - datagen.RandomInt(r, int(startHeight))
+		queryEnd := endHeight + datagen.RandomInt(r, 10)
+		resp, err := keeper.FinalityStatusRange(ctx, &types.QueryFinalityStatusRangeRequest{
+			StartHeight: queryStart,
+			EndHeight:   queryEnd,
+		})
+		require.NoError(t, err)
+		require.Equal(t, expLatestFinalizedHeight, resp.LatestFinalizedHeight)
+		require.Equal(t, expStatuses, resp.Statuses)
+
+		// the range is validated
+		_, err = keeper.FinalityStatusRange(ctx, &types.QueryFinalityStatusRangeRequest{
+			StartHeight: endHeight,
+			EndHeight:   startHeight - 1,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = keeper.FinalityStatusRange(ctx, &types.QueryFinalityStatusRangeRequest{
+			StartHeight: startHeight,
+			EndHeight:   startHeight + types.MaxFinalityStatusRange,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func FuzzVotesAtHeight(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
@@ -639,6 +741,116 @@ func FuzzSigningInfo(f *testing.F) {
 	})
 }
 
+func FuzzFinalityProviderLiveness(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(&bstypes.FinalityProvider{BtcPk: fpPk}, nil).AnyTimes()
+		// each epoch has 10 blocks
+		epochInterval := uint64(10)
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, height uint64) uint64 {
+				return (height-1)/epochInterval + 1
+			}).AnyTimes()
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, bsKeeper, nil, cKeeper, nil)
+
+		// the finality provider is never jailed
+		params := fKeeper.GetParams(ctx)
+		params.SignedBlocksWindow = int64(datagen.RandomInt(r, 50) + 10)
+		params.MinSignedPerWindow = sdkmath.LegacyZeroDec()
+		require.NoError(t, fKeeper.SetParams(ctx, params))
+
+		// the finality provider becomes active at startHeight, has voting
+		// power at random heights since then and votes for random heights
+		startHeight := int64(datagen.RandomInt(r, 50) + 1)
+		lastHeight := startHeight + int64(datagen.RandomInt(r, 200))
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), types.NewFinalityProviderSigningInfo(fpPk, startHeight, 0))
+		require.NoError(t, err)
+		active := make(map[int64]bool)
+		voted := make(map[int64]bool)
+		for height := startHeight; height <= lastHeight; height++ {
+			active[height] = height == startHeight || r.Intn(10) > 0
+			voted[height] = active[height] && r.Intn(3) > 0
+			if !active[height] {
+				continue
+			}
+			fKeeper.SetVotingPower(ctx, fpPk.MustMarshal(), uint64(height), 1)
+			if voted[height] {
+				sig, err := datagen.GenRandomFinalitySig(r)
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, uint64(height), fpPk, sig)
+			}
+			err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, !voted[height], height)
+			require.NoError(t, err)
+		}
+		ctx = datagen.WithCtxHeight(ctx, uint64(lastHeight+params.FinalitySigTimeout))
+
+		numEpochs := datagen.RandomInt(r, 5) + 1
+		res, err := fKeeper.FinalityProviderLiveness(ctx, &types.QueryFinalityProviderLivenessRequest{
+			FpBtcPkHex: fpPk.MarshalHex(),
+			NumEpochs:  numEpochs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, fpPk.MarshalHex(), res.SigningInfo.FpBtcPkHex)
+		require.Empty(t, res.JailRecords)
+
+		// the missed heights within the signed blocks window
+		windowStart := max(lastHeight-params.SignedBlocksWindow+1, startHeight)
+		require.Equal(t, windowStart, res.WindowStartHeight)
+		require.Equal(t, lastHeight, res.WindowEndHeight)
+		expMissedHeights := make([]int64, 0)
+		for height := windowStart; height <= lastHeight; height++ {
+			if active[height] && !voted[height] {
+				expMissedHeights = append(expMissedHeights, height)
+			}
+		}
+		require.Equal(t, expMissedHeights, res.MissedHeights)
+		// the counter also counts the bits of the heights at which the finality
+		// provider has no voting power, which refer to earlier heights
+		require.LessOrEqual(t, int64(len(expMissedHeights)), res.SigningInfo.MissedBlocksCounter)
+
+		// the uptimes in the most recent epochs
+		lastEpoch := (uint64(lastHeight)-1)/epochInterval + 1
+		firstEpoch := (uint64(startHeight)-1)/epochInterval + 1
+		expNumEpochs := min(numEpochs, lastEpoch-firstEpoch+1)
+		require.Len(t, res.EpochUptimes, int(expNumEpochs))
+		for i, uptime := range res.EpochUptimes {
+			require.Equal(t, lastEpoch-uint64(i), uptime.EpochNum)
+			numActive, numVoted := uint64(0), uint64(0)
+			for height := startHeight; height <= lastHeight; height++ {
+				if (uint64(height)-1)/epochInterval+1 != uptime.EpochNum || !active[height] {
+					continue
+				}
+				numActive++
+				if voted[height] {
+					numVoted++
+				}
+			}
+			require.Equal(t, numActive, uptime.NumActiveHeights)
+			require.Equal(t, numVoted, uptime.NumVotedHeights)
+			if numActive > 0 {
+				require.Equal(t, sdkmath.LegacyNewDec(int64(numVoted)).QuoInt64(int64(numActive)), uptime.Uptime)
+			} else {
+				require.True(t, uptime.Uptime.IsZero())
+			}
+		}
+
+		// too many epochs
+		_, err = fKeeper.FinalityProviderLiveness(ctx, &types.QueryFinalityProviderLivenessRequest{
+			FpBtcPkHex: fpPk.MarshalHex(),
+			NumEpochs:  types.MaxLivenessQueryEpochs + 1,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func convertToEvidence(er *types.EvidenceResponse) *types.Evidence {
 	fpBtcPk, err := bbn.NewBIP340PubKeyFromHex(er.FpBtcPkHex)
 	if err != nil {
@@ -674,6 +886,111 @@ func constructRequestWithLimit(r *rand.Rand, limit uint64) *query.PageRequest {
 	return constructRequestWithKeyAndLimit(r, nil, limit)
 }
 
+func FuzzEpochVoteParticipation(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		epochInterval := datagen.RandomInt(r, 20) + 5
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, height uint64) uint64 {
+				if height == 0 {
+					return 0
+				}
+				return (height-1)/epochInterval + 1
+			}).AnyTimes()
+		fKeeper, ctx := testkeeper.FinalityKeeper(t, nil, nil, cKeeper, nil)
+
+		// finality providers are active at random heights of a few epochs
+		// and vote for them at random
+		currentHeight := datagen.RandomInt(r, int(epochInterval*3)) + 1
+		ctx = datagen.WithCtxHeight(ctx, currentHeight)
+		numFps := int(datagen.RandomInt(r, 5)) + 1
+		fpBtcPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			fpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpBtcPks = append(fpBtcPks, fpBtcPk)
+		}
+		epochNum := datagen.RandomInt(r, int((currentHeight-1)/epochInterval+1)) + 1
+		expStart := (epochNum-1)*epochInterval + 1
+		expEnd := min(epochNum*epochInterval, currentHeight)
+		expParticipations := map[string]*types.FinalityProviderVoteParticipation{}
+		for height := uint64(1); height <= currentHeight; height++ {
+			for _, fpBtcPk := range fpBtcPks {
+				if r.Intn(4) == 0 {
+					continue
+				}
+				power := datagen.RandomInt(r, 100) + 1
+				fKeeper.SetVotingPower(ctx, fpBtcPk.MustMarshal(), height, power)
+				voted := r.Intn(3) != 0
+				if voted {
+					sig, err := datagen.GenRandomFinalitySig(r)
+					require.NoError(t, err)
+					fKeeper.SetSig(ctx, height, fpBtcPk, sig)
+				}
+
+				if height < expStart || height > expEnd {
+					continue
+				}
+				p, ok := expParticipations[fpBtcPk.MarshalHex()]
+				if !ok {
+					p = &types.FinalityProviderVoteParticipation{FpBtcPkHex: fpBtcPk.MarshalHex()}
+					expParticipations[fpBtcPk.MarshalHex()] = p
+				}
+				p.EligibleHeights++
+				p.EligibleVotingPower += power
+				if voted {
+					p.SignedHeights++
+					p.SignedVotingPower += power
+					if p.FirstSignedHeight == 0 {
+						p.FirstSignedHeight = height
+					}
+					p.LastSignedHeight = height
+				}
+			}
+		}
+
+		resp, err := fKeeper.EpochVoteParticipation(ctx, &types.QueryEpochVoteParticipationRequest{EpochNum: epochNum})
+		require.NoError(t, err)
+		require.Equal(t, expStart, resp.StartHeight)
+		require.Equal(t, expEnd, resp.EndHeight)
+		require.Zero(t, resp.NumPrunedHeights)
+		require.Len(t, resp.Participations, len(expParticipations))
+		for i, p := range resp.Participations {
+			if i > 0 {
+				require.Less(t, resp.Participations[i-1].FpBtcPkHex, p.FpBtcPkHex)
+			}
+			expP := expParticipations[p.FpBtcPkHex]
+			require.NotNil(t, expP)
+			require.Equal(t, expP.EligibleHeights, p.EligibleHeights)
+			require.Equal(t, expP.SignedHeights, p.SignedHeights)
+			require.Equal(t, expP.FirstSignedHeight, p.FirstSignedHeight)
+			require.Equal(t, expP.LastSignedHeight, p.LastSignedHeight)
+			require.Equal(t, expP.EligibleVotingPower, p.EligibleVotingPower)
+			require.Equal(t, expP.SignedVotingPower, p.SignedVotingPower)
+			expWeighted := sdkmath.LegacyNewDec(int64(expP.SignedVotingPower)).QuoInt64(int64(expP.EligibleVotingPower))
+			require.True(t, expWeighted.Equal(p.WeightedParticipation))
+		}
+
+		// heights whose votes are pruned are not counted
+		fKeeper.SetNextHeightToPrune(ctx, expEnd+1)
+		resp, err = fKeeper.EpochVoteParticipation(ctx, &types.QueryEpochVoteParticipationRequest{EpochNum: epochNum})
+		require.NoError(t, err)
+		require.Empty(t, resp.Participations)
+		require.Equal(t, len(expParticipations) > 0, resp.NumPrunedHeights > 0)
+
+		// an epoch that has not started yet is rejected
+		_, err = fKeeper.EpochVoteParticipation(ctx, &types.QueryEpochVoteParticipationRequest{EpochNum: (currentHeight-1)/epochInterval + 2})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		_, err = fKeeper.EpochVoteParticipation(ctx, &types.QueryEpochVoteParticipationRequest{EpochNum: 0})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
 func FuzzVotingPowerDistribution(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 10)
 	f.Fuzz(func(t *testing.T, seed int64) {
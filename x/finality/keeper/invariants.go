@@ -0,0 +1,146 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bstypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// RegisterInvariants registers all finality invariants
+//
+//nolint:staticcheck
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "voting-power-dist-cache", VotingPowerDistCacheInvariant(k))
+}
+
+// AllInvariants runs all invariants of the finality module
+//
+//nolint:staticcheck
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		return VotingPowerDistCacheInvariant(k)(ctx)
+	}
+}
+
+// VotingPowerDistCacheInvariant checks that the total bonded satoshis of each
+// finality provider in the voting power distribution cache of the current
+// height equals the sum of satoshis of its active BTC delegations.
+// The voting power distribution cache is computed upon `BeginBlock`, so BTC
+// delegations activated or unbonded in the current block are reflected by
+// the power distribution update events that are not processed yet.
+//
+//nolint:staticcheck
+func VotingPowerDistCacheInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		height := uint64(ctx.HeaderInfo().Height)
+		dc := k.GetVotingPowerDistCache(ctx, height)
+		if dc == nil {
+			dc = types.NewVotingPowerDistCache()
+		}
+		btcTipHeight := k.BTCStakingKeeper.GetCurrentBTCHeight(ctx)
+
+		// BTC delegations whose activation or unbonding is not processed yet
+		pendingActive := make(map[string]struct{})
+		pendingUnbonded := make(map[string]struct{})
+		k.BTCStakingKeeper.IterateAllPowerDistUpdateEvents(ctx, func(_ uint32, event *bstypes.EventPowerDistUpdate) bool {
+			delEvent := event.GetBtcDelStateUpdate()
+			if delEvent == nil {
+				return true
+			}
+			switch delEvent.NewState {
+			case bstypes.BTCDelegationStatus_ACTIVE:
+				pendingActive[delEvent.StakingTxHash] = struct{}{}
+			case bstypes.BTCDelegationStatus_UNBONDED:
+				pendingUnbonded[delEvent.StakingTxHash] = struct{}{}
+			}
+			return true
+		})
+
+		// finality provider BTC PK hex -> sum of satoshis of active BTC delegations
+		activeSats := make(map[string]uint64)
+		err := k.BTCStakingKeeper.IterateBTCDelegations(ctx, func(btcDel *bstypes.BTCDelegation) error {
+			stakingTxHash := btcDel.MustGetStakingTxHash().String()
+			if _, ok := pendingActive[stakingTxHash]; ok {
+				return nil
+			}
+
+			params := k.BTCStakingKeeper.GetParamsByVersion(ctx, btcDel.ParamsVersion)
+			if params == nil {
+				return fmt.Errorf("params version %d of BTC delegation %s not found", btcDel.ParamsVersion, stakingTxHash)
+			}
+
+			var inCache bool
+			if _, ok := pendingUnbonded[stakingTxHash]; ok {
+				// the BTC delegation is still in the cache only if it had
+				// covenant quorum, see processBtcDelUpdate
+				hasQuorum, err := k.BTCStakingKeeper.BtcDelHasCovenantQuorums(ctx, btcDel, params.CovenantQuorum)
+				if err != nil {
+					return err
+				}
+				inCache = hasQuorum
+			} else {
+				status, err := k.BTCStakingKeeper.BtcDelStatus(ctx, btcDel, params.CovenantQuorum, btcTipHeight)
+				if err != nil {
+					return err
+				}
+				inCache = status == bstypes.BTCDelegationStatus_ACTIVE
+			}
+
+			if inCache {
+				for _, fpBTCPK := range btcDel.FpBtcPkList {
+					activeSats[fpBTCPK.MarshalHex()] += btcDel.TotalSat
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tfailed to iterate BTC delegations: %s\n", err.Error())
+			return sdk.FormatInvariant(types.ModuleName, "voting-power-dist-cache", msg), true
+		}
+
+		for _, fp := range dc.FinalityProviders {
+			fpBTCPKHex := fp.BtcPk.MarshalHex()
+			if fp.TotalBondedSat != activeSats[fpBTCPKHex] {
+				broken = true
+				msg += fmt.Sprintf("\tfinality provider %s has %d bonded sats in the voting power distribution cache but %d sats in active BTC delegations\n",
+					fpBTCPKHex, fp.TotalBondedSat, activeSats[fpBTCPKHex])
+			}
+			delete(activeSats, fpBTCPKHex)
+		}
+
+		// the rest of finality providers with active BTC delegations are
+		// missing in the cache, which is expected only if they are slashed
+		missingFps := make([]string, 0, len(activeSats))
+		for fpBTCPKHex, sats := range activeSats {
+			if sats > 0 {
+				missingFps = append(missingFps, fpBTCPKHex)
+			}
+		}
+		sort.Strings(missingFps)
+		for _, fpBTCPKHex := range missingFps {
+			fpBTCPK, err := hex.DecodeString(fpBTCPKHex)
+			if err != nil {
+				panic(err) // only programming error
+			}
+			fp, err := k.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPK)
+			if err == nil && fp.IsSlashed() {
+				continue
+			}
+			broken = true
+			msg += fmt.Sprintf("\tfinality provider %s has %d sats in active BTC delegations but is missing in the voting power distribution cache\n",
+				fpBTCPKHex, activeSats[fpBTCPKHex])
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "voting-power-dist-cache", msg), broken
+	}
+}
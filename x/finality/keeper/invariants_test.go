@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/babylonlabs-io/babylon/v4/testutil/btcstaking-helper"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
+	btcstakingkeeper "github.com/babylonlabs-io/babylon/v4/x/btcstaking/keeper"
+	btcstktypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	finalitykeeper "github.com/babylonlabs-io/babylon/v4/x/finality/keeper"
+	ftypes "github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+func FuzzVotingPowerDistCacheInvariant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		btclcKeeper := btcstktypes.NewMockBTCLightClientKeeper(ctrl)
+		btccKeeper := btcstktypes.NewMockBtcCheckpointKeeper(ctrl)
+		h := testutil.NewHelper(t, btclcKeeper, btccKeeper, nil)
+		fHooks := h.FinalityHooks.(*ftypes.MockFinalityHooks)
+		fHooks.EXPECT().AfterBtcDelegationActivated(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		fHooks.EXPECT().AfterBtcDelegationUnbonded(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+		fHooks.EXPECT().AfterBbnFpEntersActiveSet(gomock.Any(), gomock.Any()).AnyTimes()
+		fHooks.EXPECT().AfterBbnFpRemovedFromActiveSet(gomock.Any(), gomock.Any()).AnyTimes()
+
+		covenantSKs, _ := h.GenAndApplyParams(r)
+		fpSK, fpPK, fp := h.CreateFinalityProvider(r)
+		h.CommitPubRandList(r, fpSK, fp, 1, 100, true)
+
+		createActiveDelegation := func(stakingValue int64) {
+			delSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			h.NoError(err)
+			stakingTxHash, msgCreateBTCDel, actualDel, btcHeaderInfo, inclusionProof, _, err := h.CreateDelegationWithBtcBlockHeight(
+				r, delSK, fpPK, stakingValue, 1000, 0, 0, true, false, 10, 10,
+			)
+			h.NoError(err)
+			h.CreateCovenantSigs(r, covenantSKs, msgCreateBTCDel, actualDel, 10)
+			h.AddInclusionProof(stakingTxHash, btcHeaderInfo, inclusionProof, 30)
+		}
+
+		totalSats := uint64(0)
+		numDels := int(datagen.RandomInt(r, 3)) + 1
+		for i := 0; i < numDels; i++ {
+			stakingValue := int64(datagen.RandomInt(r, 10e8)) + 10e8
+			createActiveDelegation(stakingValue)
+			totalSats += uint64(stakingValue)
+		}
+
+		// BTC delegations activated in the current block are not in the
+		// voting power distribution cache until the next `BeginBlock`
+		msg, broken := finalitykeeper.AllInvariants(*h.FinalityKeeper)(h.Ctx)
+		require.False(t, broken, msg)
+
+		// process activation of BTC delegations
+		btcTip := &btclctypes.BTCHeaderInfo{Height: 30}
+		babylonHeight := datagen.RandomInt(r, 10) + 1
+		h.SetCtxHeight(babylonHeight)
+		h.BTCLightClientKeeper.EXPECT().GetTipInfo(gomock.Eq(h.Ctx)).Return(btcTip).AnyTimes()
+		h.BeginBlocker()
+
+		dc := h.FinalityKeeper.GetVotingPowerDistCache(h.Ctx, babylonHeight)
+		require.Len(t, dc.FinalityProviders, 1)
+		require.Equal(t, totalSats, dc.FinalityProviders[0].TotalBondedSat)
+
+		msg, broken = finalitykeeper.AllInvariants(*h.FinalityKeeper)(h.Ctx)
+		require.False(t, broken, msg)
+		msg, broken = btcstakingkeeper.AllInvariants(*h.BTCStakingKeeper)(h.Ctx)
+		require.False(t, broken, msg)
+
+		// drift in the voting power distribution cache is detected
+		dc.FinalityProviders[0].TotalBondedSat++
+		h.FinalityKeeper.SetVotingPowerDistCache(h.Ctx, babylonHeight, dc)
+		_, broken = finalitykeeper.AllInvariants(*h.FinalityKeeper)(h.Ctx)
+		require.True(t, broken)
+
+		// missing expiry events are detected
+		params := h.BTCStakingKeeper.GetParams(h.Ctx)
+		expiryEvents := 0
+		h.BTCStakingKeeper.IterateAllPowerDistUpdateEvents(h.Ctx, func(btcHeight uint32, event *btcstktypes.EventPowerDistUpdate) bool {
+			if event.GetBtcDelStateUpdate().GetNewState() == btcstktypes.BTCDelegationStatus_EXPIRED {
+				require.Greater(t, btcHeight, btcTip.Height)
+				expiryEvents++
+			}
+			return true
+		})
+		require.Equal(t, numDels, expiryEvents)
+
+		actualDel, err := h.BTCStakingKeeper.GetBTCDelegation(h.Ctx, anyBTCDelStakingTxHash(t, h))
+		h.NoError(err)
+		h.BTCStakingKeeper.ClearPowerDistUpdateEvents(h.Ctx, actualDel.EndHeight-params.UnbondingTimeBlocks)
+		_, broken = btcstakingkeeper.AllInvariants(*h.BTCStakingKeeper)(h.Ctx)
+		require.True(t, broken)
+	})
+}
+
+// anyBTCDelStakingTxHash returns the staking tx hash of an arbitrary BTC delegation
+func anyBTCDelStakingTxHash(t *testing.T, h *testutil.Helper) string {
+	var stakingTxHash string
+	err := h.BTCStakingKeeper.IterateBTCDelegations(h.Ctx, func(btcDel *btcstktypes.BTCDelegation) error {
+		stakingTxHash = btcDel.MustGetStakingTxHash().String()
+		return nil
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, stakingTxHash)
+	return stakingTxHash
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -15,8 +16,11 @@ import (
 
 type (
 	Keeper struct {
-		cdc                   codec.BinaryCodec
-		storeService          corestoretypes.KVStoreService
+		cdc          codec.BinaryCodec
+		storeService corestoretypes.KVStoreService
+		// storeQuerier queries the committed multistore for proofs of the
+		// store entries against the AppHash
+		storeQuerier          storetypes.Queryable
 		hooks                 types.FinalityHooks
 		finalityModuleAddress string
 
@@ -31,6 +35,12 @@ type (
 		FinalityProviderSigningTracker collections.Map[[]byte, types.FinalityProviderSigningInfo]
 		// FinalityProviderMissedBlockBitmap key: BIP340PubKey bytes | value: byte key for a finality provider's missed block bitmap chunk
 		FinalityProviderMissedBlockBitmap collections.Map[collections.Pair[[]byte, uint64], []byte]
+		// JailRecords key: (BIP340PubKey bytes, jail count) | value: JailRecord
+		JailRecords collections.Map[collections.Pair[[]byte, uint64], types.JailRecord]
+		// voteTallies key: block height | value: VoteTally
+		voteTallies collections.Map[uint64, types.VoteTally]
+		// votingPowerIndexes key: (block height, BIP340PubKey bytes) | value: index of the finality provider in the voting power table
+		votingPowerIndexes collections.Map[collections.Pair[uint64, []byte], uint32]
 
 		// pubRandCommitIndex key: BIP340PubKey bytes | value: PubRandCommitIndexValue (ordered start heights of commitments)
 		// This index is useful for retrieving PubRandCommits using binary search
@@ -41,6 +51,7 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService corestoretypes.KVStoreService,
+	storeQuerier storetypes.Queryable,
 	btcstakingKeeper types.BTCStakingKeeper,
 	incentiveKeeper types.IncentiveKeeper,
 	checkpointingKeeper types.CheckpointingKeeper,
@@ -50,6 +61,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
+		storeQuerier: storeQuerier,
 
 		BTCStakingKeeper:    btcstakingKeeper,
 		IncentiveKeeper:     incentiveKeeper,
@@ -69,6 +81,27 @@ func NewKeeper(
 			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
 			collections.BytesValue,
 		),
+		JailRecords: collections.NewMap(
+			sb,
+			types.JailRecordKeyPrefix,
+			"jail_records",
+			collections.PairKeyCodec(collections.BytesKey, collections.Uint64Key),
+			codec.CollValue[types.JailRecord](cdc),
+		),
+		voteTallies: collections.NewMap(
+			sb,
+			types.VoteTallyKeyPrefix,
+			"vote_tallies",
+			collections.Uint64Key,
+			codec.CollValue[types.VoteTally](cdc),
+		),
+		votingPowerIndexes: collections.NewMap(
+			sb,
+			types.VotingPowerIndexKeyPrefix,
+			"voting_power_indexes",
+			collections.PairKeyCodec(collections.Uint64Key, collections.BytesKey),
+			collections.Uint32Value,
+		),
 		pubRandCommitIndex: collections.NewMap(
 			sb,
 			types.PubRandCommitIndexKeyPrefix,
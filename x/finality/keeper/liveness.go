@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/babylonlabs-io/babylon/v4/types"
@@ -84,22 +85,17 @@ func (k Keeper) HandleFinalityProviderLiveness(ctx context.Context, fpPk *types.
 	if height > minHeight && signInfo.MissedBlocksCounter > maxMissed {
 		updated = true
 
-		if err := k.jailSluggishFinalityProvider(ctx, fpPk); err != nil {
+		if err := k.jailForDowntime(ctx, fpPk, signInfo, height); err != nil {
 			return fmt.Errorf("failed to jail sluggish finality provider %s: %w", fpPk.MarshalHex(), err)
 		}
 
-		signInfo.JailedUntil = sdkCtx.HeaderInfo().Time.Add(params.JailDuration)
-		// we need to reset the counter & bitmap so that the finality provider won't be
-		// immediately jailed after unjailing.
-		signInfo.MissedBlocksCounter = 0
-		if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
-			return fmt.Errorf("failed to remove the missed block bit map: %w", err)
-		}
-
 		k.Logger(sdkCtx).Info(
 			"finality provider is jailed",
 			"height", height,
 			"public_key", fpPk.MarshalHex(),
+			"jail_count", signInfo.JailCount,
+			"jailed_until", signInfo.JailedUntil,
+			"tombstoned", signInfo.Tombstoned,
 		)
 	}
 
@@ -186,6 +182,69 @@ func (k Keeper) UpdateSigningInfo(
 	return modifiedSignInfo, &signInfo, nil
 }
 
+// jailForDowntime jails the given sluggish finality provider and updates its
+// signing info accordingly, i.e., the jail duration doubles each time the
+// finality provider is jailed, and the finality provider is tombstoned once
+// it is jailed too many times. The jailing is recorded in the jail history of
+// the finality provider. The caller is responsible for storing the updated
+// signing info.
+func (k Keeper) jailForDowntime(
+	ctx context.Context,
+	fpPk *types.BIP340PubKey,
+	signInfo *finalitytypes.FinalityProviderSigningInfo,
+	height int64,
+) error {
+	if err := k.jailSluggishFinalityProvider(ctx, fpPk); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record := signInfo.Jail(height, sdkCtx.HeaderInfo().Time, k.GetParams(ctx))
+	// we need to reset the bitmap so that the finality provider won't be
+	// immediately jailed after unjailing.
+	if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
+		return fmt.Errorf("failed to remove the missed block bit map: %w", err)
+	}
+	if err := k.JailRecords.Set(ctx, collections.Join(fpPk.MustMarshal(), record.JailCount), record); err != nil {
+		return fmt.Errorf("failed to set the jail record: %w", err)
+	}
+
+	if record.Tombstoned {
+		err := sdkCtx.EventManager().EmitTypedEvent(
+			finalitytypes.NewEventTombstonedFinalityProvider(fpPk, record.JailCount),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to emit tombstoned finality provider event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// jailByGovernance jails the finality provider upon a MsgResumeFinalityProposal
+// for JailDuration. Unlike jailForDowntime, the jailing is not counted in the
+// jail count, so that it neither doubles the duration of later jailings nor
+// tombstones the finality provider, and is not recorded in the jail history.
+func (k Keeper) jailByGovernance(
+	ctx context.Context,
+	fpPk *types.BIP340PubKey,
+	signInfo *finalitytypes.FinalityProviderSigningInfo,
+) error {
+	if err := k.jailSluggishFinalityProvider(ctx, fpPk); err != nil {
+		return err
+	}
+
+	signInfo.JailedUntil = sdk.UnwrapSDKContext(ctx).HeaderInfo().Time.Add(k.GetParams(ctx).JailDuration)
+	// we need to reset the counter and the bitmap so that the finality
+	// provider won't be immediately jailed after unjailing.
+	signInfo.MissedBlocksCounter = 0
+	if err := k.DeleteMissedBlockBitmap(ctx, fpPk); err != nil {
+		return fmt.Errorf("failed to remove the missed block bit map: %w", err)
+	}
+
+	return nil
+}
+
 func (k Keeper) jailSluggishFinalityProvider(ctx context.Context, fpBtcPk *types.BIP340PubKey) error {
 	err := k.BTCStakingKeeper.JailFinalityProvider(ctx, fpBtcPk.MustMarshal())
 	if err != nil {
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// GetSignedBlocksWindow returns the range of heights of the signed blocks
+// window of the given finality provider, i.e., the last SignedBlocksWindow
+// heights examined by the liveness check since the finality provider became
+// active. The range is empty, i.e., start > end, if no height is examined yet.
+func (k Keeper) GetSignedBlocksWindow(ctx context.Context, signInfo *types.FinalityProviderSigningInfo) (start int64, end int64) {
+	params := k.GetParams(ctx)
+	// the liveness check examines the height FinalitySigTimeout blocks
+	// behind the current height, see EndBlocker
+	end = sdk.UnwrapSDKContext(ctx).HeaderInfo().Height - params.FinalitySigTimeout
+	start = max(end-params.SignedBlocksWindow+1, signInfo.StartHeight)
+	return start, end
+}
+
+// GetMissedHeights returns the heights within the signed blocks window at
+// which the given finality provider has voting power but missed the vote, as
+// recorded in its missed block bitmap
+func (k Keeper) GetMissedHeights(ctx context.Context, signInfo *types.FinalityProviderSigningInfo) ([]int64, error) {
+	fpPk := signInfo.FpBtcPk
+	missedBlocks, err := k.GetFinalityProviderMissedBlocks(ctx, fpPk)
+	if err != nil {
+		return nil, err
+	}
+	missedIndexes := make(map[int64]struct{}, len(missedBlocks))
+	for _, mb := range missedBlocks {
+		missedIndexes[mb.Index] = struct{}{}
+	}
+
+	signedBlocksWindow := k.GetParams(ctx).SignedBlocksWindow
+	start, end := k.GetSignedBlocksWindow(ctx, signInfo)
+	missedHeights := make([]int64, 0, len(missedIndexes))
+	for height := start; height <= end; height++ {
+		// the bit of a height at which the finality provider has no voting
+		// power is not updated, and thus refers to an earlier height
+		if k.GetVotingPower(ctx, fpPk.MustMarshal(), uint64(height)) == 0 {
+			continue
+		}
+		// same index as in UpdateSigningInfo
+		index := (height - signInfo.StartHeight) % signedBlocksWindow
+		if _, missed := missedIndexes[index]; missed {
+			missedHeights = append(missedHeights, height)
+		}
+	}
+
+	return missedHeights, nil
+}
+
+// GetEpochUptimes returns the uptimes of the given finality provider in the
+// given number of the most recent epochs, in descending order of the epoch
+// number. The uptime in an epoch is the ratio of the heights the finality
+// provider has voted for among the heights of the epoch, up to the last height
+// examined by the liveness check, at which it has voting power. Heights whose
+// votes are pruned are not counted.
+func (k Keeper) GetEpochUptimes(ctx context.Context, fpPk *bbn.BIP340PubKey, numEpochs uint64) []types.EpochUptime {
+	activatedHeight, err := k.GetBTCStakingActivatedHeight(ctx)
+	if err != nil {
+		return nil
+	}
+	lastHeight := sdk.UnwrapSDKContext(ctx).HeaderInfo().Height - k.GetParams(ctx).FinalitySigTimeout
+	if lastHeight < int64(activatedHeight) || lastHeight < 1 {
+		return nil
+	}
+
+	uptimes := make([]types.EpochUptime, 0, numEpochs)
+	for height := uint64(lastHeight); height >= max(activatedHeight, 1); height-- {
+		epochNum := k.CheckpointingKeeper.GetEpochByHeight(ctx, height)
+		if len(uptimes) == 0 || uptimes[len(uptimes)-1].EpochNum != epochNum {
+			if uint64(len(uptimes)) == numEpochs {
+				break
+			}
+			uptimes = append(uptimes, types.EpochUptime{EpochNum: epochNum})
+		}
+		uptime := &uptimes[len(uptimes)-1]
+
+		if k.IsHeightPruned(ctx, height) {
+			continue
+		}
+		if k.GetVotingPower(ctx, fpPk.MustMarshal(), height) == 0 {
+			continue
+		}
+		uptime.NumActiveHeights++
+		if k.HasSig(ctx, height, fpPk) {
+			uptime.NumVotedHeights++
+		}
+	}
+
+	for i := range uptimes {
+		uptimes[i].Uptime = sdkmath.LegacyZeroDec()
+		if uptimes[i].NumActiveHeights > 0 {
+			uptimes[i].Uptime = sdkmath.LegacyNewDec(int64(uptimes[i].NumVotedHeights)).
+				QuoInt64(int64(uptimes[i].NumActiveHeights))
+		}
+	}
+
+	return uptimes
+}
+
+// recordUnjailing records the height and time at which the given finality
+// provider is unjailed in the record of its last jailing, if any
+func (k Keeper) recordUnjailing(ctx context.Context, fpPk *bbn.BIP340PubKey, jailCount uint64) error {
+	key := collections.Join(fpPk.MustMarshal(), jailCount)
+	record, err := k.JailRecords.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		// the finality provider is jailed before the jail history is recorded
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	headerInfo := sdk.UnwrapSDKContext(ctx).HeaderInfo()
+	record.UnjailedHeight = headerInfo.Height
+	record.UnjailedAt = &headerInfo.Time
+	return k.JailRecords.Set(ctx, key, record)
+}
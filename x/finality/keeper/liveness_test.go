@@ -16,6 +16,7 @@ import (
 	btclctypes "github.com/babylonlabs-io/babylon/v4/x/btclightclient/types"
 	btcstakingkeeper "github.com/babylonlabs-io/babylon/v4/x/btcstaking/keeper"
 	bstypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/keeper"
 	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
 	ftypes "github.com/babylonlabs-io/babylon/v4/x/finality/types"
 )
@@ -84,6 +85,110 @@ func FuzzHandleLiveness(f *testing.F) {
 	})
 }
 
+// FuzzGraduatedJailing tests that the jail duration of a sluggish finality
+// provider doubles each time it is jailed, and that it is tombstoned, i.e.,
+// it cannot be unjailed anymore, once it is jailed too many times
+func FuzzGraduatedJailing(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		fpPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		fp := &bstypes.FinalityProvider{
+			Addr:  datagen.GenRandomAccount().Address,
+			BtcPk: fpPk,
+		}
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), fpPk.MustMarshal()).Return(fp, nil).AnyTimes()
+		bsKeeper.EXPECT().JailFinalityProvider(gomock.Any(), fpPk.MustMarshal()).DoAndReturn(
+			func(_ any, _ []byte) error {
+				fp.Jailed = true
+				return nil
+			}).AnyTimes()
+		bsKeeper.EXPECT().UnjailFinalityProvider(gomock.Any(), fpPk.MustMarshal()).DoAndReturn(
+			func(_ any, _ []byte) error {
+				fp.Jailed = false
+				return nil
+			}).AnyTimes()
+
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, nil, nil, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+		blockTime := time.Now().UTC()
+		ctx = ctx.WithHeaderInfo(header.Info{Time: blockTime})
+
+		params := fKeeper.GetParams(ctx)
+		params.JailDuration = time.Duration(datagen.RandomInt(r, 100)+1) * time.Minute
+		params.MaxJailDuration = params.JailDuration * time.Duration(datagen.RandomInt(r, 10)+1)
+		params.TombstoneJailCount = uint32(datagen.RandomInt(r, 5) + 2)
+		require.NoError(t, fKeeper.SetParams(ctx, params))
+
+		signingInfo := types.NewFinalityProviderSigningInfo(fpPk, 1, 0)
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpPk.MustMarshal(), signingInfo)
+		require.NoError(t, err)
+
+		height := int64(1)
+		expJailDuration := params.JailDuration
+		for jailCount := uint64(1); jailCount <= uint64(params.TombstoneJailCount); jailCount++ {
+			// the finality provider misses blocks until it is jailed
+			for !fp.IsJailed() {
+				err := fKeeper.HandleFinalityProviderLiveness(ctx, fpPk, true, height)
+				require.NoError(t, err)
+				height++
+			}
+			signingInfo, err = fKeeper.FinalityProviderSigningTracker.Get(ctx, fpPk.MustMarshal())
+			require.NoError(t, err)
+			require.Equal(t, jailCount, signingInfo.JailCount)
+			require.Equal(t, params.JailDurationForCount(jailCount), expJailDuration)
+			require.Equal(t, blockTime.Add(expJailDuration), signingInfo.JailedUntil)
+			require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+
+			// the jailing is recorded in the jail history
+			res, err := fKeeper.JailHistory(ctx, &types.QueryJailHistoryRequest{FpBtcPkHex: fpPk.MarshalHex()})
+			require.NoError(t, err)
+			require.Len(t, res.JailRecords, int(jailCount))
+			record := res.JailRecords[jailCount-1]
+			require.Equal(t, fpPk.MarshalHex(), record.FpBtcPkHex)
+			require.Equal(t, jailCount, record.JailCount)
+			require.Equal(t, height-1, record.Height)
+			require.Equal(t, blockTime, record.JailedAt)
+			require.Equal(t, signingInfo.JailedUntil, record.JailedUntil)
+			require.Equal(t, signingInfo.Tombstoned, record.Tombstoned)
+
+			// the finality provider can be unjailed after the jailing period
+			// unless it is tombstoned
+			blockTime = signingInfo.JailedUntil.Add(time.Second)
+			ctx = ctx.WithHeaderInfo(header.Info{Height: height, Time: blockTime})
+			_, unjailErr := ms.UnjailFinalityProvider(ctx, &types.MsgUnjailFinalityProvider{
+				Signer:  fp.Addr,
+				FpBtcPk: fpPk,
+			})
+			res, err = fKeeper.JailHistory(ctx, &types.QueryJailHistoryRequest{FpBtcPkHex: fpPk.MarshalHex()})
+			require.NoError(t, err)
+			record = res.JailRecords[jailCount-1]
+			if jailCount < uint64(params.TombstoneJailCount) {
+				require.NoError(t, unjailErr)
+				require.False(t, signingInfo.Tombstoned)
+				require.False(t, fp.IsJailed())
+				// the unjailing is recorded in the jail history
+				require.Equal(t, height, record.UnjailedHeight)
+				require.Equal(t, blockTime, *record.UnjailedAt)
+			} else {
+				require.ErrorIs(t, unjailErr, types.ErrFpTombstoned)
+				require.True(t, signingInfo.Tombstoned)
+				require.True(t, fp.IsJailed())
+				require.Zero(t, record.UnjailedHeight)
+				require.Nil(t, record.UnjailedAt)
+			}
+
+			expJailDuration = min(2*expJailDuration, params.MaxJailDuration)
+		}
+	})
+}
+
 // FuzzHandleLivenessDeterminism tests the property of determinism of
 // HandleLiveness by creating two helpers with the same steps to jailing
 // and asserting the jailing events should be with the same order
@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	v2 "github.com/babylonlabs-io/babylon/v4/x/finality/migrations/v2"
+	v3 "github.com/babylonlabs-io/babylon/v4/x/finality/migrations/v3"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -24,3 +25,9 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
 	return v2.MigrateStore(ctx, store, m.keeper.upsertPubRandCommitIdx)
 }
+
+// Migrate2to3 migrates from version 2 to 3.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+	return v3.MigrateStore(ctx, store, m.keeper.cdc, m.keeper.buildVoteTallies)
+}
@@ -68,33 +68,112 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	refundable, err := ms.addFinalitySig(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// at this point, the finality signature is 1) valid, 2) over a canonical block,
+	// and 3) not duplicated.
+	// Thus, we can safely consider this message as refundable
+	if refundable {
+		ms.IncentiveKeeper.IndexRefundableMsg(ctx, req)
+	}
+
+	return &types.MsgAddFinalitySigResponse{}, nil
+}
+
+// AddFinalitySigs adds a batch of votes of a finality provider to a list of
+// blocks. Each vote is processed in order, in the same way as in AddFinalitySig,
+// and its state changes are only committed if it is accepted. The message
+// fails only if none of the votes is accepted.
+func (ms msgServer) AddFinalitySigs(goCtx context.Context, req *types.MsgAddFinalitySigs) (*types.MsgAddFinalitySigsResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyAddFinalitySigs)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	results := make([]*types.FinalitySigResult, 0, len(req.Sigs))
+	numAccepted := 0
+	allRefundable := true
+	for i := range req.Sigs {
+		// charge gas for each vote as its verification does not touch the store
+		ctx.GasMeter().ConsumeGas(types.AddFinalitySigsGasPerItem, "add finality sig in batch")
+
+		item := req.ToMsgAddFinalitySig(i)
+		result := &types.FinalitySigResult{BlockHeight: item.BlockHeight}
+
+		// process each vote in a cached context so that the state changes of
+		// a rejected vote are discarded without affecting the other votes
+		cacheCtx, writeCache := ctx.CacheContext()
+		refundable, err := ms.addFinalitySig(cacheCtx, item)
+		if err != nil {
+			ms.Logger(ctx).Debug("rejected finality vote in batch",
+				"block height", item.BlockHeight, "finality provider", req.FpBtcPk.MarshalHex(), "error", err)
+			result.Error = err.Error()
+			allRefundable = false
+		} else {
+			writeCache()
+			result.Accepted = true
+			numAccepted++
+			allRefundable = allRefundable && refundable
+		}
+
+		results = append(results, result)
+	}
+
+	if numAccepted == 0 {
+		return nil, types.ErrNoFinalitySigAccepted.Wrapf("finality provider: %s, first error: %s",
+			req.FpBtcPk.MarshalHex(), results[0].Error)
+	}
+
+	// the batch is refundable only if all of its votes are refundable, i.e.,
+	// 1) valid, 2) over canonical blocks, and 3) not duplicated
+	if allRefundable {
+		ms.IncentiveKeeper.IndexRefundableMsg(ctx, req)
+	}
+
+	return &types.MsgAddFinalitySigsResponse{Results: results}, nil
+}
+
+// addFinalitySig verifies the given vote and adds it to the given block. It
+// returns whether the vote is eligible for a fee refund, i.e., it is valid,
+// over a canonical block and not duplicated.
+func (ms msgServer) addFinalitySig(ctx sdk.Context, req *types.MsgAddFinalitySig) (bool, error) {
 	activationHeight, errMod := ms.validateActivationHeight(ctx, req.BlockHeight)
 	if errMod != nil {
-		return nil, errMod.Wrapf("finality block height: %d is lower than activation height %d", req.BlockHeight, activationHeight)
+		return false, errMod.Wrapf("finality block height: %d is lower than activation height %d", req.BlockHeight, activationHeight)
 	}
 
+	// the state of pruned heights is finalized and BTC-timestamped
+	if ms.IsHeightPruned(ctx, req.BlockHeight) {
+		return false, types.ErrSigHeightOutdated.Wrapf("height: %d", req.BlockHeight)
+	}
 	indexedBlock, err := ms.GetBlock(ctx, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	should, err := ms.ShouldAcceptSigForHeight(ctx, indexedBlock)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	if !should {
-		return nil, types.ErrSigHeightOutdated.Wrapf("height: %d", req.BlockHeight)
+		return false, types.ErrSigHeightOutdated.Wrapf("height: %d", req.BlockHeight)
 	}
 
 	fpPK := req.FpBtcPk
 
 	if ms.BTCStakingKeeper.IsFinalityProviderDeleted(ctx, req.FpBtcPk) {
-		return nil, types.ErrFinalityProviderIsDeleted.Wrapf("fp_btc_pk_hex: %s", req.FpBtcPk.MarshalHex())
+		return false, types.ErrFinalityProviderIsDeleted.Wrapf("fp_btc_pk_hex: %s", req.FpBtcPk.MarshalHex())
 	}
 
 	// ensure the finality provider exists
 	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 	// ensure the finality provider is not slashed at this time point
 	// NOTE: it's possible that the finality provider equivocates for height h, and the signature is processed at
@@ -114,16 +193,16 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 	//     corrupt a new finality provider and equivocate a historical block over and over again, making a previous block
 	//     unfinalisable forever
 	if fp.IsSlashed() {
-		return nil, bstypes.ErrFpAlreadySlashed.Wrapf("finality provider public key: %s", fpPK.MarshalHex())
+		return false, bstypes.ErrFpAlreadySlashed.Wrapf("finality provider public key: %s", fpPK.MarshalHex())
 	}
 
 	if fp.IsJailed() {
-		return nil, bstypes.ErrFpAlreadyJailed.Wrapf("finality provider public key: %s", fpPK.MarshalHex())
+		return false, bstypes.ErrFpAlreadyJailed.Wrapf("finality provider public key: %s", fpPK.MarshalHex())
 	}
 
 	// ensure the finality provider has voting power at this height
 	if ms.GetVotingPower(ctx, fpPK.MustMarshal(), req.BlockHeight) == 0 {
-		return nil, types.ErrInvalidFinalitySig.Wrapf("the finality provider %s does not have voting power at height %d", fpPK.MarshalHex(), req.BlockHeight)
+		return false, types.ErrInvalidFinalitySig.Wrapf("the finality provider %s does not have voting power at height %d", fpPK.MarshalHex(), req.BlockHeight)
 	}
 
 	existingSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
@@ -131,22 +210,31 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		ms.Logger(ctx).Debug("Received duplicated finality vote", "block height", req.BlockHeight, "finality provider", req.FpBtcPk)
 		// exactly same vote already exists, return error
 		// this is to secure the tx refunding against duplicated messages
-		return nil, types.ErrDuplicatedFinalitySig
+		return false, types.ErrDuplicatedFinalitySig
 	}
 
 	// find the timestamped public randomness commitment for this height from this finality provider
 	prCommit, err := ms.GetTimestampedPubRandCommitForHeight(ctx, req.FpBtcPk, req.BlockHeight)
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+
+	// the vote is signed by the EOTS key of the finality provider at this
+	// height, which has to be the key that committed the public randomness
+	eotsPk := fp.EotsPkAtHeight(req.BlockHeight)
+	if !fp.EotsPkAtHeight(prCommit.StartHeight).Equals(eotsPk) {
+		return false, types.ErrInvalidFinalitySig.Wrapf("the public randomness at height %d is committed under a previous key of the finality provider", req.BlockHeight)
 	}
 
 	// verify the finality signature message w.r.t. the public randomness commitment
 	// including the public randomness inclusion proof and the finality signature
-	if err := types.VerifyFinalitySig(req, prCommit); err != nil {
-		return nil, err
+	if err := types.VerifyFinalitySigWithEotsPk(req, prCommit, eotsPk); err != nil {
+		return false, err
 	}
-	// the public randomness is good, set the public randomness
+	// the public randomness is good, set the public randomness and its
+	// inclusion proof
 	ms.SetPubRand(ctx, req.FpBtcPk, req.BlockHeight, *req.PubRand)
+	ms.SetPubRandProof(ctx, req.FpBtcPk, req.BlockHeight, req.Proof)
 
 	// verify whether the voted block is a fork or not
 	if !bytes.Equal(indexedBlock.AppHash, req.BlockAppHash) {
@@ -162,6 +250,9 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 			ForkAppHash:          req.BlockAppHash,
 			ForkFinalitySig:      req.FinalitySig,
 		}
+		if !eotsPk.Equals(fpPK) {
+			evidence.EotsPk = eotsPk
+		}
 
 		// if this finality provider has also signed canonical block, slash it
 		canonicalSig, err := ms.GetSig(ctx, req.BlockHeight, fpPK)
@@ -178,7 +269,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 
 		// NOTE: we should NOT return error here, otherwise the state change triggered in this tx
 		// (including the evidence) will be rolled back
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return false, nil
 	}
 
 	// this signature is good, add vote to DB
@@ -189,7 +280,7 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 		fp.HighestVotedHeight = uint32(req.BlockHeight)
 		err := ms.BTCStakingKeeper.UpdateFinalityProvider(ctx, fp)
 		if err != nil {
-			return nil, fmt.Errorf("failed to update the finality provider: %w", err)
+			return false, fmt.Errorf("failed to update the finality provider: %w", err)
 		}
 	}
 
@@ -215,15 +306,10 @@ func (ms msgServer) AddFinalitySig(goCtx context.Context, req *types.MsgAddFinal
 
 		// NOTE: we should NOT return error here, otherwise the state change triggered in this tx
 		// (including the evidence and slashing) will be rolled back
-		return &types.MsgAddFinalitySigResponse{}, nil
+		return false, nil
 	}
 
-	// at this point, the finality signature is 1) valid, 2) over a canonical block,
-	// and 3) not duplicated.
-	// Thus, we can safely consider this message as refundable
-	ms.IncentiveKeeper.IndexRefundableMsg(ctx, req)
-
-	return &types.MsgAddFinalitySigResponse{}, nil
+	return true, nil
 }
 
 func (ms msgServer) ShouldAcceptSigForHeight(ctx context.Context, block *types.IndexedBlock) (bool, error) {
@@ -273,7 +359,8 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 		return nil, types.ErrInvalidPubRand.Wrap("empty finality provider public key")
 	}
 	fpBTCPKBytes := req.FpBtcPk.MustMarshal()
-	if !ms.BTCStakingKeeper.HasFinalityProvider(ctx, fpBTCPKBytes) {
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, fpBTCPKBytes)
+	if err != nil {
 		return nil, bstypes.ErrFpNotFound.Wrapf("the finality provider with BTC PK %v is not registered", fpBTCPKBytes)
 	}
 
@@ -282,8 +369,16 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 		return nil, types.ErrFinalityProviderIsDeleted.Wrapf("fp_btc_pk_hex: %s", req.FpBtcPk.MarshalHex())
 	}
 
+	// the public randomness has to be committed by the EOTS key of the
+	// finality provider at these heights, so it cannot span a key rotation
+	eotsPk := fp.EotsPkAtHeight(req.StartHeight)
+	endHeight := req.StartHeight + req.NumPubRand - 1
+	if !fp.EotsPkAtHeight(endHeight).Equals(eotsPk) {
+		return nil, types.ErrInvalidPubRand.Wrapf("the public randomness list [%d, %d] spans a key rotation of the finality provider", req.StartHeight, endHeight)
+	}
+
 	// verify signature over the public randomness commitment
-	if err := req.VerifySig(); err != nil {
+	if err := req.VerifySigWithEotsPk(eotsPk); err != nil {
 		return nil, types.ErrInvalidPubRand.Wrapf("invalid signature over the public randomness list: %v", err)
 	}
 
@@ -320,6 +415,49 @@ func (ms msgServer) CommitPubRandList(goCtx context.Context, req *types.MsgCommi
 	return &types.MsgCommitPubRandListResponse{}, nil
 }
 
+// RotateFinalityProviderKey registers the successor EOTS key of a finality
+// provider. From the activation height, the finality provider commits public
+// randomness and casts votes with the successor key, while its previous keys
+// remain slashable for the heights they voted on
+func (ms msgServer) RotateFinalityProviderKey(goCtx context.Context, req *types.MsgRotateFinalityProviderKey) (*types.MsgRotateFinalityProviderKeyResponse, error) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyRotateFpKey)
+
+	if err := req.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	fp, err := ms.BTCStakingKeeper.GetFinalityProvider(ctx, req.FpBtcPk.MustMarshal())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get finality provider %s: %w", req.FpBtcPk.MarshalHex(), err)
+	}
+
+	// ensure the signer's address matches the fp's address
+	if fp.Addr != req.Signer {
+		return nil, fmt.Errorf("the fp's address %s does not match the signer %s of the request", fp.Addr, req.Signer)
+	}
+
+	// the rotation has to be authorized by the current key and the possession
+	// of the successor key has to be proven
+	if err := req.VerifySigs(ctx.ChainID(), fp.LatestEotsPk()); err != nil {
+		return nil, types.ErrInvalidKeyRotation.Wrap(err.Error())
+	}
+
+	// the public randomness committed under the current key cannot be used
+	// with the successor key, as the current key might be compromised. Thus,
+	// the rotation can only be activated after the committed public randomness
+	if lastPrCommit := ms.GetLastPubRandCommit(ctx, req.FpBtcPk); lastPrCommit != nil && req.ActivationHeight <= lastPrCommit.EndHeight() {
+		return nil, types.ErrInvalidKeyRotation.Wrapf("activation height %d is not after the highest height with committed public randomness %d",
+			req.ActivationHeight, lastPrCommit.EndHeight())
+	}
+
+	if err := ms.BTCStakingKeeper.RotateFinalityProviderKey(ctx, req.FpBtcPk, req.NewEotsPk, req.ActivationHeight); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRotateFinalityProviderKeyResponse{}, nil
+}
+
 // UnjailFinalityProvider unjails a jailed finality provider
 func (ms msgServer) UnjailFinalityProvider(ctx context.Context, req *types.MsgUnjailFinalityProvider) (*types.MsgUnjailFinalityProviderResponse, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), types.MetricsKeyUnjailFinalityProvider)
@@ -346,6 +484,11 @@ func (ms msgServer) UnjailFinalityProvider(ctx context.Context, req *types.MsgUn
 		return nil, fmt.Errorf("failed to get the signing info of finality provider %s: %w", fpPk.MarshalHex(), err)
 	}
 
+	// cannot be unjailed if tombstoned due to being jailed too many times
+	if info.Tombstoned {
+		return nil, types.ErrFpTombstoned.Wrapf("finality provider %s is jailed %d times", fpPk.MarshalHex(), info.JailCount)
+	}
+
 	// cannot be unjailed until jailing period is passed
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	curBlockTime := sdkCtx.HeaderInfo().Time
@@ -362,6 +505,10 @@ func (ms msgServer) UnjailFinalityProvider(ctx context.Context, req *types.MsgUn
 		return nil, fmt.Errorf("failed to unjail finality provider %s: %w", fpPk.MarshalHex(), err)
 	}
 
+	if err := ms.recordUnjailing(ctx, fpPk, info.JailCount); err != nil {
+		return nil, fmt.Errorf("failed to record the unjailing of finality provider %s: %w", fpPk.MarshalHex(), err)
+	}
+
 	types.IncrementUnjailedFinalityProviderCounter()
 
 	return &types.MsgUnjailFinalityProviderResponse{}, nil
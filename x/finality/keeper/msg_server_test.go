@@ -59,9 +59,11 @@ func FuzzCommitPubRandList(f *testing.F) {
 		require.NoError(t, err)
 		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
 		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
 
 		// Case 1: fail if the finality provider is not registered
-		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(false).Times(1)
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(nil, bstypes.ErrFpNotFound).Times(1)
 		startHeight := datagen.RandomInt(r, 10)
 		numPubRand := uint64(200)
 		_, msg, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
@@ -69,7 +71,7 @@ func FuzzCommitPubRandList(f *testing.F) {
 		_, err = ms.CommitPubRandList(ctx, msg)
 		require.Error(t, err)
 		// register the finality provider
-		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
 		// finality provider is not deleted
 		bsKeeper.EXPECT().IsFinalityProviderDeleted(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
 
@@ -153,7 +155,7 @@ func FuzzAddFinalitySig(f *testing.F) {
 		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
 		fpBTCPKBytes := fpBTCPK.MustMarshal()
 		require.NoError(t, err)
-		bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 
 		bsKeeper.EXPECT().IsFinalityProviderDeleted(gomock.Any(), gomock.Any()).Return(false).Times(8)
 
@@ -292,6 +294,119 @@ func FuzzAddFinalitySig(f *testing.F) {
 	})
 }
 
+func FuzzAddFinalitySigs(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		bsKeeper := types.NewMockBTCStakingKeeper(ctrl)
+		bsKeeper.EXPECT().UpdateFinalityProvider(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		bsKeeper.EXPECT().IsFinalityProviderDeleted(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		iKeeper := types.NewMockIncentiveKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, bsKeeper, iKeeper, cKeeper, nil)
+		ms := keeper.NewMsgServerImpl(*fKeeper)
+
+		// create and register a random finality provider
+		btcSK, btcPK, err := datagen.GenRandomBTCKeyPair(r)
+		require.NoError(t, err)
+		fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+		require.NoError(t, err)
+		fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
+		fpBTCPKBytes := fpBTCPK.MustMarshal()
+		bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).AnyTimes()
+
+		// set committed epoch num and finalize it
+		committedEpochNum := datagen.GenRandomEpochNum(r) + 1
+		cKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: committedEpochNum}).AnyTimes()
+		cKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(committedEpochNum).AnyTimes()
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).Return(uint64(1)).AnyTimes()
+
+		// commit some public randomness
+		startHeight := uint64(0)
+		numPubRand := uint64(200)
+		randListInfo, msgCommitPubRandList, err := datagen.GenRandomMsgCommitPubRandList(r, btcSK, startHeight, numPubRand)
+		require.NoError(t, err)
+		_, err = ms.CommitPubRandList(ctx, msgCommitPubRandList)
+		require.NoError(t, err)
+
+		// index a few blocks
+		numBlocks := datagen.RandomInt(r, 20) + 2
+		blockHeights := make([]uint64, 0, numBlocks)
+		blockAppHashes := make([][]byte, 0, numBlocks)
+		for h := uint64(1); h <= numBlocks; h++ {
+			appHash := datagen.GenRandomByteArray(r, 32)
+			ctx = ctx.WithHeaderInfo(header.Info{Height: int64(h), AppHash: appHash})
+			fKeeper.IndexBlock(ctx)
+			blockHeights = append(blockHeights, h)
+			blockAppHashes = append(blockAppHashes, appHash)
+		}
+
+		signer := datagen.GenRandomAccount().Address
+		msg, err := datagen.NewMsgAddFinalitySigs(signer, btcSK, startHeight, blockHeights, randListInfo, blockAppHashes)
+		require.NoError(t, err)
+
+		// Case 1: fail if none of the votes is accepted, without any state change
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.ErrorIs(t, err, types.ErrNoFinalitySigAccepted)
+		for _, h := range blockHeights {
+			_, err := fKeeper.GetSig(ctx, h, fpBTCPK)
+			require.Error(t, err)
+		}
+
+		// Case 2: votes without voting power are rejected while the others
+		// are accepted. The batch is not refundable
+		rejectedIdx := int(datagen.RandomInt(r, int(numBlocks)))
+		for i, h := range blockHeights {
+			if i != rejectedIdx {
+				fKeeper.SetVotingPower(ctx, fpBTCPKBytes, h, 1)
+			}
+		}
+		iKeeper.EXPECT().IndexRefundableMsg(gomock.Any(), gomock.Any()).Times(0)
+		gasBefore := ctx.GasMeter().GasConsumed()
+		resp, err := ms.AddFinalitySigs(ctx, msg)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-gasBefore, numBlocks*types.AddFinalitySigsGasPerItem)
+		require.Len(t, resp.Results, int(numBlocks))
+		for i, result := range resp.Results {
+			require.Equal(t, blockHeights[i], result.BlockHeight)
+			sig, err := fKeeper.GetSig(ctx, blockHeights[i], fpBTCPK)
+			if i == rejectedIdx {
+				require.False(t, result.Accepted)
+				require.NotEmpty(t, result.Error)
+				require.Error(t, err)
+				require.False(t, fKeeper.HasPubRand(ctx, fpBTCPK, blockHeights[i]))
+			} else {
+				require.True(t, result.Accepted)
+				require.Empty(t, result.Error)
+				require.NoError(t, err)
+				require.Equal(t, msg.Sigs[i].FinalitySig.MustMarshal(), sig.MustMarshal())
+			}
+		}
+
+		// Case 3: a batch only with votes that are all valid is refundable
+		fKeeper.SetVotingPower(ctx, fpBTCPKBytes, blockHeights[rejectedIdx], 1)
+		msg2 := &types.MsgAddFinalitySigs{
+			Signer:  msg.Signer,
+			FpBtcPk: msg.FpBtcPk,
+			Sigs:    []*types.FinalitySigEntry{msg.Sigs[rejectedIdx]},
+		}
+		iKeeper.EXPECT().IndexRefundableMsg(gomock.Any(), gomock.Eq(msg2)).Times(1)
+		resp, err = ms.AddFinalitySigs(ctx, msg2)
+		require.NoError(t, err)
+		require.Len(t, resp.Results, 1)
+		require.True(t, resp.Results[0].Accepted)
+
+		// Case 4: resubmitting the same votes fails as all of them are duplicated
+		_, err = ms.AddFinalitySigs(ctx, msg)
+		require.ErrorIs(t, err, types.ErrNoFinalitySigAccepted)
+		require.ErrorContains(t, err, types.ErrDuplicatedFinalitySig.Error())
+	})
+}
+
 func FuzzUnjailFinalityProvider(f *testing.F) {
 	datagen.AddRandomSeedsToFuzzer(f, 100)
 
@@ -354,6 +469,14 @@ func FuzzUnjailFinalityProvider(f *testing.F) {
 		bsKeeper.EXPECT().UnjailFinalityProvider(ctx, fpBTCPKBytes).Return(nil).AnyTimes()
 		_, err = ms.UnjailFinalityProvider(ctx, msg)
 		require.NoError(t, err)
+
+		// case 5: unjail the fp when it is tombstoned
+		signingInfo.JailCount = datagen.RandomInt(r, 10) + 1
+		signingInfo.Tombstoned = true
+		err = fKeeper.FinalityProviderSigningTracker.Set(ctx, fpBTCPK.MustMarshal(), signingInfo)
+		require.NoError(t, err)
+		_, err = ms.UnjailFinalityProvider(ctx, msg)
+		require.ErrorIs(t, err, types.ErrFpTombstoned)
 	})
 }
 
@@ -377,8 +500,8 @@ func TestVoteForConflictingHashShouldRetrieveEvidenceAndSlash(t *testing.T) {
 	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
 	fpBTCPKBytes := fpBTCPK.MustMarshal()
 	require.NoError(t, err)
-	bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(),
-		gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(),
+		gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 	cKeeper.EXPECT().GetEpoch(gomock.Any()).Return(&epochingtypes.Epoch{EpochNumber: 1}).AnyTimes()
 	cKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(1)).AnyTimes()
 	// commit some public randomness
@@ -451,7 +574,7 @@ func TestDoNotPanicOnNilProof(t *testing.T) {
 	fpBTCPK := bbn.NewBIP340PubKeyFromBTCPK(btcPK)
 	fpBTCPKBytes := fpBTCPK.MustMarshal()
 	require.NoError(t, err)
-	bsKeeper.EXPECT().HasFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(true).AnyTimes()
+	bsKeeper.EXPECT().GetFinalityProvider(gomock.Any(), gomock.Eq(fpBTCPKBytes)).Return(fp, nil).Times(1)
 	bsKeeper.EXPECT().IsFinalityProviderDeleted(gomock.Any(), gomock.Any()).Return(false).AnyTimes()
 
 	// set committed epoch num
@@ -92,7 +92,11 @@ func (k Keeper) RecordVotingPowerAndCache(ctx context.Context, newDc *ftypes.Vot
 	// set voting power table for each active finality providers at this height
 	for i := uint32(0); i < newDc.NumActiveFps; i++ {
 		fp := newDc.FinalityProviders[i]
-		k.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), babylonTipHeight, fp.TotalBondedSat)
+		k.setVotingPower(ctx, fp.BtcPk.MustMarshal(), babylonTipHeight, fp.TotalBondedSat)
+	}
+	// build the vote tally of the block once its voting power table is set
+	if babylonTipHeight >= k.getNextHeightToFinalize(ctx) {
+		k.buildVoteTally(ctx, babylonTipHeight)
 	}
 
 	// set the voting power distribution cache of the current height
@@ -18,7 +18,19 @@ type FpWithVotingPower struct {
 	VotingPower uint64
 }
 
+// SetVotingPower sets the voting power of a given finality provider at a given Babylon height,
+// and updates the vote tally of the block if it is not finalized yet
 func (k Keeper) SetVotingPower(ctx context.Context, fpBTCPK []byte, height uint64, power uint64) {
+	oldPower := k.GetVotingPower(ctx, fpBTCPK, height)
+	k.setVotingPower(ctx, fpBTCPK, height, power)
+	if height >= k.getNextHeightToFinalize(ctx) {
+		k.updateVoteTally(ctx, fpBTCPK, height, oldPower, power)
+	}
+}
+
+// setVotingPower sets the voting power of a given finality provider at a given Babylon height
+// without updating the vote tally of the block
+func (k Keeper) setVotingPower(ctx context.Context, fpBTCPK []byte, height uint64, power uint64) {
 	store := k.votingPowerBbnBlockHeightStore(ctx, height)
 	store.Set(fpBTCPK, sdk.Uint64ToBigEndian(power))
 }
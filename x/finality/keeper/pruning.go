@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// HandlePruning prunes the public randomness, votes and indexed blocks of the
+// heights older than the state retention window, in a range of at most
+// maxPrunedBlocks heights starting from the next height to prune.
+// A height is pruned only if it is finalized, rewarded and BTC-timestamped.
+// Finality votes are no longer accepted at such a height, thus its state is no
+// longer needed for detecting and slashing equivocations. Evidences and voting
+// power tables are never pruned.
+// The public randomness commitments of the pruned heights are pruned in a
+// sweep over the finality providers that visits at most
+// maxPrunedCommitIndexes finality providers per call.
+func (k Keeper) HandlePruning(ctx context.Context, maxPrunedBlocks, maxPrunedCommitIndexes uint64) {
+	retentionWindow := k.GetParams(ctx).StateRetentionWindow
+	currentHeight := uint64(sdk.UnwrapSDKContext(ctx).HeaderInfo().Height)
+	if retentionWindow == 0 || currentHeight <= retentionWindow {
+		return
+	}
+
+	// only rewarded heights, which are finalized, can be pruned
+	nextHeightToReward := k.GetNextHeightToReward(ctx)
+	if nextHeightToReward == 0 {
+		return
+	}
+
+	nextHeightToPrune := k.GetNextHeightToPrune(ctx)
+	firstPruning := nextHeightToPrune == 0
+	if firstPruning {
+		// first time to prune, start from the first indexed block
+		firstHeight, found := k.firstIndexedBlockHeight(ctx)
+		if !found {
+			return
+		}
+		nextHeightToPrune = firstHeight
+	}
+
+	maxHeightToPrune := min(
+		// need to add minus 1, as the pruning loop is inclusive of [start, end]
+		nextHeightToPrune+maxPrunedBlocks-1,
+		currentHeight-retentionWindow,
+		nextHeightToReward-1,
+	)
+	lastFinalizedEpoch := k.GetLastFinalizedEpoch(ctx)
+
+	copiedNextHeightToPrune := nextHeightToPrune
+
+	for height := nextHeightToPrune; height <= maxHeightToPrune; height++ {
+		// finality votes are accepted until the block is BTC-timestamped,
+		// see ShouldAcceptSigForHeight
+		if k.CheckpointingKeeper.GetEpochByHeight(ctx, height) > lastFinalizedEpoch {
+			break
+		}
+		block, err := k.GetBlock(ctx, height)
+		if err == nil && !block.Finalized {
+			break
+		}
+		k.pruneHeight(ctx, height)
+		nextHeightToPrune = height + 1
+	}
+
+	if nextHeightToPrune != copiedNextHeightToPrune {
+		k.SetNextHeightToPrune(ctx, nextHeightToPrune)
+	} else if firstPruning {
+		return
+	}
+
+	// resume the ongoing sweep over the finality providers, if any, or start
+	// a new one if the pruning advanced since the start of the last one
+	if k.getNextFpToPruneCommits(ctx) == nil {
+		if k.getPubRandCommitsPruningHeight(ctx) >= nextHeightToPrune {
+			return
+		}
+		k.setPubRandCommitsPruningHeight(ctx, nextHeightToPrune)
+	}
+	if err := k.prunePubRandCommits(ctx, nextHeightToPrune, maxPrunedCommitIndexes); err != nil {
+		panic(err)
+	}
+}
+
+// pruneHeight deletes the votes, the public randomness and its inclusion
+// proofs, the indexes of the finality providers in the voting power table and
+// the indexed block at the given height
+func (k Keeper) pruneHeight(ctx context.Context, height uint64) {
+	heightBytes := sdk.Uint64ToBigEndian(height)
+
+	// the public randomness is set upon finality votes, which are accepted
+	// only from finality providers with voting power at the height
+	pubRandStore := k.pubRandStore(ctx)
+	pubRandProofStore := k.pubRandProofStore(ctx)
+	powerStore := k.votingPowerBbnBlockHeightStore(ctx, height)
+	powerIter := powerStore.Iterator(nil, nil)
+	var fpBTCPKs [][]byte
+	for ; powerIter.Valid(); powerIter.Next() {
+		fpBTCPKs = append(fpBTCPKs, powerIter.Key())
+	}
+	powerIter.Close()
+	for _, fpBTCPK := range fpBTCPKs {
+		key := append(append([]byte{}, fpBTCPK...), heightBytes...)
+		pubRandStore.Delete(key)
+		pubRandProofStore.Delete(key)
+	}
+
+	voteStore := k.voteHeightStore(ctx, height)
+	voteIter := voteStore.Iterator(nil, nil)
+	var voteKeys [][]byte
+	for ; voteIter.Valid(); voteIter.Next() {
+		voteKeys = append(voteKeys, voteIter.Key())
+	}
+	voteIter.Close()
+	for _, key := range voteKeys {
+		voteStore.Delete(key)
+	}
+	k.pruneVotingPowerIndexes(ctx, height)
+
+	k.blockStore(ctx).Delete(heightBytes)
+}
+
+// prunePubRandCommits deletes the public randomness commitments whose heights
+// are all pruned, i.e., lower than the given next height to prune, together
+// with their start heights in the index. The last commitment of each finality
+// provider is kept, as it determines the start height of the next one.
+// It visits the indexes of at most maxIndexes finality providers, starting
+// from the one where the previous call stopped, so that the work per EndBlock
+// is bounded regardless of the number of finality providers.
+func (k Keeper) prunePubRandCommits(ctx context.Context, nextHeightToPrune, maxIndexes uint64) error {
+	var rng collections.Ranger[[]byte]
+	if nextFp := k.getNextFpToPruneCommits(ctx); nextFp != nil {
+		rng = new(collections.Range[[]byte]).StartInclusive(nextFp)
+	}
+	iter, err := k.pubRandCommitIndex.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	var indexes []collections.KeyValue[[]byte, types.PubRandCommitIndexValue]
+	for ; iter.Valid() && uint64(len(indexes)) < maxIndexes; iter.Next() {
+		index, err := iter.KeyValue()
+		if err != nil {
+			iter.Close()
+			return err
+		}
+		indexes = append(indexes, index)
+	}
+	// the sweep resumes from the next finality provider, if any, or is
+	// completed otherwise
+	var nextFp []byte
+	if iter.Valid() {
+		if nextFp, err = iter.Key(); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+	k.setNextFpToPruneCommits(ctx, nextFp)
+
+	for _, index := range indexes {
+		fpBTCPK := bbn.BIP340PubKey(index.Key)
+		store := k.pubRandCommitFpStore(ctx, &fpBTCPK)
+		heights := index.Value.Heights
+
+		numPruned := 0
+		for ; numPruned < len(heights)-1; numPruned++ {
+			startHeightBytes := sdk.Uint64ToBigEndian(heights[numPruned])
+			var prCommit types.PubRandCommit
+			k.cdc.MustUnmarshal(store.Get(startHeightBytes), &prCommit)
+			if prCommit.EndHeight() >= nextHeightToPrune {
+				break
+			}
+			store.Delete(startHeightBytes)
+		}
+		if numPruned == 0 {
+			continue
+		}
+
+		index.Value.Heights = heights[numPruned:]
+		if err := k.pubRandCommitIndex.Set(ctx, index.Key, index.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setNextFpToPruneCommits sets the BTC PK of the finality provider from which
+// the ongoing sweep of prunePubRandCommits resumes, or deletes it if nil
+func (k Keeper) setNextFpToPruneCommits(ctx context.Context, fpBtcPK []byte) {
+	store := k.storeService.OpenKVStore(ctx)
+	var err error
+	if fpBtcPK == nil {
+		err = store.Delete(types.NextFpToPruneCommitsKey)
+	} else {
+		err = store.Set(types.NextFpToPruneCommitsKey, fpBtcPK)
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+// getNextFpToPruneCommits gets the BTC PK of the finality provider from which
+// the ongoing sweep of prunePubRandCommits resumes, or nil if there is no
+// ongoing sweep
+func (k Keeper) getNextFpToPruneCommits(ctx context.Context) []byte {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextFpToPruneCommitsKey)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// setPubRandCommitsPruningHeight sets the next height to prune at the start of
+// the last sweep of prunePubRandCommits
+func (k Keeper) setPubRandCommitsPruningHeight(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PubRandCommitsPruningHeightKey, sdk.Uint64ToBigEndian(height)); err != nil {
+		panic(err)
+	}
+}
+
+// getPubRandCommitsPruningHeight gets the next height to prune at the start of
+// the last sweep of prunePubRandCommits, or 0 if there was no sweep
+func (k Keeper) getPubRandCommitsPruningHeight(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PubRandCommitsPruningHeightKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IsHeightPruned returns whether the public randomness, votes and indexed
+// block at the given height are pruned
+func (k Keeper) IsHeightPruned(ctx context.Context, height uint64) bool {
+	return height < k.GetNextHeightToPrune(ctx)
+}
+
+// firstIndexedBlockHeight returns the height of the first indexed block, if any
+func (k Keeper) firstIndexedBlockHeight(ctx context.Context) (uint64, bool) {
+	iter := k.blockStore(ctx).Iterator(nil, nil)
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(iter.Key()), true
+}
+
+// SetNextHeightToPrune sets the next height to prune as the given height
+func (k Keeper) SetNextHeightToPrune(ctx context.Context, height uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	heightBytes := sdk.Uint64ToBigEndian(height)
+	if err := store.Set(types.NextHeightToPruneKey, heightBytes); err != nil {
+		panic(err)
+	}
+}
+
+// GetNextHeightToPrune gets the next height to prune
+func (k Keeper) GetNextHeightToPrune(ctx context.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.NextHeightToPruneKey)
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+func FuzzHandlePruning(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+		fKeeper, ctx := keepertest.FinalityKeeper(t, nil, nil, cKeeper, nil)
+
+		params := fKeeper.GetParams(ctx)
+		params.StateRetentionWindow = datagen.RandomInt(r, 10) + 10
+		params.SignedBlocksWindow = int64(params.StateRetentionWindow)
+		require.NoError(t, fKeeper.SetParams(ctx, params))
+
+		// each epoch has 10 blocks, and each public randomness commitment
+		// covers 10 heights
+		epochInterval := uint64(10)
+		cKeeper.EXPECT().GetEpochByHeight(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ any, height uint64) uint64 {
+				return (height-1)/epochInterval + 1
+			}).AnyTimes()
+		lastFinalizedEpoch := datagen.RandomInt(r, 10) + 1
+		cKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(lastFinalizedEpoch).AnyTimes()
+
+		// blocks are indexed since the first height, and the ones up to
+		// finalizedHeight are finalized and rewarded
+		firstHeight := datagen.RandomInt(r, 10) + 1
+		numBlocks := uint64(100)
+		lastHeight := firstHeight + numBlocks - 1
+		finalizedHeight := firstHeight + datagen.RandomInt(r, int(numBlocks))
+		fKeeper.SetNextHeightToReward(ctx, finalizedHeight+1)
+
+		numFps := int(datagen.RandomInt(r, 3)) + 1
+		fpBtcPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			fpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpBtcPks = append(fpBtcPks, fpBtcPk)
+			for startHeight := uint64(1); startHeight <= lastHeight; startHeight += epochInterval {
+				err := fKeeper.SetPubRandCommit(ctx, fpBtcPk, &types.PubRandCommit{
+					StartHeight: startHeight,
+					NumPubRand:  epochInterval,
+					Commitment:  datagen.GenRandomByteArray(r, 32),
+					EpochNum:    1,
+				})
+				require.NoError(t, err)
+			}
+		}
+
+		for height := firstHeight; height <= lastHeight; height++ {
+			fKeeper.SetBlock(ctx, &types.IndexedBlock{
+				Height:    height,
+				AppHash:   datagen.GenRandomByteArray(r, 32),
+				Finalized: height <= finalizedHeight,
+			})
+			for _, fpBtcPk := range fpBtcPks {
+				fKeeper.SetVotingPower(ctx, fpBtcPk.MustMarshal(), height, 1)
+				sig, err := datagen.GenRandomFinalitySig(r)
+				require.NoError(t, err)
+				fKeeper.SetSig(ctx, height, fpBtcPk, sig)
+				pubRand := bbn.SchnorrPubRand(datagen.GenRandomByteArray(r, 32))
+				fKeeper.SetPubRand(ctx, fpBtcPk, height, pubRand)
+			}
+		}
+
+		// nothing is pruned within the retention window
+		ctx = datagen.WithCtxHeight(ctx, params.StateRetentionWindow)
+		fKeeper.HandlePruning(ctx, types.MaxPrunedBlocksPerEndBlock, types.MaxPrunedCommitIndexesPerEndBlock)
+		require.Zero(t, fKeeper.GetNextHeightToPrune(ctx))
+
+		// the heights that are finalized, rewarded, BTC-timestamped and
+		// out of the retention window are pruned in bounded batches
+		currentHeight := lastHeight + datagen.RandomInt(r, 20)
+		ctx = datagen.WithCtxHeight(ctx, currentHeight)
+		expNextHeightToPrune := min(
+			currentHeight-params.StateRetentionWindow,
+			finalizedHeight,
+			lastFinalizedEpoch*epochInterval,
+		) + 1
+		if expNextHeightToPrune < firstHeight {
+			expNextHeightToPrune = firstHeight
+		}
+
+		maxPrunedBlocks := datagen.RandomInt(r, 10) + 1
+		// the public randomness commitments are pruned in a sweep over the
+		// finality providers that may span several calls
+		maxPrunedCommitIndexes := datagen.RandomInt(r, numFps) + 1
+		nextHeightToPrune := firstHeight
+		for {
+			fKeeper.HandlePruning(ctx, maxPrunedBlocks, maxPrunedCommitIndexes)
+			newNextHeightToPrune := fKeeper.GetNextHeightToPrune(ctx)
+			if newNextHeightToPrune == 0 || newNextHeightToPrune == nextHeightToPrune {
+				break
+			}
+			require.LessOrEqual(t, newNextHeightToPrune-nextHeightToPrune, maxPrunedBlocks)
+			nextHeightToPrune = newNextHeightToPrune
+		}
+		if expNextHeightToPrune > firstHeight {
+			require.Equal(t, expNextHeightToPrune, fKeeper.GetNextHeightToPrune(ctx))
+		}
+		// complete the ongoing sweep over the finality providers, if any
+		for i := 0; i < numFps; i++ {
+			fKeeper.HandlePruning(ctx, maxPrunedBlocks, maxPrunedCommitIndexes)
+		}
+
+		for height := firstHeight; height <= lastHeight; height++ {
+			pruned := height < expNextHeightToPrune
+			require.Equal(t, pruned, fKeeper.IsHeightPruned(ctx, height))
+			require.Equal(t, !pruned, fKeeper.HasBlock(ctx, height))
+			for _, fpBtcPk := range fpBtcPks {
+				require.Equal(t, !pruned, fKeeper.HasSig(ctx, height, fpBtcPk))
+				require.Equal(t, !pruned, fKeeper.HasPubRand(ctx, fpBtcPk, height))
+				// the voting power table is retained
+				require.Equal(t, uint64(1), fKeeper.GetVotingPower(ctx, fpBtcPk.MustMarshal(), height))
+			}
+
+			_, err := fKeeper.Block(ctx, &types.QueryBlockRequest{Height: height})
+			_, err2 := fKeeper.VotesAtHeight(ctx, &types.QueryVotesAtHeightRequest{Height: height})
+			if pruned {
+				require.ErrorIs(t, err, types.ErrHeightPruned)
+				require.ErrorIs(t, err2, types.ErrHeightPruned)
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, err2)
+			}
+		}
+
+		// the public randomness commitments of the pruned heights are pruned,
+		// while the last one of each finality provider is retained
+		for _, fpBtcPk := range fpBtcPks {
+			for height := uint64(1); height <= lastHeight; height++ {
+				endHeight := ((height-1)/epochInterval + 1) * epochInterval
+				_, err := fKeeper.GetPubRandCommitForHeight(ctx, fpBtcPk, height)
+				if endHeight < expNextHeightToPrune && endHeight < lastHeight {
+					require.Error(t, err, "height %d", height)
+				} else {
+					require.NoError(t, err, "height %d", height)
+				}
+			}
+			require.NotNil(t, fKeeper.GetLastPubRandCommit(ctx, fpBtcPk))
+		}
+	})
+}
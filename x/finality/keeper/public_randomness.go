@@ -10,6 +10,7 @@ import (
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	bbn "github.com/babylonlabs-io/babylon/v4/types"
@@ -178,6 +179,32 @@ func (k Keeper) GetLastPubRand(ctx context.Context, fpBtcPK *bbn.BIP340PubKey) (
 	return height, pubRand, nil
 }
 
+// SetPubRandProof sets the inclusion proof of the public randomness at a
+// given height for a given finality provider, so that finality proofs can
+// prove the public randomness is in its commitment
+func (k Keeper) SetPubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64, proof *cmtcrypto.Proof) {
+	store := k.pubRandProofStore(ctx)
+	key := append(fpBtcPK.MustMarshal(), sdk.Uint64ToBigEndian(height)...)
+	store.Set(key, k.cdc.MustMarshal(proof))
+}
+
+// HasPubRandProof returns whether the inclusion proof of the public
+// randomness at a given height for a given finality provider is stored
+func (k Keeper) HasPubRandProof(ctx context.Context, fpBtcPK *bbn.BIP340PubKey, height uint64) bool {
+	store := k.pubRandProofStore(ctx)
+	return store.Has(append(fpBtcPK.MustMarshal(), sdk.Uint64ToBigEndian(height)...))
+}
+
+// pubRandProofStore returns the KVStore of the inclusion proofs of the
+// public randomness
+// prefix: PubRandProofKey
+// key: (finality provider PK || block height)
+// value: Proof
+func (k Keeper) pubRandProofStore(ctx context.Context) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	return prefix.NewStore(storeAdapter, types.PubRandProofKey)
+}
+
 // pubRandFpStore returns the KVStore of the public randomness
 // prefix: PubRandKey
 // key: (finality provider PK || block height)
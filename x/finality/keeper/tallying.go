@@ -40,11 +40,10 @@ func (k Keeper) TallyBlocks(ctx context.Context, maxFinalizedBlocks uint64) {
 	maxHeightToFinalize := min(startHeight+maxFinalizedBlocks-1, currentLastBlockHeight)
 
 	// find all blocks that are non-finalised AND have finality provider set since max(activatedHeight, lastFinalizedHeight+1)
-	// There are 4 different scenarios as follows
+	// There are 3 different scenarios as follows
 	// - has finality providers, non-finalised: tally and try to finalise
 	// - does not have finality providers, non-finalised: non-finalisable, continue
-	// - has finality providers, finalised: impossible to happen, panic
-	// - does not have finality providers, finalised: impossible to happen, panic
+	// - finalised: impossible to happen, panic
 	// After this for loop, the blocks since earliest activated height are either finalised or non-finalisable
 finalizationLoop:
 	for i := startHeight; i <= maxHeightToFinalize; i++ {
@@ -53,14 +52,19 @@ finalizationLoop:
 			panic(err) // failing to get an existing block is a programming error
 		}
 
-		// get the finality provider set of this block
-		fpSet := k.GetVotingPowerTable(ctx, ib.Height)
+		// get the vote tally of this block, which exists iff the block has
+		// a finality provider set and is non-finalised
+		voteTally := k.GetVoteTally(ctx, ib.Height)
 
 		switch {
-		case fpSet != nil && !ib.Finalized:
+		case ib.Finalized:
+			// the block has finalised
+			// this can only be a programming error
+			panic(fmt.Errorf("block %d is finalized, but last finalized height in DB does not reach here", ib.Height))
+		case voteTally != nil:
 			// has finality providers, non-finalised: tally and try to finalise the block
-			voterBTCPKs := k.GetVoters(ctx, ib.Height)
-			if tally(fpSet, voterBTCPKs) {
+			// by the accumulated voting power of the voters
+			if voteTally.HasQuorum() {
 				// if this block gets >2/3 votes, finalise it
 				k.finalizeBlock(ctx, ib)
 			} else {
@@ -68,18 +72,11 @@ finalizationLoop:
 				// thus, we need to break here
 				break finalizationLoop
 			}
-		case fpSet == nil && !ib.Finalized:
+		default:
 			// does not have finality providers, non-finalised: not finalisable,
 			// increment the next height to finalise and continue
 			k.setNextHeightToFinalize(ctx, ib.Height+1)
 			continue
-		case fpSet != nil && ib.Finalized:
-			// has finality providers and the block has finalised
-			// this can only be a programming error
-			panic(fmt.Errorf("block %d is finalized, but last finalized height in DB does not reach here", ib.Height))
-		case fpSet == nil && ib.Finalized:
-			// does not have finality providers, finalised: impossible to happen, panic
-			panic(fmt.Errorf("block %d is finalized, but does not have a finality provider set", ib.Height))
 		}
 	}
 }
@@ -92,24 +89,12 @@ func (k Keeper) finalizeBlock(ctx context.Context, block *types.IndexedBlock) {
 	k.SetBlock(ctx, block)
 	// set next height to finalise as height+1
 	k.setNextHeightToFinalize(ctx, block.Height+1)
+	// the vote tally is no longer needed once the block is finalised
+	k.removeVoteTally(ctx, block.Height)
 	// record the last finalized height metric
 	types.RecordLastFinalizedHeight(block.Height)
 }
 
-// tally checks whether a block with the given finality provider set and votes reaches a quorum or not
-func tally(fpSet map[string]uint64, voterBTCPKs map[string]struct{}) bool {
-	totalPower := uint64(0)
-	votedPower := uint64(0)
-	for pkStr, power := range fpSet {
-		totalPower += power
-		if _, ok := voterBTCPKs[pkStr]; ok {
-			votedPower += power
-		}
-	}
-
-	return votedPower*3 > totalPower*2
-}
-
 // setNextHeightToFinalize sets the next height to finalise as the given height
 func (k Keeper) setNextHeightToFinalize(ctx context.Context, height uint64) {
 	store := k.storeService.OpenKVStore(ctx)
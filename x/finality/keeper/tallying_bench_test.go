@@ -8,12 +8,19 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
 	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
 	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/keeper"
 	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
 )
 
@@ -89,3 +96,192 @@ func benchmarkTallyBlocks(b *testing.B, numFPs int) {
 func BenchmarkTallyBlocks_10(b *testing.B)  { benchmarkTallyBlocks(b, 10) }
 func BenchmarkTallyBlocks_50(b *testing.B)  { benchmarkTallyBlocks(b, 50) }
 func BenchmarkTallyBlocks_100(b *testing.B) { benchmarkTallyBlocks(b, 100) }
+
+// newBenchFinalityKeeper returns a finality keeper over a fresh store where
+// the given finality providers have timestamped public randomness for the
+// given number of blocks, along with the store key of the module, which is
+// used to replicate tallying by voters
+func newBenchFinalityKeeper(
+	b *testing.B,
+	ctrl *gomock.Controller,
+	r *rand.Rand,
+	fpBTCPKs []*bbn.BIP340PubKey,
+	numBlocks uint64,
+) (*keeper.Keeper, sdk.Context, store.CommitMultiStore, *storetypes.KVStoreKey) {
+	cKeeper := types.NewMockCheckpointingKeeper(ctrl)
+	cKeeper.EXPECT().GetLastFinalizedEpoch(gomock.Any()).Return(uint64(1)).AnyTimes()
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), storemetrics.NewNoOpMetrics())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	fKeeper, ctx := keepertest.FinalityKeeperWithStoreKey(b, db, stateStore, storeKey, nil, nil, cKeeper, nil)
+
+	params := types.DefaultParams()
+	params.FinalityActivationHeight = 0
+	params.MaxActiveFinalityProviders = uint32(len(fpBTCPKs))
+	require.NoError(b, fKeeper.SetParams(ctx, params))
+	for _, fpBTCPK := range fpBTCPKs {
+		require.NoError(b, fKeeper.SetPubRandCommit(ctx, fpBTCPK, &types.PubRandCommit{
+			StartHeight: 1,
+			NumPubRand:  numBlocks,
+			Commitment:  datagen.GenRandomByteArray(r, 32),
+			EpochNum:    1,
+		}))
+	}
+	stateStore.Commit()
+
+	return fKeeper, ctx, stateStore, storeKey
+}
+
+// recordVotingPowerByVoters records the voting power table of the block at the
+// height of the given context as RecordVotingPowerAndCache, but without
+// building its vote tally, as before the vote tallies
+func recordVotingPowerByVoters(fKeeper *keeper.Keeper, ctx sdk.Context, storeKey *storetypes.KVStoreKey, dc *types.VotingPowerDistCache) {
+	kvStore := ctx.KVStore(storeKey)
+	height := uint64(ctx.HeaderInfo().Height)
+	for _, fpDistInfo := range dc.FinalityProviders {
+		fpDistInfo.IsTimestamped = fKeeper.HasTimestampedPubRand(ctx, fpDistInfo.BtcPk, height)
+	}
+	dc.ApplyActiveFinalityProviders(fKeeper.GetParams(ctx).MaxActiveFinalityProviders)
+	for _, fp := range dc.FinalityProviders[:dc.NumActiveFps] {
+		key := append(types.VotingPowerTableStorePrefix(height), fp.BtcPk.MustMarshal()...)
+		kvStore.Set(key, sdk.Uint64ToBigEndian(fp.TotalBondedSat))
+	}
+	fKeeper.SetVotingPowerDistCache(ctx, height, dc)
+}
+
+// tallyBlocksByVoters tallies and finalizes the blocks as TallyBlocks, but by
+// comparing the voters of each block against its voting power table, as before
+// the vote tallies
+func tallyBlocksByVoters(b *testing.B, fKeeper *keeper.Keeper, ctx sdk.Context, storeKey *storetypes.KVStoreKey, maxFinalizedBlocks uint64) {
+	kvStore := ctx.KVStore(storeKey)
+	activatedHeight, err := fKeeper.GetBTCStakingActivatedHeight(ctx)
+	require.NoError(b, err)
+	startHeight := max(sdk.BigEndianToUint64(kvStore.Get(types.NextHeightToFinalizeKey)), activatedHeight)
+
+	currentHeight := uint64(ctx.HeaderInfo().Height)
+	if !fKeeper.HasBlock(ctx, currentHeight) {
+		fKeeper.IndexBlock(ctx)
+	}
+	for height := startHeight; height <= min(startHeight+maxFinalizedBlocks-1, currentHeight); height++ {
+		ib, err := fKeeper.GetBlock(ctx, height)
+		require.NoError(b, err)
+		fpSet := fKeeper.GetVotingPowerTable(ctx, height)
+		if len(fpSet) > 0 {
+			voters := fKeeper.GetVoters(ctx, height)
+			totalPower, votedPower := uint64(0), uint64(0)
+			for fpBTCPKHex, power := range fpSet {
+				totalPower += power
+				if _, voted := voters[fpBTCPKHex]; voted {
+					votedPower += power
+				}
+			}
+			if votedPower*3 <= totalPower*2 {
+				break
+			}
+			ib.Finalized = true
+			fKeeper.SetBlock(ctx, ib)
+		}
+		kvStore.Set(types.NextHeightToFinalizeKey, sdk.Uint64ToBigEndian(height+1))
+	}
+}
+
+// benchmarkTallyCatchUp executes numBlocks blocks with a voting power table of
+// numFPs finality providers, where the finality providers vote on all the
+// blocks in the last block, e.g., upon catching up after being offline, which
+// finalizes them at once. It measures the per-block path that maintains and
+// reads the vote tallies, i.e., recording the voting power table upon
+// BeginBlock and tallying the blocks upon EndBlock, and reports the time spent
+// tallying the blocks as tally-ns/op and the time spent recording the votes,
+// which is paid by the finality vote txs, as vote-ns/op.
+// byVoters measures the same path without the vote tallies, i.e., tallying the
+// blocks by comparing their voters against their voting power tables. The
+// state is generated per iteration and committed after each block, and
+// neither is measured.
+func benchmarkTallyCatchUp(b *testing.B, numFPs int, numBlocks uint64, byVoters bool) {
+	r := rand.New(rand.NewSource(time.Now().Unix()))
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+
+	fpBTCPKs := make([]*bbn.BIP340PubKey, 0, numFPs)
+	for i := 0; i < numFPs; i++ {
+		fpBTCPK, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(b, err)
+		fpBTCPKs = append(fpBTCPKs, fpBTCPK)
+	}
+	sig, err := datagen.GenRandomFinalitySig(r)
+	require.NoError(b, err)
+
+	var tallyTime, voteTime time.Duration
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		fKeeper, ctx, stateStore, storeKey := newBenchFinalityKeeper(b, ctrl, r, fpBTCPKs, numBlocks)
+
+		for height := uint64(1); height <= numBlocks; height++ {
+			fps := make([]*types.FinalityProviderDistInfo, 0, numFPs)
+			for _, fpBTCPK := range fpBTCPKs {
+				fps = append(fps, &types.FinalityProviderDistInfo{BtcPk: fpBTCPK, TotalBondedSat: 1})
+			}
+			dc := types.NewVotingPowerDistCacheWithFinalityProviders(fps)
+			blockCtx, writeCache := datagen.WithCtxHeight(ctx, height).CacheContext()
+
+			b.StartTimer()
+			if byVoters {
+				recordVotingPowerByVoters(fKeeper, blockCtx, storeKey, dc)
+			} else {
+				fKeeper.RecordVotingPowerAndCache(blockCtx, dc)
+			}
+			b.StopTimer()
+
+			if height == numBlocks {
+				start := time.Now()
+				for votedHeight := uint64(1); votedHeight <= numBlocks; votedHeight++ {
+					for _, fpBTCPK := range fpBTCPKs {
+						if byVoters {
+							blockCtx.KVStore(storeKey).Set(types.VoteStoreKey(votedHeight, fpBTCPK.MustMarshal()), sig.MustMarshal())
+						} else {
+							fKeeper.SetSig(blockCtx, votedHeight, fpBTCPK, sig)
+						}
+					}
+				}
+				voteTime += time.Since(start)
+			}
+
+			b.StartTimer()
+			start := time.Now()
+			if byVoters {
+				tallyBlocksByVoters(b, fKeeper, blockCtx, storeKey, numBlocks)
+			} else {
+				fKeeper.TallyBlocks(blockCtx, numBlocks)
+			}
+			tallyTime += time.Since(start)
+			b.StopTimer()
+
+			writeCache()
+			stateStore.Commit()
+		}
+
+		ib, err := fKeeper.GetBlock(ctx, numBlocks)
+		require.NoError(b, err)
+		require.True(b, ib.Finalized)
+	}
+	b.ReportMetric(float64(tallyTime.Nanoseconds())/float64(b.N), "tally-ns/op")
+	b.ReportMetric(float64(voteTime.Nanoseconds())/float64(b.N), "vote-ns/op")
+}
+
+func BenchmarkTallyCatchUp_100FPs_1000Blocks(b *testing.B) {
+	benchmarkTallyCatchUp(b, 100, 1000, false)
+}
+
+func BenchmarkTallyCatchUpByVoters_100FPs_1000Blocks(b *testing.B) {
+	benchmarkTallyCatchUp(b, 100, 1000, true)
+}
+
+func BenchmarkTallyCatchUp_1000FPs_100Blocks(b *testing.B) {
+	benchmarkTallyCatchUp(b, 1000, 100, false)
+}
+
+func BenchmarkTallyCatchUpByVoters_1000FPs_100Blocks(b *testing.B) {
+	benchmarkTallyCatchUp(b, 1000, 100, true)
+}
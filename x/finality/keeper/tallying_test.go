@@ -300,3 +300,94 @@ func FuzzConsecutiveFinalization(f *testing.F) {
 		}
 	})
 }
+
+func FuzzVoteTally(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		fKeeper, ctx := keepertest.FinalityKeeper(t, nil, nil, nil, nil)
+		height := datagen.RandomInt(r, 100) + 1
+
+		// finality providers are added to the voting power table and vote in
+		// a random order, and some of them vote before being added to the
+		// table or have their voting power updated after voting
+		numFps := int(datagen.RandomInt(r, 20)) + 1
+		fpBtcPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			fpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			fpBtcPks = append(fpBtcPks, fpBtcPk)
+		}
+		vote := func(fpBtcPk *bbn.BIP340PubKey) {
+			sig, err := datagen.GenRandomFinalitySig(r)
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, height, fpBtcPk, sig)
+		}
+		addedFpBtcPks := make([]*bbn.BIP340PubKey, 0, numFps)
+		for _, i := range r.Perm(numFps) {
+			if r.Intn(4) == 0 {
+				vote(fpBtcPks[i])
+			}
+			fKeeper.SetVotingPower(ctx, fpBtcPks[i].MustMarshal(), height, datagen.RandomInt(r, 100))
+			addedFpBtcPks = append(addedFpBtcPks, fpBtcPks[i])
+		}
+		for _, i := range r.Perm(numFps) {
+			if r.Intn(2) == 0 {
+				vote(fpBtcPks[i])
+			}
+			if r.Intn(4) == 0 {
+				fKeeper.SetVotingPower(ctx, fpBtcPks[i].MustMarshal(), height, datagen.RandomInt(r, 100))
+			}
+		}
+		// votes of finality providers without voting power are not counted
+		nonFpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+		require.NoError(t, err)
+		vote(nonFpBtcPk)
+
+		// the vote tally is consistent with the voting power table and votes
+		fpSet := fKeeper.GetVotingPowerTable(ctx, height)
+		voters := fKeeper.GetVoters(ctx, height)
+		expTotalPower, expVotedPower := uint64(0), uint64(0)
+		for fpBtcPkHex, power := range fpSet {
+			expTotalPower += power
+			if _, voted := voters[fpBtcPkHex]; voted {
+				expVotedPower += power
+			}
+		}
+		voteTally := fKeeper.GetVoteTally(ctx, height)
+		require.NotNil(t, voteTally)
+		require.Equal(t, uint32(numFps), voteTally.NumFps)
+		require.Equal(t, expTotalPower, voteTally.TotalVotingPower)
+		require.Equal(t, expVotedPower, voteTally.VotedVotingPower)
+		require.Equal(t, expVotedPower*3 > expTotalPower*2, voteTally.HasQuorum())
+
+		// the finality providers are indexed in the order they are added to
+		// the voting power table, so that adding one does not shift the
+		// indexes of the others
+		for i, fpBtcPk := range addedFpBtcPks {
+			_, voted := voters[fpBtcPk.MarshalHex()]
+			require.Equal(t, voted, voteTally.HasVoted(uint32(i)))
+		}
+
+		// the vote tally is removed once the block is finalised, and votes
+		// afterwards are not counted
+		ctx = datagen.WithCtxHeight(ctx, height)
+		for _, fpBtcPk := range fpBtcPks {
+			vote(fpBtcPk)
+		}
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{
+			Height:  height,
+			AppHash: datagen.GenRandomByteArray(r, 32),
+		})
+		fKeeper.TallyBlocks(ctx, types.MaxFinalizedRewardedBlocksPerEndBlock)
+		ib, err := fKeeper.GetBlock(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, expTotalPower > 0, ib.Finalized)
+		if ib.Finalized {
+			require.Nil(t, fKeeper.GetVoteTally(ctx, height))
+			vote(fpBtcPks[0])
+			require.Nil(t, fKeeper.GetVoteTally(ctx, height))
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// getEpochHeightRange returns the first and the last height of the given epoch
+// up to the given max height, and false if the epoch has not started at the
+// max height yet. Epoch numbers do not decrease with heights, thus the range
+// is found by binary searching GetEpochByHeight.
+func (k Keeper) getEpochHeightRange(ctx context.Context, epochNum uint64, maxHeight uint64) (uint64, uint64, bool) {
+	if maxHeight == 0 {
+		return 0, 0, false
+	}
+	// first height in [1, maxHeight] whose epoch number is at least epochNum
+	firstHeightFrom := func(epochNum uint64) uint64 {
+		return uint64(sort.Search(int(maxHeight), func(i int) bool {
+			return k.CheckpointingKeeper.GetEpochByHeight(ctx, uint64(i)+1) >= epochNum
+		})) + 1
+	}
+
+	start := firstHeightFrom(epochNum)
+	if start > maxHeight || k.CheckpointingKeeper.GetEpochByHeight(ctx, start) != epochNum {
+		return 0, 0, false
+	}
+	end := firstHeightFrom(epochNum+1) - 1
+	return start, end, true
+}
+
+// GetVoteParticipation returns the finality vote participation of each
+// finality provider active at any height in [start, end], sorted by the BTC PK
+// of the finality providers, and the number of heights whose votes are pruned.
+// The active finality providers and their voting power at each height are
+// taken from the voting power table, which records the active finality
+// providers of the voting power distribution cache and is kept after the cache
+// is removed upon rewarding. Heights whose votes are pruned are not counted.
+func (k Keeper) GetVoteParticipation(ctx context.Context, start uint64, end uint64) ([]types.FinalityProviderVoteParticipation, uint64) {
+	var numPrunedHeights uint64
+	byFp := map[string]*types.FinalityProviderVoteParticipation{}
+	for height := start; height <= end; height++ {
+		powerTable := k.GetVotingPowerTable(ctx, height)
+		if len(powerTable) == 0 {
+			continue
+		}
+		if k.IsHeightPruned(ctx, height) {
+			numPrunedHeights++
+			continue
+		}
+
+		voters := k.GetVoters(ctx, height)
+		for fpBTCPKHex, power := range powerTable {
+			p, ok := byFp[fpBTCPKHex]
+			if !ok {
+				p = &types.FinalityProviderVoteParticipation{FpBtcPkHex: fpBTCPKHex}
+				byFp[fpBTCPKHex] = p
+			}
+			p.EligibleHeights++
+			p.EligibleVotingPower += power
+			if _, voted := voters[fpBTCPKHex]; !voted {
+				continue
+			}
+			p.SignedHeights++
+			p.SignedVotingPower += power
+			if p.FirstSignedHeight == 0 {
+				p.FirstSignedHeight = height
+			}
+			p.LastSignedHeight = height
+		}
+	}
+
+	participations := make([]types.FinalityProviderVoteParticipation, 0, len(byFp))
+	for _, p := range byFp {
+		p.WeightedParticipation = sdkmath.LegacyZeroDec()
+		if p.EligibleVotingPower > 0 {
+			p.WeightedParticipation = sdkmath.LegacyNewDecFromInt(sdkmath.NewIntFromUint64(p.SignedVotingPower)).
+				QuoInt(sdkmath.NewIntFromUint64(p.EligibleVotingPower))
+		}
+		participations = append(participations, *p)
+	}
+	sort.Slice(participations, func(i, j int) bool {
+		return participations[i].FpBtcPkHex < participations[j].FpBtcPkHex
+	})
+
+	return participations, numPrunedHeights
+}
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// GetVoteTally gets the vote tally of the non-finalized block at the given
+// height. It returns nil if the block does not have a finality provider set,
+// or if the block is finalized.
+func (k Keeper) GetVoteTally(ctx context.Context, height uint64) *types.VoteTally {
+	tally, err := k.voteTallies.Get(ctx, height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		panic(err)
+	}
+	return &tally
+}
+
+// setVoteTally sets the vote tally of the block at the given height
+func (k Keeper) setVoteTally(ctx context.Context, height uint64, tally *types.VoteTally) {
+	if err := k.voteTallies.Set(ctx, height, *tally); err != nil {
+		panic(err)
+	}
+}
+
+// updateVoteTally updates the vote tally of the block at the given height
+// upon changing the voting power of the given finality provider from oldPower
+// to newPower. If the finality provider is not indexed yet, it is indexed
+// after the finality providers that are already indexed, so that their
+// indexes remain unchanged. If no finality provider is indexed at the height
+// yet, the vote tally is built from the voting power table.
+func (k Keeper) updateVoteTally(ctx context.Context, fpBTCPK []byte, height uint64, oldPower uint64, newPower uint64) {
+	index, err := k.votingPowerIndexes.Get(ctx, collections.Join(height, fpBTCPK))
+	if errors.Is(err, collections.ErrNotFound) {
+		tally := k.GetVoteTally(ctx, height)
+		if tally == nil {
+			k.buildVoteTally(ctx, height)
+			return
+		}
+		k.addToVoteTally(ctx, tally, fpBTCPK, height, newPower)
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	tally := k.GetVoteTally(ctx, height)
+	if tally == nil {
+		// the indexes are always written together with the vote tally
+		panic(fmt.Errorf("vote tally of block %d with indexed finality providers is not found", height))
+	}
+	tally.TotalVotingPower = tally.TotalVotingPower - oldPower + newPower
+	if tally.HasVoted(index) {
+		tally.VotedVotingPower = tally.VotedVotingPower - oldPower + newPower
+	}
+	k.setVoteTally(ctx, height, tally)
+}
+
+// addToVoteTally indexes the given finality provider with the given voting
+// power after the finality providers in the vote tally of the block at the
+// given height, and counts its vote if it has voted for the block
+func (k Keeper) addToVoteTally(ctx context.Context, tally *types.VoteTally, fpBTCPK []byte, height uint64, power uint64) {
+	index := tally.NumFps
+	if err := k.votingPowerIndexes.Set(ctx, collections.Join(height, fpBTCPK), index); err != nil {
+		panic(err)
+	}
+	tally.NumFps++
+	tally.TotalVotingPower += power
+	// the finality provider might have voted before it is added to the
+	// voting power table, e.g., upon InitGenesis
+	if k.voteHeightStore(ctx, height).Has(fpBTCPK) {
+		tally.SetVoted(index)
+		tally.VotedVotingPower += power
+	}
+	k.setVoteTally(ctx, height, tally)
+}
+
+// buildVoteTally builds the vote tally of the block at the given height from
+// its voting power table and votes. The finality providers are indexed by
+// their position in the voting power table, which is ordered by their BTC PK,
+// and the indexes are stored so that a vote is recorded without reading the
+// table. Finality providers added to the table afterwards are indexed after
+// them, see updateVoteTally.
+func (k Keeper) buildVoteTally(ctx context.Context, height uint64) {
+	type powerEntry struct {
+		fpBTCPK []byte
+		power   uint64
+	}
+
+	k.pruneVotingPowerIndexes(ctx, height)
+
+	// collect the voting power table first, so that the indexes are not
+	// written while iterating the table
+	var entries []powerEntry
+	iter := k.votingPowerBbnBlockHeightStore(ctx, height).Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		entries = append(entries, powerEntry{
+			fpBTCPK: append([]byte{}, iter.Key()...),
+			power:   sdk.BigEndianToUint64(iter.Value()),
+		})
+	}
+	iter.Close()
+	if len(entries) == 0 {
+		k.removeVoteTally(ctx, height)
+		return
+	}
+
+	tally := &types.VoteTally{
+		NumFps:      uint32(len(entries)),
+		VoterBitmap: make([]byte, (len(entries)+7)/8),
+	}
+	indexes := make(map[string]uint32, len(entries))
+	for i, entry := range entries {
+		index := uint32(i)
+		if err := k.votingPowerIndexes.Set(ctx, collections.Join(height, entry.fpBTCPK), index); err != nil {
+			panic(err)
+		}
+		indexes[string(entry.fpBTCPK)] = index
+		tally.TotalVotingPower += entry.power
+	}
+
+	// the finality providers might have voted before the voting power table
+	// is set, e.g., upon InitGenesis
+	voteIter := k.voteHeightStore(ctx, height).Iterator(nil, nil)
+	for ; voteIter.Valid(); voteIter.Next() {
+		index, ok := indexes[string(voteIter.Key())]
+		if !ok {
+			continue
+		}
+		tally.SetVoted(index)
+		tally.VotedVotingPower += entries[index].power
+	}
+	voteIter.Close()
+
+	k.setVoteTally(ctx, height, tally)
+}
+
+// recordVoteInTally records the vote of the given finality provider in the
+// vote tally of the block at the given height. The vote is not counted if the
+// finality provider is not in the voting power table of the block, or if the
+// block is finalized.
+func (k Keeper) recordVoteInTally(ctx context.Context, height uint64, fpBTCPK []byte) {
+	index, err := k.votingPowerIndexes.Get(ctx, collections.Join(height, fpBTCPK))
+	if errors.Is(err, collections.ErrNotFound) {
+		return
+	}
+	if err != nil {
+		panic(err)
+	}
+
+	tally := k.GetVoteTally(ctx, height)
+	if tally == nil || tally.HasVoted(index) {
+		return
+	}
+	tally.SetVoted(index)
+	tally.VotedVotingPower += k.GetVotingPower(ctx, fpBTCPK, height)
+	k.setVoteTally(ctx, height, tally)
+}
+
+// removeVoteTally deletes the vote tally of the block at the given height
+// together with the indexes of the finality providers in its voting power
+// table
+func (k Keeper) removeVoteTally(ctx context.Context, height uint64) {
+	if err := k.voteTallies.Remove(ctx, height); err != nil {
+		panic(err)
+	}
+	k.pruneVotingPowerIndexes(ctx, height)
+}
+
+// pruneVotingPowerIndexes deletes the indexes of the finality providers in the
+// voting power table at the given height
+func (k Keeper) pruneVotingPowerIndexes(ctx context.Context, height uint64) {
+	if err := k.votingPowerIndexes.Clear(ctx, collections.NewPrefixedPairRange[uint64, []byte](height)); err != nil {
+		panic(err)
+	}
+}
+
+// buildVoteTallies builds the vote tallies of the non-finalized blocks from
+// their voting power tables and votes
+func (k Keeper) buildVoteTallies(ctx context.Context) error {
+	// collect the heights first, so that the vote tallies are not written
+	// while iterating the voting power tables
+	var heights []uint64
+	iter := k.votingPowerStore(ctx).Iterator(sdk.Uint64ToBigEndian(k.getNextHeightToFinalize(ctx)), nil)
+	for ; iter.Valid(); iter.Next() {
+		// key is <height><fpBtcPK>
+		key := iter.Key()
+		if len(key) <= 8 {
+			iter.Close()
+			return fmt.Errorf("voting power key with smaller length (%d) than expected (>8)", len(key))
+		}
+		height := sdk.BigEndianToUint64(key[:8])
+		if len(heights) == 0 || heights[len(heights)-1] != height {
+			heights = append(heights, height)
+		}
+	}
+	iter.Close()
+
+	for _, height := range heights {
+		k.buildVoteTally(ctx, height)
+	}
+
+	return nil
+}
@@ -12,9 +12,12 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+// SetSig sets the EOTS signature of a given finality provider at a given height,
+// and records the vote in the vote tally of the block if it is not finalized yet
 func (k Keeper) SetSig(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey, sig *bbn.SchnorrEOTSSig) {
 	store := k.voteHeightStore(ctx, height)
 	store.Set(fpBtcPK.MustMarshal(), sig.MustMarshal())
+	k.recordVoteInTally(ctx, height, fpBtcPK.MustMarshal())
 }
 
 func (k Keeper) HasSig(ctx context.Context, height uint64, fpBtcPK *bbn.BIP340PubKey) bool {
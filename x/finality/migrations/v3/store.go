@@ -0,0 +1,65 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+// MigrateStore performs in-place store migrations. The
+// migration includes
+//   - enabling the pruning of the public randomness, votes and
+//     indexed blocks by setting the state retention window to its
+//     default value, and setting the next height to prune to the
+//     first indexed block. The existing state out of the retention
+//     window is then pruned by the EndBlocker in bounded batches
+//     per block.
+//   - enabling the detection of finality halts by setting the
+//     finality halt threshold to its default value.
+//   - enabling the graduated jailing and tombstoning of sluggish
+//     finality providers by setting the max jail duration, which is
+//     no lower than the jail duration, and the tombstone jail count
+//     to their default values. The existing finality providers start
+//     with a jail count of 0.
+//   - building the vote tallies of the non-finalized blocks from
+//     their voting power tables and votes, which are used for
+//     tallying the blocks.
+func MigrateStore(
+	ctx sdk.Context,
+	s storetypes.KVStore,
+	cdc codec.BinaryCodec,
+	buildVoteTallies func(ctx context.Context) error,
+) error {
+	var params types.Params
+	if bz := s.Get(types.ParamsKey); bz != nil {
+		if err := cdc.Unmarshal(bz, &params); err != nil {
+			return err
+		}
+	}
+	params.StateRetentionWindow = types.DefaultStateRetentionWindow
+	params.FinalityHaltThreshold = types.DefaultFinalityHaltThreshold
+	params.MaxJailDuration = max(types.DefaultMaxJailDuration, params.JailDuration)
+	params.TombstoneJailCount = types.DefaultTombstoneJailCount
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	s.Set(types.ParamsKey, bz)
+
+	blockStore := prefix.NewStore(s, types.BlockKey)
+	iter := blockStore.Iterator(nil, nil)
+	if iter.Valid() {
+		s.Set(types.NextHeightToPruneKey, iter.Key())
+	}
+	iter.Close()
+
+	return buildVoteTallies(ctx)
+}
@@ -0,0 +1,102 @@
+package v3_test
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		r            = rand.New(rand.NewSource(time.Now().UnixNano()))
+		db           = dbm.NewMemDB()
+		stateStore   = store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		storeKey     = storetypes.NewKVStoreKey(types.StoreKey)
+		fKeeper, ctx = keepertest.FinalityKeeperWithStoreKey(t, db, stateStore, storeKey, nil, nil, nil, nil)
+		firstHeight  = datagen.RandomInt(r, 1000) + 1
+		numBlocks    = datagen.RandomInt(r, 100) + 1
+	)
+
+	// setup store with the params without state retention window and some
+	// indexed blocks
+	params := types.DefaultParams()
+	params.StateRetentionWindow = 0
+	params.FinalityHaltThreshold = 0
+	params.MaxJailDuration = 0
+	params.TombstoneJailCount = 0
+	require.NoError(t, fKeeper.SetParams(ctx, params))
+	for height := firstHeight; height < firstHeight+numBlocks; height++ {
+		fKeeper.SetBlock(ctx, &types.IndexedBlock{
+			Height:    height,
+			AppHash:   datagen.GenRandomByteArray(r, 32),
+			Finalized: true,
+		})
+	}
+	require.Zero(t, fKeeper.GetNextHeightToPrune(ctx))
+
+	// setup store with the voting power tables and votes of some
+	// non-finalized blocks, without vote tallies
+	kvStore := ctx.KVStore(storeKey)
+	nonFinalizedHeights := []uint64{firstHeight + numBlocks, firstHeight + numBlocks + 1}
+	expTallies := make(map[uint64]*types.VoteTally)
+	for _, height := range nonFinalizedHeights {
+		expTally := &types.VoteTally{}
+		numFps := int(datagen.RandomInt(r, 10)) + 1
+		for i := 0; i < numFps; i++ {
+			fpBtcPk, err := datagen.GenRandomBIP340PubKey(r)
+			require.NoError(t, err)
+			power := datagen.RandomInt(r, 100) + 1
+			kvStore.Set(append(types.VotingPowerTableStorePrefix(height), fpBtcPk.MustMarshal()...), sdk.Uint64ToBigEndian(power))
+			expTally.TotalVotingPower += power
+			if r.Intn(2) == 0 {
+				sig, err := datagen.GenRandomFinalitySig(r)
+				require.NoError(t, err)
+				kvStore.Set(types.VoteStoreKey(height, fpBtcPk.MustMarshal()), sig.MustMarshal())
+				expTally.VotedVotingPower += power
+			}
+		}
+		expTally.NumFps = uint32(numFps)
+		expTallies[height] = expTally
+		require.Nil(t, fKeeper.GetVoteTally(ctx, height))
+	}
+
+	// Perform migration
+	m := keeper.NewMigrator(*fKeeper)
+	require.NoError(t, m.Migrate2to3(ctx))
+
+	// Check migration was successful
+	// the pruning is enabled, starting from the first indexed block, and
+	// the detection of finality halts and the graduated jailing are enabled
+	params.StateRetentionWindow = types.DefaultStateRetentionWindow
+	params.FinalityHaltThreshold = types.DefaultFinalityHaltThreshold
+	params.MaxJailDuration = types.DefaultMaxJailDuration
+	params.TombstoneJailCount = types.DefaultTombstoneJailCount
+	require.Equal(t, params, fKeeper.GetParams(ctx))
+	require.Equal(t, firstHeight, fKeeper.GetNextHeightToPrune(ctx))
+	// no state is pruned by the migration
+	for height := firstHeight; height < firstHeight+numBlocks; height++ {
+		require.False(t, fKeeper.IsHeightPruned(ctx, height))
+		require.True(t, fKeeper.HasBlock(ctx, height))
+	}
+	// the vote tallies of the non-finalized blocks are built
+	for height, expTally := range expTallies {
+		voteTally := fKeeper.GetVoteTally(ctx, height)
+		require.NotNil(t, voteTally)
+		require.Equal(t, expTally.NumFps, voteTally.NumFps)
+		require.Equal(t, expTally.TotalVotingPower, voteTally.TotalVotingPower)
+		require.Equal(t, expTally.VotedVotingPower, voteTally.VotedVotingPower)
+	}
+}
@@ -10,8 +10,10 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCommitPubRandList{}, "finality/MsgCommitPubRandList", nil)
 	cdc.RegisterConcrete(&MsgAddFinalitySig{}, "finality/MsgAddFinalitySig", nil)
+	cdc.RegisterConcrete(&MsgAddFinalitySigs{}, "finality/MsgAddFinalitySigs", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "finality/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgResumeFinalityProposal{}, "finality/MsgResumeFinalityProposal", nil)
+	cdc.RegisterConcrete(&MsgRotateFinalityProviderKey{}, "finality/MsgRotateFinalityProviderKey", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -20,8 +22,10 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgCommitPubRandList{},
 		&MsgAddFinalitySig{},
+		&MsgAddFinalitySigs{},
 		&MsgUpdateParams{},
 		&MsgResumeFinalityProposal{},
+		&MsgRotateFinalityProviderKey{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -5,9 +5,39 @@ const (
 	// with this setting, block processing times are <1s, even if BTC finality
 	// stalls a lot
 	MaxFinalizedRewardedBlocksPerEndBlock = uint64(10000)
+	// MaxPrunedBlocksPerEndBlock defines the maximum number of heights whose
+	// public randomness, votes and indexed blocks are pruned in an EndBlock,
+	// so that the pruning of a large backlog of heights, e.g., after enabling
+	// the pruning, is spread over several blocks
+	MaxPrunedBlocksPerEndBlock = uint64(1000)
+	// MaxPrunedCommitIndexesPerEndBlock defines the maximum number of finality
+	// providers whose public randomness commitments are pruned in an EndBlock,
+	// so that the pruning does not iterate over all finality providers in a
+	// single block
+	MaxPrunedCommitIndexesPerEndBlock = uint64(100)
 	// MaxPubRandCommitOffset defines the maximum number of blocks into the future
 	// that a public randomness commitment start height can target. This limit prevents abuse by capping
 	// the size of the commitments index, protecting against potential memory exhaustion
 	// or performance degradation caused by excessive future commitments.
 	MaxPubRandCommitOffset = 160_000
+	// MaxFinalitySigsPerBatch defines the maximum number of finality signatures
+	// that can be submitted in a single MsgAddFinalitySigs, so that the
+	// execution time of a single message remains bounded.
+	MaxFinalitySigsPerBatch = 500
+	// AddFinalitySigsGasPerItem is the gas charged for each finality signature
+	// in a MsgAddFinalitySigs, on top of the gas consumed by store accesses.
+	// It roughly accounts for the verification of the inclusion proof and the
+	// EOTS signature, which do not touch the store.
+	AddFinalitySigsGasPerItem = uint64(10_000)
+	// DefaultLivenessQueryEpochs defines the default number of the most recent
+	// epochs for which the FinalityProviderLiveness query computes the uptime
+	// of a finality provider
+	DefaultLivenessQueryEpochs = uint64(10)
+	// MaxLivenessQueryEpochs defines the maximum number of epochs for which the
+	// FinalityProviderLiveness query computes the uptime of a finality
+	// provider, so that the number of heights it iterates remains bounded
+	MaxLivenessQueryEpochs = uint64(50)
+	// MaxFinalityStatusRange defines the maximum number of heights whose
+	// finality status can be queried in a single FinalityStatusRange query
+	MaxFinalityStatusRange = uint64(1000)
 )
@@ -29,4 +29,9 @@ var (
 	ErrFinalityProviderIsDeleted      = errorsmod.Register(ModuleName, 1122, "finality provider is deleted")
 	ErrInvalidSats                    = errorsmod.Register(ModuleName, 1123, "invalid satoshi amount")
 	ErrVotingPowerTableNotFound       = errorsmod.Register(ModuleName, 1124, "voting power table was not found")
+	ErrInvalidFinalitySigBatch        = errorsmod.Register(ModuleName, 1125, "finality signature batch is not valid")
+	ErrNoFinalitySigAccepted          = errorsmod.Register(ModuleName, 1126, "none of the finality signatures in the batch is accepted")
+	ErrInvalidKeyRotation             = errorsmod.Register(ModuleName, 1127, "finality provider key rotation is not valid")
+	ErrHeightPruned                   = errorsmod.Register(ModuleName, 1128, "the state at the given height is pruned")
+	ErrFpTombstoned                   = errorsmod.Register(ModuleName, 1129, "the finality provider is tombstoned and cannot be unjailed")
 )
@@ -11,3 +11,14 @@ func NewEventSlashedFinalityProvider(evidence *Evidence) *EventSlashedFinalityPr
 func NewEventJailedFinalityProvider(fpPk *types.BIP340PubKey) *EventJailedFinalityProvider {
 	return &EventJailedFinalityProvider{PublicKey: fpPk.MarshalHex()}
 }
+
+func NewEventTombstonedFinalityProvider(fpPk *types.BIP340PubKey, jailCount uint64) *EventTombstonedFinalityProvider {
+	return &EventTombstonedFinalityProvider{
+		PublicKey: fpPk.MarshalHex(),
+		JailCount: jailCount,
+	}
+}
+
+func NewEventFinalityHaltDetected(halt *FinalityHalt) *EventFinalityHaltDetected {
+	return &EventFinalityHaltDetected{Halt: halt}
+}
@@ -69,6 +69,55 @@ func (m *EventSlashedFinalityProvider) GetEvidence() *Evidence {
 	return nil
 }
 
+// EventFinalityHaltDetected is the event emitted when no block is finalized
+// for at least finality_halt_threshold blocks since the first non-finalized
+// block. It is emitted once per halting height.
+type EventFinalityHaltDetected struct {
+	// halt is the first non-finalized block and the finality providers that
+	// have not voted for it
+	Halt *FinalityHalt `protobuf:"bytes,1,opt,name=halt,proto3" json:"halt,omitempty"`
+}
+
+func (m *EventFinalityHaltDetected) Reset()         { *m = EventFinalityHaltDetected{} }
+func (m *EventFinalityHaltDetected) String() string { return proto.CompactTextString(m) }
+func (*EventFinalityHaltDetected) ProtoMessage()    {}
+func (*EventFinalityHaltDetected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{1}
+}
+func (m *EventFinalityHaltDetected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFinalityHaltDetected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFinalityHaltDetected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFinalityHaltDetected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFinalityHaltDetected.Merge(m, src)
+}
+func (m *EventFinalityHaltDetected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFinalityHaltDetected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFinalityHaltDetected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFinalityHaltDetected proto.InternalMessageInfo
+
+func (m *EventFinalityHaltDetected) GetHalt() *FinalityHalt {
+	if m != nil {
+		return m.Halt
+	}
+	return nil
+}
+
 // EventJailedFinalityProvider is the event emitted when a finality provider is
 // jailed due to inactivity
 type EventJailedFinalityProvider struct {
@@ -80,7 +129,7 @@ func (m *EventJailedFinalityProvider) Reset()         { *m = EventJailedFinality
 func (m *EventJailedFinalityProvider) String() string { return proto.CompactTextString(m) }
 func (*EventJailedFinalityProvider) ProtoMessage()    {}
 func (*EventJailedFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c34c03aae5e3e6bf, []int{1}
+	return fileDescriptor_c34c03aae5e3e6bf, []int{2}
 }
 func (m *EventJailedFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -116,30 +165,94 @@ func (m *EventJailedFinalityProvider) GetPublicKey() string {
 	return ""
 }
 
+// EventTombstonedFinalityProvider is the event emitted when a finality
+// provider is tombstoned after being jailed too many times due to liveness
+// downtime
+type EventTombstonedFinalityProvider struct {
+	// public_key is the BTC public key of the finality provider
+	PublicKey string `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// jail_count is the number of times the finality provider is jailed
+	JailCount uint64 `protobuf:"varint,2,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+}
+
+func (m *EventTombstonedFinalityProvider) Reset()         { *m = EventTombstonedFinalityProvider{} }
+func (m *EventTombstonedFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*EventTombstonedFinalityProvider) ProtoMessage()    {}
+func (*EventTombstonedFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c34c03aae5e3e6bf, []int{3}
+}
+func (m *EventTombstonedFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTombstonedFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTombstonedFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTombstonedFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTombstonedFinalityProvider.Merge(m, src)
+}
+func (m *EventTombstonedFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTombstonedFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTombstonedFinalityProvider.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTombstonedFinalityProvider proto.InternalMessageInfo
+
+func (m *EventTombstonedFinalityProvider) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *EventTombstonedFinalityProvider) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventSlashedFinalityProvider)(nil), "babylon.finality.v1.EventSlashedFinalityProvider")
+	proto.RegisterType((*EventFinalityHaltDetected)(nil), "babylon.finality.v1.EventFinalityHaltDetected")
 	proto.RegisterType((*EventJailedFinalityProvider)(nil), "babylon.finality.v1.EventJailedFinalityProvider")
+	proto.RegisterType((*EventTombstonedFinalityProvider)(nil), "babylon.finality.v1.EventTombstonedFinalityProvider")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/events.proto", fileDescriptor_c34c03aae5e3e6bf) }
 
 var fileDescriptor_c34c03aae5e3e6bf = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4a, 0x4c, 0xaa,
-	0xcc, 0xc9, 0xcf, 0xd3, 0x4f, 0xcb, 0xcc, 0x4b, 0xcc, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xaa,
-	0xd0, 0x83, 0xa9, 0xd0, 0x2b, 0x33, 0x94, 0x52, 0xc2, 0xa6, 0x0d, 0xae, 0x00, 0xac, 0x51, 0x29,
-	0x92, 0x4b, 0xc6, 0x15, 0x64, 0x50, 0x70, 0x4e, 0x62, 0x71, 0x46, 0x6a, 0x8a, 0x1b, 0x54, 0x36,
-	0xa0, 0x28, 0xbf, 0x2c, 0x33, 0x25, 0xb5, 0x48, 0xc8, 0x92, 0x8b, 0x23, 0x15, 0xc4, 0xca, 0x4b,
-	0x4e, 0x95, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x92, 0xd5, 0xc3, 0x62, 0x97, 0x9e, 0x2b, 0x54,
-	0x51, 0x10, 0x5c, 0xb9, 0x92, 0x0d, 0x97, 0x34, 0xd8, 0x68, 0xaf, 0xc4, 0xcc, 0x1c, 0x2c, 0x26,
-	0xcb, 0x72, 0x71, 0x15, 0x94, 0x26, 0xe5, 0x64, 0x26, 0xc7, 0x67, 0xa7, 0x56, 0x82, 0xcd, 0xe6,
-	0x0c, 0xe2, 0x84, 0x88, 0x78, 0xa7, 0x56, 0x3a, 0xf9, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91,
-	0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3,
-	0xb1, 0x1c, 0x43, 0x94, 0x69, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e,
-	0xd4, 0x29, 0x39, 0x89, 0x49, 0xc5, 0xba, 0x99, 0xf9, 0x30, 0xae, 0x7e, 0x99, 0x89, 0x7e, 0x05,
-	0xc2, 0xd7, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x0f, 0x1b, 0x03, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x5c, 0x72, 0x47, 0x32, 0x4d, 0x01, 0x00, 0x00,
+	// 310 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xbd, 0x4e, 0xc3, 0x30,
+	0x14, 0x85, 0x6b, 0x54, 0x21, 0x6a, 0xb6, 0xb2, 0x94, 0x9f, 0x9a, 0x92, 0xa9, 0x0b, 0xb6, 0x0a,
+	0x74, 0x40, 0x62, 0x02, 0x8a, 0x10, 0x0c, 0xa0, 0xc0, 0x02, 0x4b, 0x65, 0xa7, 0x17, 0x6a, 0x70,
+	0xed, 0x2a, 0x71, 0x22, 0xf2, 0x16, 0x3c, 0x16, 0x63, 0x47, 0x46, 0x94, 0xbc, 0x08, 0x8a, 0x49,
+	0x0a, 0x43, 0x16, 0xb6, 0xe4, 0xdc, 0xef, 0x7c, 0xb6, 0x75, 0x71, 0x4f, 0x70, 0x91, 0x2a, 0xa3,
+	0xd9, 0x93, 0xd4, 0x5c, 0x49, 0x9b, 0xb2, 0x64, 0xc0, 0x20, 0x01, 0x6d, 0x23, 0x3a, 0x0f, 0x8d,
+	0x35, 0xed, 0x8d, 0x92, 0xa0, 0x15, 0x41, 0x93, 0xc1, 0x96, 0x57, 0x57, 0x5b, 0x02, 0xae, 0xe8,
+	0x3d, 0xe0, 0x9d, 0x51, 0x21, 0xba, 0x53, 0x3c, 0x9a, 0xc2, 0xe4, 0xa2, 0x9c, 0xde, 0x86, 0x26,
+	0x91, 0x13, 0x08, 0xdb, 0xc7, 0x78, 0x0d, 0x8a, 0x2f, 0x1d, 0x40, 0x07, 0xf5, 0x50, 0x7f, 0xfd,
+	0xa0, 0x4b, 0x6b, 0xce, 0xa2, 0xa3, 0x12, 0xf2, 0x97, 0xb8, 0xe7, 0xe3, 0x4d, 0xa7, 0xae, 0x9c,
+	0x97, 0x5c, 0xd9, 0x73, 0xb0, 0x10, 0x58, 0x98, 0xb4, 0x87, 0xb8, 0x39, 0xe5, 0xca, 0x96, 0xce,
+	0xbd, 0x5a, 0xe7, 0xdf, 0xa2, 0xef, 0x70, 0xef, 0x04, 0x6f, 0x3b, 0xe7, 0x15, 0x97, 0xaa, 0xe6,
+	0xb6, 0x5d, 0x8c, 0xe7, 0xb1, 0x50, 0x32, 0x18, 0xbf, 0x42, 0xea, 0xdc, 0x2d, 0xbf, 0xf5, 0x93,
+	0x5c, 0x43, 0xea, 0x8d, 0xf1, 0xae, 0x6b, 0xdf, 0x9b, 0x99, 0x88, 0xac, 0xd1, 0xff, 0x36, 0x14,
+	0xe3, 0x17, 0x2e, 0xd5, 0x38, 0x30, 0xb1, 0xb6, 0x9d, 0x95, 0x1e, 0xea, 0x37, 0xfd, 0x56, 0x91,
+	0x9c, 0x15, 0xc1, 0xe9, 0xcd, 0x47, 0x46, 0xd0, 0x22, 0x23, 0xe8, 0x2b, 0x23, 0xe8, 0x3d, 0x27,
+	0x8d, 0x45, 0x4e, 0x1a, 0x9f, 0x39, 0x69, 0x3c, 0x0e, 0x9f, 0xa5, 0x9d, 0xc6, 0x82, 0x06, 0x66,
+	0xc6, 0xca, 0xb7, 0x2a, 0x2e, 0xa2, 0x7d, 0x69, 0xaa, 0x5f, 0x96, 0x1c, 0xb1, 0xb7, 0xdf, 0x55,
+	0xd9, 0x74, 0x0e, 0x91, 0x58, 0x75, 0x5b, 0x3a, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xb4,
+	0xb7, 0xdd, 0x02, 0x02, 0x00, 0x00,
 }
 
 func (m *EventSlashedFinalityProvider) Marshal() (dAtA []byte, err error) {
@@ -177,6 +290,41 @@ func (m *EventSlashedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *EventFinalityHaltDetected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFinalityHaltDetected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFinalityHaltDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halt != nil {
+		{
+			size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventJailedFinalityProvider) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -207,6 +355,41 @@ func (m *EventJailedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
+func (m *EventTombstonedFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTombstonedFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTombstonedFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.JailCount != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -231,6 +414,19 @@ func (m *EventSlashedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventFinalityHaltDetected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Halt != nil {
+		l = m.Halt.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func (m *EventJailedFinalityProvider) Size() (n int) {
 	if m == nil {
 		return 0
@@ -244,6 +440,22 @@ func (m *EventJailedFinalityProvider) Size() (n int) {
 	return n
 }
 
+func (m *EventTombstonedFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovEvents(uint64(m.JailCount))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -336,6 +548,92 @@ func (m *EventSlashedFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventFinalityHaltDetected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFinalityHaltDetected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFinalityHaltDetected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Halt == nil {
+				m.Halt = &FinalityHalt{}
+			}
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -418,6 +716,107 @@ func (m *EventJailedFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventTombstonedFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTombstonedFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTombstonedFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -25,8 +25,12 @@ type BTCStakingKeeper interface {
 	JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	UnjailFinalityProvider(ctx context.Context, fpBTCPK []byte) error
 	UpdateFinalityProvider(ctx context.Context, fp *bstypes.FinalityProvider) error
+	RotateFinalityProviderKey(ctx context.Context, fpBtcPk *bbn.BIP340PubKey, newEotsPk *bbn.BIP340PubKey, activationHeight uint64) error
 	PowerDistUpdateEventBtcHeightStoreIterator(ctx context.Context, btcHeight uint32) storetypes.Iterator
 	BtcDelHasCovenantQuorums(ctx context.Context, btcDel *bstypes.BTCDelegation, quorum uint32) (bool, error)
+	BtcDelStatus(ctx context.Context, btcDel *bstypes.BTCDelegation, covenantQuorum uint32, btcTipHeight uint32) (bstypes.BTCDelegationStatus, error)
+	IterateBTCDelegations(ctx context.Context, fn func(btcDel *bstypes.BTCDelegation) error) error
+	IterateAllPowerDistUpdateEvents(ctx context.Context, handleFunc func(btcHeight uint32, event *bstypes.EventPowerDistUpdate) bool)
 }
 
 type CheckpointingKeeper interface {
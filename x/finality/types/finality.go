@@ -189,6 +189,34 @@ func (ib IndexedBlock) Validate() error {
 	return nil
 }
 
+// HasVoted returns whether the finality provider with the given index in the
+// voting power table has voted for the block
+func (t *VoteTally) HasVoted(index uint32) bool {
+	byteIndex := index / 8
+	if byteIndex >= uint32(len(t.VoterBitmap)) {
+		return false
+	}
+	return t.VoterBitmap[byteIndex]&(1<<(index%8)) != 0
+}
+
+// SetVoted records that the finality provider with the given index in the
+// voting power table has voted for the block
+func (t *VoteTally) SetVoted(index uint32) {
+	byteIndex := index / 8
+	if byteIndex >= uint32(len(t.VoterBitmap)) {
+		bitmap := make([]byte, byteIndex+1)
+		copy(bitmap, t.VoterBitmap)
+		t.VoterBitmap = bitmap
+	}
+	t.VoterBitmap[byteIndex] |= 1 << (index % 8)
+}
+
+// HasQuorum returns whether the voted voting power reaches a quorum, i.e.,
+// more than 2/3 of the total voting power
+func (t *VoteTally) HasQuorum() bool {
+	return t.VotedVotingPower*3 > t.TotalVotingPower*2
+}
+
 func (e *Evidence) canonicalMsgToSign() []byte {
 	return msgToSignForVote(e.BlockHeight, e.CanonicalAppHash)
 }
@@ -219,6 +247,11 @@ func (e *Evidence) ValidateBasic() error {
 	if e.ForkFinalitySig.Size() != types.SchnorrEOTSSigLen {
 		return fmt.Errorf("malformed ForkFinalitySig")
 	}
+	if e.EotsPk != nil {
+		if _, err := e.EotsPk.ToBTCPK(); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
@@ -233,12 +266,22 @@ func (e *Evidence) IsSlashable() bool {
 	return true
 }
 
+// SigningPk returns the EOTS PK that signed the conflicting votes, i.e., the
+// finality provider's BTC PK unless it rotated its key before the height of
+// the evidence
+func (e *Evidence) SigningPk() *bbn.BIP340PubKey {
+	if e.EotsPk != nil {
+		return e.EotsPk
+	}
+	return e.FpBtcPk
+}
+
 // ExtractBTCSK extracts the BTC SK given the data in the evidence
 func (e *Evidence) ExtractBTCSK() (*btcec.PrivateKey, error) {
 	if !e.IsSlashable() {
 		return nil, fmt.Errorf("the evidence lacks some fields so does not allow extracting BTC SK")
 	}
-	btcPK, err := e.FpBtcPk.ToBTCPK()
+	btcPK, err := e.SigningPk().ToBTCPK()
 	if err != nil {
 		return nil, err
 	}
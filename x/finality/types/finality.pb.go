@@ -7,6 +7,7 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	github_com_babylonlabs_io_babylon_v4_types "github.com/babylonlabs-io/babylon/v4/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -374,6 +375,10 @@ type Evidence struct {
 	// fork_finality_sig is the finality signature to the fork block
 	// where finality signature is an EOTS signature
 	ForkFinalitySig *github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig `protobuf:"bytes,7,opt,name=fork_finality_sig,json=forkFinalitySig,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.SchnorrEOTSSig" json:"fork_finality_sig,omitempty"`
+	// eots_pk is the EOTS PK of the finality provider at block_height, if it
+	// differs from fp_btc_pk due to a key rotation. The secret key extracted
+	// from the evidence corresponds to this PK.
+	EotsPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,8,opt,name=eots_pk,json=eotsPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"eots_pk,omitempty"`
 }
 
 func (m *Evidence) Reset()         { *m = Evidence{} }
@@ -442,6 +447,12 @@ type FinalityProviderSigningInfo struct {
 	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
 	// Timestamp until which the validator is jailed due to liveness downtime.
 	JailedUntil time.Time `protobuf:"bytes,4,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// jail_count is the number of times the finality provider is jailed due to
+	// liveness downtime
+	JailCount uint64 `protobuf:"varint,5,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+	// tombstoned is whether the finality provider is jailed too many times due
+	// to liveness downtime, in which case it can never be unjailed
+	Tombstoned bool `protobuf:"varint,6,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
 }
 
 func (m *FinalityProviderSigningInfo) Reset()         { *m = FinalityProviderSigningInfo{} }
@@ -498,713 +509,3521 @@ func (m *FinalityProviderSigningInfo) GetJailedUntil() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*VotingPowerDistCache)(nil), "babylon.finality.v1.VotingPowerDistCache")
-	proto.RegisterType((*FinalityProviderDistInfo)(nil), "babylon.finality.v1.FinalityProviderDistInfo")
-	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
-	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
-	proto.RegisterType((*PubRandCommitIndexValue)(nil), "babylon.finality.v1.PubRandCommitIndexValue")
-	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
-	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+func (m *FinalityProviderSigningInfo) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("babylon/finality/v1/finality.proto", fileDescriptor_ca5b87e52e3e6d02)
+func (m *FinalityProviderSigningInfo) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
 }
 
-var fileDescriptor_ca5b87e52e3e6d02 = []byte{
-	// 946 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x41, 0x8f, 0xdb, 0x44,
-	0x14, 0x5e, 0x6f, 0xb2, 0x9b, 0x64, 0x92, 0x2c, 0xdd, 0xe9, 0x52, 0xdc, 0x5d, 0x48, 0xd2, 0x08,
-	0xa4, 0x08, 0x75, 0x6d, 0xba, 0x2d, 0x42, 0x05, 0x71, 0x68, 0x76, 0x5b, 0x75, 0xa1, 0xd0, 0xc8,
-	0x29, 0x05, 0x21, 0xa4, 0xd1, 0xd8, 0x9e, 0xd8, 0x43, 0xec, 0x19, 0xcb, 0x33, 0x0e, 0x0d, 0xbf,
-	0x80, 0x63, 0xe1, 0xca, 0x85, 0x23, 0x47, 0x0e, 0xfc, 0x88, 0x9e, 0x50, 0xc5, 0x09, 0xed, 0x21,
-	0xa0, 0xdd, 0x03, 0x7f, 0x03, 0x79, 0x3c, 0x49, 0x36, 0x15, 0x48, 0x80, 0xda, 0x8b, 0x35, 0xef,
-	0x7b, 0xcf, 0xef, 0xbd, 0xf9, 0xde, 0xe7, 0x67, 0xd0, 0x75, 0xb1, 0x3b, 0x8d, 0x38, 0xb3, 0x47,
-	0x94, 0xe1, 0x88, 0xca, 0xa9, 0x3d, 0xb9, 0xb6, 0x38, 0x5b, 0x49, 0xca, 0x25, 0x87, 0x17, 0x75,
-	0x8c, 0xb5, 0xc0, 0x27, 0xd7, 0x76, 0x2f, 0x7b, 0x5c, 0xc4, 0x5c, 0x20, 0x15, 0x62, 0x17, 0x46,
-	0x11, 0xbf, 0xbb, 0x13, 0xf0, 0x80, 0x17, 0x78, 0x7e, 0xd2, 0xe8, 0x36, 0x8e, 0x29, 0xe3, 0xb6,
-	0x7a, 0x6a, 0xa8, 0x1d, 0x70, 0x1e, 0x44, 0xc4, 0x56, 0x96, 0x9b, 0x8d, 0x6c, 0x49, 0x63, 0x22,
-	0x24, 0x8e, 0x93, 0x22, 0xa0, 0xfb, 0x8b, 0x01, 0x76, 0x1e, 0x72, 0x49, 0x59, 0x30, 0xe0, 0x5f,
-	0x91, 0xf4, 0x88, 0x0a, 0x79, 0x88, 0xbd, 0x90, 0xc0, 0xab, 0x00, 0x4a, 0x2e, 0x71, 0x84, 0x26,
-	0xca, 0x8b, 0x92, 0xdc, 0x6d, 0x1a, 0x1d, 0xa3, 0x57, 0x76, 0x2e, 0x28, 0xcf, 0xb9, 0xd7, 0xe0,
-	0x17, 0x00, 0xce, 0x5b, 0xcf, 0xfb, 0x9d, 0x50, 0x9f, 0xa4, 0xc2, 0x5c, 0xef, 0x94, 0x7a, 0xf5,
-	0x83, 0x7d, 0xeb, 0x6f, 0x6e, 0x67, 0xdd, 0xd1, 0xe7, 0x81, 0x8e, 0xce, 0x2b, 0x1f, 0xb3, 0x11,
-	0x77, 0xb6, 0x47, 0xcf, 0x78, 0x04, 0x7c, 0x1d, 0x6c, 0xb1, 0x2c, 0x46, 0xd8, 0x93, 0x74, 0x42,
-	0xd0, 0x28, 0x11, 0x66, 0xa9, 0x63, 0xf4, 0x9a, 0x4e, 0x83, 0x65, 0xf1, 0x2d, 0x05, 0xde, 0x49,
-	0xc4, 0xbb, 0xe5, 0x6f, 0x7e, 0x68, 0xaf, 0x75, 0x67, 0xeb, 0xc0, 0xfc, 0xa7, 0xdc, 0xd0, 0x01,
-	0x9b, 0xae, 0xf4, 0x50, 0x32, 0x56, 0x17, 0x69, 0xf4, 0xdf, 0x3b, 0x99, 0xb5, 0xdf, 0x09, 0xa8,
-	0x0c, 0x33, 0xd7, 0xf2, 0x78, 0x6c, 0xeb, 0x46, 0x23, 0xec, 0x8a, 0x7d, 0xca, 0xe7, 0xa6, 0x3d,
-	0xb9, 0x61, 0xcb, 0x69, 0x42, 0x84, 0xd5, 0x3f, 0x1e, 0x5c, 0xbf, 0xf1, 0xd6, 0x20, 0x73, 0x3f,
-	0x24, 0x53, 0x67, 0xc3, 0x95, 0xde, 0x60, 0x0c, 0x21, 0x28, 0x63, 0xdf, 0x4f, 0xcd, 0xf5, 0x3c,
-	0xa3, 0xa3, 0xce, 0xf0, 0x23, 0x00, 0x3c, 0x1e, 0xc7, 0x54, 0x08, 0xca, 0x99, 0x6a, 0xb6, 0xd6,
-	0xdf, 0x3f, 0x99, 0xb5, 0xf7, 0x8a, 0x29, 0x0a, 0x7f, 0x6c, 0x51, 0x6e, 0xc7, 0x58, 0x86, 0xd6,
-	0x3d, 0x12, 0x60, 0x6f, 0x7a, 0x44, 0xbc, 0x5f, 0x7f, 0xde, 0x07, 0x7a, 0xc8, 0x47, 0xc4, 0x73,
-	0xce, 0x25, 0x80, 0x3d, 0x50, 0x30, 0x8e, 0x5c, 0xce, 0x7c, 0xe2, 0x23, 0x81, 0xa5, 0x59, 0x56,
-	0x93, 0xd8, 0x52, 0x78, 0x5f, 0xc1, 0x43, 0x2c, 0xe1, 0x1b, 0x60, 0x8b, 0x0a, 0xb4, 0x18, 0x32,
-	0xf1, 0xcd, 0x8d, 0x8e, 0xd1, 0xab, 0x3a, 0x4d, 0x2a, 0x1e, 0x2c, 0x41, 0xb8, 0x07, 0x6a, 0x54,
-	0xa0, 0x2f, 0x31, 0x8d, 0x88, 0x6f, 0x6e, 0xaa, 0x88, 0x2a, 0x15, 0x1f, 0x28, 0x1b, 0xbe, 0x06,
-	0x00, 0x15, 0x48, 0x44, 0x58, 0x84, 0xc4, 0x37, 0x2b, 0xca, 0x5b, 0xa3, 0x62, 0x58, 0x00, 0x5d,
-	0x04, 0x1a, 0xc7, 0xcc, 0x27, 0x8f, 0x88, 0xdf, 0x8f, 0xb8, 0x37, 0x86, 0x97, 0xc0, 0x66, 0x48,
-	0x68, 0x10, 0x4a, 0x2d, 0x0e, 0x6d, 0xc1, 0xcb, 0xa0, 0x8a, 0x93, 0x04, 0x85, 0x58, 0x84, 0x9a,
-	0x9b, 0x0a, 0x4e, 0x92, 0xbb, 0x58, 0x84, 0xf0, 0x55, 0x50, 0x2b, 0x86, 0xfc, 0x35, 0xf1, 0x15,
-	0x3b, 0x55, 0x67, 0x09, 0x74, 0xbf, 0x35, 0x40, 0x73, 0x90, 0xb9, 0x0e, 0x66, 0xfe, 0x61, 0xce,
-	0x81, 0x84, 0x57, 0x40, 0x43, 0x48, 0x9c, 0x4a, 0xb4, 0x52, 0xa8, 0xae, 0xb0, 0xbb, 0x45, 0xb5,
-	0x0e, 0xc8, 0xc5, 0x80, 0x92, 0xcc, 0x45, 0x29, 0x66, 0xbe, 0xaa, 0x58, 0x76, 0x00, 0xcb, 0x62,
-	0x9d, 0x0a, 0xb6, 0xf4, 0x4c, 0x64, 0x4c, 0x98, 0x54, 0x55, 0x1b, 0xce, 0x39, 0x24, 0xe7, 0x84,
-	0x24, 0xdc, 0x0b, 0x11, 0xcb, 0x62, 0xcd, 0x6e, 0x55, 0x01, 0x1f, 0x67, 0x71, 0xf7, 0x26, 0x78,
-	0x65, 0xa5, 0x25, 0xc5, 0xc0, 0x43, 0x1c, 0x65, 0x04, 0x9a, 0xa0, 0x52, 0xb4, 0x25, 0x4c, 0xa3,
-	0x53, 0xea, 0x95, 0x9d, 0xb9, 0xa9, 0x05, 0xf9, 0x5d, 0x19, 0x54, 0x6f, 0xe7, 0x32, 0x64, 0x1e,
-	0x81, 0x9f, 0x82, 0xda, 0x28, 0x41, 0xcf, 0x4f, 0x83, 0x95, 0x51, 0xd2, 0x57, 0x2a, 0xbc, 0x02,
-	0x1a, 0x6e, 0x3e, 0x8e, 0x39, 0x45, 0xc5, 0xfd, 0xeb, 0x0a, 0xd3, 0x14, 0x7d, 0x06, 0xaa, 0x0b,
-	0x7a, 0xd4, 0xf5, 0xfb, 0xef, 0x9f, 0xcc, 0xda, 0x37, 0xff, 0x43, 0xe9, 0xa1, 0x17, 0x32, 0x9e,
-	0xa6, 0x9a, 0x09, 0xa7, 0x92, 0x68, 0x6a, 0xaf, 0x02, 0xe8, 0x61, 0xc6, 0x19, 0xf5, 0x70, 0x84,
-	0x16, 0x43, 0x2f, 0x2b, 0x8a, 0x2f, 0x2c, 0x3c, 0xb7, 0xf4, 0xf4, 0xbb, 0xa0, 0x39, 0xe2, 0xe9,
-	0x78, 0x19, 0xb8, 0xa1, 0x02, 0xeb, 0x39, 0x38, 0x8f, 0x11, 0xe0, 0xd2, 0x32, 0xe3, 0x62, 0xb3,
-	0x08, 0x1a, 0x28, 0xb5, 0xfe, 0xef, 0xce, 0x6f, 0xdf, 0x7f, 0x30, 0x1c, 0xd2, 0xc0, 0xd9, 0x59,
-	0x24, 0x9f, 0xaf, 0x8a, 0x21, 0x0d, 0x20, 0x05, 0xdb, 0xaa, 0xb1, 0x95, 0x7a, 0x95, 0xe7, 0x51,
-	0xef, 0xa5, 0x3c, 0xef, 0xb9, 0x52, 0xdd, 0xef, 0xd7, 0xc1, 0xde, 0xb3, 0x5b, 0x6a, 0x48, 0x03,
-	0x46, 0x59, 0xa0, 0x16, 0xd5, 0x8b, 0xd4, 0xc9, 0xca, 0xa7, 0x94, 0xeb, 0xa4, 0xb4, 0xfa, 0x29,
-	0x1d, 0x80, 0x97, 0xf3, 0xc5, 0x43, 0x7c, 0xa4, 0xd4, 0x23, 0x90, 0xc7, 0x33, 0x26, 0x49, 0xaa,
-	0x44, 0x53, 0x72, 0x2e, 0x16, 0x4e, 0xf5, 0xf1, 0x8b, 0xc3, 0xc2, 0x05, 0xef, 0x81, 0x46, 0xb1,
-	0x4d, 0x50, 0xc6, 0x24, 0x8d, 0xd4, 0xec, 0xeb, 0x07, 0xbb, 0x56, 0xf1, 0xfb, 0xb1, 0xe6, 0xbf,
-	0x1f, 0x6b, 0xb1, 0x84, 0xfa, 0xcd, 0x27, 0xb3, 0xf6, 0xda, 0xe3, 0xdf, 0xdb, 0xc6, 0x8f, 0x7f,
-	0xfe, 0xf4, 0xa6, 0xe1, 0xd4, 0x8b, 0xd7, 0x3f, 0xc9, 0xdf, 0xee, 0xdf, 0x7f, 0x72, 0xda, 0x32,
-	0x9e, 0x9e, 0xb6, 0x8c, 0x3f, 0x4e, 0x5b, 0xc6, 0xe3, 0xb3, 0xd6, 0xda, 0xd3, 0xb3, 0xd6, 0xda,
-	0x6f, 0x67, 0xad, 0xb5, 0xcf, 0xdf, 0xfe, 0x57, 0x04, 0x3c, 0x5a, 0xfe, 0x6b, 0x15, 0x17, 0xee,
-	0xa6, 0x6a, 0xe0, 0xfa, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x97, 0x8e, 0xd8, 0x5c, 0x8c, 0x07,
-	0x00, 0x00,
+// JailRecord is the record of a finality provider being jailed due to
+// liveness downtime.
+type JailRecord struct {
+	// fp_btc_pk is the BTC PK of the jailed finality provider
+	FpBtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// jail_count is the number of times the finality provider is jailed,
+	// including this one
+	JailCount uint64 `protobuf:"varint,2,opt,name=jail_count,json=jailCount,proto3" json:"jail_count,omitempty"`
+	// height is the block height at which the finality provider is jailed
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// jailed_at is the block time at which the finality provider is jailed
+	JailedAt time.Time `protobuf:"bytes,4,opt,name=jailed_at,json=jailedAt,proto3,stdtime" json:"jailed_at"`
+	// jailed_until is the time until which the finality provider is jailed
+	JailedUntil time.Time `protobuf:"bytes,5,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// tombstoned is whether the finality provider is tombstoned by this jailing
+	Tombstoned bool `protobuf:"varint,6,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// unjailed_height is the block height at which the finality provider is
+	// unjailed, or 0 if it is not unjailed yet
+	UnjailedHeight int64 `protobuf:"varint,7,opt,name=unjailed_height,json=unjailedHeight,proto3" json:"unjailed_height,omitempty"`
+	// unjailed_at is the block time at which the finality provider is
+	// unjailed, if it is unjailed
+	UnjailedAt *time.Time `protobuf:"bytes,8,opt,name=unjailed_at,json=unjailedAt,proto3,stdtime" json:"unjailed_at,omitempty"`
 }
 
-func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *JailRecord) Reset()         { *m = JailRecord{} }
+func (m *JailRecord) String() string { return proto.CompactTextString(m) }
+func (*JailRecord) ProtoMessage()    {}
+func (*JailRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{7}
 }
-
-func (m *VotingPowerDistCache) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JailRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *VotingPowerDistCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumActiveFps != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.NumActiveFps))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.FinalityProviders) > 0 {
-		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintFinality(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *JailRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_JailRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.TotalVotingPower != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.TotalVotingPower))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *JailRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JailRecord.Merge(m, src)
+}
+func (m *JailRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *JailRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_JailRecord.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderDistInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_JailRecord proto.InternalMessageInfo
+
+func (m *JailRecord) GetJailCount() uint64 {
+	if m != nil {
+		return m.JailCount
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FinalityProviderDistInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *JailRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *FinalityProviderDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.IsSlashed {
-		i--
-		if m.IsSlashed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.IsJailed {
-		i--
-		if m.IsJailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.IsTimestamped {
-		i--
-		if m.IsTimestamped {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.TotalBondedSat != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.TotalBondedSat))
-		i--
-		dAtA[i] = 0x20
+func (m *JailRecord) GetJailedAt() time.Time {
+	if m != nil {
+		return m.JailedAt
 	}
-	if m.Commission != nil {
-		{
-			size := m.Commission.Size()
-			i -= size
-			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return time.Time{}
+}
+
+func (m *JailRecord) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
 	}
-	if len(m.Addr) > 0 {
-		i -= len(m.Addr)
-		copy(dAtA[i:], m.Addr)
-		i = encodeVarintFinality(dAtA, i, uint64(len(m.Addr)))
-		i--
-		dAtA[i] = 0x12
+	return time.Time{}
+}
+
+func (m *JailRecord) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
 	}
-	if m.BtcPk != nil {
-		{
-			size := m.BtcPk.Size()
-			i -= size
-			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *JailRecord) GetUnjailedHeight() int64 {
+	if m != nil {
+		return m.UnjailedHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *JailRecord) GetUnjailedAt() *time.Time {
+	if m != nil {
+		return m.UnjailedAt
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *IndexedBlock) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EpochUptime is the uptime of a finality provider in an epoch, i.e., the
+// ratio of the heights it has voted for among the heights at which it has
+// voting power.
+type EpochUptime struct {
+	// epoch_num is the epoch number
+	EpochNum uint64 `protobuf:"varint,1,opt,name=epoch_num,json=epochNum,proto3" json:"epoch_num,omitempty"`
+	// num_active_heights is the number of heights of the epoch at which the
+	// finality provider has voting power
+	NumActiveHeights uint64 `protobuf:"varint,2,opt,name=num_active_heights,json=numActiveHeights,proto3" json:"num_active_heights,omitempty"`
+	// num_voted_heights is the number of such heights the finality provider
+	// has voted for
+	NumVotedHeights uint64 `protobuf:"varint,3,opt,name=num_voted_heights,json=numVotedHeights,proto3" json:"num_voted_heights,omitempty"`
+	// uptime is num_voted_heights / num_active_heights
+	Uptime cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=uptime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"uptime"`
 }
 
-func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Finalized {
-		i--
-		if m.Finalized {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EpochUptime) Reset()         { *m = EpochUptime{} }
+func (m *EpochUptime) String() string { return proto.CompactTextString(m) }
+func (*EpochUptime) ProtoMessage()    {}
+func (*EpochUptime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{8}
+}
+func (m *EpochUptime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochUptime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochUptime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x18
+		return b[:n], nil
 	}
-	if len(m.AppHash) > 0 {
-		i -= len(m.AppHash)
-		copy(dAtA[i:], m.AppHash)
-		i = encodeVarintFinality(dAtA, i, uint64(len(m.AppHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *EpochUptime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochUptime.Merge(m, src)
+}
+func (m *EpochUptime) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochUptime) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochUptime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochUptime proto.InternalMessageInfo
+
+func (m *EpochUptime) GetEpochNum() uint64 {
+	if m != nil {
+		return m.EpochNum
 	}
-	if m.Height != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *EpochUptime) GetNumActiveHeights() uint64 {
+	if m != nil {
+		return m.NumActiveHeights
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PubRandCommit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EpochUptime) GetNumVotedHeights() uint64 {
+	if m != nil {
+		return m.NumVotedHeights
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PubRandCommit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// FinalityProof is the proof that a Babylon block has received finality votes
+// from finality providers. All the store entries are proven against the
+// AppHash committing to the Babylon state at state_height, i.e., the AppHash
+// in the header of the Babylon block at height state_height + 1.
+type FinalityProof struct {
+	// state_height is the height of the Babylon state the entries are proven in
+	StateHeight uint64 `protobuf:"varint,1,opt,name=state_height,json=stateHeight,proto3" json:"state_height,omitempty"`
+	// block is the IndexedBlock of the Babylon block
+	Block *ProvenStoreEntry `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	// voting_power_table_proofs is the list of non-membership proofs of the
+	// keys delimiting the successive entries of the voting power table at
+	// the block height. The proof for the first entry is the non-membership
+	// proof of the prefix of the table, and the proof for the next entry is
+	// the non-membership proof of the key of the current entry followed by a
+	// zero byte. Each proof contains its right neighbour, so that the proofs
+	// altogether prove the complete voting power table.
+	VotingPowerTableProofs []*crypto.ProofOps `protobuf:"bytes,3,rep,name=voting_power_table_proofs,json=votingPowerTableProofs,proto3" json:"voting_power_table_proofs,omitempty"`
+	// votes is the list of finality votes on the Babylon block
+	Votes []*FinalityProofVote `protobuf:"bytes,4,rep,name=votes,proto3" json:"votes,omitempty"`
 }
 
-func (m *PubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EpochNum != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.EpochNum))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Commitment) > 0 {
-		i -= len(m.Commitment)
-		copy(dAtA[i:], m.Commitment)
-		i = encodeVarintFinality(dAtA, i, uint64(len(m.Commitment)))
-		i--
-		dAtA[i] = 0x1a
+func (m *FinalityProof) Reset()         { *m = FinalityProof{} }
+func (m *FinalityProof) String() string { return proto.CompactTextString(m) }
+func (*FinalityProof) ProtoMessage()    {}
+func (*FinalityProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{9}
+}
+func (m *FinalityProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.NumPubRand != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.NumPubRand))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *FinalityProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProof.Merge(m, src)
+}
+func (m *FinalityProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProof proto.InternalMessageInfo
+
+func (m *FinalityProof) GetStateHeight() uint64 {
+	if m != nil {
+		return m.StateHeight
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *FinalityProof) GetBlock() *ProvenStoreEntry {
+	if m != nil {
+		return m.Block
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *PubRandCommitIndexValue) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FinalityProof) GetVotingPowerTableProofs() []*crypto.ProofOps {
+	if m != nil {
+		return m.VotingPowerTableProofs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *PubRandCommitIndexValue) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityProof) GetVotes() []*FinalityProofVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *PubRandCommitIndexValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Heights) > 0 {
-		dAtA2 := make([]byte, len(m.Heights)*10)
-		var j1 int
-		for _, num := range m.Heights {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+// ProvenStoreEntry is an entry of a module store with an ICS-23 membership
+// proof against the AppHash
+type ProvenStoreEntry struct {
+	// key is the key of the entry in the module store
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// value is the value of the entry
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// proof is the ICS-23 proof of the entry, including the proof of the
+	// module store against the AppHash
+	Proof *crypto.ProofOps `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *ProvenStoreEntry) Reset()         { *m = ProvenStoreEntry{} }
+func (m *ProvenStoreEntry) String() string { return proto.CompactTextString(m) }
+func (*ProvenStoreEntry) ProtoMessage()    {}
+func (*ProvenStoreEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{10}
+}
+func (m *ProvenStoreEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProvenStoreEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProvenStoreEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintFinality(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ProvenStoreEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProvenStoreEntry.Merge(m, src)
+}
+func (m *ProvenStoreEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProvenStoreEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProvenStoreEntry.DiscardUnknown(m)
 }
 
-func (m *Evidence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProvenStoreEntry proto.InternalMessageInfo
+
+func (m *ProvenStoreEntry) GetKey() []byte {
+	if m != nil {
+		return m.Key
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProvenStoreEntry) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ForkFinalitySig != nil {
-		{
-			size := m.ForkFinalitySig.Size()
-			i -= size
-			if _, err := m.ForkFinalitySig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
+func (m *ProvenStoreEntry) GetProof() *crypto.ProofOps {
+	if m != nil {
+		return m.Proof
 	}
-	if m.CanonicalFinalitySig != nil {
-		{
-			size := m.CanonicalFinalitySig.Size()
-			i -= size
-			if _, err := m.CanonicalFinalitySig.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
+	return nil
+}
+
+// FinalityProofVote is a finality vote of a finality provider with the
+// proofs needed to verify it
+type FinalityProofVote struct {
+	// fp_btc_pk is the BTC PK of the finality provider that casts this vote
+	FpBtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,1,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// sig is the EOTS signature of the finality provider on the block
+	Sig *ProvenStoreEntry `protobuf:"bytes,2,opt,name=sig,proto3" json:"sig,omitempty"`
+	// pub_rand is the public randomness used for the EOTS signature
+	PubRand *ProvenStoreEntry `protobuf:"bytes,3,opt,name=pub_rand,json=pubRand,proto3" json:"pub_rand,omitempty"`
+	// pub_rand_commit is the PubRandCommit including the public randomness
+	PubRandCommit *ProvenStoreEntry `protobuf:"bytes,4,opt,name=pub_rand_commit,json=pubRandCommit,proto3" json:"pub_rand_commit,omitempty"`
+	// finality_provider is the FinalityProvider in the x/btcstaking store.
+	// It is only set if the finality provider has rotated its EOTS key, so
+	// that the EOTS PK at the block height can be determined.
+	FinalityProvider *ProvenStoreEntry `protobuf:"bytes,5,opt,name=finality_provider,json=finalityProvider,proto3" json:"finality_provider,omitempty"`
+	// pub_rand_proof is the inclusion proof of the public randomness in the
+	// commitment of pub_rand_commit, which is stored upon the finality vote
+	PubRandProof *ProvenStoreEntry `protobuf:"bytes,6,opt,name=pub_rand_proof,json=pubRandProof,proto3" json:"pub_rand_proof,omitempty"`
+}
+
+func (m *FinalityProofVote) Reset()         { *m = FinalityProofVote{} }
+func (m *FinalityProofVote) String() string { return proto.CompactTextString(m) }
+func (*FinalityProofVote) ProtoMessage()    {}
+func (*FinalityProofVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{11}
+}
+func (m *FinalityProofVote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityProofVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityProofVote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x32
+		return b[:n], nil
 	}
-	if len(m.ForkAppHash) > 0 {
-		i -= len(m.ForkAppHash)
-		copy(dAtA[i:], m.ForkAppHash)
-		i = encodeVarintFinality(dAtA, i, uint64(len(m.ForkAppHash)))
-		i--
-		dAtA[i] = 0x2a
+}
+func (m *FinalityProofVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityProofVote.Merge(m, src)
+}
+func (m *FinalityProofVote) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityProofVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityProofVote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalityProofVote proto.InternalMessageInfo
+
+func (m *FinalityProofVote) GetSig() *ProvenStoreEntry {
+	if m != nil {
+		return m.Sig
 	}
-	if len(m.CanonicalAppHash) > 0 {
-		i -= len(m.CanonicalAppHash)
-		copy(dAtA[i:], m.CanonicalAppHash)
-		i = encodeVarintFinality(dAtA, i, uint64(len(m.CanonicalAppHash)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *FinalityProofVote) GetPubRand() *ProvenStoreEntry {
+	if m != nil {
+		return m.PubRand
 	}
-	if m.PubRand != nil {
-		{
-			size := m.PubRand.Size()
-			i -= size
-			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *FinalityProofVote) GetPubRandCommit() *ProvenStoreEntry {
+	if m != nil {
+		return m.PubRandCommit
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+	return nil
+}
+
+func (m *FinalityProofVote) GetFinalityProvider() *ProvenStoreEntry {
+	if m != nil {
+		return m.FinalityProvider
 	}
-	if m.FpBtcPk != nil {
-		{
-			size := m.FpBtcPk.Size()
-			i -= size
-			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *FinalityProofVote) GetPubRandProof() *ProvenStoreEntry {
+	if m != nil {
+		return m.PubRandProof
+	}
+	return nil
+}
+
+// FinalityHalt is the first non-finalized block that has a finality provider
+// set, together with the finality providers that have not voted for it
+type FinalityHalt struct {
+	// halting_height is the height of the first non-finalized block
+	HaltingHeight uint64 `protobuf:"varint,1,opt,name=halting_height,json=haltingHeight,proto3" json:"halting_height,omitempty"`
+	// num_halted_blocks is the number of blocks produced since the halting
+	// height
+	NumHaltedBlocks uint64 `protobuf:"varint,2,opt,name=num_halted_blocks,json=numHaltedBlocks,proto3" json:"num_halted_blocks,omitempty"`
+	// total_voting_power is the total voting power at the halting height
+	TotalVotingPower uint64 `protobuf:"varint,3,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// voted_voting_power is the voting power of the finality providers that
+	// have voted for the block at the halting height
+	VotedVotingPower uint64 `protobuf:"varint,4,opt,name=voted_voting_power,json=votedVotingPower,proto3" json:"voted_voting_power,omitempty"`
+	// non_voting_fps are the finality providers with voting power at the
+	// halting height that have not voted for the block, sorted by BTC PK
+	NonVotingFps []*NonVotingFinalityProvider `protobuf:"bytes,5,rep,name=non_voting_fps,json=nonVotingFps,proto3" json:"non_voting_fps,omitempty"`
+}
+
+func (m *FinalityHalt) Reset()         { *m = FinalityHalt{} }
+func (m *FinalityHalt) String() string { return proto.CompactTextString(m) }
+func (*FinalityHalt) ProtoMessage()    {}
+func (*FinalityHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{12}
+}
+func (m *FinalityHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalityHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalityHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *FinalityHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalityHalt.Merge(m, src)
+}
+func (m *FinalityHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalityHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalityHalt.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderSigningInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_FinalityHalt proto.InternalMessageInfo
+
+func (m *FinalityHalt) GetHaltingHeight() uint64 {
+	if m != nil {
+		return m.HaltingHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *FinalityProviderSigningInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FinalityHalt) GetNumHaltedBlocks() uint64 {
+	if m != nil {
+		return m.NumHaltedBlocks
+	}
+	return 0
 }
 
-func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
-	if err3 != nil {
-		return 0, err3
+func (m *FinalityHalt) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
 	}
-	i -= n3
-	i = encodeVarintFinality(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	if m.MissedBlocksCounter != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.MissedBlocksCounter))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *FinalityHalt) GetVotedVotingPower() uint64 {
+	if m != nil {
+		return m.VotedVotingPower
 	}
-	if m.StartHeight != 0 {
-		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
-		i--
-		dAtA[i] = 0x10
+	return 0
+}
+
+func (m *FinalityHalt) GetNonVotingFps() []*NonVotingFinalityProvider {
+	if m != nil {
+		return m.NonVotingFps
 	}
-	if m.FpBtcPk != nil {
-		{
-			size := m.FpBtcPk.Size()
-			i -= size
-			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintFinality(dAtA, i, uint64(size))
+	return nil
+}
+
+// NonVotingFinalityProvider is a finality provider with voting power that has
+// not voted for a block
+type NonVotingFinalityProvider struct {
+	// fp_btc_pk_hex is the hex string of the BTC PK of the finality provider
+	FpBtcPkHex string `protobuf:"bytes,1,opt,name=fp_btc_pk_hex,json=fpBtcPkHex,proto3" json:"fp_btc_pk_hex,omitempty"`
+	// voting_power is the voting power of the finality provider at the height
+	// of the block
+	VotingPower uint64 `protobuf:"varint,2,opt,name=voting_power,json=votingPower,proto3" json:"voting_power,omitempty"`
+}
+
+func (m *NonVotingFinalityProvider) Reset()         { *m = NonVotingFinalityProvider{} }
+func (m *NonVotingFinalityProvider) String() string { return proto.CompactTextString(m) }
+func (*NonVotingFinalityProvider) ProtoMessage()    {}
+func (*NonVotingFinalityProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{13}
+}
+func (m *NonVotingFinalityProvider) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NonVotingFinalityProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NonVotingFinalityProvider.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NonVotingFinalityProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NonVotingFinalityProvider.Merge(m, src)
+}
+func (m *NonVotingFinalityProvider) XXX_Size() int {
+	return m.Size()
+}
+func (m *NonVotingFinalityProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_NonVotingFinalityProvider.DiscardUnknown(m)
 }
 
-func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFinality(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_NonVotingFinalityProvider proto.InternalMessageInfo
+
+func (m *NonVotingFinalityProvider) GetFpBtcPkHex() string {
+	if m != nil {
+		return m.FpBtcPkHex
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *VotingPowerDistCache) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TotalVotingPower != 0 {
-		n += 1 + sovFinality(uint64(m.TotalVotingPower))
+
+func (m *NonVotingFinalityProvider) GetVotingPower() uint64 {
+	if m != nil {
+		return m.VotingPower
 	}
-	if len(m.FinalityProviders) > 0 {
-		for _, e := range m.FinalityProviders {
-			l = e.Size()
-			n += 1 + l + sovFinality(uint64(l))
+	return 0
+}
+
+// VoteTally is the tally of the finality votes for a block. The finality
+// providers in the voting power table of the block are indexed by their
+// position in the table ordered by BTC PK when the tally is built, finality
+// providers added to the table afterwards are indexed after them, and the
+// voters are recorded as a bitmap over these indexes.
+type VoteTally struct {
+	// total_voting_power is the total voting power of the finality providers
+	// in the voting power table
+	TotalVotingPower uint64 `protobuf:"varint,1,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// voted_voting_power is the voting power of the finality providers that
+	// have voted for the block
+	VotedVotingPower uint64 `protobuf:"varint,2,opt,name=voted_voting_power,json=votedVotingPower,proto3" json:"voted_voting_power,omitempty"`
+	// num_fps is the number of finality providers in the voting power table
+	NumFps uint32 `protobuf:"varint,3,opt,name=num_fps,json=numFps,proto3" json:"num_fps,omitempty"`
+	// voter_bitmap is the bitmap of the voters, where the i-th bit (in
+	// little-endian bit order) is set iff the finality provider with index i
+	// has voted for the block
+	VoterBitmap []byte `protobuf:"bytes,4,opt,name=voter_bitmap,json=voterBitmap,proto3" json:"voter_bitmap,omitempty"`
+}
+
+func (m *VoteTally) Reset()         { *m = VoteTally{} }
+func (m *VoteTally) String() string { return proto.CompactTextString(m) }
+func (*VoteTally) ProtoMessage()    {}
+func (*VoteTally) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca5b87e52e3e6d02, []int{14}
+}
+func (m *VoteTally) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoteTally) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoteTally.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NumActiveFps != 0 {
-		n += 1 + sovFinality(uint64(m.NumActiveFps))
-	}
-	return n
+}
+func (m *VoteTally) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoteTally.Merge(m, src)
+}
+func (m *VoteTally) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoteTally) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoteTally.DiscardUnknown(m)
 }
 
-func (m *FinalityProviderDistInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BtcPk != nil {
-		l = m.BtcPk.Size()
-		n += 1 + l + sovFinality(uint64(l))
-	}
-	l = len(m.Addr)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
-	}
-	if m.Commission != nil {
-		l = m.Commission.Size()
-		n += 1 + l + sovFinality(uint64(l))
-	}
-	if m.TotalBondedSat != 0 {
-		n += 1 + sovFinality(uint64(m.TotalBondedSat))
-	}
-	if m.IsTimestamped {
-		n += 2
-	}
-	if m.IsJailed {
-		n += 2
-	}
-	if m.IsSlashed {
-		n += 2
+var xxx_messageInfo_VoteTally proto.InternalMessageInfo
+
+func (m *VoteTally) GetTotalVotingPower() uint64 {
+	if m != nil {
+		return m.TotalVotingPower
 	}
-	return n
+	return 0
 }
 
-func (m *IndexedBlock) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovFinality(uint64(m.Height))
+func (m *VoteTally) GetVotedVotingPower() uint64 {
+	if m != nil {
+		return m.VotedVotingPower
 	}
-	l = len(m.AppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	return 0
+}
+
+func (m *VoteTally) GetNumFps() uint32 {
+	if m != nil {
+		return m.NumFps
 	}
-	if m.Finalized {
-		n += 2
+	return 0
+}
+
+func (m *VoteTally) GetVoterBitmap() []byte {
+	if m != nil {
+		return m.VoterBitmap
 	}
-	return n
+	return nil
 }
 
-func (m *PubRandCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func init() {
+	proto.RegisterType((*VotingPowerDistCache)(nil), "babylon.finality.v1.VotingPowerDistCache")
+	proto.RegisterType((*FinalityProviderDistInfo)(nil), "babylon.finality.v1.FinalityProviderDistInfo")
+	proto.RegisterType((*IndexedBlock)(nil), "babylon.finality.v1.IndexedBlock")
+	proto.RegisterType((*PubRandCommit)(nil), "babylon.finality.v1.PubRandCommit")
+	proto.RegisterType((*PubRandCommitIndexValue)(nil), "babylon.finality.v1.PubRandCommitIndexValue")
+	proto.RegisterType((*Evidence)(nil), "babylon.finality.v1.Evidence")
+	proto.RegisterType((*FinalityProviderSigningInfo)(nil), "babylon.finality.v1.FinalityProviderSigningInfo")
+	proto.RegisterType((*JailRecord)(nil), "babylon.finality.v1.JailRecord")
+	proto.RegisterType((*EpochUptime)(nil), "babylon.finality.v1.EpochUptime")
+	proto.RegisterType((*FinalityProof)(nil), "babylon.finality.v1.FinalityProof")
+	proto.RegisterType((*ProvenStoreEntry)(nil), "babylon.finality.v1.ProvenStoreEntry")
+	proto.RegisterType((*FinalityProofVote)(nil), "babylon.finality.v1.FinalityProofVote")
+	proto.RegisterType((*FinalityHalt)(nil), "babylon.finality.v1.FinalityHalt")
+	proto.RegisterType((*NonVotingFinalityProvider)(nil), "babylon.finality.v1.NonVotingFinalityProvider")
+	proto.RegisterType((*VoteTally)(nil), "babylon.finality.v1.VoteTally")
+}
+
+func init() {
+	proto.RegisterFile("babylon/finality/v1/finality.proto", fileDescriptor_ca5b87e52e3e6d02)
+}
+
+var fileDescriptor_ca5b87e52e3e6d02 = []byte{
+	// 1557 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x8f, 0xbf, 0xed, 0x67, 0x3b, 0x93, 0xd4, 0x0e, 0xb3, 0x3d, 0x33, 0x6c, 0x92, 0x69, 0xb1,
+	0x10, 0xad, 0x66, 0x6c, 0x66, 0x76, 0xd1, 0x6a, 0x59, 0x90, 0x88, 0x67, 0x12, 0x25, 0xec, 0xc7,
+	0x44, 0xed, 0x6c, 0x40, 0x08, 0xa9, 0x55, 0xdd, 0x5d, 0xb6, 0x9b, 0x74, 0x57, 0xb5, 0xba, 0xaa,
+	0x4d, 0xcc, 0x1d, 0x89, 0xe3, 0x22, 0xfe, 0x01, 0x24, 0x2e, 0x1c, 0x39, 0x70, 0xe6, 0xbc, 0x27,
+	0x58, 0x71, 0x01, 0xe5, 0x10, 0xd0, 0xcc, 0x01, 0xfe, 0x8c, 0x55, 0x7d, 0xb8, 0x6d, 0xe7, 0x43,
+	0x59, 0x6b, 0x76, 0x2e, 0x56, 0xd7, 0xaf, 0x5e, 0xbd, 0x7a, 0xf5, 0x7b, 0x1f, 0xf5, 0xca, 0x60,
+	0x7b, 0xd8, 0x9b, 0x44, 0x8c, 0x76, 0x07, 0x21, 0xc5, 0x51, 0x28, 0x26, 0xdd, 0xf1, 0xe3, 0xfc,
+	0xbb, 0x93, 0xa4, 0x4c, 0x30, 0xf4, 0x86, 0x91, 0xe9, 0xe4, 0xf8, 0xf8, 0xf1, 0xbd, 0xbb, 0x3e,
+	0xe3, 0x31, 0xe3, 0xae, 0x12, 0xe9, 0xea, 0x81, 0x96, 0xbf, 0x77, 0x7b, 0xc8, 0x86, 0x4c, 0xe3,
+	0xf2, 0xcb, 0xa0, 0xeb, 0x38, 0x0e, 0x29, 0xeb, 0xaa, 0x5f, 0x03, 0x6d, 0x0e, 0x19, 0x1b, 0x46,
+	0xa4, 0xab, 0x46, 0x5e, 0x36, 0xe8, 0x8a, 0x30, 0x26, 0x5c, 0xe0, 0x38, 0x31, 0x02, 0x6f, 0x09,
+	0x42, 0x03, 0x92, 0xc6, 0x21, 0x15, 0x5d, 0x3f, 0x9d, 0x24, 0x82, 0x49, 0x59, 0x36, 0xd0, 0xd3,
+	0xf6, 0xdf, 0x0b, 0x70, 0xfb, 0x98, 0x89, 0x90, 0x0e, 0x0f, 0xd9, 0xaf, 0x49, 0xfa, 0x2c, 0xe4,
+	0xe2, 0x29, 0xf6, 0x47, 0x04, 0x3d, 0x04, 0x24, 0x98, 0xc0, 0x91, 0x3b, 0x56, 0xb3, 0x6e, 0x22,
+	0xa7, 0xad, 0xc2, 0x56, 0x61, 0xbb, 0xec, 0xac, 0xa9, 0x99, 0xb9, 0x65, 0xe8, 0x97, 0x80, 0xa6,
+	0x27, 0x93, 0xc7, 0x19, 0x87, 0x01, 0x49, 0xb9, 0x55, 0xdc, 0x2a, 0x6d, 0x37, 0x9f, 0x3c, 0xea,
+	0x5c, 0x71, 0xf8, 0xce, 0x9e, 0xf9, 0x3e, 0x34, 0xd2, 0x72, 0xe7, 0x03, 0x3a, 0x60, 0xce, 0xfa,
+	0xe0, 0xc2, 0x0c, 0x47, 0xdf, 0x81, 0x55, 0x9a, 0xc5, 0x2e, 0xf6, 0x45, 0x38, 0x26, 0xee, 0x20,
+	0xe1, 0x56, 0x69, 0xab, 0xb0, 0xdd, 0x76, 0x5a, 0x34, 0x8b, 0x77, 0x14, 0xb8, 0x97, 0xf0, 0x1f,
+	0x96, 0x7f, 0xf7, 0xc7, 0xcd, 0x15, 0xfb, 0xbc, 0x08, 0xd6, 0x75, 0xba, 0x91, 0x03, 0x55, 0x4f,
+	0xf8, 0x6e, 0x72, 0xa2, 0x0e, 0xd2, 0xea, 0x7d, 0x78, 0x76, 0xbe, 0xf9, 0xfe, 0x30, 0x14, 0xa3,
+	0xcc, 0xeb, 0xf8, 0x2c, 0xee, 0x1a, 0x43, 0x23, 0xec, 0xf1, 0x47, 0x21, 0x9b, 0x0e, 0xbb, 0xe3,
+	0xf7, 0xba, 0x62, 0x92, 0x10, 0xde, 0xe9, 0x1d, 0x1c, 0xbe, 0xfb, 0xde, 0xf7, 0x0f, 0x33, 0xef,
+	0x23, 0x32, 0x71, 0x2a, 0x9e, 0xf0, 0x0f, 0x4f, 0x10, 0x82, 0x32, 0x0e, 0x82, 0xd4, 0x2a, 0x4a,
+	0x8d, 0x8e, 0xfa, 0x46, 0x9f, 0x00, 0xf8, 0x2c, 0x8e, 0x43, 0xce, 0x43, 0x46, 0x95, 0xb1, 0x8d,
+	0xde, 0xa3, 0xb3, 0xf3, 0xcd, 0xfb, 0xda, 0xc9, 0x3c, 0x38, 0xe9, 0x84, 0xac, 0x1b, 0x63, 0x31,
+	0xea, 0x7c, 0x4c, 0x86, 0xd8, 0x9f, 0x3c, 0x23, 0xfe, 0x3f, 0xff, 0xfa, 0x08, 0x4c, 0x0c, 0x3c,
+	0x23, 0xbe, 0x33, 0xa7, 0x00, 0x6d, 0x83, 0x66, 0xdc, 0xf5, 0x18, 0x0d, 0x48, 0xe0, 0x72, 0x2c,
+	0xac, 0xb2, 0xf2, 0xc4, 0xaa, 0xc2, 0x7b, 0x0a, 0xee, 0x63, 0x81, 0xde, 0x86, 0xd5, 0x90, 0xbb,
+	0x79, 0x0c, 0x90, 0xc0, 0xaa, 0x6c, 0x15, 0xb6, 0xeb, 0x4e, 0x3b, 0xe4, 0x47, 0x33, 0x10, 0xdd,
+	0x87, 0x46, 0xc8, 0xdd, 0x5f, 0xe1, 0x30, 0x22, 0x81, 0x55, 0x55, 0x12, 0xf5, 0x90, 0xff, 0x54,
+	0x8d, 0xd1, 0x5b, 0x00, 0x21, 0x77, 0x79, 0x84, 0xf9, 0x88, 0x04, 0x56, 0x4d, 0xcd, 0x36, 0x42,
+	0xde, 0xd7, 0x80, 0xed, 0x42, 0xeb, 0x80, 0x06, 0xe4, 0x94, 0x04, 0xbd, 0x88, 0xf9, 0x27, 0xe8,
+	0x0e, 0x54, 0x47, 0x24, 0x1c, 0x8e, 0x84, 0x09, 0x0e, 0x33, 0x42, 0x77, 0xa1, 0x8e, 0x93, 0xc4,
+	0x1d, 0x61, 0x3e, 0x32, 0xdc, 0xd4, 0x70, 0x92, 0xec, 0x63, 0x3e, 0x42, 0xdf, 0x86, 0x86, 0x76,
+	0xf2, 0x6f, 0x48, 0xa0, 0xd8, 0xa9, 0x3b, 0x33, 0xc0, 0xfe, 0x7d, 0x01, 0xda, 0x87, 0x99, 0xe7,
+	0x60, 0x1a, 0x3c, 0x95, 0x1c, 0x08, 0xf4, 0x00, 0x5a, 0x5c, 0xe0, 0x54, 0xb8, 0x0b, 0x1b, 0x35,
+	0x15, 0xb6, 0xaf, 0x77, 0xdb, 0x02, 0x19, 0x0c, 0x6e, 0x92, 0x79, 0x6e, 0x8a, 0x69, 0xa0, 0x76,
+	0x2c, 0x3b, 0x40, 0xb3, 0xd8, 0xa8, 0x42, 0x1b, 0xc6, 0x27, 0x22, 0x26, 0x54, 0xa8, 0x5d, 0x5b,
+	0xce, 0x1c, 0x22, 0x39, 0x21, 0x09, 0xf3, 0x47, 0x2e, 0xcd, 0x62, 0xc3, 0x6e, 0x5d, 0x01, 0x9f,
+	0x66, 0xb1, 0xfd, 0x01, 0xbc, 0xb9, 0x60, 0x92, 0x62, 0xe0, 0x18, 0x47, 0x19, 0x41, 0x16, 0xd4,
+	0xb4, 0x59, 0xdc, 0x2a, 0x6c, 0x95, 0xb6, 0xcb, 0xce, 0x74, 0x68, 0x02, 0xf2, 0xff, 0x65, 0xa8,
+	0xef, 0xca, 0x30, 0xa4, 0x3e, 0x41, 0x3f, 0x83, 0xc6, 0x20, 0x71, 0xbf, 0xb9, 0x18, 0xac, 0x0d,
+	0x92, 0x9e, 0x8a, 0xc2, 0x07, 0xd0, 0xf2, 0xa4, 0x3b, 0xa6, 0x14, 0xe9, 0xf3, 0x37, 0x15, 0x66,
+	0x28, 0xfa, 0x39, 0xd4, 0x73, 0x7a, 0xd4, 0xf1, 0x7b, 0x3f, 0x3e, 0x3b, 0xdf, 0xfc, 0x60, 0x89,
+	0xad, 0xfb, 0xfe, 0x88, 0xb2, 0x34, 0x35, 0x4c, 0x38, 0xb5, 0xc4, 0x50, 0xfb, 0x10, 0x90, 0x8f,
+	0x29, 0xa3, 0xa1, 0x8f, 0x23, 0x37, 0x77, 0x7a, 0x59, 0x51, 0xbc, 0x96, 0xcf, 0xec, 0x18, 0xef,
+	0xdb, 0xd0, 0x1e, 0xb0, 0xf4, 0x64, 0x26, 0x58, 0x51, 0x82, 0x4d, 0x09, 0x4e, 0x65, 0x38, 0xdc,
+	0x99, 0x69, 0xcc, 0x2b, 0x0b, 0x0f, 0x87, 0x56, 0xf5, 0x55, 0x2c, 0xdf, 0x7d, 0x7e, 0xd4, 0xef,
+	0x87, 0x43, 0xe7, 0x76, 0xae, 0x7c, 0x5a, 0x2a, 0xfa, 0xe1, 0x10, 0x85, 0xb0, 0xae, 0x0c, 0x5b,
+	0xd8, 0xaf, 0xf6, 0x4d, 0xec, 0x77, 0x4b, 0xea, 0x9d, 0xdf, 0xea, 0x08, 0x6a, 0x84, 0x09, 0x2e,
+	0xa3, 0xa0, 0xfe, 0xea, 0x51, 0x50, 0x95, 0xba, 0x0e, 0x4f, 0xec, 0xb3, 0x22, 0xdc, 0xbf, 0x58,
+	0xfb, 0xfa, 0xe1, 0x90, 0x86, 0x74, 0xa8, 0xca, 0xdf, 0xeb, 0x8c, 0xbe, 0x85, 0x04, 0x95, 0xd1,
+	0x57, 0x5a, 0x4c, 0xd0, 0x27, 0xf0, 0x2d, 0x59, 0xce, 0x48, 0xe0, 0xaa, 0x98, 0xe4, 0xae, 0xcf,
+	0x32, 0x2a, 0x48, 0xaa, 0x42, 0xb1, 0xe4, 0xbc, 0xa1, 0x27, 0x55, 0x49, 0xe1, 0x4f, 0xf5, 0x14,
+	0xfa, 0x18, 0x5a, 0xba, 0x46, 0xb9, 0x19, 0x15, 0x61, 0xa4, 0x22, 0xaa, 0xf9, 0xe4, 0x5e, 0x47,
+	0xdf, 0x79, 0x9d, 0xe9, 0x9d, 0xd7, 0xc9, 0x4b, 0x5b, 0xaf, 0xfd, 0xc5, 0xf9, 0xe6, 0xca, 0xe7,
+	0xff, 0xd9, 0x2c, 0xfc, 0xf9, 0x7f, 0x7f, 0x79, 0xa7, 0xe0, 0x34, 0xf5, 0xf2, 0xcf, 0xe4, 0x6a,
+	0x59, 0xd7, 0xe4, 0x50, 0x6f, 0xac, 0x82, 0xae, 0xec, 0x34, 0x24, 0xa2, 0xb6, 0x93, 0xf5, 0x41,
+	0xb0, 0xd8, 0xe3, 0x82, 0xd1, 0xbc, 0x28, 0xce, 0x21, 0xf6, 0xdf, 0x4a, 0x00, 0xb2, 0x42, 0x3a,
+	0xc4, 0x67, 0x69, 0xf0, 0xfa, 0xb8, 0x5c, 0x34, 0xb3, 0x78, 0xd1, 0xcc, 0x59, 0xb9, 0xd5, 0xc4,
+	0x99, 0x11, 0xda, 0x83, 0x86, 0xe1, 0x0a, 0x8b, 0xe5, 0x89, 0xaa, 0xeb, 0xb5, 0x3b, 0xe2, 0x12,
+	0xe7, 0x95, 0x57, 0xe2, 0xfc, 0x06, 0x52, 0xd1, 0xf7, 0xe0, 0x56, 0x46, 0xcd, 0x7e, 0xe6, 0x58,
+	0x35, 0x75, 0xac, 0xd5, 0x29, 0x6c, 0xc2, 0x67, 0x07, 0x9a, 0xb9, 0x20, 0x16, 0x56, 0xfd, 0x46,
+	0xab, 0xca, 0xd2, 0x22, 0x07, 0xa6, 0x8b, 0x76, 0x84, 0xfd, 0x8f, 0x02, 0x34, 0x77, 0x65, 0x41,
+	0xff, 0x2c, 0x91, 0x17, 0xe4, 0x62, 0xc1, 0x2f, 0x2c, 0x16, 0x7c, 0x59, 0xd2, 0xe6, 0x5a, 0x8e,
+	0x69, 0x81, 0xd7, 0xde, 0x58, 0xcb, 0xdb, 0x0e, 0x6d, 0x1c, 0x47, 0xef, 0xc0, 0xba, 0x94, 0x1e,
+	0x33, 0x91, 0x9f, 0x43, 0xf7, 0x28, 0x65, 0xe7, 0x16, 0xcd, 0xe2, 0x63, 0x89, 0x4f, 0x65, 0x0f,
+	0xa0, 0x9a, 0x29, 0x03, 0x94, 0x97, 0x1a, 0xbd, 0xc7, 0x92, 0xbe, 0xe5, 0x7a, 0x03, 0xa3, 0xc0,
+	0xfe, 0x6d, 0x11, 0xda, 0x73, 0xf9, 0xce, 0x06, 0x26, 0x11, 0x05, 0xb9, 0x7c, 0x53, 0x0a, 0x63,
+	0x2c, 0xfa, 0x10, 0x2a, 0x2a, 0x03, 0xd5, 0x61, 0x9a, 0x4f, 0xde, 0xbe, 0xb2, 0x3b, 0x93, 0xd5,
+	0x83, 0xd0, 0xbe, 0x60, 0x29, 0xd9, 0xa5, 0x22, 0x9d, 0x38, 0x7a, 0x0d, 0x3a, 0x86, 0xbb, 0xf3,
+	0xfd, 0xa0, 0x2b, 0xb0, 0x17, 0x11, 0x57, 0x35, 0x94, 0xf2, 0xc0, 0xb2, 0xdd, 0xbb, 0xdf, 0x99,
+	0x75, 0x9c, 0x1d, 0xdd, 0x71, 0x76, 0x94, 0x71, 0xcf, 0x13, 0xee, 0xdc, 0x19, 0xcf, 0x9a, 0xc6,
+	0x23, 0xb9, 0x56, 0xcd, 0x70, 0xf4, 0x23, 0xa8, 0x48, 0xf2, 0xb8, 0x55, 0x56, 0x3a, 0xbe, 0x7b,
+	0x53, 0xcb, 0xc8, 0x06, 0x92, 0x53, 0x47, 0x2f, 0xb2, 0x63, 0x58, 0xbb, 0x68, 0x30, 0x5a, 0x83,
+	0xd2, 0x09, 0x99, 0xe8, 0xcc, 0x74, 0xe4, 0x27, 0xba, 0x0d, 0x95, 0xb1, 0xbc, 0xb1, 0x4d, 0x37,
+	0xa2, 0x07, 0xe8, 0x31, 0x54, 0x94, 0xf9, 0xca, 0x5d, 0x37, 0x58, 0xaf, 0x25, 0xed, 0x7f, 0x95,
+	0x60, 0xfd, 0x92, 0x2d, 0xaf, 0xaf, 0x20, 0xbc, 0x0f, 0x25, 0x79, 0x11, 0x2d, 0xe5, 0x2e, 0xb9,
+	0x02, 0xfd, 0xe4, 0xc2, 0x85, 0xff, 0xb5, 0x57, 0xe7, 0x17, 0xfb, 0x27, 0x70, 0x6b, 0xaa, 0xc1,
+	0xd5, 0xad, 0x92, 0x55, 0x5e, 0x46, 0x51, 0x3b, 0x59, 0xe8, 0xe3, 0x1c, 0x58, 0xbf, 0xf4, 0x4a,
+	0xb0, 0x2a, 0xcb, 0x28, 0x5c, 0xbb, 0xf8, 0x38, 0x40, 0x1f, 0xc1, 0x6a, 0x6e, 0xa2, 0x76, 0x64,
+	0x75, 0x19, 0x85, 0x2d, 0x63, 0xa1, 0xf2, 0xa3, 0xfd, 0x87, 0x22, 0xb4, 0xa6, 0x9e, 0xdd, 0xc7,
+	0x91, 0xea, 0xa7, 0x47, 0x38, 0x52, 0x01, 0xbf, 0x90, 0x51, 0x6d, 0x83, 0x9a, 0x9c, 0x32, 0xf9,
+	0x2f, 0xc1, 0xfc, 0x82, 0xb3, 0x8a, 0x79, 0xfe, 0xef, 0x2b, 0x5c, 0xdf, 0x6d, 0xd7, 0x3c, 0xac,
+	0x4a, 0xd7, 0x3c, 0xac, 0x1e, 0x02, 0xd2, 0x55, 0x65, 0x41, 0x5a, 0xb7, 0xa7, 0x6b, 0x6a, 0x66,
+	0x5e, 0xfa, 0x08, 0x56, 0x29, 0xa3, 0x53, 0x59, 0xf9, 0x50, 0xaa, 0xa8, 0x7c, 0xea, 0x5c, 0x49,
+	0xc6, 0xa7, 0x8c, 0xea, 0xc5, 0x17, 0x7b, 0x06, 0xa7, 0x45, 0xf3, 0xa9, 0x84, 0xdb, 0x18, 0xee,
+	0x5e, 0x2b, 0x8a, 0x1e, 0x40, 0x3b, 0x0f, 0x7b, 0x77, 0x44, 0x4e, 0x15, 0x41, 0x0d, 0x07, 0x4c,
+	0xf4, 0xee, 0x93, 0x53, 0x59, 0x94, 0x16, 0xac, 0x37, 0xbd, 0xe9, 0x5c, 0x29, 0xb0, 0xff, 0x54,
+	0x80, 0x86, 0xcc, 0xa2, 0x23, 0x1c, 0x45, 0x93, 0x25, 0xdf, 0x9e, 0x57, 0x53, 0x54, 0xbc, 0x86,
+	0xa2, 0x37, 0xa1, 0x26, 0x5d, 0x35, 0x7b, 0x44, 0x56, 0x69, 0x16, 0xef, 0x25, 0xdc, 0x58, 0x49,
+	0x52, 0xd7, 0x0b, 0x45, 0x8c, 0x13, 0xd3, 0xbe, 0x36, 0x15, 0xd6, 0x53, 0x50, 0xef, 0xf9, 0x17,
+	0x2f, 0x36, 0x0a, 0x5f, 0xbe, 0xd8, 0x28, 0xfc, 0xf7, 0xc5, 0x46, 0xe1, 0xf3, 0x97, 0x1b, 0x2b,
+	0x5f, 0xbe, 0xdc, 0x58, 0xf9, 0xf7, 0xcb, 0x8d, 0x95, 0x5f, 0xfc, 0xe0, 0x6b, 0x65, 0xf9, 0xe9,
+	0xec, 0x2f, 0x02, 0x95, 0xf0, 0x5e, 0x55, 0x5d, 0x5c, 0xef, 0x7e, 0x35, 0x00, 0xd8, 0xc3, 0xad,
+	0x80, 0x43, 0x10, 0x00, 0x00,
+}
+
+func (m *VotingPowerDistCache) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerDistCache) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerDistCache) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.StartHeight != 0 {
-		n += 1 + sovFinality(uint64(m.StartHeight))
-	}
-	if m.NumPubRand != 0 {
-		n += 1 + sovFinality(uint64(m.NumPubRand))
+	if m.NumActiveFps != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumActiveFps))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	if len(m.FinalityProviders) > 0 {
+		for iNdEx := len(m.FinalityProviders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalityProviders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	if m.EpochNum != 0 {
-		n += 1 + sovFinality(uint64(m.EpochNum))
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PubRandCommitIndexValue) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FinalityProviderDistInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderDistInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderDistInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Heights) > 0 {
-		l = 0
-		for _, e := range m.Heights {
-			l += sovFinality(uint64(e))
+	if m.IsSlashed {
+		i--
+		if m.IsSlashed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		n += 1 + sovFinality(uint64(l)) + l
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
+	if m.IsJailed {
+		i--
+		if m.IsJailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.IsTimestamped {
+		i--
+		if m.IsTimestamped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TotalBondedSat != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalBondedSat))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Commission != nil {
+		{
+			size := m.Commission.Size()
+			i -= size
+			if _, err := m.Commission.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Addr) > 0 {
+		i -= len(m.Addr)
+		copy(dAtA[i:], m.Addr)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.Addr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BtcPk != nil {
+		{
+			size := m.BtcPk.Size()
+			i -= size
+			if _, err := m.BtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Evidence) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IndexedBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IndexedBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IndexedBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.Finalized {
+		i--
+		if m.Finalized {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovFinality(uint64(m.BlockHeight))
+	if len(m.AppHash) > 0 {
+		i -= len(m.AppHash)
+		copy(dAtA[i:], m.AppHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.AppHash)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PubRand != nil {
-		l = m.PubRand.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.CanonicalAppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PubRandCommit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ForkAppHash)
-	if l > 0 {
-		n += 1 + l + sovFinality(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *PubRandCommit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubRandCommit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.CanonicalFinalitySig != nil {
-		l = m.CanonicalFinalitySig.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.ForkFinalitySig != nil {
-		l = m.ForkFinalitySig.Size()
-		n += 1 + l + sovFinality(uint64(l))
+	if m.NumPubRand != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumPubRand))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.StartHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *FinalityProviderSigningInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PubRandCommitIndexValue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PubRandCommitIndexValue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PubRandCommitIndexValue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovFinality(uint64(l))
-	}
-	if m.StartHeight != 0 {
-		n += 1 + sovFinality(uint64(m.StartHeight))
-	}
-	if m.MissedBlocksCounter != 0 {
-		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	if len(m.Heights) > 0 {
+		dAtA2 := make([]byte, len(m.Heights)*10)
+		var j1 int
+		for _, num := range m.Heights {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintFinality(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
-	n += 1 + l + sovFinality(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovFinality(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Evidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func sozFinality(x uint64) (n int) {
-	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *Evidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFinality
+
+func (m *Evidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EotsPk != nil {
+		{
+			size := m.EotsPk.Size()
+			i -= size
+			if _, err := m.EotsPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ForkFinalitySig != nil {
+		{
+			size := m.ForkFinalitySig.Size()
+			i -= size
+			if _, err := m.ForkFinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CanonicalFinalitySig != nil {
+		{
+			size := m.CanonicalFinalitySig.Size()
+			i -= size
+			if _, err := m.CanonicalFinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ForkAppHash) > 0 {
+		i -= len(m.ForkAppHash)
+		copy(dAtA[i:], m.ForkAppHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.ForkAppHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CanonicalAppHash) > 0 {
+		i -= len(m.CanonicalAppHash)
+		copy(dAtA[i:], m.CanonicalAppHash)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.CanonicalAppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProviderSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProviderSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProviderSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.JailCount != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintFinality(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JailRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JailRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JailRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnjailedAt != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.UnjailedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnjailedAt):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintFinality(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UnjailedHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.UnjailedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintFinality(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedAt):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintFinality(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.JailCount != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.JailCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochUptime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochUptime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochUptime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Uptime.Size()
+		i -= size
+		if _, err := m.Uptime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFinality(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NumVotedHeights != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumVotedHeights))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumActiveHeights != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumActiveHeights))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochNum != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.EpochNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.VotingPowerTableProofs) > 0 {
+		for iNdEx := len(m.VotingPowerTableProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerTableProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Block != nil {
+		{
+			size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.StateHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.StateHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProvenStoreEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProvenStoreEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProvenStoreEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityProofVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityProofVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityProofVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PubRandProof != nil {
+		{
+			size, err := m.PubRandProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.FinalityProvider != nil {
+		{
+			size, err := m.FinalityProvider.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PubRandCommit != nil {
+		{
+			size, err := m.PubRandCommit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PubRand != nil {
+		{
+			size, err := m.PubRand.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sig != nil {
+		{
+			size, err := m.Sig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintFinality(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalityHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalityHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalityHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NonVotingFps) > 0 {
+		for iNdEx := len(m.NonVotingFps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NonVotingFps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFinality(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.VotedVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.NumHaltedBlocks != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumHaltedBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HaltingHeight != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.HaltingHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NonVotingFinalityProvider) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NonVotingFinalityProvider) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NonVotingFinalityProvider) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FpBtcPkHex) > 0 {
+		i -= len(m.FpBtcPkHex)
+		copy(dAtA[i:], m.FpBtcPkHex)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.FpBtcPkHex)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VoteTally) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoteTally) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoteTally) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VoterBitmap) > 0 {
+		i -= len(m.VoterBitmap)
+		copy(dAtA[i:], m.VoterBitmap)
+		i = encodeVarintFinality(dAtA, i, uint64(len(m.VoterBitmap)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NumFps != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.NumFps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VotedVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.VotedVotingPower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintFinality(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFinality(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFinality(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *VotingPowerDistCache) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalVotingPower))
+	}
+	if len(m.FinalityProviders) > 0 {
+		for _, e := range m.FinalityProviders {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if m.NumActiveFps != 0 {
+		n += 1 + sovFinality(uint64(m.NumActiveFps))
+	}
+	return n
+}
+
+func (m *FinalityProviderDistInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BtcPk != nil {
+		l = m.BtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Commission != nil {
+		l = m.Commission.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.TotalBondedSat != 0 {
+		n += 1 + sovFinality(uint64(m.TotalBondedSat))
+	}
+	if m.IsTimestamped {
+		n += 2
+	}
+	if m.IsJailed {
+		n += 2
+	}
+	if m.IsSlashed {
+		n += 2
+	}
+	return n
+}
+
+func (m *IndexedBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = len(m.AppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Finalized {
+		n += 2
+	}
+	return n
+}
+
+func (m *PubRandCommit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovFinality(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.EpochNum != 0 {
+		n += 1 + sovFinality(uint64(m.EpochNum))
+	}
+	return n
+}
+
+func (m *PubRandCommitIndexValue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Heights) > 0 {
+		l = 0
+		for _, e := range m.Heights {
+			l += sovFinality(uint64(e))
+		}
+		n += 1 + sovFinality(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *Evidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovFinality(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.CanonicalAppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.ForkAppHash)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.CanonicalFinalitySig != nil {
+		l = m.CanonicalFinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.ForkFinalitySig != nil {
+		l = m.ForkFinalitySig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.EotsPk != nil {
+		l = m.EotsPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProviderSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StartHeight))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovFinality(uint64(m.MissedBlocksCounter))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovFinality(uint64(l))
+	if m.JailCount != 0 {
+		n += 1 + sovFinality(uint64(m.JailCount))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	return n
+}
+
+func (m *JailRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.JailCount != 0 {
+		n += 1 + sovFinality(uint64(m.JailCount))
+	}
+	if m.Height != 0 {
+		n += 1 + sovFinality(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedAt)
+	n += 1 + l + sovFinality(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovFinality(uint64(l))
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.UnjailedHeight != 0 {
+		n += 1 + sovFinality(uint64(m.UnjailedHeight))
+	}
+	if m.UnjailedAt != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.UnjailedAt)
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *EpochUptime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochNum != 0 {
+		n += 1 + sovFinality(uint64(m.EpochNum))
+	}
+	if m.NumActiveHeights != 0 {
+		n += 1 + sovFinality(uint64(m.NumActiveHeights))
+	}
+	if m.NumVotedHeights != 0 {
+		n += 1 + sovFinality(uint64(m.NumVotedHeights))
+	}
+	l = m.Uptime.Size()
+	n += 1 + l + sovFinality(uint64(l))
+	return n
+}
+
+func (m *FinalityProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StateHeight != 0 {
+		n += 1 + sovFinality(uint64(m.StateHeight))
+	}
+	if m.Block != nil {
+		l = m.Block.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if len(m.VotingPowerTableProofs) > 0 {
+		for _, e := range m.VotingPowerTableProofs {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProvenStoreEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityProofVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRandCommit != nil {
+		l = m.PubRandCommit.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.FinalityProvider != nil {
+		l = m.FinalityProvider.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.PubRandProof != nil {
+		l = m.PubRandProof.Size()
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func (m *FinalityHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HaltingHeight != 0 {
+		n += 1 + sovFinality(uint64(m.HaltingHeight))
+	}
+	if m.NumHaltedBlocks != 0 {
+		n += 1 + sovFinality(uint64(m.NumHaltedBlocks))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalVotingPower))
+	}
+	if m.VotedVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotedVotingPower))
+	}
+	if len(m.NonVotingFps) > 0 {
+		for _, e := range m.NonVotingFps {
+			l = e.Size()
+			n += 1 + l + sovFinality(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *NonVotingFinalityProvider) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FpBtcPkHex)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	if m.VotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotingPower))
+	}
+	return n
+}
+
+func (m *VoteTally) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.TotalVotingPower))
+	}
+	if m.VotedVotingPower != 0 {
+		n += 1 + sovFinality(uint64(m.VotedVotingPower))
+	}
+	if m.NumFps != 0 {
+		n += 1 + sovFinality(uint64(m.NumFps))
+	}
+	l = len(m.VoterBitmap)
+	if l > 0 {
+		n += 1 + l + sovFinality(uint64(l))
+	}
+	return n
+}
+
+func sovFinality(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFinality(x uint64) (n int) {
+	return sovFinality(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VotingPowerDistCache: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VotingPowerDistCache: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderDistInfo{})
+			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumActiveFps", wireType)
+			}
+			m.NumActiveFps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumActiveFps |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderDistInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderDistInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.BtcPk = &v
+			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
+			if m.Addr == nil {
+				m.Addr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Commission = &v
+			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedSat", wireType)
+			}
+			m.TotalBondedSat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBondedSat |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsTimestamped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsTimestamped = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsJailed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSlashed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSlashed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.AppHash == nil {
+				m.AppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalized = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			}
+			m.NumPubRand = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPubRand |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			}
+			m.EpochNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PubRandCommitIndexValue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PubRandCommitIndexValue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PubRandCommitIndexValue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFinality
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Heights = append(m.Heights, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowFinality
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthFinality
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthFinality
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Heights) == 0 {
+					m.Heights = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowFinality
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Heights = append(m.Heights, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Evidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.CanonicalAppHash == nil {
+				m.CanonicalAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForkAppHash == nil {
+				m.ForkAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
+			m.CanonicalFinalitySig = &v
+			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
+			m.ForkFinalitySig = &v
+			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EotsPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.EotsPk = &v
+			if err := m.EotsPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JailRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JailRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JailRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailCount", wireType)
+			}
+			m.JailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JailCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VotingPowerDistCache: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VotingPowerDistCache: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			m.TotalVotingPower = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1214,14 +4033,34 @@ func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalVotingPower |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.Tombstoned = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedHeight", wireType)
+			}
+			m.UnjailedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnjailedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProviders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnjailedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1248,30 +4087,13 @@ func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FinalityProviders = append(m.FinalityProviders, &FinalityProviderDistInfo{})
-			if err := m.FinalityProviders[len(m.FinalityProviders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.UnjailedAt == nil {
+				m.UnjailedAt = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.UnjailedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumActiveFps", wireType)
-			}
-			m.NumActiveFps = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowFinality
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumActiveFps |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -1293,7 +4115,7 @@ func (m *VotingPowerDistCache) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
+func (m *EpochUptime) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,17 +4138,17 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderDistInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochUptime: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderDistInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochUptime: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BtcPk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
 			}
-			var byteLen int
+			m.EpochNum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1336,32 +4158,16 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.EpochNum |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.BtcPk = &v
-			if err := m.BtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumActiveHeights", wireType)
 			}
-			var byteLen int
+			m.NumActiveHeights = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1371,29 +4177,33 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NumActiveHeights |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumVotedHeights", wireType)
 			}
-			m.Addr = append(m.Addr[:0], dAtA[iNdEx:postIndex]...)
-			if m.Addr == nil {
-				m.Addr = []byte{}
+			m.NumVotedHeights = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumVotedHeights |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uptime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1421,17 +4231,65 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Commission = &v
-			if err := m.Commission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Uptime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalityProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalityProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalityProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalBondedSat", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StateHeight", wireType)
 			}
-			m.TotalBondedSat = 0
+			m.StateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1441,16 +4299,16 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalBondedSat |= uint64(b&0x7F) << shift
+				m.StateHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsTimestamped", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1460,17 +4318,33 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsTimestamped = bool(v != 0)
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsJailed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Block == nil {
+				m.Block = &ProvenStoreEntry{}
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTableProofs", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1480,17 +4354,31 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsJailed = bool(v != 0)
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSlashed", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerTableProofs = append(m.VotingPowerTableProofs, &crypto.ProofOps{})
+			if err := m.VotingPowerTableProofs[len(m.VotingPowerTableProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1500,12 +4388,26 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsSlashed = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, &FinalityProofVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -1527,7 +4429,7 @@ func (m *FinalityProviderDistInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
+func (m *ProvenStoreEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1550,17 +4452,17 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IndexedBlock: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProvenStoreEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IndexedBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProvenStoreEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			m.Height = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1570,14 +4472,29 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = append(m.Key[:0], dAtA[iNdEx:postIndex]...)
+			if m.Key == nil {
+				m.Key = []byte{}
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1604,16 +4521,16 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AppHash = append(m.AppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.AppHash == nil {
-				m.AppHash = []byte{}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1623,12 +4540,28 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Finalized = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.ProofOps{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -1650,7 +4583,7 @@ func (m *IndexedBlock) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
+func (m *FinalityProofVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1673,17 +4606,17 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PubRandCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityProofVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRandCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityProofVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			m.StartHeight = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1693,16 +4626,32 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumPubRand", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sig", wireType)
 			}
-			m.NumPubRand = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1712,16 +4661,33 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumPubRand |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Sig == nil {
+				m.Sig = &ProvenStoreEntry{}
+			}
+			if err := m.Sig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1731,31 +4697,33 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
-			if m.Commitment == nil {
-				m.Commitment = []byte{}
+			if m.PubRand == nil {
+				m.PubRand = &ProvenStoreEntry{}
+			}
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandCommit", wireType)
 			}
-			m.EpochNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1765,137 +4733,100 @@ func (m *PubRandCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipFinality(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PubRandCommitIndexValue) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowFinality
+			if m.PubRandCommit == nil {
+				m.PubRandCommit = &ProvenStoreEntry{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if err := m.PubRandCommit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityProvider", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PubRandCommitIndexValue: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PubRandCommitIndexValue: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFinality
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Heights = append(m.Heights, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowFinality
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthFinality
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthFinality
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Heights) == 0 {
-					m.Heights = make([]uint64, 0, elementCount)
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinalityProvider == nil {
+				m.FinalityProvider = &ProvenStoreEntry{}
+			}
+			if err := m.FinalityProvider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRandProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFinality
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowFinality
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Heights = append(m.Heights, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Heights", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFinality
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PubRandProof == nil {
+				m.PubRandProof = &ProvenStoreEntry{}
+			}
+			if err := m.PubRandProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -1917,7 +4848,7 @@ func (m *PubRandCommitIndexValue) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Evidence) Unmarshal(dAtA []byte) error {
+func (m *FinalityHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1940,17 +4871,17 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Evidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: FinalityHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Evidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FinalityHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltingHeight", wireType)
 			}
-			var byteLen int
+			m.HaltingHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1960,32 +4891,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.HaltingHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumHaltedBlocks", wireType)
 			}
-			m.BlockHeight = 0
+			m.NumHaltedBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -1995,16 +4910,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.NumHaltedBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
 			}
-			var byteLen int
+			m.TotalVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2014,32 +4929,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalAppHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedVotingPower", wireType)
 			}
-			var byteLen int
+			m.VotedVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2049,31 +4948,16 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VotedVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CanonicalAppHash = append(m.CanonicalAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.CanonicalAppHash == nil {
-				m.CanonicalAppHash = []byte{}
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NonVotingFps", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2083,31 +4967,81 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ForkAppHash = append(m.ForkAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ForkAppHash == nil {
-				m.ForkAppHash = []byte{}
+			m.NonVotingFps = append(m.NonVotingFps, &NonVotingFinalityProvider{})
+			if err := m.NonVotingFps[len(m.NonVotingFps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFinality(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFinality
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NonVotingFinalityProvider) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFinality
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NonVotingFinalityProvider: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NonVotingFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalFinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPkHex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2117,32 +5051,29 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
-			m.CanonicalFinalitySig = &v
-			if err := m.CanonicalFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpBtcPkHex = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ForkFinalitySig", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPower", wireType)
 			}
-			var byteLen int
+			m.VotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2152,27 +5083,11 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
-			m.ForkFinalitySig = &v
-			if err := m.ForkFinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFinality(dAtA[iNdEx:])
@@ -2194,7 +5109,7 @@ func (m *Evidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
+func (m *VoteTally) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2217,17 +5132,17 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FinalityProviderSigningInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoteTally: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FinalityProviderSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoteTally: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
 			}
-			var byteLen int
+			m.TotalVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2237,32 +5152,16 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TotalVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthFinality
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFinality
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VotedVotingPower", wireType)
 			}
-			m.StartHeight = 0
+			m.VotedVotingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2272,16 +5171,16 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StartHeight |= int64(b&0x7F) << shift
+				m.VotedVotingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumFps", wireType)
 			}
-			m.MissedBlocksCounter = 0
+			m.NumFps = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2291,16 +5190,16 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				m.NumFps |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoterBitmap", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFinality
@@ -2310,23 +5209,24 @@ func (m *FinalityProviderSigningInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthFinality
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthFinality
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.VoterBitmap = append(m.VoterBitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.VoterBitmap == nil {
+				m.VoterBitmap = []byte{}
 			}
 			iNdEx = postIndex
 		default:
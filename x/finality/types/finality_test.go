@@ -236,3 +236,25 @@ func TestFinalityProviderDistInfoFpStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestVoteTally(t *testing.T) {
+	tally := &types.VoteTally{TotalVotingPower: 3, NumFps: 20}
+	require.False(t, tally.HasVoted(0))
+	require.False(t, tally.HasVoted(19))
+
+	tally.SetVoted(17)
+	require.Len(t, tally.VoterBitmap, 3)
+	require.True(t, tally.HasVoted(17))
+	require.False(t, tally.HasVoted(16))
+	require.False(t, tally.HasVoted(18))
+
+	tally.SetVoted(2)
+	require.Len(t, tally.VoterBitmap, 3)
+	require.True(t, tally.HasVoted(2))
+	require.True(t, tally.HasVoted(17))
+
+	tally.VotedVotingPower = 2
+	require.False(t, tally.HasQuorum())
+	tally.VotedVotingPower = 3
+	require.True(t, tally.HasQuorum())
+}
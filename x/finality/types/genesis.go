@@ -4,6 +4,8 @@ import (
 	fmt "fmt"
 	"sort"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/babylonlabs-io/babylon/v4/types"
 )
 
@@ -101,6 +103,20 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := types.ValidateEntries(gs.JailRecords, func(jr *JailRecord) string {
+		bz := make([]byte, 0)
+		// avoid panic here. If FpBtcPk is nil,
+		// will throw corresponding error later
+		if jr.FpBtcPk != nil {
+			bz, _ = jr.FpBtcPk.Marshal() // returned err is always nil
+		}
+		// unique key is FPs BTC PK and jail count
+		bz = append(bz, sdk.Uint64ToBigEndian(jr.JailCount)...)
+		return string(bz)
+	}); err != nil {
+		return err
+	}
+
 	if err := types.ValidateEntries(gs.VotingPowers, func(vp *VotingPowerFP) string {
 		bz := make([]byte, 0)
 		// avoid panic here. If FpBtcPk is nil,
@@ -270,6 +286,9 @@ func SortData(gs *GenesisState) {
 	sort.Slice(gs.MissedBlocks, func(i, j int) bool {
 		return gs.MissedBlocks[i].FpBtcPk.MarshalHex() < gs.MissedBlocks[j].FpBtcPk.MarshalHex()
 	})
+	sort.Slice(gs.JailRecords, func(i, j int) bool {
+		return lessByPubKeyAndHeight(gs.JailRecords[i].FpBtcPk, gs.JailRecords[i].JailCount, gs.JailRecords[j].FpBtcPk, gs.JailRecords[j].JailCount)
+	})
 	sort.Slice(gs.VotingPowers, func(i, j int) bool {
 		return lessByPubKeyAndHeight(gs.VotingPowers[i].FpBtcPk, gs.VotingPowers[i].BlockHeight, gs.VotingPowers[j].FpBtcPk, gs.VotingPowers[j].BlockHeight)
 	})
@@ -59,6 +59,11 @@ type GenesisState struct {
 	// pub_rand_commit_indexes are the indexes of the start heights of the
 	// committed pub_randomness of the finality providers
 	PubRandCommitIndexes []*PubRandCommitIdx `protobuf:"bytes,13,rep,name=pub_rand_commit_indexes,json=pubRandCommitIndexes,proto3" json:"pub_rand_commit_indexes,omitempty"`
+	// next block height to prune
+	NextHeightToPrune uint64 `protobuf:"varint,14,opt,name=next_height_to_prune,json=nextHeightToPrune,proto3" json:"next_height_to_prune,omitempty"`
+	// jail_records are the records of finality providers being jailed due to
+	// liveness downtime
+	JailRecords []*JailRecord `protobuf:"bytes,15,rep,name=jail_records,json=jailRecords,proto3" json:"jail_records,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -185,6 +190,20 @@ func (m *GenesisState) GetPubRandCommitIndexes() []*PubRandCommitIdx {
 	return nil
 }
 
+func (m *GenesisState) GetNextHeightToPrune() uint64 {
+	if m != nil {
+		return m.NextHeightToPrune
+	}
+	return 0
+}
+
+func (m *GenesisState) GetJailRecords() []*JailRecord {
+	if m != nil {
+		return m.JailRecords
+	}
+	return nil
+}
+
 // VoteSig the vote of an finality provider
 // with the block of the vote, the finality provider btc public key and the vote
 // signature.
@@ -674,66 +693,69 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/genesis.proto", fileDescriptor_52dc577f74d797d1) }
 
 var fileDescriptor_52dc577f74d797d1 = []byte{
-	// 930 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
-	0x14, 0x8f, 0x93, 0x6d, 0x36, 0x79, 0xeb, 0x6d, 0xca, 0x34, 0x50, 0x2b, 0xb4, 0x9b, 0x8d, 0x25,
-	0xa4, 0x45, 0x82, 0xdd, 0x26, 0x6d, 0x85, 0xa2, 0x88, 0x8b, 0x9b, 0xa6, 0x0d, 0x11, 0xaa, 0x35,
-	0x1b, 0xb5, 0x08, 0x55, 0x18, 0xdb, 0x3b, 0xeb, 0x1d, 0x65, 0xd7, 0x63, 0x79, 0x66, 0xdd, 0x84,
-	0x4f, 0xc1, 0x89, 0x8f, 0x01, 0x77, 0xae, 0x20, 0xd4, 0x63, 0x8f, 0xa8, 0x87, 0x08, 0x25, 0x07,
-	0xf8, 0x18, 0xc8, 0xe3, 0xd9, 0xae, 0xb3, 0x75, 0xd3, 0x45, 0x34, 0xca, 0xcd, 0xf3, 0xe6, 0xf7,
-	0x7e, 0xfa, 0xbd, 0x79, 0xff, 0x0c, 0x6b, 0x9e, 0xeb, 0x1d, 0xf5, 0x59, 0xd8, 0xea, 0xd2, 0xd0,
-	0xed, 0x53, 0x71, 0xd4, 0x4a, 0xd6, 0x5b, 0x01, 0x09, 0x09, 0xa7, 0xbc, 0x19, 0xc5, 0x4c, 0x30,
-	0x74, 0x5d, 0x41, 0x9a, 0x23, 0x48, 0x33, 0x59, 0x5f, 0x59, 0x0e, 0x58, 0xc0, 0xe4, 0x7d, 0x2b,
-	0xfd, 0xca, 0xa0, 0x2b, 0xf5, 0x22, 0xb6, 0xc8, 0x8d, 0xdd, 0x81, 0x22, 0x5b, 0x31, 0x8b, 0x10,
-	0xaf, 0x89, 0x25, 0xc6, 0xfc, 0xad, 0x0c, 0xfa, 0xc3, 0x4c, 0x42, 0x5b, 0xb8, 0x82, 0xa0, 0x4d,
-	0x98, 0xcf, 0x48, 0x0c, 0xad, 0xae, 0x35, 0x2a, 0x1b, 0x1f, 0x37, 0x0b, 0x24, 0x35, 0x6d, 0x09,
-	0xb1, 0x4a, 0x2f, 0x8e, 0x57, 0x67, 0xb0, 0x72, 0x40, 0x8f, 0xe0, 0x2a, 0x0d, 0x3b, 0xe4, 0x90,
-	0x74, 0x1c, 0xaf, 0xcf, 0xfc, 0x03, 0x6e, 0xcc, 0xd6, 0xe7, 0x1a, 0x95, 0x8d, 0xb5, 0x42, 0x8a,
-	0xdd, 0x0c, 0x6a, 0xa5, 0x48, 0x5c, 0xa5, 0xb9, 0x13, 0x47, 0x5b, 0xb0, 0x48, 0x12, 0xda, 0x21,
-	0xa1, 0x4f, 0xb8, 0x31, 0x27, 0x49, 0x6e, 0x15, 0x92, 0x3c, 0x50, 0x28, 0x3c, 0xc6, 0xa3, 0x4d,
-	0x58, 0x4c, 0x98, 0x20, 0x0e, 0xa7, 0x01, 0x37, 0x4a, 0xd2, 0xf9, 0x66, 0xa1, 0xf3, 0x13, 0x26,
-	0x48, 0x9b, 0x06, 0x78, 0x21, 0xc9, 0x3e, 0x38, 0xc2, 0xf0, 0x41, 0x34, 0xf4, 0xfa, 0xd4, 0x77,
-	0x62, 0x37, 0xec, 0xb0, 0x41, 0x48, 0x38, 0x37, 0xae, 0x48, 0x8a, 0x4f, 0x8a, 0xdf, 0x41, 0xa2,
-	0xf1, 0x6b, 0x30, 0xbe, 0x16, 0x4d, 0x58, 0x90, 0x0d, 0x4b, 0xd1, 0xd0, 0x93, 0x84, 0x8e, 0xcf,
-	0x06, 0x03, 0x2a, 0x8c, 0x79, 0xc9, 0xd8, 0x78, 0x1b, 0x63, 0xea, 0x7c, 0x5f, 0x22, 0x9f, 0x52,
-	0xd1, 0xb3, 0xf7, 0x70, 0x35, 0xca, 0x1b, 0xd1, 0x1e, 0x54, 0x39, 0x0d, 0x42, 0x1a, 0x06, 0x0e,
-	0x0d, 0xbb, 0x8c, 0x1b, 0x65, 0xc9, 0x57, 0x2f, 0xe4, 0x6b, 0x67, 0xc8, 0xdd, 0xb0, 0xcb, 0x54,
-	0xba, 0x74, 0x3e, 0x36, 0x71, 0xf4, 0x0c, 0xaa, 0x03, 0xca, 0xf9, 0x38, 0x67, 0x0b, 0x92, 0x6c,
-	0xbd, 0x90, 0x6c, 0x47, 0x7d, 0xdb, 0x31, 0x4b, 0x9f, 0x3b, 0xfe, 0x5a, 0x7a, 0x66, 0x49, 0x1b,
-	0xb1, 0x0f, 0x72, 0x36, 0xf4, 0x10, 0xaa, 0x09, 0x13, 0xa9, 0xd2, 0x88, 0x3d, 0x27, 0x31, 0x37,
-	0x16, 0x25, 0xbb, 0xf9, 0xb6, 0x7c, 0xd0, 0x30, 0xb0, 0x53, 0xe0, 0x8e, 0x8d, 0xf5, 0x64, 0x7c,
-	0xe4, 0x68, 0x1f, 0xf4, 0x24, 0x72, 0x3a, 0x5c, 0x38, 0xbe, 0xeb, 0xf7, 0x88, 0x01, 0x92, 0x67,
-	0xe3, 0x5d, 0x3c, 0xdb, 0x94, 0x8b, 0xfb, 0xa9, 0x83, 0xd5, 0x3f, 0x78, 0x44, 0x68, 0xd0, 0x13,
-	0x18, 0x92, 0x68, 0x5b, 0x19, 0xd1, 0x3d, 0xb8, 0x11, 0x92, 0x43, 0xe1, 0xf4, 0xe4, 0x95, 0x23,
-	0x98, 0x93, 0xf1, 0xfc, 0x40, 0x8c, 0x4a, 0x5d, 0x6b, 0x94, 0xf0, 0x72, 0x7a, 0x9d, 0x39, 0xee,
-	0xb3, 0x1d, 0x75, 0x87, 0xd6, 0xe1, 0xc3, 0x09, 0xb7, 0x98, 0x3c, 0x77, 0xe3, 0x8e, 0xa1, 0x4b,
-	0x27, 0x94, 0x77, 0xc2, 0xf2, 0x06, 0x3d, 0x83, 0x1b, 0x13, 0x55, 0xe0, 0x64, 0x25, 0xcf, 0x8d,
-	0xea, 0xf9, 0xf5, 0x35, 0x4e, 0xfc, 0x6e, 0xe7, 0x10, 0x2f, 0x9f, 0x29, 0x85, 0xac, 0x87, 0xb8,
-	0xf9, 0xb7, 0x06, 0x65, 0x55, 0xcd, 0x68, 0x0d, 0x74, 0x99, 0x49, 0xa5, 0x4e, 0xb6, 0x71, 0x09,
-	0x57, 0xa4, 0x2d, 0x13, 0x85, 0x9e, 0xc2, 0x62, 0x37, 0x72, 0x3c, 0xe1, 0x3b, 0xd1, 0x81, 0x31,
-	0x5b, 0xd7, 0x1a, 0xba, 0xb5, 0xf5, 0xea, 0x78, 0xf5, 0x8b, 0x80, 0x8a, 0xde, 0xd0, 0x6b, 0xfa,
-	0x6c, 0xd0, 0x52, 0x62, 0xfa, 0xae, 0xc7, 0x3f, 0xa7, 0x6c, 0x74, 0x6c, 0x25, 0x77, 0x5b, 0xe2,
-	0x28, 0x22, 0xbc, 0x69, 0xed, 0xda, 0x77, 0xee, 0xde, 0xb6, 0x87, 0xde, 0x1e, 0x39, 0xc2, 0xe5,
-	0x6e, 0x64, 0x09, 0xdf, 0x3e, 0x40, 0xdf, 0x83, 0x3e, 0x52, 0x9f, 0xb6, 0x9f, 0x31, 0x27, 0xb9,
-	0xbf, 0x7c, 0x75, 0xbc, 0xba, 0xf9, 0x1f, 0xb8, 0xdb, 0x7e, 0x2f, 0x64, 0x71, 0xfc, 0xe0, 0xf1,
-	0x7e, 0x3b, 0x6d, 0xcf, 0xca, 0x88, 0xb2, 0x4d, 0x03, 0xf3, 0x1f, 0x0d, 0xae, 0x4d, 0x36, 0xdd,
-	0xa5, 0x86, 0xfc, 0x0d, 0x2c, 0x8c, 0x12, 0xfb, 0xff, 0xc2, 0x55, 0xa9, 0xc6, 0x65, 0x95, 0x61,
-	0xf3, 0x57, 0x0d, 0xae, 0x17, 0x4c, 0x83, 0xb3, 0xa1, 0x68, 0xef, 0x31, 0x94, 0xaf, 0xde, 0x9c,
-	0x54, 0xb3, 0x72, 0x07, 0x98, 0xef, 0xae, 0xcd, 0x89, 0x19, 0x65, 0xfe, 0x92, 0xe5, 0xe9, 0x4c,
-	0xf1, 0x5e, 0x9c, 0x72, 0x0b, 0xae, 0xc8, 0x6e, 0x52, 0x7a, 0x3f, 0x9b, 0xa2, 0x97, 0x52, 0xf8,
-	0x13, 0xb7, 0x3f, 0x24, 0x38, 0x73, 0x35, 0xff, 0xd0, 0xa0, 0x92, 0x1b, 0x96, 0x17, 0x27, 0xf6,
-	0x3b, 0x58, 0xea, 0x46, 0x4e, 0x7e, 0x82, 0x2b, 0xd9, 0xb7, 0xa7, 0x9a, 0xb9, 0x6f, 0x0e, 0xf4,
-	0x6a, 0x37, 0xca, 0x19, 0xcd, 0xdf, 0x35, 0xb8, 0x79, 0xde, 0xa0, 0xbe, 0xb8, 0xc8, 0xf6, 0x26,
-	0x77, 0xc9, 0xec, 0x39, 0x8b, 0x29, 0x27, 0xa9, 0x68, 0x75, 0x98, 0x5b, 0x50, 0xc9, 0x41, 0xd0,
-	0xf2, 0x28, 0xc5, 0xa9, 0xe0, 0x39, 0x95, 0x34, 0xf4, 0x11, 0xcc, 0x67, 0x4e, 0xf2, 0x09, 0x17,
-	0xb0, 0x3a, 0x99, 0x3f, 0x6b, 0x50, 0x3d, 0xb3, 0x4e, 0x2e, 0x75, 0x46, 0xac, 0x81, 0x9e, 0xdf,
-	0x82, 0x72, 0x4e, 0x94, 0x70, 0x25, 0xb7, 0xe0, 0xcc, 0x9f, 0x34, 0xb8, 0x75, 0xee, 0xde, 0x9a,
-	0x26, 0x00, 0x0c, 0x4b, 0xe9, 0x92, 0xa4, 0x5c, 0xc4, 0xd4, 0x1b, 0x0a, 0xca, 0x42, 0x55, 0x59,
-	0x9f, 0x4e, 0xbd, 0x27, 0xf1, 0xd5, 0x24, 0xda, 0xce, 0x11, 0x58, 0x8f, 0x5f, 0x9c, 0xd4, 0xb4,
-	0x97, 0x27, 0x35, 0xed, 0xaf, 0x93, 0x9a, 0xf6, 0xe3, 0x69, 0x6d, 0xe6, 0xe5, 0x69, 0x6d, 0xe6,
-	0xcf, 0xd3, 0xda, 0xcc, 0xb7, 0xf7, 0xa6, 0x7a, 0x97, 0xc3, 0xf1, 0xdf, 0xa7, 0x7c, 0x22, 0x6f,
-	0x5e, 0xfe, 0x78, 0xde, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb5, 0xbe, 0x00, 0x87, 0x0e, 0x0b,
-	0x00, 0x00,
+	// 981 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x6f, 0xdb, 0xb6,
+	0x1b, 0x8e, 0x12, 0x37, 0x71, 0x5e, 0xcb, 0x49, 0xcb, 0xfa, 0xf7, 0xab, 0x90, 0xb5, 0x8e, 0x23,
+	0x60, 0x80, 0x07, 0x6c, 0x76, 0x93, 0xb6, 0x18, 0x82, 0x60, 0x17, 0x37, 0x4d, 0x9b, 0x06, 0x43,
+	0x05, 0x3a, 0x68, 0x87, 0xa1, 0x98, 0x26, 0xc9, 0xb4, 0xcc, 0xc6, 0x16, 0x05, 0x91, 0x56, 0x93,
+	0x7d, 0x8a, 0x9d, 0xf6, 0x31, 0xb6, 0xeb, 0xb0, 0xf3, 0x30, 0xf4, 0xd8, 0xe3, 0xd0, 0x43, 0x30,
+	0x24, 0x87, 0xed, 0x63, 0x0c, 0xa2, 0xe8, 0x58, 0x71, 0xd5, 0xd4, 0xc3, 0x16, 0xf4, 0x46, 0xbe,
+	0x7c, 0xde, 0x47, 0x0f, 0xf9, 0xfe, 0x13, 0xac, 0xb9, 0x8e, 0x7b, 0xd4, 0x67, 0x41, 0xb3, 0x4b,
+	0x03, 0xa7, 0x4f, 0xc5, 0x51, 0x33, 0x5e, 0x6f, 0xfa, 0x24, 0x20, 0x9c, 0xf2, 0x46, 0x18, 0x31,
+	0xc1, 0xd0, 0x75, 0x05, 0x69, 0x8c, 0x20, 0x8d, 0x78, 0x7d, 0xa5, 0xe2, 0x33, 0x9f, 0xc9, 0xf3,
+	0x66, 0xb2, 0x4a, 0xa1, 0x2b, 0xb5, 0x3c, 0xb6, 0xd0, 0x89, 0x9c, 0x81, 0x22, 0x5b, 0x31, 0xf3,
+	0x10, 0x67, 0xc4, 0x12, 0x63, 0xfe, 0x5c, 0x04, 0xfd, 0x61, 0x2a, 0xa1, 0x2d, 0x1c, 0x41, 0xd0,
+	0x26, 0xcc, 0xa7, 0x24, 0x86, 0x56, 0xd3, 0xea, 0xa5, 0x8d, 0x8f, 0x1a, 0x39, 0x92, 0x1a, 0x96,
+	0x84, 0xb4, 0x0a, 0xaf, 0x8e, 0x57, 0x67, 0xb0, 0x72, 0x40, 0x8f, 0x60, 0x89, 0x06, 0x1d, 0x72,
+	0x48, 0x3a, 0xb6, 0xdb, 0x67, 0xde, 0x01, 0x37, 0x66, 0x6b, 0x73, 0xf5, 0xd2, 0xc6, 0x5a, 0x2e,
+	0xc5, 0x6e, 0x0a, 0x6d, 0x25, 0x48, 0x5c, 0xa6, 0x99, 0x1d, 0x47, 0x5b, 0xb0, 0x48, 0x62, 0xda,
+	0x21, 0x81, 0x47, 0xb8, 0x31, 0x27, 0x49, 0x6e, 0xe5, 0x92, 0x3c, 0x50, 0x28, 0x3c, 0xc6, 0xa3,
+	0x4d, 0x58, 0x8c, 0x99, 0x20, 0x36, 0xa7, 0x3e, 0x37, 0x0a, 0xd2, 0xf9, 0x66, 0xae, 0xf3, 0x53,
+	0x26, 0x48, 0x9b, 0xfa, 0xb8, 0x18, 0xa7, 0x0b, 0x8e, 0x30, 0x5c, 0x0b, 0x87, 0x6e, 0x9f, 0x7a,
+	0x76, 0xe4, 0x04, 0x1d, 0x36, 0x08, 0x08, 0xe7, 0xc6, 0x15, 0x49, 0xf1, 0x71, 0xfe, 0x3b, 0x48,
+	0x34, 0x3e, 0x03, 0xe3, 0xab, 0xe1, 0x84, 0x05, 0x59, 0xb0, 0x1c, 0x0e, 0x5d, 0x49, 0x68, 0x7b,
+	0x6c, 0x30, 0xa0, 0xc2, 0x98, 0x97, 0x8c, 0xf5, 0x77, 0x31, 0x26, 0xce, 0xf7, 0x25, 0xf2, 0x19,
+	0x15, 0x3d, 0x6b, 0x0f, 0x97, 0xc3, 0xac, 0x11, 0xed, 0x41, 0x99, 0x53, 0x3f, 0xa0, 0x81, 0x6f,
+	0xd3, 0xa0, 0xcb, 0xb8, 0xb1, 0x20, 0xf9, 0x6a, 0xb9, 0x7c, 0xed, 0x14, 0xb9, 0x1b, 0x74, 0x99,
+	0x0a, 0x97, 0xce, 0xc7, 0x26, 0x8e, 0x9e, 0x43, 0x79, 0x40, 0x39, 0x1f, 0xc7, 0xac, 0x28, 0xc9,
+	0xd6, 0x73, 0xc9, 0x76, 0xd4, 0xda, 0x8a, 0x58, 0xf2, 0xdc, 0xd1, 0x97, 0xd2, 0x33, 0x0d, 0xda,
+	0x88, 0x7d, 0x90, 0xb1, 0xa1, 0x87, 0x50, 0x8e, 0x99, 0x48, 0x94, 0x86, 0xec, 0x25, 0x89, 0xb8,
+	0xb1, 0x28, 0xd9, 0xcd, 0x77, 0xc5, 0x83, 0x06, 0xbe, 0x95, 0x00, 0x77, 0x2c, 0xac, 0xc7, 0xe3,
+	0x2d, 0x47, 0xfb, 0xa0, 0xc7, 0xa1, 0xdd, 0xe1, 0xc2, 0xf6, 0x1c, 0xaf, 0x47, 0x0c, 0x90, 0x3c,
+	0x1b, 0xef, 0xe3, 0xd9, 0xa6, 0x5c, 0xdc, 0x4f, 0x1c, 0x5a, 0xfd, 0x83, 0x47, 0x84, 0xfa, 0x3d,
+	0x81, 0x21, 0x0e, 0xb7, 0x95, 0x11, 0xdd, 0x83, 0x1b, 0x01, 0x39, 0x14, 0x76, 0x4f, 0x1e, 0xd9,
+	0x82, 0xd9, 0x29, 0xcf, 0x77, 0xc4, 0x28, 0xd5, 0xb4, 0x7a, 0x01, 0x57, 0x92, 0xe3, 0xd4, 0x71,
+	0x9f, 0xed, 0xa8, 0x33, 0xb4, 0x0e, 0xff, 0x9b, 0x70, 0x8b, 0xc8, 0x4b, 0x27, 0xea, 0x18, 0xba,
+	0x74, 0x42, 0x59, 0x27, 0x2c, 0x4f, 0xd0, 0x73, 0xb8, 0x31, 0x91, 0x05, 0x76, 0x9a, 0xf2, 0xdc,
+	0x28, 0x5f, 0x9c, 0x5f, 0xe3, 0xc0, 0xef, 0x76, 0x0e, 0x71, 0xe5, 0x5c, 0x2a, 0xa4, 0x35, 0xc4,
+	0x51, 0x13, 0x2a, 0x13, 0x82, 0xc2, 0x68, 0x18, 0x10, 0x63, 0x49, 0xea, 0xb9, 0x96, 0xd5, 0x63,
+	0x25, 0x07, 0xa8, 0x05, 0xfa, 0x0b, 0x87, 0xf6, 0xed, 0x88, 0x78, 0x2c, 0xea, 0x70, 0x63, 0x59,
+	0x6a, 0x58, 0xcd, 0xd5, 0xf0, 0xd8, 0xa1, 0x7d, 0x2c, 0x71, 0xb8, 0xf4, 0xe2, 0x6c, 0xcd, 0xcd,
+	0x3f, 0x35, 0x58, 0x50, 0x25, 0x84, 0xd6, 0x40, 0x97, 0xe9, 0xa3, 0x14, 0xc8, 0xde, 0x51, 0xc0,
+	0x25, 0x69, 0x4b, 0xbf, 0x8c, 0x9e, 0xc1, 0x62, 0x37, 0xb4, 0x5d, 0xe1, 0xd9, 0xe1, 0x81, 0x31,
+	0x5b, 0xd3, 0xea, 0x7a, 0x6b, 0xeb, 0xcd, 0xf1, 0xea, 0xe7, 0x3e, 0x15, 0xbd, 0xa1, 0xdb, 0xf0,
+	0xd8, 0xa0, 0xa9, 0xbe, 0xde, 0x77, 0x5c, 0xfe, 0x19, 0x65, 0xa3, 0x6d, 0x33, 0xbe, 0xdb, 0x14,
+	0x47, 0x21, 0xe1, 0x8d, 0xd6, 0xae, 0x75, 0xe7, 0xee, 0x6d, 0x6b, 0xe8, 0xee, 0x91, 0x23, 0xbc,
+	0xd0, 0x0d, 0x5b, 0xc2, 0xb3, 0x0e, 0xd0, 0xb7, 0xa0, 0x8f, 0xe4, 0x26, 0x35, 0x6f, 0xcc, 0x49,
+	0xee, 0x2f, 0xde, 0x1c, 0xaf, 0x6e, 0xfe, 0x03, 0xee, 0xb6, 0xd7, 0x0b, 0x58, 0x14, 0x3d, 0x78,
+	0xb2, 0xdf, 0x4e, 0x7a, 0x42, 0x69, 0x44, 0xd9, 0xa6, 0xbe, 0xf9, 0x97, 0x06, 0x57, 0x27, 0x2b,
+	0xfd, 0x83, 0x5e, 0xf9, 0x2b, 0x28, 0x8e, 0xb2, 0xe9, 0xdf, 0x5d, 0x57, 0xe5, 0x17, 0x5e, 0x50,
+	0x69, 0x65, 0xfe, 0xa2, 0xc1, 0xf5, 0x9c, 0x16, 0x74, 0xfe, 0x2a, 0xda, 0x7f, 0x78, 0x95, 0xc7,
+	0x6f, 0xb7, 0xc7, 0x59, 0x39, 0x78, 0xcc, 0xf7, 0x17, 0xc4, 0x44, 0x63, 0x34, 0x7f, 0x4a, 0xe3,
+	0x74, 0xae, 0x62, 0x2e, 0x4f, 0x79, 0x0b, 0xae, 0xc8, 0x12, 0x56, 0x7a, 0x3f, 0x9d, 0xa2, 0x80,
+	0x13, 0xf8, 0x53, 0xa7, 0x3f, 0x24, 0x38, 0x75, 0x35, 0x7f, 0xd3, 0xa0, 0x94, 0xe9, 0xd0, 0x97,
+	0x27, 0xf6, 0x1b, 0x58, 0xee, 0x86, 0x76, 0x76, 0x6c, 0x28, 0xd9, 0xb7, 0xa7, 0x6a, 0xf4, 0x6f,
+	0x4f, 0x91, 0x72, 0x37, 0xcc, 0x18, 0xcd, 0x5f, 0x35, 0xb8, 0x79, 0xd1, 0x74, 0xb8, 0xbc, 0x9b,
+	0xed, 0x4d, 0x0e, 0xb0, 0xd9, 0x0b, 0xa6, 0x61, 0x46, 0x52, 0xde, 0xbc, 0x32, 0xb7, 0xa0, 0x94,
+	0x81, 0xa0, 0xca, 0x28, 0xc4, 0x89, 0xe0, 0x39, 0x15, 0x34, 0xf4, 0x7f, 0x98, 0x4f, 0x9d, 0xe4,
+	0x13, 0x16, 0xb1, 0xda, 0x99, 0x3f, 0x6a, 0x50, 0x3e, 0x37, 0xc3, 0x3e, 0x68, 0x8f, 0x58, 0x03,
+	0x3d, 0x3b, 0x7a, 0x65, 0x9f, 0x28, 0xe0, 0x52, 0x66, 0xaa, 0x9a, 0x3f, 0x68, 0x70, 0xeb, 0xc2,
+	0x61, 0x39, 0xcd, 0x05, 0x30, 0x2c, 0x27, 0x93, 0x99, 0x72, 0x11, 0x51, 0x77, 0x28, 0x28, 0x0b,
+	0x54, 0x66, 0x7d, 0x32, 0xf5, 0x70, 0xc6, 0x4b, 0x71, 0xb8, 0x9d, 0x21, 0x68, 0x3d, 0x79, 0x75,
+	0x52, 0xd5, 0x5e, 0x9f, 0x54, 0xb5, 0x3f, 0x4e, 0xaa, 0xda, 0xf7, 0xa7, 0xd5, 0x99, 0xd7, 0xa7,
+	0xd5, 0x99, 0xdf, 0x4f, 0xab, 0x33, 0x5f, 0xdf, 0x9b, 0xea, 0x5d, 0x0e, 0xc7, 0xbf, 0xbc, 0xf2,
+	0x89, 0xdc, 0x79, 0xf9, 0xb7, 0x7b, 0xe7, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x45, 0x91,
+	0x65, 0x83, 0x0b, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -756,6 +778,25 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.JailRecords) > 0 {
+		for iNdEx := len(m.JailRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.JailRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.NextHeightToPrune != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextHeightToPrune))
+		i--
+		dAtA[i] = 0x70
+	}
 	if len(m.PubRandCommitIndexes) > 0 {
 		for iNdEx := len(m.PubRandCommitIndexes) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1419,6 +1460,15 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if m.NextHeightToPrune != 0 {
+		n += 1 + sovGenesis(uint64(m.NextHeightToPrune))
+	}
+	if len(m.JailRecords) > 0 {
+		for _, e := range m.JailRecords {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2026,6 +2076,59 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeightToPrune", wireType)
+			}
+			m.NextHeightToPrune = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeightToPrune |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JailRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JailRecords = append(m.JailRecords, &JailRecord{})
+			if err := m.JailRecords[len(m.JailRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -31,6 +31,15 @@ func TestGenesisState_Validate(t *testing.T) {
 		Commission:     &validComm,
 	}
 
+	// the state retention window must exceed the finality sig timeout and
+	// cover the signed blocks window, unless the pruning is disabled
+	shortTimeoutParams := gs.Params
+	shortTimeoutParams.StateRetentionWindow = uint64(gs.Params.FinalitySigTimeout)
+	shortWindowParams := gs.Params
+	shortWindowParams.StateRetentionWindow = uint64(gs.Params.SignedBlocksWindow) - 1
+	noPruningParams := gs.Params
+	noPruningParams.StateRetentionWindow = 0
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -162,6 +171,23 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:  false,
 			errMsg: "Next height to reward 100 is higher than next height to finalize 50",
 		},
+		{
+			desc:     "state retention window not greater than finality sig timeout",
+			genState: &types.GenesisState{Params: shortTimeoutParams},
+			valid:    false,
+			errMsg:   "must be greater than the finality sig timeout",
+		},
+		{
+			desc:     "state retention window lower than signed blocks window",
+			genState: &types.GenesisState{Params: shortWindowParams},
+			valid:    false,
+			errMsg:   "must not be lower than the signed blocks window",
+		},
+		{
+			desc:     "pruning disabled",
+			genState: &types.GenesisState{Params: noPruningParams},
+			valid:    true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 const (
@@ -51,4 +52,53 @@ var (
 	VotingPowerDistCacheKey                    = []byte{0x11}              // key prefix for voting power distribution cache
 	NextHeightToRewardKey                      = []byte{0x012}             // key prefix for next height to reward
 	PubRandCommitIndexKeyPrefix                = collections.NewPrefix(13) // key prefix for the index with the start height of the PubRandCommitments
+	NextHeightToPruneKey                       = []byte{0x13}              // key prefix for next height to prune
+	LastFinalityHaltHeightKey                  = []byte{0x14}              // key prefix for the halting height of the last detected finality halt
+	JailRecordKeyPrefix                        = collections.NewPrefix(21) // key prefix for the jail records of finality providers
+	VoteTallyKeyPrefix                         = collections.NewPrefix(22) // key prefix for the vote tallies of blocks
+	VotingPowerIndexKeyPrefix                  = collections.NewPrefix(23) // key prefix for the indexes of finality providers in the voting power tables
+	PubRandProofKey                            = []byte{0x18}              // key prefix for the inclusion proofs of public randomness
+	NextFpToPruneCommitsKey                    = []byte{0x19}              // key for the finality provider from which the pruning of public randomness commitments resumes
+	PubRandCommitsPruningHeightKey             = []byte{0x1a}              // key for the next height to prune at the start of the last pruning of public randomness commitments
 )
+
+// BlockStoreKey returns the key of the IndexedBlock at the given height in
+// the module store
+func BlockStoreKey(height uint64) []byte {
+	return append(append([]byte{}, BlockKey...), sdk.Uint64ToBigEndian(height)...)
+}
+
+// VoteStoreKey returns the key of the EOTS signature of the given finality
+// provider at the given height in the module store
+func VoteStoreKey(height uint64, fpBtcPk []byte) []byte {
+	key := append(append([]byte{}, VoteKey...), sdk.Uint64ToBigEndian(height)...)
+	return append(key, fpBtcPk...)
+}
+
+// PubRandStoreKey returns the key of the public randomness of the given
+// finality provider at the given height in the module store
+func PubRandStoreKey(fpBtcPk []byte, height uint64) []byte {
+	key := append(append([]byte{}, PubRandKey...), fpBtcPk...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// PubRandProofStoreKey returns the key of the inclusion proof of the public
+// randomness of the given finality provider at the given height in the
+// module store
+func PubRandProofStoreKey(fpBtcPk []byte, height uint64) []byte {
+	key := append(append([]byte{}, PubRandProofKey...), fpBtcPk...)
+	return append(key, sdk.Uint64ToBigEndian(height)...)
+}
+
+// PubRandCommitStoreKey returns the key of the PubRandCommit of the given
+// finality provider starting at the given height in the module store
+func PubRandCommitStoreKey(fpBtcPk []byte, startHeight uint64) []byte {
+	key := append(append([]byte{}, PubRandCommitKey...), fpBtcPk...)
+	return append(key, sdk.Uint64ToBigEndian(startHeight)...)
+}
+
+// VotingPowerTableStorePrefix returns the prefix of the voting power table at
+// the given height in the module store
+func VotingPowerTableStorePrefix(height uint64) []byte {
+	return append(append([]byte{}, VotingPowerKey...), sdk.Uint64ToBigEndian(height)...)
+}
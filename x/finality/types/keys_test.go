@@ -22,6 +22,14 @@ func TestNoKeyCollision(t *testing.T) {
 		"VotingPowerDistCacheKey":                    types.VotingPowerDistCacheKey,
 		"NextHeightToRewardKey":                      types.NextHeightToRewardKey,
 		"PubRandCommitIndexKeyPrefix":                types.PubRandCommitIndexKeyPrefix,
+		"NextHeightToPruneKey":                       types.NextHeightToPruneKey,
+		"LastFinalityHaltHeightKey":                  types.LastFinalityHaltHeightKey,
+		"JailRecordKeyPrefix":                        types.JailRecordKeyPrefix,
+		"VoteTallyKeyPrefix":                         types.VoteTallyKeyPrefix,
+		"VotingPowerIndexKeyPrefix":                  types.VotingPowerIndexKeyPrefix,
+		"PubRandProofKey":                            types.PubRandProofKey,
+		"NextFpToPruneCommitsKey":                    types.NextFpToPruneCommitsKey,
+		"PubRandCommitsPruningHeightKey":             types.PubRandCommitsPruningHeightKey,
 	}
 
 	store.CheckKeyCollisions(t, keys)
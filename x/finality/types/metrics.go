@@ -9,7 +9,9 @@ import (
 const (
 	MetricsKeyCommitPubRandList      = "commit_pub_rand_list"
 	MetricsKeyAddFinalitySig         = "add_finality_sig"
+	MetricsKeyAddFinalitySigs        = "add_finality_sigs"
 	MetricsKeyUnjailFinalityProvider = "unjail_finality_provider"
+	MetricsKeyRotateFpKey            = "rotate_finality_provider_key"
 )
 
 const (
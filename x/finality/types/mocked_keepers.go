@@ -54,6 +54,21 @@ func (mr *MockBTCStakingKeeperMockRecorder) BtcDelHasCovenantQuorums(ctx, btcDel
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BtcDelHasCovenantQuorums", reflect.TypeOf((*MockBTCStakingKeeper)(nil).BtcDelHasCovenantQuorums), ctx, btcDel, quorum)
 }
 
+// BtcDelStatus mocks base method.
+func (m *MockBTCStakingKeeper) BtcDelStatus(ctx context.Context, btcDel *types1.BTCDelegation, covenantQuorum, btcTipHeight uint32) (types1.BTCDelegationStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BtcDelStatus", ctx, btcDel, covenantQuorum, btcTipHeight)
+	ret0, _ := ret[0].(types1.BTCDelegationStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BtcDelStatus indicates an expected call of BtcDelStatus.
+func (mr *MockBTCStakingKeeperMockRecorder) BtcDelStatus(ctx, btcDel, covenantQuorum, btcTipHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BtcDelStatus", reflect.TypeOf((*MockBTCStakingKeeper)(nil).BtcDelStatus), ctx, btcDel, covenantQuorum, btcTipHeight)
+}
+
 // ClearPowerDistUpdateEvents mocks base method.
 func (m *MockBTCStakingKeeper) ClearPowerDistUpdateEvents(ctx context.Context, btcHeight uint32) {
 	m.ctrl.T.Helper()
@@ -180,6 +195,32 @@ func (mr *MockBTCStakingKeeperMockRecorder) IsFinalityProviderDeleted(ctx, fpBtc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFinalityProviderDeleted", reflect.TypeOf((*MockBTCStakingKeeper)(nil).IsFinalityProviderDeleted), ctx, fpBtcPk)
 }
 
+// IterateAllPowerDistUpdateEvents mocks base method.
+func (m *MockBTCStakingKeeper) IterateAllPowerDistUpdateEvents(ctx context.Context, handleFunc func(uint32, *types1.EventPowerDistUpdate) bool) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "IterateAllPowerDistUpdateEvents", ctx, handleFunc)
+}
+
+// IterateAllPowerDistUpdateEvents indicates an expected call of IterateAllPowerDistUpdateEvents.
+func (mr *MockBTCStakingKeeperMockRecorder) IterateAllPowerDistUpdateEvents(ctx, handleFunc interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateAllPowerDistUpdateEvents", reflect.TypeOf((*MockBTCStakingKeeper)(nil).IterateAllPowerDistUpdateEvents), ctx, handleFunc)
+}
+
+// IterateBTCDelegations mocks base method.
+func (m *MockBTCStakingKeeper) IterateBTCDelegations(ctx context.Context, fn func(*types1.BTCDelegation) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IterateBTCDelegations", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// IterateBTCDelegations indicates an expected call of IterateBTCDelegations.
+func (mr *MockBTCStakingKeeperMockRecorder) IterateBTCDelegations(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IterateBTCDelegations", reflect.TypeOf((*MockBTCStakingKeeper)(nil).IterateBTCDelegations), ctx, fn)
+}
+
 // JailFinalityProvider mocks base method.
 func (m *MockBTCStakingKeeper) JailFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
 	m.ctrl.T.Helper()
@@ -208,6 +249,20 @@ func (mr *MockBTCStakingKeeperMockRecorder) PowerDistUpdateEventBtcHeightStoreIt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PowerDistUpdateEventBtcHeightStoreIterator", reflect.TypeOf((*MockBTCStakingKeeper)(nil).PowerDistUpdateEventBtcHeightStoreIterator), ctx, btcHeight)
 }
 
+// RotateFinalityProviderKey mocks base method.
+func (m *MockBTCStakingKeeper) RotateFinalityProviderKey(ctx context.Context, fpBtcPk, newEotsPk *types0.BIP340PubKey, activationHeight uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateFinalityProviderKey", ctx, fpBtcPk, newEotsPk, activationHeight)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RotateFinalityProviderKey indicates an expected call of RotateFinalityProviderKey.
+func (mr *MockBTCStakingKeeperMockRecorder) RotateFinalityProviderKey(ctx, fpBtcPk, newEotsPk, activationHeight interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateFinalityProviderKey", reflect.TypeOf((*MockBTCStakingKeeper)(nil).RotateFinalityProviderKey), ctx, fpBtcPk, newEotsPk, activationHeight)
+}
+
 // SlashFinalityProvider mocks base method.
 func (m *MockBTCStakingKeeper) SlashFinalityProvider(ctx context.Context, fpBTCPK []byte) error {
 	m.ctrl.T.Helper()
@@ -20,9 +20,13 @@ var (
 	_ sdk.Msg = &MsgResumeFinalityProposal{}
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgAddFinalitySig{}
+	_ sdk.Msg = &MsgAddFinalitySigs{}
+	_ sdk.Msg = &MsgRotateFinalityProviderKey{}
 	_ sdk.Msg = &MsgCommitPubRandList{}
 
 	_ sdk.HasValidateBasic = &MsgResumeFinalityProposal{}
+	_ sdk.HasValidateBasic = &MsgAddFinalitySigs{}
+	_ sdk.HasValidateBasic = &MsgRotateFinalityProviderKey{}
 )
 
 const ExpectedCommitmentLengthBytes = 32
@@ -71,11 +75,66 @@ func (m *MsgAddFinalitySig) ValidateBasic() error {
 	return nil
 }
 
+// ToMsgAddFinalitySig returns the MsgAddFinalitySig corresponding to the
+// i-th finality signature of the batch
+func (m *MsgAddFinalitySigs) ToMsgAddFinalitySig(i int) *MsgAddFinalitySig {
+	entry := m.Sigs[i]
+	return &MsgAddFinalitySig{
+		Signer:       m.Signer,
+		FpBtcPk:      m.FpBtcPk,
+		BlockHeight:  entry.BlockHeight,
+		PubRand:      entry.PubRand,
+		Proof:        entry.Proof,
+		BlockAppHash: entry.BlockAppHash,
+		FinalitySig:  entry.FinalitySig,
+	}
+}
+
+// ValidateBasic performs stateless validation on MsgAddFinalitySigs
+func (m *MsgAddFinalitySigs) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: got %s", m.Signer)
+	}
+
+	if len(m.Sigs) == 0 {
+		return ErrInvalidFinalitySigBatch.Wrap("empty list of finality signatures")
+	}
+
+	if len(m.Sigs) > MaxFinalitySigsPerBatch {
+		return ErrInvalidFinalitySigBatch.Wrapf("too many finality signatures: got %d, max %d", len(m.Sigs), MaxFinalitySigsPerBatch)
+	}
+
+	for i, entry := range m.Sigs {
+		if entry == nil {
+			return ErrInvalidFinalitySigBatch.Wrapf("empty finality signature at index %d", i)
+		}
+		// heights have to be strictly increasing so that the batch does not
+		// contain duplicated votes and its processing order is unambiguous
+		if i > 0 && entry.BlockHeight <= m.Sigs[i-1].BlockHeight {
+			return ErrInvalidFinalitySigBatch.Wrapf("block heights are not strictly increasing: %d at index %d after %d",
+				entry.BlockHeight, i, m.Sigs[i-1].BlockHeight)
+		}
+		if err := m.ToMsgAddFinalitySig(i).ValidateBasic(); err != nil {
+			return ErrInvalidFinalitySigBatch.Wrapf("invalid finality signature at index %d: %v", i, err)
+		}
+	}
+
+	return nil
+}
+
 // VerifyFinalitySig verifies the finality signature message w.r.t. the
 // public randomness commitment. The verification includes
 // - verifying the proof of inclusion of the given public randomness
 // - verifying the finality signature w.r.t. the given block height/hash
 func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
+	return VerifyFinalitySigWithEotsPk(m, prCommit, m.FpBtcPk)
+}
+
+// VerifyFinalitySigWithEotsPk is the same as VerifyFinalitySig, except that
+// the finality signature is verified w.r.t. the given EOTS public key of the
+// finality provider, which differs from its BTC public key after a key
+// rotation
+func VerifyFinalitySigWithEotsPk(m *MsgAddFinalitySig, prCommit *PubRandCommit, eotsPk *bbn.BIP340PubKey) error {
 	// verify the index of the public randomness
 	heightOfProof := prCommit.StartHeight + uint64(m.Proof.Index)
 	if m.BlockHeight != heightOfProof {
@@ -96,7 +155,7 @@ func VerifyFinalitySig(m *MsgAddFinalitySig, prCommit *PubRandCommit) error {
 
 	// public randomness is good, verify finality signature
 	msgToSign := m.MsgToSign()
-	pk, err := m.FpBtcPk.ToBTCPK()
+	pk, err := eotsPk.ToBTCPK()
 	if err != nil {
 		return err
 	}
@@ -120,11 +179,17 @@ func (m *MsgCommitPubRandList) HashToSign() ([]byte, error) {
 }
 
 func (m *MsgCommitPubRandList) VerifySig() error {
+	return m.VerifySigWithEotsPk(m.FpBtcPk)
+}
+
+// VerifySigWithEotsPk verifies the signature over the public randomness
+// commitment w.r.t. the given EOTS public key of the finality provider
+func (m *MsgCommitPubRandList) VerifySigWithEotsPk(eotsPk *bbn.BIP340PubKey) error {
 	msgHash, err := m.HashToSign()
 	if err != nil {
 		return err
 	}
-	pk, err := m.FpBtcPk.ToBTCPK()
+	pk, err := eotsPk.ToBTCPK()
 	if err != nil {
 		return err
 	}
@@ -192,3 +257,90 @@ func (m *MsgResumeFinalityProposal) ValidateBasic() error {
 
 	return nil
 }
+
+// HashToSign returns a 32-byte hash of
+// (chain_id || signer || fp_btc_pk || new_eots_pk || activation_height).
+// Both signatures in MsgRotateFinalityProviderKey are on this hash. The chain
+// ID ensures the signatures cannot be replayed on another chain
+func (m *MsgRotateFinalityProviderKey) HashToSign(chainID string) ([]byte, error) {
+	hasher := tmhash.New()
+	if _, err := hasher.Write([]byte(chainID)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write([]byte(m.Signer)); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(m.FpBtcPk.MustMarshal()); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(m.NewEotsPk.MustMarshal()); err != nil {
+		return nil, err
+	}
+	if _, err := hasher.Write(sdk.Uint64ToBigEndian(m.ActivationHeight)); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
+
+// VerifySigs verifies the signatures of the current EOTS key of the finality
+// provider and of the successor key over the rotation on the given chain
+func (m *MsgRotateFinalityProviderKey) VerifySigs(chainID string, currentEotsPk *bbn.BIP340PubKey) error {
+	msgHash, err := m.HashToSign(chainID)
+	if err != nil {
+		return err
+	}
+	if err := verifyBIP340Sig(msgHash, currentEotsPk, m.CurrentKeySig); err != nil {
+		return fmt.Errorf("invalid signature by the current key: %w", err)
+	}
+	if err := verifyBIP340Sig(msgHash, m.NewEotsPk, m.NewKeySig); err != nil {
+		return fmt.Errorf("invalid signature by the new key: %w", err)
+	}
+	return nil
+}
+
+func verifyBIP340Sig(msgHash []byte, pk *bbn.BIP340PubKey, sig *bbn.BIP340Signature) error {
+	btcPK, err := pk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	schnorrSig, err := sig.ToBTCSig()
+	if err != nil {
+		return err
+	}
+	if !schnorrSig.Verify(msgHash, btcPK) {
+		return fmt.Errorf("failed to verify signature")
+	}
+	return nil
+}
+
+// ValidateBasic performs stateless validation on MsgRotateFinalityProviderKey
+func (m *MsgRotateFinalityProviderKey) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid signer address: got %s", m.Signer)
+	}
+	if m.FpBtcPk == nil {
+		return ErrInvalidKeyRotation.Wrap("empty finality provider BTC public key")
+	}
+	if _, err := m.FpBtcPk.ToBTCPK(); err != nil {
+		return ErrInvalidKeyRotation.Wrapf("invalid finality provider BTC public key: %v", err)
+	}
+	if m.NewEotsPk == nil {
+		return ErrInvalidKeyRotation.Wrap("empty new EOTS public key")
+	}
+	if _, err := m.NewEotsPk.ToBTCPK(); err != nil {
+		return ErrInvalidKeyRotation.Wrapf("invalid new EOTS public key: %v", err)
+	}
+	if m.NewEotsPk.Equals(m.FpBtcPk) {
+		return ErrInvalidKeyRotation.Wrap("the new EOTS public key is the same as the finality provider BTC public key")
+	}
+	if m.ActivationHeight == 0 {
+		return ErrInvalidKeyRotation.Wrap("zero activation height")
+	}
+	if m.CurrentKeySig == nil {
+		return ErrInvalidKeyRotation.Wrap("empty signature by the current key")
+	}
+	if m.NewKeySig == nil {
+		return ErrInvalidKeyRotation.Wrap("empty signature by the new key")
+	}
+	return nil
+}
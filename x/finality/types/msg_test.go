@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/babylonlabs-io/babylon/v4/crypto/eots"
 	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
 	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
@@ -129,6 +130,196 @@ func TestMsgCommitPubRandListValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgAddFinalitySigsValidateBasic(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	sk, err := eots.KeyGen(r)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name        string
+		msgModifier func(*types.MsgAddFinalitySigs)
+		expectErr   bool
+		errString   string
+	}{
+		{
+			name:        "valid message",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {},
+			expectErr:   false,
+		},
+		{
+			name: "invalid signer",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				msg.Signer = "invalid-address"
+			},
+			expectErr: true,
+			errString: "invalid signer address",
+		},
+		{
+			name: "empty batch",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				msg.Sigs = nil
+			},
+			expectErr: true,
+			errString: "empty list of finality signatures",
+		},
+		{
+			name: "too many finality signatures",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				for len(msg.Sigs) <= types.MaxFinalitySigsPerBatch {
+					msg.Sigs = append(msg.Sigs, msg.Sigs[0])
+				}
+			},
+			expectErr: true,
+			errString: "too many finality signatures",
+		},
+		{
+			name: "duplicated block height",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				msg.Sigs = append(msg.Sigs, msg.Sigs[len(msg.Sigs)-1])
+			},
+			expectErr: true,
+			errString: "block heights are not strictly increasing",
+		},
+		{
+			name: "decreasing block heights",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				msg.Sigs[0], msg.Sigs[1] = msg.Sigs[1], msg.Sigs[0]
+			},
+			expectErr: true,
+			errString: "block heights are not strictly increasing",
+		},
+		{
+			name: "nil entry",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				msg.Sigs[1] = nil
+			},
+			expectErr: true,
+			errString: "empty finality signature at index 1",
+		},
+		{
+			name: "invalid entry",
+			msgModifier: func(msg *types.MsgAddFinalitySigs) {
+				msg.Sigs[1].BlockAppHash = []byte("too-short")
+			},
+			expectErr: true,
+			errString: "invalid finality signature at index 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			numPubRand := uint64(100)
+			randListInfo, err := datagen.GenRandomPubRandList(r, numPubRand)
+			require.NoError(t, err)
+
+			startHeight := datagen.RandomInt(r, 10)
+			blockHeights := []uint64{startHeight + 1, startHeight + 2, startHeight + 3}
+			blockHashes := [][]byte{
+				datagen.GenRandomByteArray(r, 32),
+				datagen.GenRandomByteArray(r, 32),
+				datagen.GenRandomByteArray(r, 32),
+			}
+
+			signer := datagen.GenRandomAccount().Address
+			msg, err := datagen.NewMsgAddFinalitySigs(signer, sk, startHeight, blockHeights, randListInfo, blockHashes)
+			require.NoError(t, err)
+
+			tc.msgModifier(msg)
+
+			err = msg.ValidateBasic()
+
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errString)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRotateFinalityProviderKey(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	currentSK, err := eots.KeyGen(r)
+	require.NoError(t, err)
+	newSK, err := eots.KeyGen(r)
+	require.NoError(t, err)
+	fpBtcPk := bbn.NewBIP340PubKeyFromBTCPK(currentSK.PubKey())
+
+	tests := []struct {
+		name        string
+		msgModifier func(*types.MsgRotateFinalityProviderKey)
+		errString   string
+	}{
+		{
+			name:        "valid message",
+			msgModifier: func(msg *types.MsgRotateFinalityProviderKey) {},
+		},
+		{
+			name: "invalid signer",
+			msgModifier: func(msg *types.MsgRotateFinalityProviderKey) {
+				msg.Signer = "invalid-address"
+			},
+			errString: "invalid signer address",
+		},
+		{
+			name: "empty new EOTS public key",
+			msgModifier: func(msg *types.MsgRotateFinalityProviderKey) {
+				msg.NewEotsPk = nil
+			},
+			errString: "empty new EOTS public key",
+		},
+		{
+			name: "rotation to the finality provider BTC public key",
+			msgModifier: func(msg *types.MsgRotateFinalityProviderKey) {
+				msg.NewEotsPk = msg.FpBtcPk
+			},
+			errString: "the new EOTS public key is the same",
+		},
+		{
+			name: "zero activation height",
+			msgModifier: func(msg *types.MsgRotateFinalityProviderKey) {
+				msg.ActivationHeight = 0
+			},
+			errString: "zero activation height",
+		},
+		{
+			name: "empty signature by the new key",
+			msgModifier: func(msg *types.MsgRotateFinalityProviderKey) {
+				msg.NewKeySig = nil
+			},
+			errString: "empty signature by the new key",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			signer := datagen.GenRandomAccount().Address
+			chainID := datagen.GenRandomHexStr(r, 10)
+			msg, err := datagen.NewMsgRotateFinalityProviderKey(chainID, signer, fpBtcPk, currentSK, newSK, datagen.RandomInt(r, 1000)+1)
+			require.NoError(t, err)
+
+			tc.msgModifier(msg)
+
+			err = msg.ValidateBasic()
+			if tc.errString != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.errString)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, msg.VerifySigs(chainID, fpBtcPk))
+
+			// the signatures are bound to the chain
+			require.Error(t, msg.VerifySigs(chainID+"-other", fpBtcPk))
+
+			// the signatures are bound to the message content
+			msg.ActivationHeight++
+			require.Error(t, msg.VerifySigs(chainID, fpBtcPk))
+		})
+	}
+}
+
 func TestMsgResumeFinalityProposal_ValidateBasic(t *testing.T) {
 	t.Parallel()
 	r := rand.New(rand.NewSource(time.Now().Unix()))
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	stdmath "math"
 	"time"
 
 	"cosmossdk.io/math"
@@ -23,6 +24,16 @@ const (
 	// be 27360 + 220 = 27600.
 	// For now it is set to 1 to avoid breaking dependencies.
 	DefaultFinalityActivationHeight = 1
+	// The public randomness, votes and indexed blocks are retained for
+	// 14 days at a block time of 10s
+	DefaultStateRetentionWindow = uint64(120960)
+	// Finality is considered halted if no block is finalized for 50 minutes
+	// at a block time of 10s
+	DefaultFinalityHaltThreshold = uint64(300)
+	// The jail duration of 1 day doubles up to 32 days, and a finality
+	// provider is tombstoned after being jailed 7 times
+	DefaultMaxJailDuration    = 32 * DefaultJailDuration
+	DefaultTombstoneJailCount = uint32(7)
 )
 
 var (
@@ -41,6 +52,10 @@ func DefaultParams() Params {
 		MinPubRand:                 DefaultMinPubRand,
 		JailDuration:               DefaultJailDuration,
 		FinalityActivationHeight:   DefaultFinalityActivationHeight,
+		StateRetentionWindow:       DefaultStateRetentionWindow,
+		FinalityHaltThreshold:      DefaultFinalityHaltThreshold,
+		MaxJailDuration:            DefaultMaxJailDuration,
+		TombstoneJailCount:         DefaultTombstoneJailCount,
 	}
 }
 
@@ -85,6 +100,46 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateJailDurations(p.JailDuration, p.MaxJailDuration); err != nil {
+		return err
+	}
+
+	if err := validateStateRetentionWindow(p.StateRetentionWindow, p.FinalitySigTimeout, p.SignedBlocksWindow); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStateRetentionWindow checks that the state retention window, if the
+// pruning is enabled, retains the votes of a height until the liveness of the
+// finality providers at the height is checked, i.e., for longer than the
+// finality signature timeout, and covers the signed blocks window
+func validateStateRetentionWindow(stateRetentionWindow uint64, finalitySigTimeout, signedBlocksWindow int64) error {
+	if stateRetentionWindow == 0 {
+		return nil
+	}
+	if stateRetentionWindow <= uint64(finalitySigTimeout) {
+		return fmt.Errorf("state retention window %d must be greater than the finality sig timeout %d", stateRetentionWindow, finalitySigTimeout)
+	}
+	if stateRetentionWindow < uint64(signedBlocksWindow) {
+		return fmt.Errorf("state retention window %d must not be lower than the signed blocks window %d", stateRetentionWindow, signedBlocksWindow)
+	}
+	return nil
+}
+
+// validateJailDurations checks that the jail duration is not negative and
+// that the maximum jail duration, if any, is not lower than it
+func validateJailDurations(jailDuration, maxJailDuration time.Duration) error {
+	if jailDuration < 0 {
+		return fmt.Errorf("jail duration cannot be negative: %v", jailDuration)
+	}
+	if maxJailDuration < 0 {
+		return fmt.Errorf("max jail duration cannot be negative: %v", maxJailDuration)
+	}
+	if maxJailDuration != 0 && maxJailDuration < jailDuration {
+		return fmt.Errorf("max jail duration %v is lower than jail duration %v", maxJailDuration, jailDuration)
+	}
 	return nil
 }
 
@@ -151,3 +206,24 @@ func (p *Params) MinSignedPerWindowInt() int64 {
 	//       less than 1.
 	return minSignedPerWindow.MulInt64(signedBlocksWindow).RoundInt64()
 }
+
+// JailDurationForCount returns the duration of jailing a finality provider
+// for the jailCount-th time due to downtime, i.e., JailDuration doubled for
+// each previous jailing, capped at MaxJailDuration if set
+func (p *Params) JailDurationForCount(jailCount uint64) time.Duration {
+	duration := p.JailDuration
+	for i := uint64(1); i < jailCount; i++ {
+		if p.MaxJailDuration != 0 && duration >= p.MaxJailDuration {
+			break
+		}
+		if duration > stdmath.MaxInt64/2 {
+			// avoid overflow
+			return time.Duration(stdmath.MaxInt64)
+		}
+		duration *= 2
+	}
+	if p.MaxJailDuration != 0 && duration > p.MaxJailDuration {
+		return p.MaxJailDuration
+	}
+	return duration
+}
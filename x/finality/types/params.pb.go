@@ -51,6 +51,25 @@ type Params struct {
 	// start to accept finality voting and the minimum allowed value for the public randomness
 	// commit start height.
 	FinalityActivationHeight uint64 `protobuf:"varint,7,opt,name=finality_activation_height,json=finalityActivationHeight,proto3" json:"finality_activation_height,omitempty"`
+	// state_retention_window is the number of blocks for which the public
+	// randomness, votes and indexed blocks of finalized and rewarded heights are
+	// retained before being pruned. Zero disables the pruning. Otherwise, it
+	// must be greater than finality_sig_timeout and not lower than
+	// signed_blocks_window.
+	StateRetentionWindow uint64 `protobuf:"varint,8,opt,name=state_retention_window,json=stateRetentionWindow,proto3" json:"state_retention_window,omitempty"`
+	// finality_halt_threshold is the number of blocks produced since the first
+	// non-finalized block after which finality is considered halted. Zero
+	// disables the detection of finality halts.
+	FinalityHaltThreshold uint64 `protobuf:"varint,9,opt,name=finality_halt_threshold,json=finalityHaltThreshold,proto3" json:"finality_halt_threshold,omitempty"`
+	// max_jail_duration is the maximum period of time that a finality provider
+	// remains jailed for downtime. The jail duration starts from jail_duration
+	// and doubles each time the finality provider is jailed again. Zero means
+	// no maximum.
+	MaxJailDuration time.Duration `protobuf:"bytes,10,opt,name=max_jail_duration,json=maxJailDuration,proto3,stdduration" json:"max_jail_duration"`
+	// tombstone_jail_count is the number of times a finality provider can be
+	// jailed for downtime before being tombstoned, i.e., it can never be
+	// unjailed again. Zero disables tombstoning.
+	TombstoneJailCount uint32 `protobuf:"varint,11,opt,name=tombstone_jail_count,json=tombstoneJailCount,proto3" json:"tombstone_jail_count,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -127,6 +146,34 @@ func (m *Params) GetFinalityActivationHeight() uint64 {
 	return 0
 }
 
+func (m *Params) GetStateRetentionWindow() uint64 {
+	if m != nil {
+		return m.StateRetentionWindow
+	}
+	return 0
+}
+
+func (m *Params) GetFinalityHaltThreshold() uint64 {
+	if m != nil {
+		return m.FinalityHaltThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMaxJailDuration() time.Duration {
+	if m != nil {
+		return m.MaxJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetTombstoneJailCount() uint32 {
+	if m != nil {
+		return m.TombstoneJailCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "babylon.finality.v1.Params")
 }
@@ -134,38 +181,44 @@ func init() {
 func init() { proto.RegisterFile("babylon/finality/v1/params.proto", fileDescriptor_25539c9a61c72ee9) }
 
 var fileDescriptor_25539c9a61c72ee9 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x92, 0x3f, 0x8f, 0xd3, 0x30,
-	0x18, 0xc6, 0x6b, 0xae, 0x14, 0x29, 0xb4, 0x03, 0xe1, 0x90, 0x72, 0x45, 0xa4, 0x11, 0x53, 0x85,
-	0x74, 0x31, 0xc7, 0xbf, 0x01, 0xb1, 0xb4, 0xaa, 0x10, 0x03, 0x88, 0xaa, 0x87, 0x84, 0xc4, 0x12,
-	0x39, 0x89, 0xcf, 0x7d, 0xb9, 0xd8, 0xae, 0x62, 0xa7, 0xd7, 0x7e, 0x0b, 0xc6, 0x1b, 0x19, 0x19,
-	0x19, 0xf8, 0x10, 0xb7, 0x71, 0x62, 0x42, 0x0c, 0x07, 0x6a, 0x07, 0xbe, 0x06, 0x8a, 0x1d, 0x97,
-	0x25, 0xca, 0xeb, 0xe7, 0xf7, 0xfa, 0x79, 0xf3, 0xe4, 0xf5, 0xa2, 0x94, 0xa4, 0xeb, 0x42, 0x0a,
-	0x7c, 0x02, 0x82, 0x14, 0xa0, 0xd7, 0x78, 0x79, 0x84, 0x17, 0xa4, 0x24, 0x5c, 0xc5, 0x8b, 0x52,
-	0x6a, 0xe9, 0xdf, 0x6e, 0x88, 0xd8, 0x11, 0xf1, 0xf2, 0xa8, 0xbf, 0xcf, 0x24, 0x93, 0x46, 0xc7,
-	0xf5, 0x9b, 0x45, 0xfb, 0xb7, 0x08, 0x07, 0x21, 0xb1, 0x79, 0x36, 0x47, 0x07, 0x99, 0x54, 0x5c,
-	0xaa, 0xc4, 0xb2, 0xb6, 0x68, 0xa4, 0x90, 0x49, 0xc9, 0x0a, 0x8a, 0x4d, 0x95, 0x56, 0x27, 0x38,
-	0xaf, 0x4a, 0xa2, 0x41, 0x0a, 0xab, 0xdf, 0xff, 0xbe, 0xe7, 0x75, 0xa6, 0x66, 0x12, 0x7f, 0xe4,
-	0xdd, 0xe3, 0x64, 0x95, 0x90, 0x4c, 0xc3, 0x92, 0x26, 0x6e, 0x90, 0xfa, 0xd2, 0x25, 0xe4, 0xb4,
-	0x54, 0x01, 0x8a, 0xd0, 0xb0, 0x37, 0xeb, 0x73, 0xb2, 0x1a, 0x19, 0xe6, 0x65, 0x83, 0x4c, 0x1d,
-	0xe1, 0x3f, 0xf4, 0xf6, 0x15, 0x30, 0x41, 0xf3, 0x24, 0x2d, 0x64, 0x76, 0xaa, 0x92, 0x33, 0x10,
-	0xb9, 0x3c, 0x0b, 0xae, 0x45, 0x68, 0xb8, 0x37, 0xf3, 0xad, 0x36, 0x36, 0xd2, 0x7b, 0xa3, 0xd4,
-	0x1d, 0x3b, 0x27, 0x05, 0x2c, 0xd1, 0xc0, 0xa9, 0xac, 0x74, 0xb0, 0x67, 0x3b, 0x9c, 0x76, 0x0c,
-	0xec, 0x9d, 0x55, 0x7c, 0xf0, 0xee, 0x70, 0x10, 0x49, 0xe3, 0xb3, 0xa0, 0xa5, 0x33, 0x69, 0x47,
-	0x68, 0xd8, 0x1d, 0x3f, 0xbb, 0xb8, 0x1a, 0xb4, 0x7e, 0x5d, 0x0d, 0xee, 0xda, 0x18, 0x54, 0x7e,
-	0x1a, 0x83, 0xc4, 0x9c, 0xe8, 0x79, 0xfc, 0x9a, 0x32, 0x92, 0xad, 0x27, 0x34, 0xfb, 0xf1, 0xed,
-	0xd0, 0x6b, 0x52, 0x9a, 0xd0, 0xec, 0xcb, 0xdf, 0xaf, 0x0f, 0xd0, 0xcc, 0xe7, 0x20, 0x8e, 0xcd,
-	0x9d, 0x53, 0x5a, 0x36, 0xc3, 0x45, 0x5e, 0xb7, 0xb6, 0x5a, 0x54, 0x69, 0x52, 0x12, 0x91, 0x07,
-	0xd7, 0x23, 0x34, 0x6c, 0xcf, 0x3c, 0x0e, 0x62, 0x5a, 0xa5, 0x33, 0x22, 0x72, 0xff, 0x8d, 0xd7,
-	0xfb, 0x48, 0xa0, 0x48, 0x5c, 0xaa, 0x41, 0x27, 0x42, 0xc3, 0x9b, 0x8f, 0x0e, 0x62, 0x1b, 0x7b,
-	0xec, 0x62, 0x8f, 0x27, 0x0d, 0x30, 0xee, 0xd5, 0xf3, 0x9d, 0xff, 0x1e, 0x20, 0x6b, 0xdb, 0xad,
-	0xdb, 0x9d, 0xe8, 0xbf, 0xf0, 0xfa, 0xbb, 0x34, 0xcc, 0x7f, 0x30, 0xc7, 0xc9, 0x9c, 0x02, 0x9b,
-	0xeb, 0xe0, 0x86, 0xb1, 0x0f, 0x1c, 0x31, 0xda, 0x01, 0xaf, 0x8c, 0xfe, 0xbc, 0x7d, 0xfe, 0x79,
-	0xd0, 0x1a, 0xbf, 0xbd, 0xd8, 0x84, 0xe8, 0x72, 0x13, 0xa2, 0x3f, 0x9b, 0x10, 0x7d, 0xda, 0x86,
-	0xad, 0xcb, 0x6d, 0xd8, 0xfa, 0xb9, 0x0d, 0x5b, 0x1f, 0x9e, 0x32, 0xd0, 0xf3, 0x2a, 0x8d, 0x33,
-	0xc9, 0x71, 0xb3, 0x6f, 0x05, 0x49, 0xd5, 0x21, 0x48, 0x57, 0xe2, 0xe5, 0x13, 0xbc, 0xfa, 0xbf,
-	0xa5, 0x7a, 0xbd, 0xa0, 0x2a, 0xed, 0x98, 0x8f, 0x78, 0xfc, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x03,
-	0x0d, 0x1b, 0xa1, 0xc6, 0x02, 0x00, 0x00,
+	// 584 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x1c, 0xc5, 0x1b, 0xd6, 0x15, 0xf0, 0x5a, 0xa1, 0x85, 0x0e, 0xb2, 0x22, 0xd2, 0x88, 0x53, 0x85,
+	0xb4, 0x64, 0x83, 0xb1, 0x03, 0xe2, 0xd2, 0x52, 0xa1, 0x09, 0x81, 0xa8, 0xb2, 0x4a, 0x48, 0x5c,
+	0x2c, 0x27, 0xf1, 0x12, 0xb3, 0xd8, 0xae, 0x62, 0xa7, 0x6b, 0xbf, 0x05, 0xc7, 0x1d, 0x39, 0x72,
+	0xe4, 0xc0, 0x57, 0x40, 0xda, 0x71, 0xe2, 0x84, 0x38, 0x0c, 0xd4, 0x1e, 0xf8, 0x1a, 0x28, 0x4e,
+	0x5c, 0xe0, 0xb8, 0x4b, 0x55, 0xe7, 0xf7, 0x9e, 0xdf, 0xdf, 0x2f, 0x31, 0x70, 0x02, 0x14, 0xcc,
+	0x53, 0xce, 0xbc, 0x63, 0xc2, 0x50, 0x4a, 0xe4, 0xdc, 0x9b, 0xee, 0x79, 0x13, 0x94, 0x21, 0x2a,
+	0xdc, 0x49, 0xc6, 0x25, 0x37, 0x6f, 0x57, 0x0a, 0x57, 0x2b, 0xdc, 0xe9, 0x5e, 0xa7, 0x1d, 0xf3,
+	0x98, 0x2b, 0xee, 0x15, 0xff, 0x4a, 0x69, 0x67, 0x13, 0x51, 0xc2, 0xb8, 0xa7, 0x7e, 0xab, 0x47,
+	0xdb, 0x21, 0x17, 0x94, 0x0b, 0x58, 0x6a, 0xcb, 0x45, 0x85, 0xec, 0x98, 0xf3, 0x38, 0xc5, 0x9e,
+	0x5a, 0x05, 0xf9, 0xb1, 0x17, 0xe5, 0x19, 0x92, 0x84, 0xb3, 0x92, 0x3f, 0xf8, 0xba, 0x0e, 0x1a,
+	0x23, 0x35, 0x89, 0xd9, 0x07, 0xf7, 0x29, 0x9a, 0x41, 0x14, 0x4a, 0x32, 0xc5, 0x50, 0x0f, 0x52,
+	0x6c, 0x3a, 0x25, 0x11, 0xce, 0x84, 0x65, 0x38, 0x46, 0xaf, 0xe5, 0x77, 0x28, 0x9a, 0xf5, 0x95,
+	0xe6, 0x45, 0x25, 0x19, 0x69, 0x85, 0xb9, 0x0b, 0xda, 0x82, 0xc4, 0x0c, 0x47, 0x30, 0x48, 0x79,
+	0x78, 0x22, 0xe0, 0x29, 0x61, 0x11, 0x3f, 0xb5, 0xae, 0x39, 0x46, 0x6f, 0xcd, 0x37, 0x4b, 0x36,
+	0x50, 0xe8, 0xad, 0x22, 0x85, 0x63, 0x95, 0x24, 0x48, 0x0c, 0x25, 0xa1, 0x98, 0xe7, 0xd2, 0x5a,
+	0x2b, 0x1d, 0x9a, 0x1d, 0x91, 0x78, 0x5c, 0x12, 0x93, 0x80, 0x2d, 0x4a, 0x18, 0xac, 0x72, 0x26,
+	0x38, 0xd3, 0x21, 0x75, 0xc7, 0xe8, 0x35, 0x07, 0x07, 0xe7, 0x97, 0xdd, 0xda, 0x8f, 0xcb, 0xee,
+	0xbd, 0xb2, 0x06, 0x11, 0x9d, 0xb8, 0x84, 0x7b, 0x14, 0xc9, 0xc4, 0x7d, 0x85, 0x63, 0x14, 0xce,
+	0x87, 0x38, 0xfc, 0xf6, 0x65, 0x07, 0x54, 0x2d, 0x0d, 0x71, 0xf8, 0xe9, 0xf7, 0xe7, 0x87, 0x86,
+	0x6f, 0x52, 0xc2, 0x8e, 0xd4, 0x9e, 0x23, 0x9c, 0x55, 0xc3, 0x39, 0xa0, 0x59, 0x44, 0x4d, 0xf2,
+	0x00, 0x66, 0x88, 0x45, 0xd6, 0xba, 0x63, 0xf4, 0xea, 0x3e, 0xa0, 0x84, 0x8d, 0xf2, 0xc0, 0x47,
+	0x2c, 0x32, 0x5f, 0x83, 0xd6, 0x7b, 0x44, 0x52, 0xa8, 0x5b, 0xb5, 0x1a, 0x8e, 0xd1, 0xdb, 0x78,
+	0xb4, 0xed, 0x96, 0xb5, 0xbb, 0xba, 0x76, 0x77, 0x58, 0x09, 0x06, 0xad, 0x62, 0xbe, 0xb3, 0x9f,
+	0x5d, 0xa3, 0x8c, 0x6d, 0x16, 0x76, 0x0d, 0xcd, 0x67, 0xa0, 0xb3, 0x6a, 0x43, 0xbd, 0x07, 0xf5,
+	0x18, 0x26, 0x98, 0xc4, 0x89, 0xb4, 0xae, 0xab, 0x78, 0x4b, 0x2b, 0xfa, 0x2b, 0xc1, 0xa1, 0xe2,
+	0xe6, 0x3e, 0xb8, 0x23, 0x24, 0x92, 0x18, 0x66, 0x58, 0x62, 0xa6, 0x9c, 0x55, 0x35, 0x37, 0x94,
+	0xb3, 0xad, 0xa8, 0xaf, 0x61, 0x75, 0xc8, 0x03, 0x70, 0x77, 0x95, 0x99, 0xa0, 0x54, 0x42, 0x99,
+	0x64, 0x58, 0x24, 0x3c, 0x8d, 0xac, 0x9b, 0xca, 0xb6, 0xa5, 0xf1, 0x21, 0x4a, 0xe5, 0x58, 0x43,
+	0x73, 0x0c, 0x36, 0x8b, 0xcf, 0xe5, 0xff, 0xe3, 0x83, 0x2b, 0x1e, 0xff, 0x16, 0x45, 0xb3, 0x97,
+	0xff, 0x36, 0xb0, 0x0b, 0xda, 0x92, 0xd3, 0x40, 0x48, 0xce, 0x70, 0xb9, 0x77, 0xc8, 0x73, 0x26,
+	0xad, 0x0d, 0xf5, 0xed, 0x99, 0x2b, 0x56, 0x98, 0x9e, 0x17, 0xe4, 0x69, 0xfd, 0xec, 0x63, 0xb7,
+	0x36, 0x78, 0x73, 0xbe, 0xb0, 0x8d, 0x8b, 0x85, 0x6d, 0xfc, 0x5a, 0xd8, 0xc6, 0x87, 0xa5, 0x5d,
+	0xbb, 0x58, 0xda, 0xb5, 0xef, 0x4b, 0xbb, 0xf6, 0xee, 0x49, 0x4c, 0x64, 0x92, 0x07, 0x6e, 0xc8,
+	0xa9, 0x57, 0xdd, 0xb2, 0x14, 0x05, 0x62, 0x87, 0x70, 0xbd, 0xf4, 0xa6, 0xfb, 0xde, 0xec, 0xef,
+	0xdd, 0x94, 0xf3, 0x09, 0x16, 0x41, 0x43, 0xcd, 0xfe, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
+	0x58, 0x2c, 0xfb, 0x3c, 0xbc, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -188,18 +241,41 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.FinalityActivationHeight != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.FinalityActivationHeight))
+	if m.TombstoneJailCount != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TombstoneJailCount))
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x58
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxJailDuration):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x52
+	if m.FinalityHaltThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalityHaltThreshold))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StateRetentionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StateRetentionWindow))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FinalityActivationHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FinalityActivationHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x32
 	if m.MinPubRand != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MinPubRand))
@@ -270,6 +346,17 @@ func (m *Params) Size() (n int) {
 	if m.FinalityActivationHeight != 0 {
 		n += 1 + sovParams(uint64(m.FinalityActivationHeight))
 	}
+	if m.StateRetentionWindow != 0 {
+		n += 1 + sovParams(uint64(m.StateRetentionWindow))
+	}
+	if m.FinalityHaltThreshold != 0 {
+		n += 1 + sovParams(uint64(m.FinalityHaltThreshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	if m.TombstoneJailCount != 0 {
+		n += 1 + sovParams(uint64(m.TombstoneJailCount))
+	}
 	return n
 }
 
@@ -469,6 +556,96 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRetentionWindow", wireType)
+			}
+			m.StateRetentionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateRetentionWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalityHaltThreshold", wireType)
+			}
+			m.FinalityHaltThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalityHaltThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TombstoneJailCount", wireType)
+			}
+			m.TombstoneJailCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TombstoneJailCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -40,6 +40,31 @@ func (si *FinalityProviderSigningInfo) ResetMissedBlocksCounter() {
 	si.MissedBlocksCounter = 0
 }
 
+// Jail updates the signing info of a finality provider that is jailed due to
+// liveness downtime at the given height and time, and returns the record of
+// the jailing. The jail duration doubles each time the finality provider is
+// jailed, and the finality provider is tombstoned once it is jailed
+// TombstoneJailCount times.
+func (si *FinalityProviderSigningInfo) Jail(height int64, jailedAt time.Time, p Params) JailRecord {
+	si.JailCount++
+	si.JailedUntil = jailedAt.Add(p.JailDurationForCount(si.JailCount))
+	if p.TombstoneJailCount > 0 && si.JailCount >= uint64(p.TombstoneJailCount) {
+		si.Tombstoned = true
+	}
+	// reset the counter so that the finality provider won't be immediately
+	// jailed after unjailing
+	si.MissedBlocksCounter = 0
+
+	return JailRecord{
+		FpBtcPk:     si.FpBtcPk,
+		JailCount:   si.JailCount,
+		Height:      height,
+		JailedAt:    jailedAt,
+		JailedUntil: si.JailedUntil,
+		Tombstoned:  si.Tombstoned,
+	}
+}
+
 func (fpsi FinalityProviderSigningInfo) Validate() error {
 	if fpsi.FpBtcPk == nil {
 		return fmt.Errorf("invalid signing info. empty finality provider BTC public key")
@@ -53,5 +78,27 @@ func (fpsi FinalityProviderSigningInfo) Validate() error {
 	if fpsi.MissedBlocksCounter < 0 {
 		return fmt.Errorf("invalid missed blocks counter")
 	}
+	if fpsi.Tombstoned && fpsi.JailCount == 0 {
+		return fmt.Errorf("invalid signing info. tombstoned finality provider is never jailed")
+	}
+	return nil
+}
+
+func (jr JailRecord) Validate() error {
+	if jr.FpBtcPk == nil {
+		return fmt.Errorf("invalid jail record. empty finality provider BTC public key")
+	}
+	if jr.FpBtcPk.Size() != bbntypes.BIP340PubKeyLen {
+		return fmt.Errorf("invalid jail record. finality provider BTC public key length: got %d, want %d", jr.FpBtcPk.Size(), bbntypes.BIP340PubKeyLen)
+	}
+	if jr.JailCount == 0 {
+		return fmt.Errorf("invalid jail record. jail count must be positive")
+	}
+	if jr.Height < 0 {
+		return fmt.Errorf("invalid jail record. invalid height")
+	}
+	if jr.JailedUntil.Before(jr.JailedAt) {
+		return fmt.Errorf("invalid jail record. jailed until %v is before jailed at %v", jr.JailedUntil, jr.JailedAt)
+	}
 	return nil
 }
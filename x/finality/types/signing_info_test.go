@@ -74,6 +74,18 @@ func TestFinalityProviderSigningInfo_Validate(t *testing.T) {
 			expectError:    true,
 			expectedErrMsg: "invalid missed blocks counter",
 		},
+		{
+			name: "tombstoned without being jailed",
+			signingInfo: types.FinalityProviderSigningInfo{
+				FpBtcPk:             fpPk,
+				StartHeight:         100,
+				MissedBlocksCounter: 0,
+				JailedUntil:         time.Unix(0, 0).UTC(),
+				Tombstoned:          true,
+			},
+			expectError:    true,
+			expectedErrMsg: "tombstoned finality provider is never jailed",
+		},
 		{
 			name: "zero values are valid",
 			signingInfo: types.FinalityProviderSigningInfo{
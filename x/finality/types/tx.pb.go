@@ -268,6 +268,245 @@ func (m *MsgAddFinalitySigResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddFinalitySigResponse proto.InternalMessageInfo
 
+// FinalitySigEntry is a finality vote for a single block, as part of a
+// MsgAddFinalitySigs batch
+type FinalitySigEntry struct {
+	// block_height is the height of the voted block
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// pub_rand is the public randomness committed at this height
+	PubRand *github_com_babylonlabs_io_babylon_v4_types.SchnorrPubRand `protobuf:"bytes,2,opt,name=pub_rand,json=pubRand,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.SchnorrPubRand" json:"pub_rand,omitempty"`
+	// proof is the proof that the given public randomness is committed under the commitment
+	Proof *crypto.Proof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof,omitempty"`
+	// block_app_hash is the AppHash of the voted block
+	BlockAppHash []byte `protobuf:"bytes,4,opt,name=block_app_hash,json=blockAppHash,proto3" json:"block_app_hash,omitempty"`
+	// finality_sig is the finality signature to this block
+	FinalitySig *github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig `protobuf:"bytes,5,opt,name=finality_sig,json=finalitySig,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.SchnorrEOTSSig" json:"finality_sig,omitempty"`
+}
+
+func (m *FinalitySigEntry) Reset()         { *m = FinalitySigEntry{} }
+func (m *FinalitySigEntry) String() string { return proto.CompactTextString(m) }
+func (*FinalitySigEntry) ProtoMessage()    {}
+func (*FinalitySigEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+}
+func (m *FinalitySigEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalitySigEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalitySigEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalitySigEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySigEntry.Merge(m, src)
+}
+func (m *FinalitySigEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalitySigEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySigEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySigEntry proto.InternalMessageInfo
+
+func (m *FinalitySigEntry) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FinalitySigEntry) GetProof() *crypto.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *FinalitySigEntry) GetBlockAppHash() []byte {
+	if m != nil {
+		return m.BlockAppHash
+	}
+	return nil
+}
+
+// MsgAddFinalitySigs defines a message for adding finality votes of a
+// finality provider to a list of blocks in a single transaction
+type MsgAddFinalitySigs struct {
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK of the finality provider that casts these votes
+	FpBtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// sigs is the list of finality votes, in strictly increasing order of
+	// block height
+	Sigs []*FinalitySigEntry `protobuf:"bytes,3,rep,name=sigs,proto3" json:"sigs,omitempty"`
+}
+
+func (m *MsgAddFinalitySigs) Reset()         { *m = MsgAddFinalitySigs{} }
+func (m *MsgAddFinalitySigs) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigs) ProtoMessage()    {}
+func (*MsgAddFinalitySigs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+}
+func (m *MsgAddFinalitySigs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigs.Merge(m, src)
+}
+func (m *MsgAddFinalitySigs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigs proto.InternalMessageInfo
+
+func (m *MsgAddFinalitySigs) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgAddFinalitySigs) GetSigs() []*FinalitySigEntry {
+	if m != nil {
+		return m.Sigs
+	}
+	return nil
+}
+
+// FinalitySigResult is the result of processing a single finality vote of a
+// MsgAddFinalitySigs batch
+type FinalitySigResult struct {
+	// block_height is the height of the voted block
+	BlockHeight uint64 `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// accepted is whether the finality vote is accepted
+	Accepted bool `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// error is the reason why the finality vote is rejected, if any
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FinalitySigResult) Reset()         { *m = FinalitySigResult{} }
+func (m *FinalitySigResult) String() string { return proto.CompactTextString(m) }
+func (*FinalitySigResult) ProtoMessage()    {}
+func (*FinalitySigResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+}
+func (m *FinalitySigResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalitySigResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalitySigResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalitySigResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalitySigResult.Merge(m, src)
+}
+func (m *FinalitySigResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalitySigResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalitySigResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalitySigResult proto.InternalMessageInfo
+
+func (m *FinalitySigResult) GetBlockHeight() uint64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *FinalitySigResult) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *FinalitySigResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// MsgAddFinalitySigsResponse is the response to the MsgAddFinalitySigs message
+type MsgAddFinalitySigsResponse struct {
+	// results is the list of results of the finality votes, in the same order
+	// as in the request
+	Results []*FinalitySigResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *MsgAddFinalitySigsResponse) Reset()         { *m = MsgAddFinalitySigsResponse{} }
+func (m *MsgAddFinalitySigsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddFinalitySigsResponse) ProtoMessage()    {}
+func (*MsgAddFinalitySigsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddFinalitySigsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddFinalitySigsResponse.Merge(m, src)
+}
+func (m *MsgAddFinalitySigsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddFinalitySigsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddFinalitySigsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddFinalitySigsResponse proto.InternalMessageInfo
+
+func (m *MsgAddFinalitySigsResponse) GetResults() []*FinalitySigResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
 // MsgUpdateParams defines a message for updating finality module parameters.
 type MsgUpdateParams struct {
 	// authority is the address of the governance account.
@@ -285,7 +524,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{4}
+	return fileDescriptor_2dd6da066b6baf1d, []int{8}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -336,7 +575,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{5}
+	return fileDescriptor_2dd6da066b6baf1d, []int{9}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -376,7 +615,7 @@ func (m *MsgUnjailFinalityProvider) Reset()         { *m = MsgUnjailFinalityProv
 func (m *MsgUnjailFinalityProvider) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjailFinalityProvider) ProtoMessage()    {}
 func (*MsgUnjailFinalityProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{6}
+	return fileDescriptor_2dd6da066b6baf1d, []int{10}
 }
 func (m *MsgUnjailFinalityProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -413,7 +652,7 @@ func (m *MsgUnjailFinalityProviderResponse) Reset()         { *m = MsgUnjailFina
 func (m *MsgUnjailFinalityProviderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnjailFinalityProviderResponse) ProtoMessage()    {}
 func (*MsgUnjailFinalityProviderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{7}
+	return fileDescriptor_2dd6da066b6baf1d, []int{11}
 }
 func (m *MsgUnjailFinalityProviderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -460,7 +699,7 @@ func (m *MsgResumeFinalityProposal) Reset()         { *m = MsgResumeFinalityProp
 func (m *MsgResumeFinalityProposal) String() string { return proto.CompactTextString(m) }
 func (*MsgResumeFinalityProposal) ProtoMessage()    {}
 func (*MsgResumeFinalityProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{8}
+	return fileDescriptor_2dd6da066b6baf1d, []int{12}
 }
 func (m *MsgResumeFinalityProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -518,7 +757,7 @@ func (m *MsgResumeFinalityProposalResponse) Reset()         { *m = MsgResumeFina
 func (m *MsgResumeFinalityProposalResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgResumeFinalityProposalResponse) ProtoMessage()    {}
 func (*MsgResumeFinalityProposalResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2dd6da066b6baf1d, []int{9}
+	return fileDescriptor_2dd6da066b6baf1d, []int{13}
 }
 func (m *MsgResumeFinalityProposalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -547,77 +786,206 @@ func (m *MsgResumeFinalityProposalResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgResumeFinalityProposalResponse proto.InternalMessageInfo
 
+// MsgRotateFinalityProviderKey defines a message for rotating the EOTS key
+// of a finality provider to a successor key
+type MsgRotateFinalityProviderKey struct {
+	// signer is the Babylon address of the finality provider
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// fp_btc_pk is the BTC PK identifying the finality provider
+	FpBtcPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,2,opt,name=fp_btc_pk,json=fpBtcPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"fp_btc_pk,omitempty"`
+	// new_eots_pk is the successor EOTS PK of the finality provider
+	NewEotsPk *github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey `protobuf:"bytes,3,opt,name=new_eots_pk,json=newEotsPk,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340PubKey" json:"new_eots_pk,omitempty"`
+	// activation_height is the Babylon height from which new_eots_pk is used
+	// to commit public randomness and cast finality votes
+	ActivationHeight uint64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// current_key_sig is the signature on
+	// (chain_id || signer || fp_btc_pk || new_eots_pk || activation_height) by the
+	// current EOTS key of the finality provider
+	CurrentKeySig *github_com_babylonlabs_io_babylon_v4_types.BIP340Signature `protobuf:"bytes,5,opt,name=current_key_sig,json=currentKeySig,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340Signature" json:"current_key_sig,omitempty"`
+	// new_key_sig is the signature on the same message by new_eots_pk,
+	// proving the possession of the successor key
+	NewKeySig *github_com_babylonlabs_io_babylon_v4_types.BIP340Signature `protobuf:"bytes,6,opt,name=new_key_sig,json=newKeySig,proto3,customtype=github.com/babylonlabs-io/babylon/v4/types.BIP340Signature" json:"new_key_sig,omitempty"`
+}
+
+func (m *MsgRotateFinalityProviderKey) Reset()         { *m = MsgRotateFinalityProviderKey{} }
+func (m *MsgRotateFinalityProviderKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFinalityProviderKey) ProtoMessage()    {}
+func (*MsgRotateFinalityProviderKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{14}
+}
+func (m *MsgRotateFinalityProviderKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateFinalityProviderKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateFinalityProviderKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateFinalityProviderKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFinalityProviderKey.Merge(m, src)
+}
+func (m *MsgRotateFinalityProviderKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateFinalityProviderKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFinalityProviderKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateFinalityProviderKey proto.InternalMessageInfo
+
+func (m *MsgRotateFinalityProviderKey) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+func (m *MsgRotateFinalityProviderKey) GetActivationHeight() uint64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// MsgRotateFinalityProviderKeyResponse is the response to the
+// MsgRotateFinalityProviderKey message
+type MsgRotateFinalityProviderKeyResponse struct {
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) Reset()         { *m = MsgRotateFinalityProviderKeyResponse{} }
+func (m *MsgRotateFinalityProviderKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateFinalityProviderKeyResponse) ProtoMessage()    {}
+func (*MsgRotateFinalityProviderKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2dd6da066b6baf1d, []int{15}
+}
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateFinalityProviderKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateFinalityProviderKeyResponse.Merge(m, src)
+}
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateFinalityProviderKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateFinalityProviderKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateFinalityProviderKeyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCommitPubRandList)(nil), "babylon.finality.v1.MsgCommitPubRandList")
 	proto.RegisterType((*MsgCommitPubRandListResponse)(nil), "babylon.finality.v1.MsgCommitPubRandListResponse")
 	proto.RegisterType((*MsgAddFinalitySig)(nil), "babylon.finality.v1.MsgAddFinalitySig")
 	proto.RegisterType((*MsgAddFinalitySigResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigResponse")
+	proto.RegisterType((*FinalitySigEntry)(nil), "babylon.finality.v1.FinalitySigEntry")
+	proto.RegisterType((*MsgAddFinalitySigs)(nil), "babylon.finality.v1.MsgAddFinalitySigs")
+	proto.RegisterType((*FinalitySigResult)(nil), "babylon.finality.v1.FinalitySigResult")
+	proto.RegisterType((*MsgAddFinalitySigsResponse)(nil), "babylon.finality.v1.MsgAddFinalitySigsResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "babylon.finality.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "babylon.finality.v1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MsgUnjailFinalityProvider)(nil), "babylon.finality.v1.MsgUnjailFinalityProvider")
 	proto.RegisterType((*MsgUnjailFinalityProviderResponse)(nil), "babylon.finality.v1.MsgUnjailFinalityProviderResponse")
 	proto.RegisterType((*MsgResumeFinalityProposal)(nil), "babylon.finality.v1.MsgResumeFinalityProposal")
 	proto.RegisterType((*MsgResumeFinalityProposalResponse)(nil), "babylon.finality.v1.MsgResumeFinalityProposalResponse")
+	proto.RegisterType((*MsgRotateFinalityProviderKey)(nil), "babylon.finality.v1.MsgRotateFinalityProviderKey")
+	proto.RegisterType((*MsgRotateFinalityProviderKeyResponse)(nil), "babylon.finality.v1.MsgRotateFinalityProviderKeyResponse")
 }
 
 func init() { proto.RegisterFile("babylon/finality/v1/tx.proto", fileDescriptor_2dd6da066b6baf1d) }
 
 var fileDescriptor_2dd6da066b6baf1d = []byte{
-	// 863 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0xf9, 0xd1, 0x8c, 0xdd, 0x54, 0x5d, 0xa2, 0x76, 0xe3, 0x06, 0xdb, 0x0d, 0x05,
-	0x85, 0x8a, 0xee, 0x92, 0xb4, 0x14, 0x35, 0x08, 0xa4, 0x18, 0x81, 0x82, 0x20, 0xea, 0x6a, 0x4d,
-	0x05, 0xe2, 0xb2, 0xcc, 0x7a, 0xc7, 0xb3, 0x83, 0xbd, 0x33, 0xc3, 0xcc, 0x6c, 0x14, 0xdf, 0x2a,
-	0x4e, 0x1c, 0x39, 0x70, 0x45, 0xe2, 0xc8, 0xb1, 0x07, 0xfe, 0x06, 0x54, 0x89, 0x4b, 0x05, 0x17,
-	0x94, 0x43, 0x84, 0x92, 0x43, 0xfe, 0x0d, 0xe4, 0xdd, 0xf1, 0xaf, 0xc4, 0x8b, 0x1c, 0x90, 0xe0,
-	0xb6, 0x33, 0xef, 0x9b, 0x79, 0xdf, 0x7b, 0xdf, 0xf7, 0x46, 0x0b, 0xd6, 0x03, 0x18, 0xf4, 0xba,
-	0x8c, 0x3a, 0x6d, 0x42, 0x61, 0x97, 0xa8, 0x9e, 0x73, 0xb0, 0xe5, 0xa8, 0x43, 0x9b, 0x0b, 0xa6,
-	0x98, 0xf9, 0x92, 0x8e, 0xda, 0x83, 0xa8, 0x7d, 0xb0, 0x55, 0x59, 0xc5, 0x0c, 0xb3, 0x34, 0xee,
-	0xf4, 0xbf, 0x32, 0x68, 0xe5, 0x65, 0x85, 0x68, 0x88, 0x44, 0x4c, 0xa8, 0x72, 0x5a, 0xa2, 0xc7,
-	0x15, 0x73, 0xb8, 0x60, 0xac, 0xad, 0xc3, 0x6b, 0x2d, 0x26, 0x63, 0x26, 0xfd, 0xec, 0x5c, 0xb6,
-	0xd0, 0xa1, 0x9b, 0xd9, 0xca, 0x89, 0x25, 0xee, 0x27, 0x8f, 0x25, 0xd6, 0x81, 0xfa, 0x34, 0x6e,
-	0x1c, 0x0a, 0x18, 0xeb, 0xa3, 0x1b, 0xbf, 0xce, 0x81, 0xd5, 0x7d, 0x89, 0xdf, 0x67, 0x71, 0x4c,
-	0x94, 0x9b, 0x04, 0x1e, 0xa4, 0xe1, 0x27, 0x44, 0x2a, 0xf3, 0x06, 0x58, 0x94, 0x04, 0x53, 0x24,
-	0x2c, 0xa3, 0x6e, 0x6c, 0x2e, 0x7b, 0x7a, 0x65, 0x7e, 0x06, 0x96, 0xdb, 0xdc, 0x0f, 0x54, 0xcb,
-	0xe7, 0x1d, 0x6b, 0xae, 0x6e, 0x6c, 0x96, 0x1b, 0xef, 0x1c, 0x1d, 0xd7, 0xde, 0xc6, 0x44, 0x45,
-	0x49, 0x60, 0xb7, 0x58, 0xec, 0xe8, 0xa4, 0x5d, 0x18, 0xc8, 0x7b, 0x84, 0x0d, 0x96, 0xce, 0xc1,
-	0x03, 0x47, 0xf5, 0x38, 0x92, 0x76, 0xe3, 0x23, 0xf7, 0xfe, 0x83, 0x37, 0xdd, 0x24, 0xf8, 0x18,
-	0xf5, 0xbc, 0xa5, 0x36, 0x6f, 0xa8, 0x96, 0xdb, 0x31, 0x6f, 0x83, 0xb2, 0x54, 0x50, 0x28, 0x3f,
-	0x42, 0x04, 0x47, 0xca, 0x2a, 0xd6, 0x8d, 0xcd, 0x79, 0xaf, 0x94, 0xee, 0xed, 0xa5, 0x5b, 0x66,
-	0x1d, 0x94, 0x69, 0x12, 0xfb, 0x3c, 0x09, 0x7c, 0x01, 0x69, 0x68, 0xcd, 0xa7, 0x10, 0x40, 0x93,
-	0x58, 0x33, 0x37, 0xab, 0x00, 0xb4, 0xd2, 0x52, 0x62, 0x44, 0x95, 0xb5, 0xd0, 0xa7, 0xe7, 0x8d,
-	0xed, 0x98, 0x2e, 0x28, 0x4a, 0x82, 0xad, 0xc5, 0x94, 0xf7, 0x7b, 0x47, 0xc7, 0xb5, 0x9d, 0x4b,
-	0xf3, 0x6e, 0x12, 0x4c, 0xa1, 0x4a, 0x04, 0xf2, 0xfa, 0x57, 0xed, 0x94, 0xbe, 0x39, 0x7b, 0x76,
-	0x57, 0x37, 0x67, 0xa3, 0x0a, 0xd6, 0xa7, 0x35, 0xd3, 0x43, 0x92, 0x33, 0x2a, 0xd1, 0xc6, 0x2f,
-	0x45, 0x70, 0x7d, 0x5f, 0xe2, 0xdd, 0x30, 0xfc, 0x50, 0x0b, 0xd2, 0x24, 0xf8, 0x7f, 0x69, 0x75,
-	0xd0, 0x65, 0xad, 0xce, 0xb9, 0x56, 0xa7, 0x7b, 0xba, 0xd5, 0x9f, 0x83, 0x2b, 0x13, 0x6d, 0x2e,
-	0x37, 0xde, 0x3d, 0x3a, 0xae, 0x3d, 0xba, 0x44, 0xea, 0x66, 0x2b, 0xa2, 0x4c, 0x08, 0xdd, 0x06,
-	0x6f, 0x89, 0x6b, 0x89, 0x6c, 0xb0, 0x90, 0xda, 0x3a, 0x55, 0xa7, 0xb4, 0x6d, 0xd9, 0x23, 0xdb,
-	0xdb, 0x99, 0xed, 0x6d, 0xb7, 0x1f, 0xf7, 0x32, 0x98, 0x79, 0x07, 0xac, 0x64, 0x64, 0x21, 0xe7,
-	0x7e, 0x04, 0x65, 0x94, 0xa9, 0xe7, 0x65, 0x25, 0xec, 0x72, 0xbe, 0x07, 0x65, 0x64, 0x7e, 0x09,
-	0xca, 0x03, 0x8f, 0xfb, 0x7d, 0x85, 0x97, 0xfe, 0x0d, 0xe7, 0x0f, 0x1e, 0x7f, 0xda, 0x6c, 0x12,
-	0xec, 0x95, 0xda, 0x23, 0x95, 0x26, 0x85, 0xbe, 0x05, 0xd6, 0x2e, 0xe8, 0x38, 0x54, 0xf9, 0x7b,
-	0x03, 0x5c, 0xdb, 0x97, 0xf8, 0x09, 0x0f, 0xa1, 0x42, 0x6e, 0x3a, 0x6d, 0xe6, 0x43, 0xb0, 0x0c,
-	0x13, 0x15, 0x31, 0x41, 0x54, 0x2f, 0x93, 0xb9, 0x61, 0xfd, 0xf6, 0xf3, 0xbd, 0x55, 0x3d, 0xc7,
-	0xbb, 0x61, 0x28, 0x90, 0x94, 0x4d, 0x25, 0x08, 0xc5, 0xde, 0x08, 0x6a, 0x3e, 0x02, 0x8b, 0xd9,
-	0xbc, 0xa6, 0x06, 0x28, 0x6d, 0xdf, 0xb2, 0xa7, 0x3c, 0x28, 0x76, 0x96, 0xa4, 0x31, 0xff, 0xfc,
-	0xb8, 0x56, 0xf0, 0xf4, 0x81, 0x9d, 0x95, 0x3e, 0xe1, 0xd1, 0x55, 0x1b, 0x6b, 0xe0, 0xe6, 0x39,
-	0x56, 0x43, 0xc6, 0x3f, 0x18, 0x69, 0x3d, 0x4f, 0xe8, 0x57, 0x90, 0x74, 0x07, 0x25, 0xb9, 0x82,
-	0x1d, 0x90, 0x10, 0x89, 0xff, 0xdc, 0x9f, 0x3b, 0xd7, 0xbe, 0xfd, 0xb1, 0x56, 0x18, 0x6f, 0xf7,
-	0x2b, 0xe0, 0x76, 0x2e, 0xbd, 0x61, 0x11, 0x3f, 0x65, 0x45, 0x78, 0x48, 0x26, 0x31, 0x1a, 0x43,
-	0x71, 0x26, 0x61, 0xf7, 0x1f, 0x0b, 0xb0, 0x0e, 0x40, 0x9b, 0xfb, 0xbc, 0x23, 0xfd, 0x08, 0x1d,
-	0x5a, 0x73, 0xf5, 0xe2, 0xe6, 0xb2, 0x77, 0xa5, 0xcd, 0xdd, 0x8e, 0xdc, 0x43, 0x87, 0xe6, 0xab,
-	0x60, 0x25, 0x82, 0x5d, 0x45, 0x28, 0x1e, 0x9f, 0xa5, 0xab, 0xde, 0x55, 0xbd, 0x9b, 0x4d, 0xd3,
-	0x05, 0x29, 0xb2, 0x7a, 0xa6, 0x33, 0x1d, 0xd4, 0xb3, 0xfd, 0xfb, 0x3c, 0x28, 0xee, 0x4b, 0x6c,
-	0x7e, 0x0d, 0xae, 0x5f, 0x7c, 0x9e, 0x5f, 0x9f, 0xea, 0x83, 0x69, 0x8f, 0x4f, 0x65, 0x6b, 0x66,
-	0xe8, 0x20, 0xb5, 0x19, 0x81, 0x95, 0x73, 0x6f, 0xd4, 0x6b, 0x79, 0x97, 0x4c, 0xe2, 0x2a, 0xf6,
-	0x6c, 0xb8, 0x61, 0xa6, 0x00, 0x94, 0x27, 0xe6, 0xe4, 0x4e, 0xde, 0xf9, 0x71, 0x54, 0xe5, 0x8d,
-	0x59, 0x50, 0xc3, 0x1c, 0x4f, 0x0d, 0x70, 0x23, 0xc7, 0xda, 0xb9, 0x74, 0xa7, 0xe3, 0x2b, 0x0f,
-	0x2f, 0x87, 0x9f, 0xa0, 0x90, 0x63, 0xcc, 0x5c, 0x0a, 0xd3, 0xf1, 0xf9, 0x14, 0xfe, 0xde, 0x4e,
-	0x95, 0x85, 0xa7, 0x67, 0xcf, 0xee, 0x1a, 0x8d, 0xc7, 0xcf, 0x4f, 0xaa, 0xc6, 0x8b, 0x93, 0xaa,
-	0xf1, 0xe7, 0x49, 0xd5, 0xf8, 0xee, 0xb4, 0x5a, 0x78, 0x71, 0x5a, 0x2d, 0xfc, 0x71, 0x5a, 0x2d,
-	0x7c, 0xf1, 0xd6, 0x4c, 0x73, 0x7b, 0x38, 0xfa, 0x97, 0x48, 0x47, 0x38, 0x58, 0x4c, 0x7f, 0x24,
-	0xee, 0xff, 0x15, 0x00, 0x00, 0xff, 0xff, 0xd2, 0x34, 0x3b, 0x59, 0x08, 0x09, 0x00, 0x00,
+	// 1135 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0xb3, 0xf9, 0xb5, 0x6f, 0x37, 0x49, 0x63, 0xa2, 0xd6, 0x71, 0xc3, 0x66, 0xbb, 0xa4,
+	0x21, 0x14, 0x6a, 0x93, 0xb4, 0x14, 0x25, 0x08, 0x44, 0x16, 0x05, 0x05, 0x85, 0xa8, 0x2b, 0x2f,
+	0x15, 0x08, 0xa4, 0x1a, 0xdb, 0x3b, 0x6b, 0x9b, 0x5d, 0x7b, 0xcc, 0xcc, 0x6c, 0x9a, 0xbd, 0x55,
+	0x9c, 0xe0, 0xc6, 0x81, 0x2b, 0x12, 0x47, 0x8e, 0x3d, 0xf0, 0x37, 0x40, 0x25, 0x40, 0xaa, 0x38,
+	0xa1, 0x1c, 0x22, 0x94, 0x1c, 0x7a, 0xe3, 0x6f, 0x40, 0xeb, 0xf1, 0xfe, 0x5e, 0x87, 0x4d, 0x53,
+	0xc2, 0xcd, 0x33, 0xf3, 0xe6, 0xbd, 0xef, 0x7d, 0xdf, 0x9b, 0x37, 0x63, 0x58, 0x34, 0x0d, 0xb3,
+	0x5e, 0xc5, 0xbe, 0x5a, 0x76, 0x7d, 0xa3, 0xea, 0xb2, 0xba, 0xba, 0xbf, 0xa6, 0xb2, 0x03, 0x25,
+	0x20, 0x98, 0x61, 0xf1, 0x85, 0x68, 0x55, 0x69, 0xae, 0x2a, 0xfb, 0x6b, 0xf2, 0xbc, 0x8d, 0x6d,
+	0x1c, 0xae, 0xab, 0x8d, 0x2f, 0x6e, 0x2a, 0xbf, 0xc8, 0x90, 0x5f, 0x42, 0xc4, 0x73, 0x7d, 0xa6,
+	0x5a, 0xa4, 0x1e, 0x30, 0xac, 0x06, 0x04, 0xe3, 0x72, 0xb4, 0xbc, 0x60, 0x61, 0xea, 0x61, 0xaa,
+	0xf3, 0x7d, 0x7c, 0x10, 0x2d, 0x5d, 0xe1, 0x23, 0xd5, 0xa3, 0x76, 0x23, 0xb8, 0x47, 0xed, 0x68,
+	0x21, 0x3b, 0x08, 0x5b, 0x60, 0x10, 0xc3, 0x8b, 0xb6, 0xe6, 0x7e, 0x1d, 0x85, 0xf9, 0x3d, 0x6a,
+	0xbf, 0x87, 0x3d, 0xcf, 0x65, 0x85, 0x9a, 0xa9, 0x19, 0x7e, 0xe9, 0x43, 0x97, 0x32, 0xf1, 0x32,
+	0x4c, 0x50, 0xd7, 0xf6, 0x11, 0x91, 0x84, 0xac, 0xb0, 0x9a, 0xd4, 0xa2, 0x91, 0xf8, 0x31, 0x24,
+	0xcb, 0x81, 0x6e, 0x32, 0x4b, 0x0f, 0x2a, 0xd2, 0x68, 0x56, 0x58, 0x4d, 0xe7, 0xdf, 0x3a, 0x3c,
+	0x5a, 0x7a, 0xd3, 0x76, 0x99, 0x53, 0x33, 0x15, 0x0b, 0x7b, 0x6a, 0x14, 0xb4, 0x6a, 0x98, 0xf4,
+	0xa6, 0x8b, 0x9b, 0x43, 0x75, 0xff, 0xb6, 0xca, 0xea, 0x01, 0xa2, 0x4a, 0xfe, 0x83, 0xc2, 0xad,
+	0xdb, 0xaf, 0x17, 0x6a, 0xe6, 0x2e, 0xaa, 0x6b, 0x93, 0xe5, 0x20, 0xcf, 0xac, 0x42, 0x45, 0xbc,
+	0x06, 0x69, 0xca, 0x0c, 0xc2, 0x74, 0x07, 0xb9, 0xb6, 0xc3, 0xa4, 0x44, 0x56, 0x58, 0x1d, 0xd3,
+	0x52, 0xe1, 0xdc, 0x4e, 0x38, 0x25, 0x66, 0x21, 0xed, 0xd7, 0x3c, 0x3d, 0xa8, 0x99, 0x3a, 0x31,
+	0xfc, 0x92, 0x34, 0x16, 0x9a, 0x80, 0x5f, 0xf3, 0x22, 0xe4, 0x62, 0x06, 0xc0, 0x0a, 0x53, 0xf1,
+	0x90, 0xcf, 0xa4, 0xf1, 0x06, 0x3c, 0xad, 0x63, 0x46, 0x2c, 0x40, 0x82, 0xba, 0xb6, 0x34, 0x11,
+	0xe2, 0x7e, 0xe7, 0xf0, 0x68, 0x69, 0xf3, 0xcc, 0xb8, 0x8b, 0xae, 0xed, 0x1b, 0xac, 0x46, 0x90,
+	0xd6, 0x70, 0xb5, 0x99, 0xfa, 0xea, 0xe9, 0xa3, 0x1b, 0x11, 0x39, 0xb9, 0x0c, 0x2c, 0x0e, 0x22,
+	0x53, 0x43, 0x34, 0xc0, 0x3e, 0x45, 0xb9, 0x9f, 0x13, 0x30, 0xb7, 0x47, 0xed, 0xad, 0x52, 0xe9,
+	0xfd, 0x48, 0x90, 0xa2, 0x6b, 0xff, 0x2f, 0x54, 0x9b, 0x55, 0x6c, 0x55, 0x7a, 0xa8, 0x0e, 0xe7,
+	0x22, 0xaa, 0x3f, 0x81, 0xa9, 0x2e, 0x9a, 0xd3, 0xf9, 0xb7, 0x0f, 0x8f, 0x96, 0x36, 0xce, 0x10,
+	0xba, 0x68, 0x39, 0x3e, 0x26, 0x24, 0xa2, 0x41, 0x9b, 0x0c, 0x22, 0x89, 0x14, 0x18, 0x0f, 0xcb,
+	0x3a, 0x54, 0x27, 0xb5, 0x2e, 0x29, 0xed, 0xb2, 0x57, 0x78, 0xd9, 0x2b, 0x85, 0xc6, 0xba, 0xc6,
+	0xcd, 0xc4, 0x65, 0x98, 0xe1, 0x60, 0x8d, 0x20, 0xd0, 0x1d, 0x83, 0x3a, 0x5c, 0x3d, 0x8d, 0xa7,
+	0xb0, 0x15, 0x04, 0x3b, 0x06, 0x75, 0xc4, 0xcf, 0x21, 0xdd, 0xac, 0x71, 0xbd, 0xa1, 0xf0, 0xe4,
+	0x79, 0x30, 0x6f, 0xdf, 0xfd, 0xa8, 0x58, 0x74, 0x6d, 0x2d, 0x55, 0x6e, 0xab, 0xd4, 0x2d, 0xf4,
+	0x55, 0x58, 0xe8, 0xd3, 0xb1, 0xa5, 0xf2, 0x2f, 0xa3, 0x70, 0xa9, 0x63, 0x7e, 0xdb, 0x67, 0xa4,
+	0xde, 0xc7, 0xb9, 0x70, 0x3a, 0xe7, 0xa3, 0xff, 0x0d, 0xe7, 0x89, 0x67, 0xe5, 0x7c, 0x6c, 0x08,
+	0xce, 0xc7, 0x9f, 0x37, 0xe7, 0xb9, 0xdf, 0x05, 0x10, 0xfb, 0x78, 0xa6, 0x17, 0x7f, 0x60, 0x36,
+	0x60, 0x8c, 0xba, 0x36, 0x95, 0x12, 0xd9, 0xc4, 0x6a, 0x6a, 0xfd, 0xba, 0x32, 0xa0, 0xa9, 0x2b,
+	0xbd, 0x8a, 0x6b, 0xe1, 0x96, 0xee, 0xb2, 0x71, 0x60, 0xae, 0xbb, 0x60, 0x6a, 0x55, 0x36, 0x4c,
+	0x65, 0xc8, 0x30, 0x65, 0x58, 0x16, 0x0a, 0x18, 0xe2, 0x95, 0x31, 0xa5, 0xb5, 0xc6, 0xe2, 0x3c,
+	0x8c, 0x23, 0x42, 0x30, 0x09, 0xb5, 0x4d, 0x6a, 0x7c, 0x90, 0xbb, 0x0f, 0x72, 0x3f, 0x71, 0xcd,
+	0x0a, 0x15, 0xdf, 0x85, 0x49, 0x12, 0x06, 0xa7, 0x92, 0x10, 0xa6, 0xb4, 0xf2, 0x6f, 0x29, 0x71,
+	0xac, 0x5a, 0x73, 0x5b, 0xee, 0x3b, 0x01, 0x66, 0xf7, 0xa8, 0x7d, 0x2f, 0x28, 0x19, 0x0c, 0x15,
+	0xc2, 0x1b, 0x45, 0xbc, 0x03, 0x49, 0xa3, 0xc6, 0x1c, 0x4c, 0x5c, 0x56, 0xe7, 0xca, 0xe4, 0xa5,
+	0x3f, 0x7e, 0xba, 0x39, 0x1f, 0xdd, 0x55, 0x5b, 0xa5, 0x12, 0x41, 0x94, 0x16, 0x19, 0x71, 0x7d,
+	0x5b, 0x6b, 0x9b, 0x8a, 0x1b, 0x30, 0xc1, 0xef, 0xa4, 0x30, 0xb7, 0xd4, 0xfa, 0xd5, 0x81, 0x60,
+	0x78, 0x90, 0xfc, 0xd8, 0xe3, 0xa3, 0xa5, 0x11, 0x2d, 0xda, 0xb0, 0x39, 0xd3, 0x60, 0xb7, 0xed,
+	0x2a, 0xb7, 0x00, 0x57, 0x7a, 0x50, 0xb5, 0x4e, 0xe5, 0xf7, 0x42, 0x78, 0x66, 0xef, 0xf9, 0x5f,
+	0x18, 0x6e, 0xb5, 0x99, 0x59, 0x81, 0xe0, 0x7d, 0xb7, 0x84, 0xc8, 0x85, 0x97, 0xd4, 0xe6, 0xec,
+	0xd7, 0x3f, 0x2c, 0x8d, 0x74, 0xd6, 0xc6, 0x4b, 0x70, 0x2d, 0x16, 0x5e, 0x2b, 0x89, 0x1f, 0x79,
+	0x12, 0x0d, 0x35, 0x3c, 0xd4, 0x61, 0x15, 0x60, 0x6a, 0x54, 0x9f, 0x59, 0x80, 0x45, 0x80, 0x72,
+	0xa0, 0x07, 0x15, 0xaa, 0x3b, 0xe8, 0x40, 0x1a, 0xcd, 0x26, 0x56, 0x93, 0xda, 0x54, 0x39, 0x28,
+	0x54, 0xe8, 0x0e, 0x3a, 0x10, 0xaf, 0xc3, 0x8c, 0x63, 0x54, 0x99, 0xeb, 0xdb, 0x9d, 0xf7, 0xc5,
+	0xb4, 0x36, 0x1d, 0xcd, 0xf2, 0x1a, 0xed, 0x93, 0x82, 0xe7, 0x33, 0x18, 0x69, 0x2b, 0x9f, 0xbf,
+	0x13, 0xe1, 0x8d, 0xa9, 0x61, 0x66, 0x30, 0xd4, 0x9b, 0xf5, 0x2e, 0xaa, 0x5f, 0xfc, 0x51, 0xff,
+	0x0c, 0x52, 0x3e, 0x7a, 0xa0, 0x23, 0xcc, 0x68, 0xc3, 0x75, 0xe2, 0xfc, 0xae, 0x93, 0x3e, 0x7a,
+	0xb0, 0x8d, 0x19, 0x2d, 0x54, 0xc4, 0x57, 0x61, 0xce, 0xb0, 0x98, 0xbb, 0x6f, 0x30, 0x17, 0xfb,
+	0x4d, 0x36, 0xf9, 0x2b, 0xe6, 0x52, 0x7b, 0x21, 0x3a, 0xf4, 0x65, 0x98, 0xb5, 0x6a, 0x84, 0x20,
+	0x9f, 0xe9, 0x15, 0xd4, 0xd9, 0x61, 0xcf, 0xfb, 0x6e, 0x99, 0x8e, 0xdc, 0xee, 0xa2, 0xf0, 0xf9,
+	0x71, 0x9f, 0x67, 0xdc, 0x8c, 0xf1, 0x7c, 0xde, 0x46, 0x8d, 0xa4, 0xb9, 0xff, 0xee, 0x0e, 0xb8,
+	0x02, 0xcb, 0xa7, 0xe9, 0xdd, 0x2c, 0x8c, 0xf5, 0xdf, 0x26, 0x20, 0xb1, 0x47, 0x6d, 0xf1, 0x4b,
+	0x98, 0xeb, 0x7f, 0x9b, 0xbe, 0x32, 0xb0, 0x41, 0x0c, 0x7a, 0x79, 0xc9, 0x6b, 0x43, 0x9b, 0xb6,
+	0x9a, 0xa3, 0x03, 0x33, 0x3d, 0x0f, 0xb4, 0x95, 0x38, 0x27, 0xdd, 0x76, 0xb2, 0x32, 0x9c, 0x5d,
+	0x2b, 0x52, 0x05, 0x66, 0x7b, 0xaf, 0xb6, 0x97, 0x87, 0x73, 0x41, 0x65, 0x75, 0x48, 0xc3, 0x56,
+	0x30, 0x13, 0xd2, 0x5d, 0xdd, 0x7a, 0x39, 0xce, 0x41, 0xa7, 0x95, 0xfc, 0xda, 0x30, 0x56, 0xad,
+	0x18, 0x0f, 0x05, 0xb8, 0x1c, 0xd3, 0x60, 0x63, 0xb9, 0x19, 0x6c, 0x2f, 0xdf, 0x39, 0x9b, 0x7d,
+	0x17, 0x84, 0x98, 0xf6, 0x18, 0x0b, 0x61, 0xb0, 0x7d, 0x3c, 0x84, 0xd3, 0x9b, 0x9a, 0xf8, 0x8d,
+	0x00, 0x0b, 0xf1, 0x1d, 0x2d, 0xb6, 0x22, 0x63, 0xb7, 0xc8, 0x1b, 0x67, 0xde, 0xd2, 0xc4, 0x22,
+	0x8f, 0x3f, 0x7c, 0xfa, 0xe8, 0x86, 0x90, 0xbf, 0xfb, 0xf8, 0x38, 0x23, 0x3c, 0x39, 0xce, 0x08,
+	0x7f, 0x1d, 0x67, 0x84, 0x6f, 0x4f, 0x32, 0x23, 0x4f, 0x4e, 0x32, 0x23, 0x7f, 0x9e, 0x64, 0x46,
+	0x3e, 0x7d, 0x63, 0xa8, 0x43, 0x7e, 0xd0, 0xfe, 0x83, 0x0c, 0xcf, 0xbb, 0x39, 0x11, 0xfe, 0x3e,
+	0xde, 0xfa, 0x27, 0x00, 0x00, 0xff, 0xff, 0xe9, 0xc6, 0xc7, 0x3a, 0xfe, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -636,6 +1004,8 @@ type MsgClient interface {
 	CommitPubRandList(ctx context.Context, in *MsgCommitPubRandList, opts ...grpc.CallOption) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, opts ...grpc.CallOption) (*MsgAddFinalitySigResponse, error)
+	// AddFinalitySigs adds a batch of finality signatures to a list of blocks
+	AddFinalitySigs(ctx context.Context, in *MsgAddFinalitySigs, opts ...grpc.CallOption) (*MsgAddFinalitySigsResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -644,6 +1014,9 @@ type MsgClient interface {
 	UnjailFinalityProvider(ctx context.Context, in *MsgUnjailFinalityProvider, opts ...grpc.CallOption) (*MsgUnjailFinalityProviderResponse, error)
 	// ResumeFinalityProposal handles the proposal of resuming finality.
 	ResumeFinalityProposal(ctx context.Context, in *MsgResumeFinalityProposal, opts ...grpc.CallOption) (*MsgResumeFinalityProposalResponse, error)
+	// RotateFinalityProviderKey registers a successor EOTS key of a finality
+	// provider, used from an activation height
+	RotateFinalityProviderKey(ctx context.Context, in *MsgRotateFinalityProviderKey, opts ...grpc.CallOption) (*MsgRotateFinalityProviderKeyResponse, error)
 }
 
 type msgClient struct {
@@ -672,6 +1045,15 @@ func (c *msgClient) AddFinalitySig(ctx context.Context, in *MsgAddFinalitySig, o
 	return out, nil
 }
 
+func (c *msgClient) AddFinalitySigs(ctx context.Context, in *MsgAddFinalitySigs, opts ...grpc.CallOption) (*MsgAddFinalitySigsResponse, error) {
+	out := new(MsgAddFinalitySigsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/AddFinalitySigs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/UpdateParams", in, out, opts...)
@@ -699,12 +1081,23 @@ func (c *msgClient) ResumeFinalityProposal(ctx context.Context, in *MsgResumeFin
 	return out, nil
 }
 
+func (c *msgClient) RotateFinalityProviderKey(ctx context.Context, in *MsgRotateFinalityProviderKey, opts ...grpc.CallOption) (*MsgRotateFinalityProviderKeyResponse, error) {
+	out := new(MsgRotateFinalityProviderKeyResponse)
+	err := c.cc.Invoke(ctx, "/babylon.finality.v1.Msg/RotateFinalityProviderKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// CommitPubRandList commits a list of public randomness for EOTS
 	CommitPubRandList(context.Context, *MsgCommitPubRandList) (*MsgCommitPubRandListResponse, error)
 	// AddFinalitySig adds a finality signature to a given block
 	AddFinalitySig(context.Context, *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error)
+	// AddFinalitySigs adds a batch of finality signatures to a list of blocks
+	AddFinalitySigs(context.Context, *MsgAddFinalitySigs) (*MsgAddFinalitySigsResponse, error)
 	// TODO: msg for evidence of equivocation. this is not specified yet
 	// UpdateParams updates the finality module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
@@ -713,6 +1106,9 @@ type MsgServer interface {
 	UnjailFinalityProvider(context.Context, *MsgUnjailFinalityProvider) (*MsgUnjailFinalityProviderResponse, error)
 	// ResumeFinalityProposal handles the proposal of resuming finality.
 	ResumeFinalityProposal(context.Context, *MsgResumeFinalityProposal) (*MsgResumeFinalityProposalResponse, error)
+	// RotateFinalityProviderKey registers a successor EOTS key of a finality
+	// provider, used from an activation height
+	RotateFinalityProviderKey(context.Context, *MsgRotateFinalityProviderKey) (*MsgRotateFinalityProviderKeyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -725,6 +1121,9 @@ func (*UnimplementedMsgServer) CommitPubRandList(ctx context.Context, req *MsgCo
 func (*UnimplementedMsgServer) AddFinalitySig(ctx context.Context, req *MsgAddFinalitySig) (*MsgAddFinalitySigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySig not implemented")
 }
+func (*UnimplementedMsgServer) AddFinalitySigs(ctx context.Context, req *MsgAddFinalitySigs) (*MsgAddFinalitySigsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFinalitySigs not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -734,6 +1133,9 @@ func (*UnimplementedMsgServer) UnjailFinalityProvider(ctx context.Context, req *
 func (*UnimplementedMsgServer) ResumeFinalityProposal(ctx context.Context, req *MsgResumeFinalityProposal) (*MsgResumeFinalityProposalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResumeFinalityProposal not implemented")
 }
+func (*UnimplementedMsgServer) RotateFinalityProviderKey(ctx context.Context, req *MsgRotateFinalityProviderKey) (*MsgRotateFinalityProviderKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateFinalityProviderKey not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -775,6 +1177,24 @@ func _Msg_AddFinalitySig_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddFinalitySigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFinalitySigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFinalitySigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/AddFinalitySigs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFinalitySigs(ctx, req.(*MsgAddFinalitySigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -829,6 +1249,24 @@ func _Msg_ResumeFinalityProposal_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateFinalityProviderKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateFinalityProviderKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateFinalityProviderKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.finality.v1.Msg/RotateFinalityProviderKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateFinalityProviderKey(ctx, req.(*MsgRotateFinalityProviderKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.finality.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -841,6 +1279,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddFinalitySig",
 			Handler:    _Msg_AddFinalitySig_Handler,
 		},
+		{
+			MethodName: "AddFinalitySigs",
+			Handler:    _Msg_AddFinalitySigs_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -853,6 +1295,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ResumeFinalityProposal",
 			Handler:    _Msg_ResumeFinalityProposal_Handler,
 		},
+		{
+			MethodName: "RotateFinalityProviderKey",
+			Handler:    _Msg_RotateFinalityProviderKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/finality/v1/tx.proto",
@@ -1065,7 +1511,7 @@ func (m *MsgAddFinalitySigResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *FinalitySigEntry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1075,17 +1521,226 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *FinalitySigEntry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FinalitySigEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
+	if m.FinalitySig != nil {
+		{
+			size := m.FinalitySig.Size()
+			i -= size
+			if _, err := m.FinalitySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.BlockAppHash) > 0 {
+		i -= len(m.BlockAppHash)
+		copy(dAtA[i:], m.BlockAppHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BlockAppHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PubRand != nil {
+		{
+			size := m.PubRand.Size()
+			i -= size
+			if _, err := m.PubRand.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalitySigs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalitySigs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalitySigs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sigs) > 0 {
+		for iNdEx := len(m.Sigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalitySigResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalitySigResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalitySigResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddFinalitySigsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddFinalitySigsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddFinalitySigsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
@@ -1260,49 +1915,155 @@ func (m *MsgResumeFinalityProposalResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRotateFinalityProviderKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCommitPubRandList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgRotateFinalityProviderKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFinalityProviderKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.NewKeySig != nil {
+		{
+			size := m.NewKeySig.Size()
+			i -= size
+			if _, err := m.NewKeySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.FpBtcPk != nil {
-		l = m.FpBtcPk.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if m.CurrentKeySig != nil {
+		{
+			size := m.CurrentKeySig.Size()
+			i -= size
+			if _, err := m.CurrentKeySig.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.StartHeight != 0 {
-		n += 1 + sovTx(uint64(m.StartHeight))
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.NumPubRand != 0 {
-		n += 1 + sovTx(uint64(m.NumPubRand))
+	if m.NewEotsPk != nil {
+		{
+			size := m.NewEotsPk.Size()
+			i -= size
+			if _, err := m.NewEotsPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Commitment)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.FpBtcPk != nil {
+		{
+			size := m.FpBtcPk.Size()
+			i -= size
+			if _, err := m.FpBtcPk.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Sig != nil {
-		l = m.Sig.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCommitPubRandListResponse) Size() (n int) {
+func (m *MsgRotateFinalityProviderKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCommitPubRandList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.NumPubRand != 0 {
+		n += 1 + sovTx(uint64(m.NumPubRand))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sig != nil {
+		l = m.Sig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitPubRandListResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1356,6 +2117,91 @@ func (m *MsgAddFinalitySigResponse) Size() (n int) {
 	return n
 }
 
+func (m *FinalitySigEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.PubRand != nil {
+		l = m.PubRand.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BlockAppHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FinalitySig != nil {
+		l = m.FinalitySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Sigs) > 0 {
+		for _, e := range m.Sigs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalitySigResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovTx(uint64(m.BlockHeight))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddFinalitySigsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1437,6 +2283,47 @@ func (m *MsgResumeFinalityProposalResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRotateFinalityProviderKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FpBtcPk != nil {
+		l = m.FpBtcPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewEotsPk != nil {
+		l = m.NewEotsPk.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if m.CurrentKeySig != nil {
+		l = m.CurrentKeySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewKeySig != nil {
+		l = m.NewKeySig.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateFinalityProviderKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1652,50 +2539,585 @@ func (m *MsgCommitPubRandList) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalitySigEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalitySigEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalitySigEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrPubRand
+			m.PubRand = &v
+			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &crypto.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BlockAppHash == nil {
+				m.BlockAppHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
+			m.FinalitySig = &v
+			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitPubRandListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1717,7 +3139,7 @@ func (m *MsgCommitPubRandListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
+func (m *MsgAddFinalitySigs) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1740,10 +3162,10 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddFinalitySigs: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddFinalitySigs: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1814,6 +3236,90 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sigs = append(m.Sigs, &FinalitySigEntry{})
+			if err := m.Sigs[len(m.Sigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalitySigResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalitySigResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalitySigResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
@@ -1832,11 +3338,31 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubRand", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1846,30 +3372,77 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrPubRand
-			m.PubRand = &v
-			if err := m.PubRand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 5:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddFinalitySigsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddFinalitySigsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddFinalitySigsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1896,18 +3469,66 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &crypto.Proof{}
-			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Results = append(m.Results, &FinalitySigResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockAppHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1917,31 +3538,29 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlockAppHash = append(m.BlockAppHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BlockAppHash == nil {
-				m.BlockAppHash = []byte{}
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinalitySig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1951,24 +3570,22 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.SchnorrEOTSSig
-			m.FinalitySig = &v
-			if err := m.FinalitySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1993,7 +3610,7 @@ func (m *MsgAddFinalitySig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2016,10 +3633,10 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddFinalitySigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2043,7 +3660,7 @@ func (m *MsgAddFinalitySigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2066,15 +3683,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2102,13 +3719,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2118,22 +3735,24 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2158,7 +3777,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2181,10 +3800,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2208,7 +3827,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2231,15 +3850,15 @@ func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailFinalityProvider: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeFinalityProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailFinalityProvider: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeFinalityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2267,13 +3886,13 @@ func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpPksHex", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2283,27 +3902,43 @@ func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
-			m.FpBtcPk = &v
-			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FpPksHex = append(m.FpPksHex, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltingHeight", wireType)
+			}
+			m.HaltingHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HaltingHeight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2325,7 +3960,7 @@ func (m *MsgUnjailFinalityProvider) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResumeFinalityProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2348,10 +3983,10 @@ func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResumeFinalityProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnjailFinalityProviderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResumeFinalityProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2375,7 +4010,7 @@ func (m *MsgUnjailFinalityProviderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateFinalityProviderKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2398,15 +4033,15 @@ func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResumeFinalityProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResumeFinalityProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2434,13 +4069,13 @@ func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FpPksHex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FpBtcPk", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2450,29 +4085,67 @@ func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FpPksHex = append(m.FpPksHex, string(dAtA[iNdEx:postIndex]))
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.FpBtcPk = &v
+			if err := m.FpBtcPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewEotsPk", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340PubKey
+			m.NewEotsPk = &v
+			if err := m.NewEotsPk.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HaltingHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.HaltingHeight = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2482,11 +4155,81 @@ func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HaltingHeight |= uint32(b&0x7F) << shift
+				m.ActivationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKeySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
+			m.CurrentKeySig = &v
+			if err := m.CurrentKeySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeySig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_babylonlabs_io_babylon_v4_types.BIP340Signature
+			m.NewKeySig = &v
+			if err := m.NewKeySig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2508,7 +4251,7 @@ func (m *MsgResumeFinalityProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgResumeFinalityProposalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRotateFinalityProviderKeyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2531,10 +4274,10 @@ func (m *MsgResumeFinalityProposalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgResumeFinalityProposalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKeyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgResumeFinalityProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRotateFinalityProviderKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,312 @@
+// Package verifier verifies finality proofs of Babylon blocks, as returned by
+// the Query/FinalityProof RPC of the finality module, against the AppHash of
+// a Babylon header. It allows external chains and bridges to verify that a
+// Babylon block has received finality votes from more than 2/3 of the voting
+// power without trusting the node the proof is queried from.
+package verifier
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/crypto/eots"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	bstypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+)
+
+var (
+	// ErrInvalidProof is returned if the finality proof is malformed or does
+	// not verify against the AppHash
+	ErrInvalidProof = errors.New("invalid finality proof")
+	// ErrNotFinalized is returned if the finality proof is valid but the
+	// finality votes do not reach more than 2/3 of the voting power
+	ErrNotFinalized = errors.New("the block does not have finality votes from more than 2/3 of the voting power")
+)
+
+// Result is the outcome of the verification of a finality proof
+type Result struct {
+	// Block is the proven IndexedBlock
+	Block *types.IndexedBlock
+	// TotalVotingPower is the total voting power at the block height
+	TotalVotingPower uint64
+	// VotedVotingPower is the voting power of the valid finality votes
+	VotedVotingPower uint64
+}
+
+// VerifyFinalityProof verifies the finality proof of the Babylon block at the
+// given height against the given AppHash, which commits to the Babylon state
+// at proof.StateHeight, i.e., the AppHash in the Babylon header at height
+// proof.StateHeight + 1. The AppHash has to be obtained from a trusted source,
+// e.g., a CometBFT light client. It verifies that
+//   - the IndexedBlock and the complete voting power table at the height are
+//     in the Babylon state,
+//   - the EOTS signature, public randomness, inclusion proof of the public
+//     randomness and public randomness commitment of each finality vote are
+//     in the Babylon state,
+//   - the public randomness of each finality vote is in its commitment,
+//   - each EOTS signature is valid over the block under the EOTS PK of the
+//     finality provider at the height, and
+//   - the finality providers that voted have more than 2/3 of the voting power.
+//
+// ErrNotFinalized is returned together with the result if the proof is valid
+// but the voting power of the votes is not sufficient.
+func VerifyFinalityProof(proof *types.FinalityProof, height uint64, appHash []byte) (*Result, error) {
+	if proof == nil {
+		return nil, fmt.Errorf("%w: empty proof", ErrInvalidProof)
+	}
+	prt := rootmulti.DefaultProofRuntime()
+
+	// verify the block
+	blockBytes, err := verifyStoreEntry(prt, appHash, types.StoreKey, types.BlockStoreKey(height), proof.Block)
+	if err != nil {
+		return nil, fmt.Errorf("%w: block: %v", ErrInvalidProof, err)
+	}
+	var block types.IndexedBlock
+	if err := block.Unmarshal(blockBytes); err != nil {
+		return nil, fmt.Errorf("%w: failed to unmarshal block: %v", ErrInvalidProof, err)
+	}
+	if block.Height != height {
+		return nil, fmt.Errorf("%w: block height %d does not match the height %d", ErrInvalidProof, block.Height, height)
+	}
+
+	// verify the voting power table
+	powerTable, err := verifyVotingPowerTable(prt, appHash, height, proof.VotingPowerTableProofs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: voting power table: %v", ErrInvalidProof, err)
+	}
+	res := &Result{Block: &block}
+	for _, power := range powerTable {
+		res.TotalVotingPower += power
+	}
+
+	// verify the votes
+	voted := make(map[string]struct{}, len(proof.Votes))
+	for i, vote := range proof.Votes {
+		if vote == nil || vote.FpBtcPk == nil {
+			return nil, fmt.Errorf("%w: empty vote %d", ErrInvalidProof, i)
+		}
+		fpBtcPkHex := vote.FpBtcPk.MarshalHex()
+		if _, ok := voted[fpBtcPkHex]; ok {
+			return nil, fmt.Errorf("%w: duplicated vote of finality provider %s", ErrInvalidProof, fpBtcPkHex)
+		}
+		power, ok := powerTable[fpBtcPkHex]
+		if !ok {
+			return nil, fmt.Errorf("%w: finality provider %s is not in the voting power table", ErrInvalidProof, fpBtcPkHex)
+		}
+		if err := verifyVote(prt, appHash, &block, vote); err != nil {
+			return nil, fmt.Errorf("%w: vote of finality provider %s: %v", ErrInvalidProof, fpBtcPkHex, err)
+		}
+		voted[fpBtcPkHex] = struct{}{}
+		res.VotedVotingPower += power
+	}
+
+	if res.VotedVotingPower*3 <= res.TotalVotingPower*2 {
+		return res, fmt.Errorf("%w: voted power %d, total power %d", ErrNotFinalized, res.VotedVotingPower, res.TotalVotingPower)
+	}
+
+	return res, nil
+}
+
+// verifyVote verifies the store entries of the given vote and its EOTS
+// signature over the given block
+func verifyVote(prt *merkle.ProofRuntime, appHash []byte, block *types.IndexedBlock, vote *types.FinalityProofVote) error {
+	fpBtcPkBytes := vote.FpBtcPk.MustMarshal()
+
+	sigBytes, err := verifyStoreEntry(prt, appHash, types.StoreKey, types.VoteStoreKey(block.Height, fpBtcPkBytes), vote.Sig)
+	if err != nil {
+		return fmt.Errorf("signature: %w", err)
+	}
+	sig, err := bbn.NewSchnorrEOTSSig(sigBytes)
+	if err != nil {
+		return err
+	}
+
+	pubRandBytes, err := verifyStoreEntry(prt, appHash, types.StoreKey, types.PubRandStoreKey(fpBtcPkBytes, block.Height), vote.PubRand)
+	if err != nil {
+		return fmt.Errorf("public randomness: %w", err)
+	}
+	pubRand, err := bbn.NewSchnorrPubRand(pubRandBytes)
+	if err != nil {
+		return err
+	}
+
+	// the key of the public randomness commitment depends on its start height
+	if vote.PubRandCommit == nil {
+		return errors.New("empty public randomness commitment")
+	}
+	var prCommit types.PubRandCommit
+	if err := prCommit.Unmarshal(vote.PubRandCommit.Value); err != nil {
+		return fmt.Errorf("failed to unmarshal public randomness commitment: %w", err)
+	}
+	prCommitKey := types.PubRandCommitStoreKey(fpBtcPkBytes, prCommit.StartHeight)
+	if _, err := verifyStoreEntry(prt, appHash, types.StoreKey, prCommitKey, vote.PubRandCommit); err != nil {
+		return fmt.Errorf("public randomness commitment: %w", err)
+	}
+	if !prCommit.IsInRange(block.Height) {
+		return fmt.Errorf("the public randomness commitment [%d, %d] does not include the height %d",
+			prCommit.StartHeight, prCommit.EndHeight(), block.Height)
+	}
+
+	// the public randomness has to be at the position of the height in the
+	// commitment
+	pubRandProofBytes, err := verifyStoreEntry(prt, appHash, types.StoreKey, types.PubRandProofStoreKey(fpBtcPkBytes, block.Height), vote.PubRandProof)
+	if err != nil {
+		return fmt.Errorf("public randomness inclusion proof: %w", err)
+	}
+	if err := verifyPubRandInclusion(&prCommit, block.Height, pubRandBytes, pubRandProofBytes); err != nil {
+		return err
+	}
+
+	// the EOTS PK is the BTC PK, unless the finality provider has rotated its
+	// EOTS key
+	eotsPk := vote.FpBtcPk
+	if vote.FinalityProvider != nil {
+		fpKey := append(append([]byte{}, bstypes.FinalityProviderKey...), fpBtcPkBytes...)
+		fpBytes, err := verifyStoreEntry(prt, appHash, bstypes.StoreKey, fpKey, vote.FinalityProvider)
+		if err != nil {
+			return fmt.Errorf("finality provider: %w", err)
+		}
+		var fp bstypes.FinalityProvider
+		if err := fp.Unmarshal(fpBytes); err != nil {
+			return fmt.Errorf("failed to unmarshal finality provider: %w", err)
+		}
+		eotsPk = fp.EotsPkAtHeight(block.Height)
+	}
+
+	pk, err := eotsPk.ToBTCPK()
+	if err != nil {
+		return err
+	}
+	if err := eots.Verify(pk, pubRand.ToFieldValNormalized(), block.MsgToSign(), sig.ToModNScalar()); err != nil {
+		return fmt.Errorf("invalid EOTS signature: %w", err)
+	}
+
+	return nil
+}
+
+// verifyPubRandInclusion verifies the given inclusion proof of the public
+// randomness at the given height against the Merkle root in the given
+// public randomness commitment
+func verifyPubRandInclusion(prCommit *types.PubRandCommit, height uint64, pubRand []byte, proofBytes []byte) error {
+	var proofProto cmtcrypto.Proof
+	if err := proofProto.Unmarshal(proofBytes); err != nil {
+		return fmt.Errorf("failed to unmarshal public randomness inclusion proof: %w", err)
+	}
+	if proofProto.Index < 0 || prCommit.StartHeight+uint64(proofProto.Index) != height {
+		return fmt.Errorf("the public randomness inclusion proof is not for the height %d", height)
+	}
+	if uint64(proofProto.Total) != prCommit.NumPubRand {
+		return fmt.Errorf("the total number of public randomness in the inclusion proof (%d) does not match the commitment (%d)",
+			proofProto.Total, prCommit.NumPubRand)
+	}
+	proof, err := merkle.ProofFromProto(&proofProto)
+	if err != nil {
+		return fmt.Errorf("invalid public randomness inclusion proof: %w", err)
+	}
+	if err := proof.Verify(prCommit.Commitment, pubRand); err != nil {
+		return fmt.Errorf("the public randomness is not in its commitment: %w", err)
+	}
+	return nil
+}
+
+// verifyVotingPowerTable verifies the non-membership proofs delimiting the
+// entries of the voting power table at the given height, and returns the
+// table. The proof for the first entry is for the prefix of the table, and
+// the proof for each next entry is for the key of the current entry followed
+// by a zero byte. The right neighbour of each proof is the next entry, until
+// the right neighbour is out of the table.
+func verifyVotingPowerTable(prt *merkle.ProofRuntime, appHash []byte, height uint64, proofs []*cmtcrypto.ProofOps) (map[string]uint64, error) {
+	tablePrefix := types.VotingPowerTableStorePrefix(height)
+	table := make(map[string]uint64, len(proofs))
+
+	key := tablePrefix
+	for i, proof := range proofs {
+		if proof == nil || len(proof.Ops) == 0 {
+			return nil, fmt.Errorf("empty non-membership proof %d", i)
+		}
+		if err := prt.VerifyAbsence(proof, appHash, keyPath(types.StoreKey, key)); err != nil {
+			return nil, fmt.Errorf("non-membership proof %d: %w", i, err)
+		}
+		rightKey, rightValue, err := rightNeighbour(proof)
+		if err != nil {
+			return nil, fmt.Errorf("non-membership proof %d: %w", i, err)
+		}
+
+		// the right neighbour is out of the table, so the table is complete
+		if rightKey == nil || !bytes.HasPrefix(rightKey, tablePrefix) {
+			if i != len(proofs)-1 {
+				return nil, fmt.Errorf("unexpected proofs after the end of the table")
+			}
+			return table, nil
+		}
+
+		fpBtcPk, err := bbn.NewBIP340PubKey(rightKey[len(tablePrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid finality provider BTC PK in entry %d: %w", i, err)
+		}
+		if len(rightValue) != 8 {
+			return nil, fmt.Errorf("invalid voting power in entry %d", i)
+		}
+		table[fpBtcPk.MarshalHex()] = sdk.BigEndianToUint64(rightValue)
+
+		key = append(append([]byte{}, rightKey...), 0)
+	}
+
+	return nil, errors.New("the proofs do not reach the end of the table")
+}
+
+// rightNeighbour returns the key and value of the right neighbour in the given
+// non-membership proof, or a nil key if there is no right neighbour
+func rightNeighbour(proof *cmtcrypto.ProofOps) ([]byte, []byte, error) {
+	// the first operation is the proof in the module store, and the second
+	// one is the proof of the module store in the multistore
+	op, err := storetypes.CommitmentOpDecoder(proof.Ops[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	commitmentOp, ok := op.(storetypes.CommitmentOp)
+	if !ok {
+		return nil, nil, fmt.Errorf("unexpected proof operation %T", op)
+	}
+	nonExist := commitmentOp.Proof.GetNonexist()
+	if nonExist == nil {
+		return nil, nil, errors.New("not a non-membership proof")
+	}
+	if nonExist.Right == nil {
+		return nil, nil, nil
+	}
+	return nonExist.Right.Key, nonExist.Right.Value, nil
+}
+
+// verifyStoreEntry verifies the given entry has the expected key in the given
+// module store and its membership proof against the AppHash, and returns its
+// value
+func verifyStoreEntry(prt *merkle.ProofRuntime, appHash []byte, storeName string, key []byte, entry *types.ProvenStoreEntry) ([]byte, error) {
+	if entry == nil || entry.Proof == nil || len(entry.Proof.Ops) == 0 {
+		return nil, errors.New("empty store entry")
+	}
+	if !bytes.Equal(entry.Key, key) {
+		return nil, fmt.Errorf("unexpected key %X, expected %X", entry.Key, key)
+	}
+	if err := prt.VerifyValue(entry.Proof, appHash, keyPath(storeName, key), entry.Value); err != nil {
+		return nil, err
+	}
+	return entry.Value, nil
+}
+
+// keyPath returns the path of the given key in the given module store of the
+// multistore
+func keyPath(storeName string, key []byte) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(key, merkle.KeyEncodingURL).
+		String()
+}
@@ -0,0 +1,181 @@
+package verifier_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/crypto/eots"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	bbn "github.com/babylonlabs-io/babylon/v4/types"
+	bstypes "github.com/babylonlabs-io/babylon/v4/x/btcstaking/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/types"
+	"github.com/babylonlabs-io/babylon/v4/x/finality/verifier"
+)
+
+func FuzzVerifyFinalityProof(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		db := dbm.NewMemDB()
+		stateStore := store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		// the module stores are mounted on the database of the multistore, so
+		// that the multistore can be committed
+		bsKeeper, _ := keepertest.BTCStakingKeeperWithStore(t, nil, stateStore, nil,
+			bstypes.NewMockBTCLightClientKeeper(ctrl), bstypes.NewMockBtcCheckpointKeeper(ctrl), nil)
+		fKeeper, ctx := keepertest.FinalityKeeperWithStore(t, nil, stateStore, bsKeeper, nil, nil, nil)
+
+		height := datagen.RandomInt(r, 1000) + 10
+		block := datagen.GenRandomBlockWithHeight(r, height)
+		fKeeper.SetBlock(ctx, block)
+
+		// finality providers, where the first one has rotated its EOTS key,
+		// and all of them vote for the block
+		numFps := int(datagen.RandomInt(r, 5)) + 4
+		totalPower := uint64(0)
+		fps := make([]*bstypes.FinalityProvider, 0, numFps)
+		for i := 0; i < numFps; i++ {
+			btcSK, _, err := datagen.GenRandomBTCKeyPair(r)
+			require.NoError(t, err)
+			fp, err := datagen.GenRandomFinalityProviderWithBTCSK(r, btcSK)
+			require.NoError(t, err)
+
+			eotsSK := btcSK
+			if i == 0 {
+				eotsSK, _, err = datagen.GenRandomBTCKeyPair(r)
+				require.NoError(t, err)
+				fp.KeyRotations = []*bstypes.FinalityProviderKeyRotation{{
+					NewEotsPk:        bbn.NewBIP340PubKeyFromBTCPK(eotsSK.PubKey()),
+					ActivationHeight: height - datagen.RandomInt(r, 5),
+				}}
+			}
+			bsKeeper.SetFinalityProvider(ctx, fp)
+
+			// power of each finality provider is less than 1/3 of the total
+			power := datagen.RandomInt(r, 100) + 100
+			fKeeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), height, power)
+			totalPower += power
+			// the voting power tables at the neighbouring heights
+			fKeeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), height-1, power)
+			fKeeper.SetVotingPower(ctx, fp.BtcPk.MustMarshal(), height+1, power)
+
+			randListInfo, err := datagen.GenRandomPubRandList(r, 10)
+			require.NoError(t, err)
+			err = fKeeper.SetPubRandCommit(ctx, fp.BtcPk, &types.PubRandCommit{
+				StartHeight: height,
+				NumPubRand:  10,
+				Commitment:  randListInfo.Commitment,
+				EpochNum:    1,
+			})
+			require.NoError(t, err)
+			sig, err := eots.Sign(eotsSK, randListInfo.SRList[0], block.MsgToSign())
+			require.NoError(t, err)
+			fKeeper.SetSig(ctx, height, fp.BtcPk, bbn.NewSchnorrEOTSSigFromModNScalar(sig))
+			fKeeper.SetPubRand(ctx, fp.BtcPk, height, randListInfo.PRList[0])
+			fKeeper.SetPubRandProof(ctx, fp.BtcPk, height, randListInfo.ProofList[0].ToProto())
+			fps = append(fps, fp)
+		}
+
+		commitID := stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version)
+		appHash := commitID.Hash
+
+		getProof := func() *types.FinalityProof {
+			res, err := fKeeper.FinalityProof(ctx, &types.QueryFinalityProofRequest{Height: height})
+			require.NoError(t, err)
+			return res.Proof
+		}
+
+		// valid proof
+		proof := getProof()
+		require.Equal(t, uint64(commitID.Version), proof.StateHeight)
+		require.Len(t, proof.VotingPowerTableProofs, numFps+1)
+		require.Len(t, proof.Votes, numFps)
+		res, err := verifier.VerifyFinalityProof(proof, height, appHash)
+		require.NoError(t, err)
+		require.True(t, block.Equal(res.Block))
+		require.Equal(t, totalPower, res.TotalVotingPower)
+		require.Equal(t, totalPower, res.VotedVotingPower)
+
+		// wrong AppHash
+		_, err = verifier.VerifyFinalityProof(proof, height, datagen.GenRandomByteArray(r, 32))
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// wrong height
+		_, err = verifier.VerifyFinalityProof(proof, height+1, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// incomplete voting power table
+		proof = getProof()
+		proof.VotingPowerTableProofs = proof.VotingPowerTableProofs[:numFps]
+		_, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		proof = getProof()
+		idx := datagen.RandomInt(r, numFps-1) + 1
+		proof.VotingPowerTableProofs = append(proof.VotingPowerTableProofs[:idx], proof.VotingPowerTableProofs[idx+1:]...)
+		_, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// tampered signature
+		proof = getProof()
+		proof.Votes[idx].Sig.Value = datagen.GenRandomByteArray(r, 32)
+		_, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// duplicated vote
+		proof = getProof()
+		proof.Votes = append(proof.Votes, proof.Votes[idx])
+		_, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// the signature of the finality provider that rotated its EOTS key
+		// cannot be verified without its finality provider entry
+		proof = getProof()
+		rotatedFpIdx := -1
+		for i, vote := range proof.Votes {
+			if vote.FinalityProvider != nil {
+				require.Equal(t, -1, rotatedFpIdx)
+				rotatedFpIdx = i
+			}
+		}
+		require.NotEqual(t, -1, rotatedFpIdx)
+		proof.Votes[rotatedFpIdx].FinalityProvider = nil
+		_, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// missing inclusion proof of the public randomness
+		proof = getProof()
+		proof.Votes[idx].PubRandProof = nil
+		_, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+
+		// insufficient votes
+		proof = getProof()
+		proof.Votes = proof.Votes[:1]
+		res, err = verifier.VerifyFinalityProof(proof, height, appHash)
+		require.ErrorIs(t, err, verifier.ErrNotFinalized)
+		require.Equal(t, totalPower, res.TotalVotingPower)
+		require.Less(t, res.VotedVotingPower, totalPower)
+
+		// the public randomness in the state is not in its commitment
+		otherRandListInfo, err := datagen.GenRandomPubRandList(r, 10)
+		require.NoError(t, err)
+		fKeeper.SetPubRandProof(ctx, fps[idx].BtcPk, height, otherRandListInfo.ProofList[0].ToProto())
+		commitID = stateStore.Commit()
+		ctx = ctx.WithBlockHeight(commitID.Version)
+		_, err = verifier.VerifyFinalityProof(getProof(), height, commitID.Hash)
+		require.ErrorIs(t, err, verifier.ErrInvalidProof)
+	})
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/incentive/types"
+)
+
+// RegisterInvariants registers all incentive invariants
+//
+//nolint:staticcheck
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reward-gauges", RewardGaugesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "btc-delegation-rewards-tracker", BTCDelegationRewardsTrackerInvariant(k))
+}
+
+// AllInvariants runs all invariants of the incentive module
+//
+//nolint:staticcheck
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := RewardGaugesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return BTCDelegationRewardsTrackerInvariant(k)(ctx)
+	}
+}
+
+// RewardGaugesInvariant checks that the rewards routed to the incentive module
+// account, e.g. by HandleCoinsInFeeCollector, cover all rewards that are not
+// withdrawn yet. That is, the incentive module account balance is not less
+// than the sum of
+//   - withdrawable coins of all reward gauges,
+//   - BTC staking gauges and FP direct gauges that are not distributed yet,
+//   - current rewards of all finality providers.
+//
+// It also checks that no reward gauge has more withdrawn coins than coins.
+//
+//nolint:staticcheck
+func RewardGaugesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		outstanding := sdk.NewCoins()
+
+		for _, st := range types.GetAllStakeholderTypes() {
+			iter := k.rewardGaugeStore(ctx, st).Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				var rg types.RewardGauge
+				k.cdc.MustUnmarshal(iter.Value(), &rg)
+				if !rg.WithdrawnCoins.IsAllLTE(rg.Coins) {
+					broken = true
+					msg += fmt.Sprintf("\treward gauge of %s %s has withdrawn coins %s exceeding coins %s\n",
+						st.String(), sdk.AccAddress(iter.Key()).String(), rg.WithdrawnCoins.String(), rg.Coins.String())
+					continue
+				}
+				outstanding = outstanding.Add(rg.GetWithdrawableCoins()...)
+			}
+			iter.Close()
+		}
+
+		for _, store := range []prefix.Store{k.btcStakingGaugeStore(ctx), k.fpDirectGaugeStore(ctx)} {
+			iter := store.Iterator(nil, nil)
+			for ; iter.Valid(); iter.Next() {
+				var gauge types.Gauge
+				k.cdc.MustUnmarshal(iter.Value(), &gauge)
+				outstanding = outstanding.Add(gauge.Coins...)
+			}
+			iter.Close()
+		}
+
+		err := k.finalityProviderCurrentRewards.Walk(ctx, nil, func(_ []byte, fpCurrentRwd types.FinalityProviderCurrentRewards) (bool, error) {
+			outstanding = outstanding.Add(fpCurrentRwd.CurrentRewards...)
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tfailed to iterate finality providers current rewards: %s\n", err.Error())
+			return sdk.FormatInvariant(types.ModuleName, "reward-gauges", msg), true
+		}
+
+		moduleAcc := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+		balance := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
+		if !outstanding.IsAllLTE(balance) {
+			broken = true
+			msg += fmt.Sprintf("\toutstanding rewards %s exceed the incentive module account balance %s\n",
+				outstanding.String(), balance.String())
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "reward-gauges", msg), broken
+	}
+}
+
+// BTCDelegationRewardsTrackerInvariant checks that the total active satoshis
+// of each finality provider in FinalityProviderCurrentRewards equals the sum
+// of total active satoshis of its BTCDelegationRewardsTracker
+//
+//nolint:staticcheck
+func BTCDelegationRewardsTrackerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		// finality provider address -> sum of total active sats of its trackers
+		trackerSats := make(map[string]sdkmath.Int)
+		// finality provider addresses in the order of the store for determinism
+		trackerFps := make([]sdk.AccAddress, 0)
+		err := k.btcDelegationRewardsTracker.Walk(ctx, nil, func(key collections.Pair[[]byte, []byte], tracker types.BTCDelegationRewardsTracker) (bool, error) {
+			fp := sdk.AccAddress(key.K1())
+			sats, ok := trackerSats[fp.String()]
+			if !ok {
+				sats = sdkmath.ZeroInt()
+				trackerFps = append(trackerFps, fp)
+			}
+			trackerSats[fp.String()] = sats.Add(tracker.TotalActiveSat)
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tfailed to iterate BTC delegation rewards trackers: %s\n", err.Error())
+			return sdk.FormatInvariant(types.ModuleName, "btc-delegation-rewards-tracker", msg), true
+		}
+
+		err = k.finalityProviderCurrentRewards.Walk(ctx, nil, func(key []byte, fpCurrentRwd types.FinalityProviderCurrentRewards) (bool, error) {
+			fp := sdk.AccAddress(key).String()
+			sats, ok := trackerSats[fp]
+			if !ok {
+				sats = sdkmath.ZeroInt()
+			}
+			if !fpCurrentRwd.TotalActiveSat.Equal(sats) {
+				broken = true
+				msg += fmt.Sprintf("\tfinality provider %s has %s total active sats in current rewards but %s in BTC delegation rewards trackers\n",
+					fp, fpCurrentRwd.TotalActiveSat.String(), sats.String())
+			}
+			delete(trackerSats, fp)
+			return false, nil
+		})
+		if err != nil {
+			msg += fmt.Sprintf("\tfailed to iterate finality providers current rewards: %s\n", err.Error())
+			return sdk.FormatInvariant(types.ModuleName, "btc-delegation-rewards-tracker", msg), true
+		}
+
+		// the remaining finality providers have trackers but no current rewards
+		for _, fp := range trackerFps {
+			if _, ok := trackerSats[fp.String()]; ok {
+				broken = true
+				msg += fmt.Sprintf("\tfinality provider %s has BTC delegation rewards trackers but no current rewards\n", fp.String())
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "btc-delegation-rewards-tracker", msg), broken
+	}
+}
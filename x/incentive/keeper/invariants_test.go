@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	testkeeper "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/incentive/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/incentive/types"
+)
+
+func FuzzRewardGaugesInvariant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		incentiveAcc := authtypes.NewEmptyModuleAccount(types.ModuleName)
+		bankKeeper := types.NewMockBankKeeper(ctrl)
+		accountKeeper := types.NewMockAccountKeeper(ctrl)
+		accountKeeper.EXPECT().GetModuleAccount(gomock.Any(), types.ModuleName).Return(incentiveAcc).AnyTimes()
+
+		k, ctx := testkeeper.IncentiveKeeper(t, bankKeeper, accountKeeper, nil, nil)
+
+		outstanding := sdk.NewCoins()
+		for i := uint64(0); i < datagen.RandomInt(r, 5)+1; i++ {
+			rg := datagen.GenRandomRewardGauge(r)
+			rg.WithdrawnCoins = datagen.GenRandomWithdrawnCoins(r, rg.Coins)
+			k.SetRewardGauge(ctx, types.BTC_STAKER, datagen.GenRandomAddress(), rg)
+			outstanding = outstanding.Add(rg.GetWithdrawableCoins()...)
+
+			gauge := datagen.GenRandomGauge(r)
+			k.SetBTCStakingGauge(ctx, i, gauge)
+			outstanding = outstanding.Add(gauge.Coins...)
+
+			fpGauge := datagen.GenRandomGauge(r)
+			k.SetFPDirectGauge(ctx, i, fpGauge)
+			outstanding = outstanding.Add(fpGauge.Coins...)
+		}
+
+		fp, del := datagen.GenRandomAddress(), datagen.GenRandomAddress()
+		require.NoError(t, k.BtcDelegationActivated(ctx, fp, del, sdkmath.NewIntFromUint64(datagen.RandomInt(r, 1000)+1)))
+		fpRwds := datagen.GenRandomCoins(r)
+		require.NoError(t, k.AddFinalityProviderRewardsForBtcDelegations(ctx, fp, fpRwds))
+		outstanding = outstanding.Add(fpRwds...)
+
+		// the module account holds exactly the outstanding rewards
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), incentiveAcc.GetAddress()).Return(outstanding).Times(1)
+		msg, broken := keeper.RewardGaugesInvariant(*k)(ctx)
+		require.False(t, broken, msg)
+
+		// the module account misses a single coin
+		short := outstanding.Sub(sdk.NewInt64Coin(outstanding[0].Denom, 1))
+		bankKeeper.EXPECT().GetAllBalances(gomock.Any(), incentiveAcc.GetAddress()).Return(short).Times(1)
+		_, broken = keeper.RewardGaugesInvariant(*k)(ctx)
+		require.True(t, broken)
+	})
+}
+
+func FuzzBTCDelegationRewardsTrackerInvariant(f *testing.F) {
+	datagen.AddRandomSeedsToFuzzer(f, 10)
+	f.Fuzz(func(t *testing.T, seed int64) {
+		r := rand.New(rand.NewSource(seed))
+
+		k, ctx := testkeeper.IncentiveKeeper(t, nil, nil, nil, nil)
+
+		fps := []sdk.AccAddress{datagen.GenRandomAddress(), datagen.GenRandomAddress()}
+		for i := uint64(0); i < datagen.RandomInt(r, 10)+1; i++ {
+			fp := fps[r.Intn(len(fps))]
+			del := datagen.GenRandomAddress()
+			sat := sdkmath.NewIntFromUint64(datagen.RandomInt(r, 1000) + 1)
+			require.NoError(t, k.BtcDelegationActivated(ctx, fp, del, sat))
+			if datagen.OneInN(r, 3) {
+				require.NoError(t, k.BtcDelegationUnbonded(ctx, fp, del, sat))
+			}
+		}
+
+		msg, broken := keeper.BTCDelegationRewardsTrackerInvariant(*k)(ctx)
+		require.False(t, broken, msg)
+
+		// drift the total active sats of a finality provider
+		fp := fps[0]
+		fpCurrentRwd, err := k.GetFinalityProviderCurrentRewards(ctx, fp)
+		if err != nil {
+			fp = fps[1]
+			fpCurrentRwd, err = k.GetFinalityProviderCurrentRewards(ctx, fp)
+			require.NoError(t, err)
+		}
+		fpCurrentRwd.TotalActiveSat = fpCurrentRwd.TotalActiveSat.AddRaw(1)
+		require.NoError(t, k.SetFinalityProviderCurrentRewards(ctx, fp, fpCurrentRwd))
+
+		_, broken = keeper.BTCDelegationRewardsTrackerInvariant(*k)(ctx)
+		require.True(t, broken)
+	})
+}
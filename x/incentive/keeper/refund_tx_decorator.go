@@ -124,11 +124,14 @@ func isRefundTx(tx sdk.Tx) bool {
 			*btcctypes.MsgInsertBTCSpvProof,
 			// BTC staking
 			*bstypes.MsgAddCovenantSigs,
+			*bstypes.MsgAddCovenantMuSig2Nonce,
+			*bstypes.MsgAddCovenantMuSig2PartialSig,
 			*bstypes.MsgBTCUndelegate,
 			*bstypes.MsgSelectiveSlashingEvidence,
 			*bstypes.MsgAddBTCDelegationInclusionProof,
 			// BTC staking finality
-			*ftypes.MsgAddFinalitySig:
+			*ftypes.MsgAddFinalitySig,
+			*ftypes.MsgAddFinalitySigs:
 			continue
 		default:
 			return false
@@ -23,12 +23,13 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) {
 // maybeUpdateMinter updates the inflation rate and annual provisions if the
 // inflation rate has changed. The inflation rate is expected to change once per
 // year at the genesis time anniversary until the TargetInflationRate is
-// reached.
+// reached, or when the params of the inflation schedule are updated.
 func maybeUpdateMinter(ctx context.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
 	genesisTime := k.GetGenesisTime(ctx).GenesisTime
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	newInflationRate := minter.CalculateInflationRate(sdkCtx, *genesisTime)
+	newInflationRate := minter.CalculateInflationRate(sdkCtx, *genesisTime, params)
 
 	isNonZeroAnnualProvisions := !minter.AnnualProvisions.IsZero()
 	if newInflationRate.Equal(minter.InflationRate) && isNonZeroAnnualProvisions {
@@ -66,6 +67,15 @@ func mintBlockProvision(ctx context.Context, k keeper.Keeper) {
 	if err != nil {
 		panic(err)
 	}
+
+	params := k.GetParams(ctx)
+	if params.HasMaxSupply() {
+		totalSupply, err := k.StakingTokenSupply(ctx)
+		if err != nil {
+			panic(err)
+		}
+		toMintCoin = types.CapBlockProvision(toMintCoin, totalSupply, params)
+	}
 	toMintCoins := sdk.NewCoins(toMintCoin)
 
 	err = k.MintCoins(ctx, toMintCoins)
@@ -132,3 +132,49 @@ func TestAnnualProvisions(t *testing.T) {
 		})
 	})
 }
+
+func TestParamsUpdate(t *testing.T) {
+	t.Run("inflation rate follows updated params", func(t *testing.T) {
+		h := helper.NewHelper(t)
+		a, ctx := h.App, h.Ctx
+
+		params := a.MintKeeper.GetParams(ctx)
+		params.InitialInflationRate = math.LegacyMustNewDecFromStr("0.08")
+		require.NoError(t, a.MintKeeper.SetParams(ctx, params))
+
+		totalSupply, err := a.StakingKeeper.StakingTokenSupply(ctx)
+		require.NoError(t, err)
+
+		genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+		ctx = ctx.WithBlockTime(genesisTime.Add(time.Second * 15))
+		mint.BeginBlocker(ctx, a.MintKeeper)
+
+		minter := a.MintKeeper.GetMinter(ctx)
+		assert.Equal(t, params.InitialInflationRate, minter.InflationRate)
+		assert.True(t, minter.AnnualProvisions.Equal(params.InitialInflationRate.MulInt(totalSupply)))
+	})
+
+	t.Run("no tokens are minted beyond max supply", func(t *testing.T) {
+		h := helper.NewHelper(t)
+		a, ctx := h.App, h.Ctx
+
+		totalSupply, err := a.StakingKeeper.StakingTokenSupply(ctx)
+		require.NoError(t, err)
+
+		// cap the total supply slightly above the current one
+		params := a.MintKeeper.GetParams(ctx)
+		params.MaxSupply = totalSupply.AddRaw(10)
+		require.NoError(t, a.MintKeeper.SetParams(ctx, params))
+
+		blockTime := ctx.BlockTime()
+		for i := 1; i <= 3; i++ {
+			blockTime = blockTime.Add(time.Minute)
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(blockTime)
+			mint.BeginBlocker(ctx, a.MintKeeper)
+		}
+
+		newTotalSupply, err := a.StakingKeeper.StakingTokenSupply(ctx)
+		require.NoError(t, err)
+		assert.True(t, newTotalSupply.Equal(params.MaxSupply), "want %s got %s", params.MaxSupply, newTotalSupply)
+	})
+}
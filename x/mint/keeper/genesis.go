@@ -20,6 +20,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, gs *types.G
 		panic(err)
 	}
 
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
 	if gs.GenesisTime == nil {
 		// If no genesis time, use the block time supplied in `InitChain`
 		blockTime := ctx.BlockTime()
@@ -40,5 +44,6 @@ func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, gs *types.G
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	minter := k.GetMinter(ctx)
 	genTime := k.GetGenesisTime(ctx)
-	return types.NewGenesisState(minter, genTime)
+	params := k.GetParams(ctx)
+	return types.NewGenesisState(minter, genTime, params)
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 	storemetrics "cosmossdk.io/store/metrics"
 	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
@@ -36,6 +37,9 @@ func FuzzTestExportGenesis(f *testing.F) {
 		time := randomGenTime(r)
 		require.NoError(t, k.SetGenesisTime(ctx, *time))
 
+		params := randomParams(r)
+		require.NoError(t, k.SetParams(ctx, params))
+
 		var exported *types.GenesisState
 		require.NotPanics(t, func() {
 			exported = k.ExportGenesis(ctx)
@@ -43,6 +47,7 @@ func FuzzTestExportGenesis(f *testing.F) {
 
 		require.Equal(t, minter, exported.Minter)
 		require.Equal(t, time, exported.GenesisTime)
+		require.Equal(t, params, exported.Params)
 	})
 }
 
@@ -60,6 +65,7 @@ func FuzzTestInitGenesis(f *testing.F) {
 		gs := &types.GenesisState{
 			Minter:      randomMinter(r),
 			GenesisTime: randomGenTime(r),
+			Params:      randomParams(r),
 		}
 		// Run the InitGenesis
 		require.NotPanics(t, func() {
@@ -94,3 +100,13 @@ func randomGenTime(r *rand.Rand) *types.GenesisTime {
 		GenesisTime: &t,
 	}
 }
+
+func randomParams(r *rand.Rand) types.Params {
+	targetInflationRate := math.LegacyNewDecWithPrec(int64(r.Intn(50)), 3)
+	return types.Params{
+		InitialInflationRate: targetInflationRate.Add(math.LegacyNewDecWithPrec(int64(r.Intn(50)), 3)),
+		DisinflationRate:     math.LegacyNewDecWithPrec(int64(r.Intn(500)), 3),
+		TargetInflationRate:  targetInflationRate,
+		MaxSupply:            math.NewIntFromUint64(datagen.RandomInt(r, 1000000000)),
+	}
+}
@@ -27,6 +27,7 @@ type Keeper struct {
 
 	Schema           collections.Schema
 	MinterStore      collections.Item[types.Minter]
+	ParamsStore      collections.Item[types.Params]
 	GenesisTimeStore collections.Item[types.GenesisTime]
 }
 
@@ -54,6 +55,7 @@ func NewKeeper(
 		feeCollectorName: feeCollectorName,
 		authority:        authority,
 		MinterStore:      collections.NewItem(sb, types.MinterKey, "minter", codec.CollValue[types.Minter](cdc)),
+		ParamsStore:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		GenesisTimeStore: collections.NewItem(sb, types.GenesisTimeKey, "genesis_time", codec.CollValue[types.GenesisTime](cdc)),
 	}
 
@@ -84,6 +86,23 @@ func (k Keeper) SetMinter(ctx context.Context, minter types.Minter) error {
 	return k.MinterStore.Set(ctx, minter)
 }
 
+// GetParams returns the params of the inflation schedule.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	params, err := k.ParamsStore.Get(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams sets the params of the inflation schedule.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	return k.ParamsStore.Set(ctx, params)
+}
+
 // GetGenesisTime returns the genesis time.
 func (k Keeper) GetGenesisTime(ctx context.Context) (gt types.GenesisTime) {
 	genesisTime, err := k.GenesisTimeStore.Get(ctx)
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/babylonlabs-io/babylon/v4/x/mint/migrations/v2"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	k Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		k: keeper,
+	}
+}
+
+// Migrate1to2 migrates from version 1 to 2.
+// This migration seeds the params of the inflation schedule.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.k.storeService.OpenKVStore(ctx))
+	return v2.MigrateStore(ctx, store, m.k.cdc)
+}
@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	dbm "github.com/cosmos/cosmos-db"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	appparams "github.com/babylonlabs-io/babylon/v4/app/params"
+	"github.com/babylonlabs-io/babylon/v4/testutil/datagen"
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/mint/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/mint/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	var (
+		db         = dbm.NewMemDB()
+		stateStore = store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		ak         = keepertest.AccountKeeper(t, db, stateStore)
+		k, ctx     = keepertest.MintKeeper(t, db, stateStore, nil, ak, nil)
+		ms         = keeper.NewMsgServerImpl(k)
+	)
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	newParams := types.NewParams(
+		math.LegacyNewDecWithPrec(8, 2),
+		math.LegacyNewDecWithPrec(1, 1),
+		math.LegacyNewDecWithPrec(15, 3),
+		math.NewInt(10_000_000_000_000000),
+	)
+
+	// only the authority can update the params
+	_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: datagen.GenRandomAddress().String(),
+		Params:    newParams,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	// invalid params are rejected
+	invalidParams := newParams
+	invalidParams.TargetInflationRate = math.LegacyNewDecWithPrec(9, 2)
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: appparams.AccGov.String(),
+		Params:    invalidParams,
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+	require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: appparams.AccGov.String(),
+		Params:    newParams,
+	})
+	require.NoError(t, err)
+	require.Equal(t, newParams, k.GetParams(ctx))
+}
@@ -0,0 +1,34 @@
+package v2
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/babylonlabs-io/babylon/v4/x/mint/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2.
+// Migration seeds the params of the inflation schedule with the constants
+// used by v1, so that the inflation schedule is unchanged.
+func MigrateStore(
+	ctx sdk.Context,
+	s storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	params := types.DefaultParams()
+	if err := params.Validate(); err != nil {
+		return fmt.Errorf("mint: invalid default params: %w", err)
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return fmt.Errorf("mint: marshal params: %w", err)
+	}
+	s.Set(types.ParamsKey, bz)
+	ctx.Logger().Info("mint: seeded params v1 to v2 with inflation schedule constants")
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	storemetrics "cosmossdk.io/store/metrics"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/babylonlabs-io/babylon/v4/testutil/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/mint/keeper"
+	"github.com/babylonlabs-io/babylon/v4/x/mint/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	var (
+		db         = dbm.NewMemDB()
+		stateStore = store.NewCommitMultiStore(db, log.NewTestLogger(t), storemetrics.NewNoOpMetrics())
+		ak         = keepertest.AccountKeeper(t, db, stateStore)
+		k, ctx     = keepertest.MintKeeper(t, db, stateStore, nil, ak, nil)
+	)
+
+	// v1 store has no params
+	_, err := k.ParamsStore.Get(ctx)
+	require.Error(t, err)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	// the params hold the constants of the v1 inflation schedule
+	params := k.GetParams(ctx)
+	require.Equal(t, types.DefaultParams(), params)
+	require.True(t, types.InitialInflationRateAsDec().Equal(params.InitialInflationRate))
+	require.True(t, types.DisinflationRateAsDec().Equal(params.DisinflationRate))
+	require.True(t, types.TargetInflationRateAsDec().Equal(params.TargetInflationRate))
+	require.False(t, params.HasMaxSupply())
+}
@@ -20,10 +20,12 @@ func TestDecodeStore(t *testing.T) {
 	minter := types.NewMinter(math.LegacyOneDec(), math.LegacyNewDec(15), appparams.DefaultBondDenom)
 	unixEpoch := time.Unix(0, 0).UTC()
 	genesisTime := types.GenesisTime{GenesisTime: &unixEpoch}
+	params := types.DefaultParams()
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: types.ParamsKey, Value: cdc.MustMarshal(&params)},
 			{Key: types.GenesisTimeKey, Value: cdc.MustMarshal(&genesisTime)},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
@@ -38,6 +40,11 @@ func TestDecodeStore(t *testing.T) {
 			expected:    fmt.Sprintf("%v\n%v", minter, minter),
 			expectPanic: false,
 		},
+		{
+			name:        "Params",
+			expected:    fmt.Sprintf("%v\n%v", params, params),
+			expectPanic: false,
+		},
 		{
 			name:        "GenesisTime",
 			expected:    fmt.Sprintf("%v\n%v", genesisTime, genesisTime),
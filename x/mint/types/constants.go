@@ -4,7 +4,6 @@ import (
 	"cosmossdk.io/math"
 )
 
-// TODO: should these be parameters?
 const (
 	NanosecondsPerSecond = 1_000_000_000
 	SecondsPerMinute     = 60
@@ -17,6 +16,9 @@ const (
 	SecondsPerYear     = int64(SecondsPerMinute * MinutesPerHour * HoursPerDay * DaysPerYear) // 31,556,952
 	NanosecondsPerYear = NanosecondsPerSecond * SecondsPerYear                                // 31,556,952,000,000,000
 
+	// The following rates are the defaults of the params and the values
+	// seeded by the v1 to v2 store migration.
+
 	// InitialInflationRate is the inflation rate that the network starts at.
 	InitialInflationRate = 0.055
 	// DisinflationRate is the rate at which the inflation rate decreases each year.
@@ -3,10 +3,11 @@ package types
 import "errors"
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(minter Minter, genTime GenesisTime) *GenesisState {
+func NewGenesisState(minter Minter, genTime GenesisTime, params Params) *GenesisState {
 	return &GenesisState{
 		Minter:      &minter,
 		GenesisTime: &genTime,
+		Params:      params,
 	}
 }
 
@@ -16,6 +17,7 @@ func DefaultGenesisState() *GenesisState {
 	dm := DefaultMinter()
 	return &GenesisState{
 		Minter: &dm,
+		Params: DefaultParams(),
 	}
 }
 
@@ -28,5 +30,8 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Minter.Validate(); err != nil {
 		return err
 	}
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -28,6 +29,8 @@ type GenesisState struct {
 	Minter *Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter,omitempty"`
 	// GenesisTime is the timestamp of the genesis block.
 	GenesisTime *GenesisTime `protobuf:"bytes,3,opt,name=genesis_time,json=genesisTime,proto3" json:"genesis_time,omitempty"`
+	// params defines the parameters of the inflation schedule.
+	Params Params `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -77,6 +80,13 @@ func (m *GenesisState) GetGenesisTime() *GenesisTime {
 	return nil
 }
 
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "babylon.mint.v1.GenesisState")
 }
@@ -84,21 +94,23 @@ func init() {
 func init() { proto.RegisterFile("babylon/mint/v1/genesis.proto", fileDescriptor_cc1262885e03592c) }
 
 var fileDescriptor_cc1262885e03592c = []byte{
-	// 216 bytes of a gzipped FileDescriptorProto
+	// 256 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0x4a, 0x4c, 0xaa,
 	0xcc, 0xc9, 0xcf, 0xd3, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
 	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x87, 0x4a, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0xa4, 0xd0, 0xd5, 0x83, 0x25, 0xc0, 0x8a, 0x95, 0xda, 0x18, 0xb9, 0x78,
-	0xdc, 0x21, 0xda, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xf4, 0xb9, 0xd8, 0x40, 0xd2, 0xa9, 0x45,
-	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xe2, 0x7a, 0x68, 0xc6, 0xe9, 0xf9, 0x82, 0xa5, 0x83,
-	0xa0, 0xca, 0x84, 0xec, 0xb9, 0x78, 0xa0, 0xf6, 0xc7, 0x97, 0x64, 0xe6, 0xa6, 0x4a, 0x30, 0x83,
-	0xb5, 0xc9, 0x60, 0x68, 0x83, 0xda, 0x12, 0x92, 0x99, 0x9b, 0x1a, 0xc4, 0x9d, 0x8e, 0xe0, 0x78,
-	0xb1, 0x70, 0x30, 0x09, 0x30, 0x3b, 0x79, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3,
-	0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c,
-	0x43, 0x94, 0x61, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e, 0xae, 0x3e, 0xd4, 0xd0,
-	0x9c, 0xc4, 0xa4, 0x62, 0xdd, 0xcc, 0x7c, 0x18, 0x57, 0xbf, 0xcc, 0x44, 0xbf, 0x02, 0xe2, 0xbb,
-	0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xe7, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x84, 0xb3, 0xe8, 0x19, 0x2a, 0x01, 0x00, 0x00,
+	0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x72, 0xfa, 0x20, 0x16, 0x44, 0x99,
+	0x94, 0x14, 0xba, 0x29, 0x60, 0xe5, 0x60, 0x39, 0xa5, 0xbd, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0x43,
+	0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0xf4, 0xb9, 0xd8, 0x40, 0xd2, 0xa9, 0x45, 0x12, 0x8c, 0x0a,
+	0x8c, 0x1a, 0xdc, 0x46, 0xe2, 0x7a, 0x68, 0x96, 0xe8, 0xf9, 0x82, 0xa5, 0x83, 0xa0, 0xca, 0x84,
+	0xec, 0xb9, 0x78, 0xa0, 0xae, 0x8a, 0x2f, 0xc9, 0xcc, 0x4d, 0x95, 0x60, 0x06, 0x6b, 0x93, 0xc1,
+	0xd0, 0x06, 0xb5, 0x25, 0x24, 0x33, 0x37, 0x35, 0x88, 0x3b, 0x1d, 0xc1, 0x11, 0x32, 0xe5, 0x62,
+	0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0xc1, 0x61, 0x63, 0x00, 0x58, 0xda, 0x89, 0xe5,
+	0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x62, 0x2f, 0x16, 0x0e, 0x26, 0x01, 0x66, 0x27, 0xef, 0x13,
+	0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86,
+	0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d,
+	0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0x1a, 0x98, 0x93, 0x98, 0x54, 0xac, 0x9b, 0x99, 0x0f, 0xe3,
+	0xea, 0x97, 0x99, 0xe8, 0x57, 0x40, 0x02, 0xa5, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c,
+	0x26, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x97, 0xf4, 0x3f, 0x1c, 0x77, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -121,6 +133,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.GenesisTime != nil {
 		{
 			size, err := m.GenesisTime.MarshalToSizedBuffer(dAtA[:i])
@@ -173,6 +195,8 @@ func (m *GenesisState) Size() (n int) {
 		l = m.GenesisTime.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -283,6 +307,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
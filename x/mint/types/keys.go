@@ -3,9 +3,8 @@ package types
 import "cosmossdk.io/collections"
 
 var (
-	MinterKey = collections.NewPrefix(0)
-	//nolint:unused
-	reservedKey    = collections.NewPrefix(1) // reserved for parameters
+	MinterKey      = collections.NewPrefix(0)
+	ParamsKey      = collections.NewPrefix(1)
 	GenesisTimeKey = collections.NewPrefix(2)
 )
 
@@ -23,4 +22,5 @@ const (
 	QueryInflationRate    = "inflation_rate"
 	QueryAnnualProvisions = "annual_provisions"
 	QueryGenesisTime      = "genesis_time"
+	QueryParams           = "params"
 )
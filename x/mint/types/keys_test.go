@@ -9,7 +9,8 @@ import (
 
 func TestNoKeyCollision(t *testing.T) {
 	keys := map[string]interface{}{
-		"MinterKey":     types.MinterKey,
+		"MinterKey":      types.MinterKey,
+		"ParamsKey":      types.ParamsKey,
 		"GenesisTimeKey": types.GenesisTimeKey,
 	}
 
@@ -137,40 +137,96 @@ func (m *GenesisTime) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// Params defines the parameters of the inflation schedule of the mint module.
+type Params struct {
+	// InitialInflationRate is the inflation rate that the network starts at.
+	InitialInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=initial_inflation_rate,json=initialInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"initial_inflation_rate"`
+	// DisinflationRate is the rate at which the inflation rate decreases each
+	// year.
+	DisinflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=disinflation_rate,json=disinflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"disinflation_rate"`
+	// TargetInflationRate is the inflation rate that the network aims to
+	// stabilize at. In practice, TargetInflationRate acts as a minimum so that
+	// the inflation rate doesn't decrease after reaching it.
+	TargetInflationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=target_inflation_rate,json=targetInflationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_inflation_rate"`
+	// MaxSupply is the maximum total supply of the bond denom. No tokens are
+	// minted once the total supply reaches MaxSupply. Zero means no cap.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a78f7d85d8f9d75c, []int{2}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "babylon.mint.v1.Minter")
 	proto.RegisterType((*GenesisTime)(nil), "babylon.mint.v1.GenesisTime")
+	proto.RegisterType((*Params)(nil), "babylon.mint.v1.Params")
 }
 
 func init() { proto.RegisterFile("babylon/mint/v1/mint.proto", fileDescriptor_a78f7d85d8f9d75c) }
 
 var fileDescriptor_a78f7d85d8f9d75c = []byte{
-	// 389 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0xcd, 0x6e, 0xd4, 0x30,
-	0x10, 0x8e, 0xab, 0x52, 0xa9, 0x5e, 0xfe, 0x1a, 0x38, 0x84, 0x20, 0x92, 0xaa, 0xa7, 0x5e, 0x6a,
-	0x2b, 0xc0, 0x13, 0x84, 0x95, 0x38, 0x00, 0x52, 0x15, 0x71, 0x40, 0x1c, 0x88, 0xec, 0xac, 0xeb,
-	0x5a, 0x4d, 0x3c, 0x51, 0xec, 0x44, 0xec, 0x5b, 0xf4, 0x5d, 0xe0, 0x21, 0x7a, 0xac, 0x38, 0x21,
-	0x0e, 0x05, 0xed, 0xbe, 0x08, 0x72, 0x9c, 0x94, 0x2b, 0xea, 0x29, 0xf9, 0xe6, 0x9b, 0xf9, 0xbe,
-	0xf1, 0xa7, 0xc1, 0x31, 0x67, 0x7c, 0x5d, 0x83, 0xa6, 0x8d, 0xd2, 0x96, 0x0e, 0xd9, 0xf8, 0x25,
-	0x6d, 0x07, 0x16, 0xc2, 0x47, 0x13, 0x47, 0xc6, 0xda, 0x90, 0xc5, 0x4f, 0x25, 0x48, 0x18, 0x39,
-	0xea, 0xfe, 0x7c, 0x5b, 0xfc, 0xac, 0x02, 0xd3, 0x80, 0x29, 0x3d, 0xe1, 0xc1, 0x44, 0xa5, 0x12,
-	0x40, 0xd6, 0x82, 0x8e, 0x88, 0xf7, 0x67, 0xd4, 0xaa, 0x46, 0x18, 0xcb, 0x9a, 0xd6, 0x37, 0x1c,
-	0x7d, 0xdb, 0xc1, 0x7b, 0x1f, 0x94, 0xb6, 0xa2, 0x0b, 0x3f, 0xe1, 0x87, 0x4a, 0x9f, 0xd5, 0xcc,
-	0x2a, 0xd0, 0x65, 0xc7, 0xac, 0x88, 0xd0, 0x21, 0x3a, 0xde, 0xcf, 0xb3, 0xab, 0x9b, 0x34, 0xf8,
-	0x75, 0x93, 0x3e, 0xf7, 0xca, 0x66, 0x75, 0x41, 0x14, 0xd0, 0x86, 0xd9, 0x73, 0xf2, 0x5e, 0x48,
-	0x56, 0xad, 0x97, 0xa2, 0xfa, 0xf1, 0xfd, 0x04, 0x4f, 0xc6, 0x4b, 0x51, 0x15, 0x0f, 0x6e, 0x85,
-	0x0a, 0x66, 0x45, 0xf8, 0x05, 0x1f, 0x30, 0xad, 0x7b, 0x56, 0xbb, 0x15, 0x07, 0x65, 0x14, 0x68,
-	0x13, 0xed, 0xdc, 0x55, 0xfc, 0xb1, 0xd7, 0x3a, 0xbd, 0x95, 0x0a, 0x4f, 0xf1, 0x93, 0xb6, 0x13,
-	0x83, 0x82, 0xde, 0x94, 0xbc, 0x86, 0xea, 0xa2, 0x74, 0xcf, 0x8c, 0x76, 0x0f, 0xd1, 0xf1, 0xe2,
-	0x65, 0x4c, 0x7c, 0x06, 0x64, 0xce, 0x80, 0x7c, 0x9c, 0x33, 0xc8, 0x77, 0x2f, 0x7f, 0xa7, 0xa8,
-	0x38, 0x98, 0x87, 0x73, 0x37, 0xeb, 0xd8, 0xf0, 0x05, 0xc6, 0x1c, 0xf4, 0xaa, 0x5c, 0x09, 0x0d,
-	0x4d, 0x74, 0xcf, 0xad, 0x5a, 0xec, 0xbb, 0xca, 0xd2, 0x15, 0x8e, 0x0a, 0xbc, 0x78, 0x2b, 0xb4,
-	0x30, 0xca, 0x8c, 0xdd, 0x6f, 0xf0, 0x7d, 0xe9, 0xa1, 0x37, 0x46, 0xff, 0x69, 0xbc, 0x90, 0xff,
-	0x44, 0xf2, 0x77, 0x57, 0x9b, 0x04, 0x5d, 0x6f, 0x12, 0xf4, 0x67, 0x93, 0xa0, 0xcb, 0x6d, 0x12,
-	0x5c, 0x6f, 0x93, 0xe0, 0xe7, 0x36, 0x09, 0x3e, 0x67, 0x52, 0xd9, 0xf3, 0x9e, 0x93, 0x0a, 0x1a,
-	0x3a, 0x5d, 0x44, 0xcd, 0xb8, 0x39, 0x51, 0x30, 0x43, 0x3a, 0xbc, 0xa6, 0x5f, 0xfd, 0x05, 0xd9,
-	0x75, 0x2b, 0x0c, 0xdf, 0x1b, 0x3d, 0x5f, 0xfd, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xfe, 0xb4,
-	0x60, 0x5e, 0x02, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0xb6, 0x44, 0xca, 0x86, 0xaf, 0xba, 0x2d, 0x0a, 0x41, 0x38, 0x55, 0x4e, 0x95,
+	0x50, 0xbd, 0x0a, 0xf0, 0x04, 0x26, 0x12, 0x0a, 0x1f, 0x52, 0x64, 0x38, 0x20, 0x0e, 0x58, 0x6b,
+	0x67, 0xbb, 0x5d, 0xd5, 0xbb, 0x63, 0x79, 0xd7, 0x56, 0x72, 0xe7, 0x01, 0xfa, 0x2e, 0xf0, 0x10,
+	0x3d, 0x56, 0x9c, 0x10, 0x87, 0x82, 0x92, 0x17, 0x41, 0xde, 0x75, 0x4a, 0x08, 0x17, 0x14, 0xf5,
+	0x64, 0xcf, 0xce, 0xcc, 0xef, 0xbf, 0x33, 0x7f, 0x2d, 0xea, 0xc6, 0x24, 0x9e, 0xa5, 0x20, 0xb1,
+	0xe0, 0x52, 0xe3, 0x72, 0x60, 0xbe, 0x7e, 0x96, 0x83, 0x06, 0xf7, 0x5e, 0x9d, 0xf3, 0xcd, 0x59,
+	0x39, 0xe8, 0xee, 0x33, 0x60, 0x60, 0x72, 0xb8, 0xfa, 0xb3, 0x65, 0xdd, 0x87, 0x09, 0x28, 0x01,
+	0x2a, 0xb2, 0x09, 0x1b, 0xd4, 0xa9, 0x1e, 0x03, 0x60, 0x29, 0xc5, 0x26, 0x8a, 0x8b, 0x13, 0xac,
+	0xb9, 0xa0, 0x4a, 0x13, 0x91, 0xd9, 0x82, 0xfe, 0x97, 0x2d, 0xd4, 0x7c, 0xcb, 0xa5, 0xa6, 0xb9,
+	0xfb, 0x01, 0xdd, 0xe5, 0xf2, 0x24, 0x25, 0x9a, 0x83, 0x8c, 0x72, 0xa2, 0x69, 0xc7, 0x39, 0x74,
+	0x8e, 0x5a, 0xc1, 0xe0, 0xe2, 0xaa, 0xd7, 0xf8, 0x71, 0xd5, 0x7b, 0x64, 0xc9, 0x6a, 0x72, 0xe6,
+	0x73, 0xc0, 0x82, 0xe8, 0x53, 0xff, 0x0d, 0x65, 0x24, 0x99, 0x0d, 0x69, 0xf2, 0xed, 0xeb, 0x31,
+	0xaa, 0x85, 0x87, 0x34, 0x09, 0xef, 0x5c, 0x83, 0x42, 0xa2, 0xa9, 0xfb, 0x09, 0xed, 0x12, 0x29,
+	0x0b, 0x92, 0x56, 0x57, 0x2c, 0xb9, 0xe2, 0x20, 0x55, 0x67, 0x6b, 0x53, 0xf8, 0x7d, 0xcb, 0x1a,
+	0x5f, 0xa3, 0xdc, 0x31, 0xda, 0xcb, 0x72, 0x5a, 0x72, 0x28, 0x54, 0x14, 0xa7, 0x90, 0x9c, 0x45,
+	0xd5, 0x98, 0x9d, 0x9d, 0x43, 0xe7, 0xa8, 0xfd, 0xb4, 0xeb, 0xdb, 0x1d, 0xf8, 0xcb, 0x1d, 0xf8,
+	0xef, 0x97, 0x3b, 0x08, 0x76, 0xce, 0x7f, 0xf6, 0x9c, 0x70, 0x77, 0xd9, 0x1c, 0x54, 0xbd, 0x55,
+	0xd6, 0x7d, 0x8c, 0x50, 0x0c, 0x72, 0x12, 0x4d, 0xa8, 0x04, 0xd1, 0xb9, 0x55, 0x5d, 0x35, 0x6c,
+	0x55, 0x27, 0xc3, 0xea, 0xa0, 0x1f, 0xa2, 0xf6, 0x4b, 0x2a, 0xa9, 0xe2, 0xca, 0x54, 0xbf, 0x40,
+	0xb7, 0x99, 0x0d, 0xad, 0xb0, 0xf3, 0x9f, 0xc2, 0x6d, 0xf6, 0x07, 0xd2, 0xff, 0xbc, 0x8d, 0x9a,
+	0x63, 0x92, 0x13, 0xa1, 0x5c, 0x86, 0x1e, 0x70, 0xc9, 0x35, 0x27, 0x69, 0x74, 0x53, 0x8e, 0xec,
+	0xd7, 0xc0, 0xd1, 0xba, 0x31, 0x13, 0xae, 0xd6, 0x34, 0x36, 0x37, 0x66, 0x95, 0x65, 0xf8, 0x14,
+	0x1d, 0x68, 0x92, 0x33, 0xaa, 0xd7, 0xe7, 0xd8, 0xde, 0x54, 0x63, 0xcf, 0xf2, 0xfe, 0x1e, 0xe3,
+	0x15, 0x42, 0x82, 0x4c, 0x23, 0x55, 0x64, 0x59, 0x3a, 0x33, 0xb6, 0xb7, 0x82, 0x27, 0x35, 0xfb,
+	0xe0, 0x5f, 0xf6, 0x48, 0xea, 0x15, 0xea, 0x48, 0xea, 0xb0, 0x25, 0xc8, 0xf4, 0x9d, 0xe9, 0x0e,
+	0x5e, 0x5f, 0xcc, 0x3d, 0xe7, 0x72, 0xee, 0x39, 0xbf, 0xe6, 0x9e, 0x73, 0xbe, 0xf0, 0x1a, 0x97,
+	0x0b, 0xaf, 0xf1, 0x7d, 0xe1, 0x35, 0x3e, 0x0e, 0x18, 0xd7, 0xa7, 0x45, 0xec, 0x27, 0x20, 0x70,
+	0xfd, 0x30, 0x53, 0x12, 0xab, 0x63, 0x0e, 0xcb, 0x10, 0x97, 0xcf, 0xf1, 0xd4, 0x3e, 0x64, 0x3d,
+	0xcb, 0xa8, 0x8a, 0x9b, 0xc6, 0xfa, 0x67, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xbd, 0x50,
+	0x03, 0xe5, 0x03, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -266,6 +322,69 @@ func (m *GenesisTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TargetInflationRate.Size()
+		i -= size
+		if _, err := m.TargetInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.DisinflationRate.Size()
+		i -= size
+		if _, err := m.DisinflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InitialInflationRate.Size()
+		i -= size
+		if _, err := m.InitialInflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -311,6 +430,23 @@ func (m *GenesisTime) Size() (n int) {
 	return n
 }
 
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialInflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.DisinflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.TargetInflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -589,6 +725,192 @@ func (m *GenesisTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisinflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DisinflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetInflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetInflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
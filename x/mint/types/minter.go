@@ -52,13 +52,13 @@ func (m Minter) Validate() error {
 
 // CalculateInflationRate returns the inflation rate for the current year depending on
 // the current block height in context. The inflation rate is expected to
-// decrease every year according to the schedule specified in the README.
-func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time) math.LegacyDec {
+// decrease every year according to the schedule specified in the params.
+func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time, params Params) math.LegacyDec {
 	years := YearsSinceGenesis(genesis, ctx.BlockTime())
-	inflationRate := InitialInflationRateAsDec().Mul(math.LegacyOneDec().Sub(DisinflationRateAsDec()).Power(uint64(years)))
+	inflationRate := params.InitialInflationRate.Mul(math.LegacyOneDec().Sub(params.DisinflationRate).Power(uint64(years)))
 
-	if inflationRate.LT(TargetInflationRateAsDec()) {
-		return TargetInflationRateAsDec()
+	if inflationRate.LT(params.TargetInflationRate) {
+		return params.TargetInflationRate
 	}
 	return inflationRate
 }
@@ -79,6 +79,19 @@ func (m Minter) CalculateBlockProvision(current time.Time, previous time.Time) (
 	return sdk.NewCoin(m.BondDenom, amountToMint), nil
 }
 
+// CapBlockProvision returns the block provision reduced so that the total
+// supply after minting does not exceed the max supply of the params.
+func CapBlockProvision(blockProvision sdk.Coin, totalSupply math.Int, params Params) sdk.Coin {
+	if !params.HasMaxSupply() {
+		return blockProvision
+	}
+	remaining := params.MaxSupply.Sub(totalSupply)
+	if !remaining.IsPositive() {
+		return sdk.NewCoin(blockProvision.Denom, math.ZeroInt())
+	}
+	return sdk.NewCoin(blockProvision.Denom, math.MinInt(blockProvision.Amount, remaining))
+}
+
 // YearsSinceGenesis returns the number of years that have passed between
 // genesis and current (rounded down).
 func YearsSinceGenesis(genesis time.Time, current time.Time) (years int64) {
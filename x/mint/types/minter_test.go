@@ -72,7 +72,7 @@ func TestCalculateInflationRate(t *testing.T) {
 		years := time.Duration(tc.year * types.NanosecondsPerYear * int64(time.Nanosecond))
 		blockTime := genesisTime.Add(years)
 		ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithBlockTime(blockTime)
-		inflationRate := minter.CalculateInflationRate(ctx, genesisTime)
+		inflationRate := minter.CalculateInflationRate(ctx, genesisTime, types.DefaultParams())
 		got, err := inflationRate.Float64()
 		assert.NoError(t, err)
 		assert.Equal(t, tc.want, got, "want %v got %v year %v blockTime %v", tc.want, got, tc.year, blockTime)
@@ -172,6 +172,68 @@ func randomBlockInterval() time.Duration {
 }
 
 // randInRange returns a random number in the range (min, max) inclusive.
+func TestCalculateInflationRateWithParams(t *testing.T) {
+	minter := types.DefaultMinter()
+	genesisTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	params := types.NewParams(
+		math.LegacyNewDecWithPrec(8, 2),
+		math.LegacyNewDecWithPrec(1, 1),
+		math.LegacyNewDecWithPrec(15, 3),
+		math.ZeroInt(),
+	)
+
+	type testCase struct {
+		year int64
+		want string
+	}
+
+	testCases := []testCase{
+		{0, "0.080000000000000000"},
+		{1, "0.072000000000000000"},
+		{2, "0.064800000000000000"},
+		{15, "0.016471290567571920"},
+		{16, "0.015000000000000000"},
+		{20, "0.015000000000000000"},
+	}
+
+	for _, tc := range testCases {
+		years := time.Duration(tc.year * types.NanosecondsPerYear * int64(time.Nanosecond))
+		blockTime := genesisTime.Add(years)
+		ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil).WithBlockTime(blockTime)
+		got := minter.CalculateInflationRate(ctx, genesisTime, params)
+		assert.Equal(t, tc.want, got.String(), "year %v", tc.year)
+	}
+}
+
+func TestCapBlockProvision(t *testing.T) {
+	blockProvision := sdk.NewCoin(types.DefaultBondDenom, math.NewInt(100))
+
+	type testCase struct {
+		name        string
+		maxSupply   math.Int
+		totalSupply math.Int
+		want        math.Int
+	}
+
+	testCases := []testCase{
+		{"no max supply", math.ZeroInt(), math.NewInt(1_000_000), math.NewInt(100)},
+		{"below max supply", math.NewInt(1_000), math.NewInt(500), math.NewInt(100)},
+		{"reaching max supply", math.NewInt(1_000), math.NewInt(950), math.NewInt(50)},
+		{"at max supply", math.NewInt(1_000), math.NewInt(1_000), math.ZeroInt()},
+		{"above max supply", math.NewInt(1_000), math.NewInt(2_000), math.ZeroInt()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.MaxSupply = tc.maxSupply
+			got := types.CapBlockProvision(blockProvision, tc.totalSupply, params)
+			require.Equal(t, types.DefaultBondDenom, got.Denom)
+			require.True(t, tc.want.Equal(got.Amount), "want %s got %s", tc.want, got.Amount)
+		})
+	}
+}
+
 func randInRange(min int64, max int64) int64 {
 	return rand.Int63n(max-min) + min
 }
@@ -199,7 +261,7 @@ func BenchmarkCalculateInflationRate(b *testing.B) {
 
 	for n := 0; n < b.N; n++ {
 		ctx := sdk.NewContext(nil, tmproto.Header{Height: int64(n)}, false, nil)
-		minter.CalculateInflationRate(ctx, genesisTime)
+		minter.CalculateInflationRate(ctx, genesisTime, types.DefaultParams())
 	}
 }
 
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// NewParams returns a new Params object.
+func NewParams(initialInflationRate, disinflationRate, targetInflationRate math.LegacyDec, maxSupply math.Int) Params {
+	return Params{
+		InitialInflationRate: initialInflationRate,
+		DisinflationRate:     disinflationRate,
+		TargetInflationRate:  targetInflationRate,
+		MaxSupply:            maxSupply,
+	}
+}
+
+// DefaultParams returns the params of the inflation schedule defined in
+// constants.go without cap on the total supply.
+func DefaultParams() Params {
+	return NewParams(
+		InitialInflationRateAsDec(),
+		DisinflationRateAsDec(),
+		TargetInflationRateAsDec(),
+		math.ZeroInt(),
+	)
+}
+
+// Validate returns an error if the params are invalid.
+func (p Params) Validate() error {
+	if err := validateRate(p.InitialInflationRate); err != nil {
+		return fmt.Errorf("invalid initial inflation rate: %w", err)
+	}
+	if err := validateRate(p.DisinflationRate); err != nil {
+		return fmt.Errorf("invalid disinflation rate: %w", err)
+	}
+	if err := validateRate(p.TargetInflationRate); err != nil {
+		return fmt.Errorf("invalid target inflation rate: %w", err)
+	}
+	if p.TargetInflationRate.GT(p.InitialInflationRate) {
+		return fmt.Errorf("target inflation rate %s cannot be greater than initial inflation rate %s",
+			p.TargetInflationRate.String(), p.InitialInflationRate.String())
+	}
+	if p.MaxSupply.IsNil() {
+		return errors.New("max supply cannot be nil")
+	}
+	if p.MaxSupply.IsNegative() {
+		return fmt.Errorf("max supply %s cannot be negative", p.MaxSupply.String())
+	}
+
+	return nil
+}
+
+// HasMaxSupply returns whether the total supply is capped.
+func (p Params) HasMaxSupply() bool {
+	return p.MaxSupply.IsPositive()
+}
+
+func validateRate(rate math.LegacyDec) error {
+	if rate.IsNil() {
+		return errors.New("rate cannot be nil")
+	}
+	if rate.IsNegative() {
+		return fmt.Errorf("rate %s cannot be negative", rate.String())
+	}
+	if rate.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("rate %s cannot be greater than 1", rate.String())
+	}
+	return nil
+}
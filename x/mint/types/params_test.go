@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/babylonlabs-io/babylon/v4/x/mint/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		params  func() types.Params
+		wantErr bool
+	}{
+		{
+			name:   "valid default params",
+			params: types.DefaultParams,
+		},
+		{
+			name: "valid params with max supply",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxSupply = math.NewInt(10_000_000_000_000000)
+				return p
+			},
+		},
+		{
+			name: "nil initial inflation rate",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.InitialInflationRate = math.LegacyDec{}
+				return p
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative disinflation rate",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DisinflationRate = math.LegacyNewDecWithPrec(-1, 2)
+				return p
+			},
+			wantErr: true,
+		},
+		{
+			name: "disinflation rate greater than 1",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.DisinflationRate = math.LegacyNewDecWithPrec(11, 1)
+				return p
+			},
+			wantErr: true,
+		},
+		{
+			name: "target inflation rate greater than initial inflation rate",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.TargetInflationRate = p.InitialInflationRate.Add(math.LegacyNewDecWithPrec(1, 3))
+				return p
+			},
+			wantErr: true,
+		},
+		{
+			name: "nil max supply",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxSupply = math.Int{}
+				return p
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max supply",
+			params: func() types.Params {
+				p := types.DefaultParams()
+				p.MaxSupply = math.NewInt(-1)
+				return p
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params().Validate()
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -275,6 +275,89 @@ func (m *QueryGenesisTimeResponse) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// QueryParamsRequest is the request type for the Query/Params RPC method.
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bab217370cac4c70, []int{6}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse is the response type for the Query/Params RPC method.
+type QueryParamsResponse struct {
+	// params holds all the parameters of the mint module.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bab217370cac4c70, []int{7}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "babylon.mint.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "babylon.mint.v1.QueryInflationRateResponse")
@@ -282,44 +365,50 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "babylon.mint.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryGenesisTimeRequest)(nil), "babylon.mint.v1.QueryGenesisTimeRequest")
 	proto.RegisterType((*QueryGenesisTimeResponse)(nil), "babylon.mint.v1.QueryGenesisTimeResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "babylon.mint.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "babylon.mint.v1.QueryParamsResponse")
 }
 
 func init() { proto.RegisterFile("babylon/mint/v1/query.proto", fileDescriptor_bab217370cac4c70) }
 
 var fileDescriptor_bab217370cac4c70 = []byte{
-	// 498 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xb3, 0xfc, 0x3b, 0x6c, 0x5a, 0x28, 0x2b, 0x24, 0x5a, 0xa7, 0x38, 0xe0, 0x08, 0x94,
-	0x52, 0x75, 0x57, 0x29, 0xbc, 0x00, 0x01, 0x09, 0xf1, 0xe7, 0x50, 0xa2, 0x9e, 0xb8, 0x44, 0xeb,
-	0xb0, 0x75, 0x56, 0xd8, 0x3b, 0x4e, 0x76, 0x1d, 0x91, 0x2b, 0x77, 0xa4, 0x4a, 0x88, 0x07, 0xe0,
-	0x6d, 0x7a, 0xac, 0xd4, 0x0b, 0xe2, 0x50, 0x50, 0xc2, 0x83, 0x20, 0xaf, 0xed, 0x92, 0x26, 0x8e,
-	0x14, 0x6e, 0x1e, 0x7f, 0xe3, 0xf9, 0x7e, 0x9e, 0x6f, 0x70, 0xcd, 0xe7, 0xfe, 0x38, 0x04, 0xc5,
-	0x22, 0xa9, 0x0c, 0x1b, 0xb5, 0xd8, 0x20, 0x11, 0xc3, 0x31, 0x8d, 0x87, 0x60, 0x80, 0xdc, 0xca,
-	0x45, 0x9a, 0x8a, 0x74, 0xd4, 0x72, 0xee, 0x04, 0x10, 0x80, 0xd5, 0x58, 0xfa, 0x94, 0xb5, 0x39,
-	0xdb, 0x01, 0x40, 0x10, 0x0a, 0xc6, 0x63, 0xc9, 0xb8, 0x52, 0x60, 0xb8, 0x91, 0xa0, 0x74, 0xae,
-	0xd6, 0x73, 0xd5, 0x56, 0x7e, 0x72, 0xc4, 0x8c, 0x8c, 0x84, 0x36, 0x3c, 0x8a, 0xb3, 0x06, 0xaf,
-	0x86, 0xb7, 0xde, 0xa5, 0xa6, 0xaf, 0xd4, 0x51, 0x68, 0xbf, 0xec, 0x70, 0x23, 0x3a, 0x62, 0x90,
-	0x08, 0x6d, 0xbc, 0x3e, 0x76, 0xca, 0x44, 0x1d, 0x83, 0xd2, 0x82, 0xbc, 0xc6, 0x37, 0x65, 0x21,
-	0x74, 0x87, 0xdc, 0x88, 0x4d, 0x74, 0x1f, 0x35, 0xd7, 0xda, 0x8d, 0x93, 0xf3, 0x7a, 0xe5, 0xe7,
-	0x79, 0xbd, 0xd6, 0x03, 0x1d, 0x81, 0xd6, 0x1f, 0x3e, 0x52, 0x09, 0x2c, 0xe2, 0xa6, 0x4f, 0xdf,
-	0x8a, 0x80, 0xf7, 0xc6, 0x2f, 0x44, 0xaf, 0xb3, 0x2e, 0x67, 0x67, 0x7a, 0x2e, 0xde, 0xb6, 0x4e,
-	0xcf, 0x94, 0x4a, 0x78, 0x78, 0x30, 0x84, 0x91, 0xd4, 0xe9, 0x6f, 0x14, 0x24, 0x03, 0x7c, 0x6f,
-	0x89, 0x9e, 0xc3, 0x1c, 0xe0, 0xdb, 0xdc, 0x6a, 0xdd, 0xf8, 0x42, 0xfc, 0x1f, 0x9e, 0x0d, 0x3e,
-	0x37, 0xd9, 0xdb, 0xc2, 0x77, 0xad, 0xe5, 0x4b, 0xa1, 0x84, 0x96, 0xfa, 0x50, 0x46, 0x17, 0x7b,
-	0xe9, 0xe2, 0xcd, 0x45, 0x29, 0x07, 0x79, 0x8e, 0xd7, 0x82, 0xec, 0x75, 0x37, 0xdd, 0xb5, 0x65,
-	0xa8, 0xee, 0x3b, 0x34, 0x0b, 0x82, 0x16, 0x41, 0xd0, 0xc3, 0x22, 0x88, 0xf6, 0xb5, 0xe3, 0x5f,
-	0x75, 0xd4, 0xa9, 0x06, 0xff, 0x86, 0xed, 0x9f, 0x5d, 0xc5, 0xd7, 0xad, 0x03, 0xf9, 0x86, 0xf0,
-	0xfa, 0xa5, 0xf5, 0x93, 0xc7, 0x74, 0xee, 0x30, 0xe8, 0xd2, 0x00, 0x9d, 0xdd, 0x95, 0x7a, 0x33,
-	0x72, 0x6f, 0xf7, 0xf3, 0xd9, 0x9f, 0xaf, 0x57, 0x1e, 0x92, 0x06, 0xcb, 0x16, 0x55, 0x5c, 0xa5,
-	0x2f, 0x0c, 0x6f, 0xb1, 0xcb, 0x51, 0x93, 0xef, 0x08, 0x6f, 0xcc, 0x87, 0x41, 0xf6, 0xca, 0xed,
-	0x96, 0x84, 0xea, 0xd0, 0x55, 0xdb, 0x73, 0x40, 0x6a, 0x01, 0x9b, 0xe4, 0x51, 0x29, 0xe0, 0x42,
-	0xfc, 0xe4, 0x0b, 0xc2, 0xd5, 0x99, 0x88, 0x48, 0xb3, 0xdc, 0x6f, 0x31, 0x60, 0x67, 0x67, 0x85,
-	0xce, 0x1c, 0x6a, 0xc7, 0x42, 0x35, 0xc8, 0x83, 0x52, 0xa8, 0xd9, 0x53, 0x68, 0xbf, 0x39, 0x99,
-	0xb8, 0xe8, 0x74, 0xe2, 0xa2, 0xdf, 0x13, 0x17, 0x1d, 0x4f, 0xdd, 0xca, 0xe9, 0xd4, 0xad, 0xfc,
-	0x98, 0xba, 0x95, 0xf7, 0xad, 0x40, 0x9a, 0x7e, 0xe2, 0xd3, 0x1e, 0x44, 0x2c, 0x77, 0x0e, 0xb9,
-	0xaf, 0xf7, 0x24, 0x14, 0x25, 0x1b, 0x3d, 0x65, 0x9f, 0xb2, 0xd9, 0x66, 0x1c, 0x0b, 0xed, 0xdf,
-	0xb0, 0x97, 0xf4, 0xe4, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x52, 0x53, 0x61, 0xa4, 0x44, 0x04,
-	0x00, 0x00,
+	// 565 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0x12, 0x41,
+	0x00, 0x65, 0x4d, 0xe5, 0x30, 0xb4, 0x5a, 0xc7, 0x26, 0x94, 0xa5, 0x2e, 0xba, 0xa8, 0xa1, 0x36,
+	0x9d, 0x09, 0x55, 0x3f, 0x40, 0x34, 0x31, 0x6a, 0x0f, 0x48, 0x7a, 0xf2, 0x42, 0x66, 0x71, 0xba,
+	0x4c, 0x64, 0x67, 0x16, 0x66, 0x20, 0x72, 0xf5, 0x6e, 0xd2, 0xc4, 0xf8, 0x01, 0xfe, 0x4d, 0xbd,
+	0x35, 0xf1, 0x62, 0x3c, 0x54, 0x03, 0x7e, 0x88, 0xd9, 0xd9, 0x59, 0x84, 0x65, 0x31, 0x78, 0x83,
+	0x79, 0x6f, 0xdf, 0x7b, 0xcc, 0x7b, 0x2c, 0x28, 0x7b, 0xc4, 0x1b, 0xf7, 0x04, 0xc7, 0x01, 0xe3,
+	0x0a, 0x8f, 0xea, 0xb8, 0x3f, 0xa4, 0x83, 0x31, 0x0a, 0x07, 0x42, 0x09, 0x78, 0xdd, 0x80, 0x28,
+	0x02, 0xd1, 0xa8, 0x6e, 0xef, 0xf8, 0xc2, 0x17, 0x1a, 0xc3, 0xd1, 0xa7, 0x98, 0x66, 0xef, 0xf9,
+	0x42, 0xf8, 0x3d, 0x8a, 0x49, 0xc8, 0x30, 0xe1, 0x5c, 0x28, 0xa2, 0x98, 0xe0, 0xd2, 0xa0, 0x15,
+	0x83, 0xea, 0x6f, 0xde, 0xf0, 0x14, 0x2b, 0x16, 0x50, 0xa9, 0x48, 0x10, 0x1a, 0x82, 0x9d, 0x8e,
+	0xa0, 0xdd, 0x34, 0xe6, 0x96, 0x41, 0xe9, 0x75, 0x14, 0xe8, 0x05, 0x3f, 0xed, 0x69, 0xd5, 0x16,
+	0x51, 0xb4, 0x45, 0xfb, 0x43, 0x2a, 0x95, 0xdb, 0x05, 0x76, 0x16, 0x28, 0x43, 0xc1, 0x25, 0x85,
+	0x2f, 0xc1, 0x35, 0x96, 0x00, 0xed, 0x01, 0x51, 0x74, 0xd7, 0xba, 0x6d, 0xd5, 0x36, 0x1b, 0xd5,
+	0xf3, 0xcb, 0x4a, 0xee, 0xc7, 0x65, 0xa5, 0xdc, 0x11, 0x32, 0x10, 0x52, 0xbe, 0x7d, 0x87, 0x98,
+	0xc0, 0x01, 0x51, 0x5d, 0x74, 0x4c, 0x7d, 0xd2, 0x19, 0x3f, 0xa3, 0x9d, 0xd6, 0x16, 0x9b, 0xd7,
+	0x74, 0x1d, 0xb0, 0xa7, 0x9d, 0x9e, 0x70, 0x3e, 0x24, 0xbd, 0xe6, 0x40, 0x8c, 0x98, 0x8c, 0x7e,
+	0x62, 0x92, 0xa4, 0x0f, 0x6e, 0xad, 0xc0, 0x4d, 0x98, 0x26, 0xb8, 0x41, 0x34, 0xd6, 0x0e, 0x67,
+	0xe0, 0xff, 0xe4, 0xd9, 0x26, 0x29, 0x65, 0xb7, 0x04, 0x8a, 0xda, 0xf2, 0x39, 0xe5, 0x54, 0x32,
+	0x79, 0xc2, 0x82, 0xd9, 0xbd, 0xb4, 0xc1, 0xee, 0x32, 0x64, 0x82, 0x3c, 0x05, 0x9b, 0x7e, 0x7c,
+	0xdc, 0x8e, 0x7a, 0xd0, 0x19, 0x0a, 0x47, 0x36, 0x8a, 0x4b, 0x42, 0x49, 0x49, 0xe8, 0x24, 0x29,
+	0xa9, 0xb1, 0x71, 0xf6, 0xb3, 0x62, 0xb5, 0x0a, 0xfe, 0x5f, 0x31, 0x77, 0x07, 0x40, 0x6d, 0xd0,
+	0x24, 0x03, 0x12, 0xcc, 0x2e, 0xe1, 0x18, 0xdc, 0x5c, 0x38, 0x35, 0x8e, 0x8f, 0x41, 0x3e, 0xd4,
+	0x27, 0xc6, 0xab, 0x88, 0x52, 0xab, 0x42, 0xf1, 0x03, 0x8d, 0x8d, 0xe8, 0x22, 0x5a, 0x86, 0x7c,
+	0xf4, 0x75, 0x03, 0x5c, 0xd5, 0x72, 0xf0, 0xb3, 0x05, 0xb6, 0x16, 0x2a, 0x86, 0x0f, 0x96, 0x24,
+	0x56, 0x8e, 0xc4, 0x3e, 0x58, 0x8b, 0x1b, 0x67, 0x75, 0x0f, 0x3e, 0x7c, 0xfb, 0xfd, 0xe9, 0xca,
+	0x3d, 0x58, 0xc5, 0x71, 0x19, 0xc9, 0x24, 0x3d, 0xaa, 0x48, 0x1d, 0x2f, 0xce, 0x09, 0x7e, 0xb1,
+	0xc0, 0x76, 0xba, 0x70, 0x78, 0x98, 0x6d, 0xb7, 0x62, 0x38, 0x36, 0x5a, 0x97, 0x6e, 0x02, 0x22,
+	0x1d, 0xb0, 0x06, 0xef, 0x67, 0x06, 0x5c, 0x9a, 0x18, 0xfc, 0x68, 0x81, 0xc2, 0xdc, 0x0c, 0x60,
+	0x2d, 0xdb, 0x6f, 0x79, 0x44, 0xf6, 0xfe, 0x1a, 0x4c, 0x13, 0x6a, 0x5f, 0x87, 0xaa, 0xc2, 0x3b,
+	0x99, 0xa1, 0xe6, 0xe7, 0x06, 0x15, 0xc8, 0xc7, 0x6d, 0xc3, 0x6a, 0xb6, 0xfe, 0xc2, 0xa4, 0xec,
+	0xbb, 0xff, 0x26, 0x19, 0xff, 0x8a, 0xf6, 0x2f, 0xc1, 0x22, 0x4e, 0xbf, 0x49, 0xe2, 0x2d, 0x35,
+	0x5e, 0x9d, 0x4f, 0x1c, 0xeb, 0x62, 0xe2, 0x58, 0xbf, 0x26, 0x8e, 0x75, 0x36, 0x75, 0x72, 0x17,
+	0x53, 0x27, 0xf7, 0x7d, 0xea, 0xe4, 0xde, 0xd4, 0x7d, 0xa6, 0xba, 0x43, 0x0f, 0x75, 0x44, 0x90,
+	0x3c, 0xdc, 0x23, 0x9e, 0x3c, 0x64, 0x62, 0xa6, 0x35, 0x7a, 0x84, 0xdf, 0xc7, 0x82, 0x6a, 0x1c,
+	0x52, 0xe9, 0xe5, 0xf5, 0x7f, 0xe4, 0xe1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x56, 0x5b, 0x5e,
+	0xa4, 0x3a, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +429,8 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(ctx context.Context, in *QueryGenesisTimeRequest, opts ...grpc.CallOption) (*QueryGenesisTimeResponse, error)
+	// Params returns the parameters of the inflation schedule.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +468,15 @@ func (c *queryClient) GenesisTime(ctx context.Context, in *QueryGenesisTimeReque
 	return out, nil
 }
 
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/babylon.mint.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InflationRate returns the current inflation rate.
@@ -385,6 +485,8 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(context.Context, *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error)
+	// Params returns the parameters of the inflation schedule.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +502,9 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GenesisTime(ctx context.Context, req *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenesisTime not implemented")
 }
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +564,24 @@ func _Query_GenesisTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/babylon.mint.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "babylon.mint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +598,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenesisTime",
 			Handler:    _Query_GenesisTime_Handler,
 		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "babylon/mint/v1/query.proto",
@@ -648,6 +775,62 @@ func (m *QueryGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -721,6 +904,26 @@ func (m *QueryGenesisTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1129,6 +1332,139 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0